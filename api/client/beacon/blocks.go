@@ -0,0 +1,89 @@
+package beacon
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/v4/api"
+	"github.com/theQRL/qrysm/v4/api/client"
+	"github.com/theQRL/qrysm/v4/consensus-types/interfaces"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	"github.com/theQRL/qrysm/v4/runtime/version"
+)
+
+const (
+	publishBlockPath        = "/zond/v2/beacon/blocks"
+	publishBlindedBlockPath = "/zond/v2/beacon/blinded_blocks"
+)
+
+// BroadcastValidation is the validation the beacon node runs on a published block before broadcasting it.
+type BroadcastValidation string
+
+const (
+	// BroadcastValidationGossip only runs the gossip validation of the block.
+	BroadcastValidationGossip BroadcastValidation = "gossip"
+	// BroadcastValidationConsensus runs the full state transition of the block.
+	BroadcastValidationConsensus BroadcastValidation = "consensus"
+	// BroadcastValidationConsensusAndEquivocation runs the state transition of the block and checks that its
+	// proposer did not propose another block in the slot.
+	BroadcastValidationConsensusAndEquivocation BroadcastValidation = "consensus_and_equivocation"
+)
+
+// PublishBlock publishes a signed block, blinded or not, for the beacon node to broadcast and import. Deneb
+// blocks are published along with their blobs by PublishBlockContents and PublishBlindedBlockContents.
+func (c *Client) PublishBlock(
+	ctx context.Context, blk interfaces.ReadOnlySignedBeaconBlock, validation BroadcastValidation,
+) error {
+	if blk.Version() >= version.Deneb {
+		return errors.New("deneb blocks must be published with their blobs")
+	}
+	b, err := blk.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "could not marshal block")
+	}
+	p := publishBlockPath
+	if blk.IsBlinded() {
+		p = publishBlindedBlockPath
+	}
+	return c.publishBlockSSZ(ctx, p, version.String(blk.Version()), b, validation)
+}
+
+// PublishBlockContents publishes a signed deneb block and its blobs for the beacon node to broadcast and import.
+func (c *Client) PublishBlockContents(
+	ctx context.Context, contents *zondpb.SignedBeaconBlockAndBlobsDeneb, validation BroadcastValidation,
+) error {
+	b, err := contents.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "could not marshal block contents")
+	}
+	return c.publishBlockSSZ(ctx, publishBlockPath, version.String(version.Deneb), b, validation)
+}
+
+// PublishBlindedBlockContents publishes a signed blinded deneb block and its blinded blobs for the beacon node
+// to broadcast and import.
+func (c *Client) PublishBlindedBlockContents(
+	ctx context.Context, contents *zondpb.SignedBlindedBeaconBlockAndBlobsDeneb, validation BroadcastValidation,
+) error {
+	b, err := contents.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "could not marshal blinded block contents")
+	}
+	return c.publishBlockSSZ(ctx, publishBlindedBlockPath, version.String(version.Deneb), b, validation)
+}
+
+func (c *Client) publishBlockSSZ(ctx context.Context, p, ver string, body []byte, validation BroadcastValidation) error {
+	opts := []client.ReqOption{
+		client.WithSSZEncoding(),
+		client.WithSSZBody(),
+		client.WithHeader(api.VersionHeader, ver),
+	}
+	if validation != "" {
+		opts = append(opts, client.WithQueryParams(url.Values{"broadcast_validation": []string{string(validation)}}))
+	}
+	if _, _, err := c.Request(ctx, http.MethodPost, p, body, opts...); err != nil {
+		return errors.Wrapf(err, "error publishing %s block", ver)
+	}
+	return nil
+}
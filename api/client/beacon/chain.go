@@ -0,0 +1,196 @@
+package beacon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/qrysm/v4/api/client"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/shared"
+	fieldparams "github.com/theQRL/qrysm/v4/config/fieldparams"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+)
+
+const (
+	getGenesisPath     = "/zond/v1/beacon/genesis"
+	getBlockHeaderPath = "/zond/v1/beacon/headers/{{.Id}}"
+	getCommitteesPath  = "/zond/v1/beacon/states/{{.Id}}/committees"
+	getValidatorsPath  = "/zond/v1/beacon/states/{{.Id}}/validators"
+)
+
+// Genesis holds the details of the chain's genesis, which identify the chain.
+type Genesis struct {
+	Time           time.Time
+	ValidatorsRoot [32]byte
+	ForkVersion    [4]byte
+}
+
+// GetGenesis retrieves the details of the chain's genesis.
+func (c *Client) GetGenesis(ctx context.Context) (*Genesis, error) {
+	body, err := c.Get(ctx, getGenesisPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting genesis")
+	}
+	resp := &struct {
+		Data *struct {
+			GenesisTime           string `json:"genesis_time"`
+			GenesisValidatorsRoot string `json:"genesis_validators_root"`
+			GenesisForkVersion    string `json:"genesis_fork_version"`
+		} `json:"data"`
+	}{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetGenesis")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("empty genesis response")
+	}
+	genesisTime, err := strconv.ParseInt(resp.Data.GenesisTime, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid genesis time %s", resp.Data.GenesisTime)
+	}
+	root, err := shared.DecodeHexWithLength(resp.Data.GenesisValidatorsRoot, fieldparams.RootLength)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid genesis validators root")
+	}
+	forkVersion, err := shared.DecodeHexWithLength(resp.Data.GenesisForkVersion, fieldparams.VersionLength)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid genesis fork version")
+	}
+	return &Genesis{
+		Time:           time.Unix(genesisTime, 0),
+		ValidatorsRoot: bytesutil.ToBytes32(root),
+		ForkVersion:    bytesutil.ToBytes4(forkVersion),
+	}, nil
+}
+
+var getBlockHeaderTpl = idTemplate(getBlockHeaderPath)
+
+// GetBlockHeader retrieves the SignedBeaconBlockHeader of the block identified by blockId.
+// Block identifier can be one of: "head" (canonical head in node's view), "genesis", "finalized",
+// <slot>, <hex encoded blockRoot with 0x prefix>.
+func (c *Client) GetBlockHeader(ctx context.Context, blockId StateOrBlockId) (*zondpb.SignedBeaconBlockHeader, error) {
+	body, err := c.Get(ctx, getBlockHeaderTpl(blockId))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting block header by id = %s", blockId)
+	}
+	resp := &struct {
+		Data *shared.SignedBeaconBlockHeaderContainer `json:"data"`
+	}{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetBlockHeader")
+	}
+	if resp.Data == nil || resp.Data.Header == nil || resp.Data.Header.Message == nil {
+		return nil, errors.Errorf("empty block header response for id = %s", blockId)
+	}
+	return signedBlockHeaderToConsensus(resp.Data.Header)
+}
+
+// Committee is a beacon committee of a slot.
+type Committee struct {
+	Index      primitives.CommitteeIndex
+	Slot       primitives.Slot
+	Validators []primitives.ValidatorIndex
+}
+
+var getCommitteesTpl = idTemplate(getCommitteesPath)
+
+// GetCommittees retrieves all the beacon committees of the given epoch, computed from the state identified by stateId.
+func (c *Client) GetCommittees(ctx context.Context, stateId StateOrBlockId, epoch primitives.Epoch) ([]*Committee, error) {
+	query := url.Values{"epoch": []string{strconv.FormatUint(uint64(epoch), 10)}}
+	body, err := c.Get(ctx, getCommitteesTpl(stateId), client.WithQueryParams(query))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting committees of epoch %d", epoch)
+	}
+	resp := &struct {
+		Data []*shared.Committee `json:"data"`
+	}{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetCommittees")
+	}
+	committees := make([]*Committee, len(resp.Data))
+	for i, d := range resp.Data {
+		index, err := strconv.ParseUint(d.Index, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid committee index %s", d.Index)
+		}
+		slot, err := strconv.ParseUint(d.Slot, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid committee slot %s", d.Slot)
+		}
+		validators := make([]primitives.ValidatorIndex, len(d.Validators))
+		for j, v := range d.Validators {
+			idx, err := strconv.ParseUint(v, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid committee validator index %s", v)
+			}
+			validators[j] = primitives.ValidatorIndex(idx)
+		}
+		committees[i] = &Committee{
+			Index:      primitives.CommitteeIndex(index),
+			Slot:       primitives.Slot(slot),
+			Validators: validators,
+		}
+	}
+	return committees, nil
+}
+
+var getValidatorsTpl = idTemplate(getValidatorsPath)
+
+// GetValidatorCount returns the number of validators in the state identified by stateId.
+// The whole validator registry of the state is downloaded to count them.
+func (c *Client) GetValidatorCount(ctx context.Context, stateId StateOrBlockId) (int, error) {
+	body, err := c.Get(ctx, getValidatorsTpl(stateId))
+	if err != nil {
+		return 0, errors.Wrapf(err, "error requesting validators of state id = %s", stateId)
+	}
+	resp := &struct {
+		Data []json.RawMessage `json:"data"`
+	}{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return 0, errors.Wrap(err, "error decoding json response in GetValidatorCount")
+	}
+	return len(resp.Data), nil
+}
+
+func signedBlockHeaderToConsensus(h *shared.SignedBeaconBlockHeader) (*zondpb.SignedBeaconBlockHeader, error) {
+	slot, err := strconv.ParseUint(h.Message.Slot, 10, 64)
+	if err != nil {
+		return nil, shared.NewDecodeError(err, "Message.Slot")
+	}
+	proposerIndex, err := strconv.ParseUint(h.Message.ProposerIndex, 10, 64)
+	if err != nil {
+		return nil, shared.NewDecodeError(err, "Message.ProposerIndex")
+	}
+	parentRoot, err := shared.DecodeHexWithLength(h.Message.ParentRoot, fieldparams.RootLength)
+	if err != nil {
+		return nil, shared.NewDecodeError(err, "Message.ParentRoot")
+	}
+	stateRoot, err := shared.DecodeHexWithLength(h.Message.StateRoot, fieldparams.RootLength)
+	if err != nil {
+		return nil, shared.NewDecodeError(err, "Message.StateRoot")
+	}
+	bodyRoot, err := shared.DecodeHexWithLength(h.Message.BodyRoot, fieldparams.RootLength)
+	if err != nil {
+		return nil, shared.NewDecodeError(err, "Message.BodyRoot")
+	}
+	sig, err := shared.DecodeHexWithLength(h.Signature, dilithium2.CryptoBytes)
+	if err != nil {
+		return nil, shared.NewDecodeError(err, "Signature")
+	}
+	return &zondpb.SignedBeaconBlockHeader{
+		Header: &zondpb.BeaconBlockHeader{
+			Slot:          primitives.Slot(slot),
+			ProposerIndex: primitives.ValidatorIndex(proposerIndex),
+			ParentRoot:    parentRoot,
+			StateRoot:     stateRoot,
+			BodyRoot:      bodyRoot,
+		},
+		Signature: sig,
+	}, nil
+}
@@ -0,0 +1,54 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/theQRL/qrysm/v4/api/client"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
+	"github.com/theQRL/qrysm/v4/testing/require"
+)
+
+func TestGetGenesis(t *testing.T) {
+	trans := &testRT{rt: func(req *http.Request) (*http.Response, error) {
+		require.Equal(t, getGenesisPath, req.URL.Path)
+		body := `{"data":{"genesis_time":"1606824023",` +
+			`"genesis_validators_root":"0x6161616161616161616161616161616161616161616161616161616161616161",` +
+			`"genesis_fork_version":"0x00000001"}}`
+		return &http.Response{Request: req, StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewBufferString(body))}, nil
+	}}
+	c, err := NewClient("http://localhost:3500", client.WithRoundTripper(trans))
+	require.NoError(t, err)
+
+	genesis, err := c.GetGenesis(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, time.Unix(1606824023, 0), genesis.Time)
+	require.Equal(t, bytesutil.ToBytes32(bytes.Repeat([]byte{'a'}, 32)), genesis.ValidatorsRoot)
+	require.Equal(t, [4]byte{0, 0, 0, 1}, genesis.ForkVersion)
+}
+
+func TestGetCommittees(t *testing.T) {
+	trans := &testRT{rt: func(req *http.Request) (*http.Response, error) {
+		require.Equal(t, "/zond/v1/beacon/states/head/committees", req.URL.Path)
+		require.Equal(t, "3", req.URL.Query().Get("epoch"))
+		b, err := marshalToEnvelope([]*shared.Committee{
+			{Index: "1", Slot: "96", Validators: []string{"4", "2"}},
+		})
+		require.NoError(t, err)
+		return &http.Response{Request: req, StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewBuffer(b))}, nil
+	}}
+	c, err := NewClient("http://localhost:3500", client.WithRoundTripper(trans))
+	require.NoError(t, err)
+
+	committees, err := c.GetCommittees(context.Background(), IdHead, 3)
+	require.NoError(t, err)
+	require.DeepEqual(t, []*Committee{
+		{Index: 1, Slot: 96, Validators: []primitives.ValidatorIndex{4, 2}},
+	}, committees)
+}
@@ -9,6 +9,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/theQRL/go-zond/common/hexutil"
 	base "github.com/theQRL/qrysm/v4/api/client"
+	"github.com/theQRL/qrysm/v4/beacon-chain/cache/depositsnapshot"
 	"github.com/theQRL/qrysm/v4/beacon-chain/core/helpers"
 	"github.com/theQRL/qrysm/v4/beacon-chain/state"
 	"github.com/theQRL/qrysm/v4/consensus-types/interfaces"
@@ -16,6 +17,7 @@ import (
 	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
 	"github.com/theQRL/qrysm/v4/encoding/ssz/detect"
 	"github.com/theQRL/qrysm/v4/io/file"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
 	"github.com/theQRL/qrysm/v4/runtime/version"
 	"github.com/theQRL/qrysm/v4/time/slots"
 	"golang.org/x/mod/semver"
@@ -124,6 +126,53 @@ func DownloadFinalizedData(ctx context.Context, client *Client) (*OriginData, er
 	}, nil
 }
 
+// depositSnapshotAttempts bounds how often the finalized data and deposit snapshot are downloaded again when the
+// remote beacon nodes finalize a new checkpoint between the two requests.
+const depositSnapshotAttempts = 3
+
+// DownloadFinalizedDataAndDepositSnapshot downloads the most recently finalized state and block, like DownloadFinalizedData,
+// together with the EIP-4881 deposit snapshot of the same checkpoint from snapshotClient, which may be the same client.
+// The snapshot holds the finalized deposits of the
+// finalized state, so it is verified against the eth1 deposit index and the eth1 data block hash of that state. If the
+// remote beacon nodes finalize between the requests, the snapshot no longer matches the state and both are downloaded again.
+// The snapshot can be used to initialize the deposit tree of a new beacon node together with the origin data, so that it
+// does not need to process every deposit contract log.
+func DownloadFinalizedDataAndDepositSnapshot(ctx context.Context, client, snapshotClient *Client) (*OriginData, *zondpb.DepositSnapshot, error) {
+	var verifyErr error
+	for i := 0; i < depositSnapshotAttempts; i++ {
+		od, err := DownloadFinalizedData(ctx, client)
+		if err != nil {
+			return nil, nil, err
+		}
+		snapshot, err := snapshotClient.GetDepositSnapshot(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		verifyErr = depositsnapshot.VerifySnapshotProto(snapshot, od.st.Eth1DepositIndex(), od.st.Eth1Data())
+		if verifyErr == nil {
+			log.
+				WithField("deposit_count", snapshot.DepositCount).
+				WithField("deposit_root", hexutil.Encode(snapshot.DepositRoot)).
+				WithField("execution_block_hash", hexutil.Encode(snapshot.ExecutionHash)).
+				Info("Downloaded deposit snapshot.")
+			return od, snapshot, nil
+		}
+		log.WithError(verifyErr).Warn("Deposit snapshot does not match the finalized state, downloading again")
+	}
+	return nil, nil, errors.Wrap(verifyErr, "deposit snapshot does not match the finalized state")
+}
+
+// SaveDepositSnapshot saves the ssz-encoded deposit snapshot to a unique file in the given path.
+// For readability and collision avoidance, the file name includes the deposit count and root of the snapshot.
+func SaveDepositSnapshot(dir string, snapshot *zondpb.DepositSnapshot) (string, error) {
+	b, err := snapshot.MarshalSSZ()
+	if err != nil {
+		return "", errors.Wrap(err, "could not marshal deposit snapshot")
+	}
+	snapshotPath := path.Join(dir, fmt.Sprintf("deposit_snapshot_%d-%#x.ssz", snapshot.DepositCount, snapshot.DepositRoot))
+	return snapshotPath, file.WriteFile(snapshotPath, b)
+}
+
 // WeakSubjectivityData represents the state root, block root and epoch of the BeaconState + ReadOnlySignedBeaconBlock
 // that falls at the beginning of the current weak subjectivity period. These values can be used to construct
 // a weak subjectivity checkpoint beacon node flag to be used for validation.
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/theQRL/qrysm/v4/api/client"
+	"github.com/theQRL/qrysm/v4/beacon-chain/cache/depositsnapshot"
 	"github.com/theQRL/qrysm/v4/beacon-chain/state"
 	"github.com/theQRL/qrysm/v4/consensus-types/blocks"
 	blocktest "github.com/theQRL/qrysm/v4/consensus-types/blocks/testing"
@@ -20,6 +21,7 @@ import (
 
 	"github.com/theQRL/qrysm/v4/config/params"
 	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
 	"github.com/theQRL/qrysm/v4/encoding/ssz/detect"
 	"github.com/theQRL/qrysm/v4/runtime/version"
 
@@ -481,3 +483,98 @@ func TestDownloadFinalizedData(t *testing.T) {
 	require.Equal(t, expected.br, od.br)
 	require.Equal(t, expected.sr, od.sr)
 }
+
+func TestDownloadFinalizedDataAndDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	cfg := params.MainnetConfig().Copy()
+
+	tree := depositsnapshot.NewDepositTree()
+	for i := 0; i < 4; i++ {
+		require.NoError(t, tree.Insert(bytesutil.PadTo([]byte{byte(i + 1)}, 32), i))
+	}
+	root, err := tree.HashTreeRoot()
+	require.NoError(t, err)
+	// The snapshot served before the remote node finalized the checkpoint of the state.
+	require.NoError(t, tree.Finalize(1, [32]byte{'a'}, 0))
+	stale, err := tree.ToProto()
+	require.NoError(t, err)
+	msStale, err := stale.MarshalSSZ()
+	require.NoError(t, err)
+	// The deposits of the finalized state, with the last deposit still pending.
+	require.NoError(t, tree.Finalize(2, [32]byte{'b'}, 0))
+	snapshot, err := tree.ToProto()
+	require.NoError(t, err)
+	msSnapshot, err := snapshot.MarshalSSZ()
+	require.NoError(t, err)
+
+	slot, err := slots.EpochStart(cfg.AltairForkEpoch - 1)
+	require.NoError(t, err)
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(slot))
+	require.NoError(t, st.SetEth1DepositIndex(3))
+	require.NoError(t, st.SetEth1Data(&zondpb.Eth1Data{
+		DepositRoot:  root[:],
+		DepositCount: 4,
+		BlockHash:    snapshot.ExecutionHash,
+	}))
+	b, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlock())
+	require.NoError(t, err)
+	b, err = blocktest.SetBlockSlot(b, slot)
+	require.NoError(t, err)
+	header, err := b.Header()
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(header.Header))
+	sr, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	b, err = blocktest.SetBlockStateRoot(b, sr)
+	require.NoError(t, err)
+	mb, err := b.MarshalSSZ()
+	require.NoError(t, err)
+	ms, err := st.MarshalSSZ()
+	require.NoError(t, err)
+
+	newClient := func(snapshots ...[]byte) *Client {
+		trans := &testRT{rt: func(req *http.Request) (*http.Response, error) {
+			res := &http.Response{Request: req}
+			switch req.URL.Path {
+			case renderGetStatePath(IdFinalized):
+				res.StatusCode = http.StatusOK
+				res.Body = io.NopCloser(bytes.NewBuffer(ms))
+			case renderGetBlockPath(IdFromSlot(slot)):
+				res.StatusCode = http.StatusOK
+				res.Body = io.NopCloser(bytes.NewBuffer(mb))
+			case getDepositSnapshotPath:
+				res.StatusCode = http.StatusOK
+				res.Body = io.NopCloser(bytes.NewBuffer(snapshots[0]))
+				if len(snapshots) > 1 {
+					snapshots = snapshots[1:]
+				}
+			default:
+				res.StatusCode = http.StatusInternalServerError
+				res.Body = io.NopCloser(bytes.NewBufferString(""))
+			}
+			return res, nil
+		}}
+		c, err := NewClient("http://localhost:3500", client.WithRoundTripper(trans))
+		require.NoError(t, err)
+		return c
+	}
+
+	c := newClient(msSnapshot)
+	od, got, err := DownloadFinalizedDataAndDepositSnapshot(ctx, c, c)
+	require.NoError(t, err)
+	require.Equal(t, sr, od.sr)
+	require.DeepEqual(t, snapshot, got)
+
+	// The snapshot is downloaded again with the finalized data when they do not match.
+	c = newClient(msStale, msSnapshot)
+	od, got, err = DownloadFinalizedDataAndDepositSnapshot(ctx, c, c)
+	require.NoError(t, err)
+	require.Equal(t, sr, od.sr)
+	require.DeepEqual(t, snapshot, got)
+
+	c = newClient(msStale)
+	_, _, err = DownloadFinalizedDataAndDepositSnapshot(ctx, c, c)
+	require.ErrorContains(t, "deposit snapshot does not match", err)
+}
@@ -36,6 +36,7 @@ const (
 	getConfigSpecPath              = "/zond/v1/config/spec"
 	getStatePath                   = "/zond/v2/debug/beacon/states"
 	getNodeVersionPath             = "/zond/v1/node/version"
+	getDepositSnapshotPath         = "/zond/v1/beacon/deposit_snapshot"
 	changeDilithiumtoExecutionPath = "/zond/v1/beacon/pool/dilithium_to_execution_changes"
 )
 
@@ -248,6 +249,19 @@ func (c *Client) GetState(ctx context.Context, stateId StateOrBlockId) ([]byte,
 	return b, nil
 }
 
+// GetDepositSnapshot retrieves the EIP-4881 deposit snapshot of the finalized deposits known to the beacon node.
+func (c *Client) GetDepositSnapshot(ctx context.Context) (*zondpb.DepositSnapshot, error) {
+	b, err := c.Get(ctx, getDepositSnapshotPath, client.WithSSZEncoding())
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting deposit snapshot")
+	}
+	snapshot := &zondpb.DepositSnapshot{}
+	if err := snapshot.UnmarshalSSZ(b); err != nil {
+		return nil, errors.Wrap(err, "error decoding ssz-encoded deposit snapshot")
+	}
+	return snapshot, nil
+}
+
 // GetWeakSubjectivity calls a proposed API endpoint that is unique to prysm
 // This api method does the following:
 // - computes weak subjectivity epoch
@@ -363,3 +377,35 @@ func (fsr *forkScheduleResponse) OrderedForkSchedule() (forks.OrderedSchedule, e
 	sort.Sort(ofs)
 	return ofs, nil
 }
+
+// getJson sends a GET request to the path, decoding the JSON response into resp.
+func (c *Client) getJson(ctx context.Context, path string, resp interface{}, opts ...client.ReqOption) error {
+	body, err := c.Get(ctx, path, opts...)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return errors.Wrapf(err, "error decoding json response of %s", path)
+	}
+	return nil
+}
+
+// postJson sends req as the JSON body of a POST request to the path, decoding the JSON response into resp
+// unless resp is nil.
+func (c *Client) postJson(ctx context.Context, path string, req, resp interface{}, opts ...client.ReqOption) error {
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+	body, err := c.Post(ctx, path, reqBody, opts...)
+	if err != nil {
+		return err
+	}
+	if resp == nil {
+		return nil
+	}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return errors.Wrapf(err, "error decoding json response of %s", path)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package beacon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/v4/api/client"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/prysm/debug"
+)
+
+const getForkChoiceGraphPath = "/qrysm/v1/debug/fork_choice/graph"
+
+// GetForkChoiceGraph retrieves the tree of blocks in the live fork choice store of the beacon node, annotated
+// with their weights, execution validity, justification and the proposer boost.
+func (c *Client) GetForkChoiceGraph(ctx context.Context) (*debug.ForkChoiceGraph, error) {
+	body, err := c.Get(ctx, getForkChoiceGraphPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting fork choice graph")
+	}
+	resp := &debug.ForkChoiceGraphResponse{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetForkChoiceGraph")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("empty fork choice graph response")
+	}
+	return resp.Data, nil
+}
+
+// GetForkChoiceGraphDOT retrieves the fork choice tree of the beacon node rendered in the Graphviz DOT language.
+func (c *Client) GetForkChoiceGraphDOT(ctx context.Context) ([]byte, error) {
+	query := url.Values{"format": []string{"dot"}}
+	body, err := c.Get(ctx, getForkChoiceGraphPath, client.WithQueryParams(query))
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting fork choice graph")
+	}
+	return body, nil
+}
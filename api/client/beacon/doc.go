@@ -1,5 +1,10 @@
 /*
 Package beacon provides a client for interacting with the standard Eth Beacon Node API.
 Interactive swagger documentation for the API is available here: https://ethereum.github.io/beacon-APIs/
+
+The client covers the routes served by the beacon node, including the qrysm specific ones, and decodes
+their responses into the JSON types of the handlers serving them. Endpoints able to serve SSZ are requested
+with SSZ/JSON content negotiation, lookups of long lists of validators and light client update ranges are
+split into pages, and idempotent requests can be retried with client.WithRetries.
 */
 package beacon
@@ -0,0 +1,68 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	ethvalidator "github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/validator"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+)
+
+const (
+	attesterDutiesPath = "/zond/v1/validator/duties/attester/%d"
+	proposerDutiesPath = "/zond/v1/validator/duties/proposer/%d"
+	syncDutiesPath     = "/zond/v1/validator/duties/sync/%d"
+	livenessPath       = "/zond/v1/validator/liveness/%d"
+)
+
+// GetAttesterDuties retrieves the attestation duties of the given validators in the epoch.
+func (c *Client) GetAttesterDuties(
+	ctx context.Context, epoch primitives.Epoch, indices []primitives.ValidatorIndex,
+) (*ethvalidator.GetAttesterDutiesResponse, error) {
+	resp := &ethvalidator.GetAttesterDutiesResponse{}
+	if err := c.postJson(ctx, fmt.Sprintf(attesterDutiesPath, epoch), indicesToStrings(indices), resp); err != nil {
+		return nil, errors.Wrapf(err, "error requesting attester duties of epoch %d", epoch)
+	}
+	return resp, nil
+}
+
+// GetProposerDuties retrieves the block proposers of every slot of the epoch.
+func (c *Client) GetProposerDuties(ctx context.Context, epoch primitives.Epoch) (*ethvalidator.GetProposerDutiesResponse, error) {
+	resp := &ethvalidator.GetProposerDutiesResponse{}
+	if err := c.getJson(ctx, fmt.Sprintf(proposerDutiesPath, epoch), resp); err != nil {
+		return nil, errors.Wrapf(err, "error requesting proposer duties of epoch %d", epoch)
+	}
+	return resp, nil
+}
+
+// GetSyncCommitteeDuties retrieves the sync committee duties of the given validators in the epoch.
+func (c *Client) GetSyncCommitteeDuties(
+	ctx context.Context, epoch primitives.Epoch, indices []primitives.ValidatorIndex,
+) (*ethvalidator.GetSyncCommitteeDutiesResponse, error) {
+	resp := &ethvalidator.GetSyncCommitteeDutiesResponse{}
+	if err := c.postJson(ctx, fmt.Sprintf(syncDutiesPath, epoch), indicesToStrings(indices), resp); err != nil {
+		return nil, errors.Wrapf(err, "error requesting sync committee duties of epoch %d", epoch)
+	}
+	return resp, nil
+}
+
+// GetLiveness reports whether each of the given validators was seen to be active in the epoch.
+func (c *Client) GetLiveness(
+	ctx context.Context, epoch primitives.Epoch, indices []primitives.ValidatorIndex,
+) ([]*ethvalidator.ValidatorLiveness, error) {
+	resp := &ethvalidator.GetLivenessResponse{}
+	if err := c.postJson(ctx, fmt.Sprintf(livenessPath, epoch), indicesToStrings(indices), resp); err != nil {
+		return nil, errors.Wrapf(err, "error requesting liveness of epoch %d", epoch)
+	}
+	return resp.Data, nil
+}
+
+func indicesToStrings(indices []primitives.ValidatorIndex) []string {
+	s := make([]string, len(indices))
+	for i, idx := range indices {
+		s[i] = strconv.FormatUint(uint64(idx), 10)
+	}
+	return s
+}
@@ -0,0 +1,145 @@
+package beacon
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/v4/api/client"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/apimiddleware"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/events"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/shared"
+	slasherprysm "github.com/theQRL/qrysm/v4/beacon-chain/rpc/prysm/slasher"
+	"github.com/theQRL/qrysm/v4/runtime/version"
+)
+
+const (
+	eventsPath = "/zond/v1/events"
+	// maxEventSize bounds the size of a single line of the event stream, which holds the JSON data of an event.
+	maxEventSize = 1 << 24
+)
+
+// Event is a server-sent event of the beacon node.
+type Event struct {
+	Topic string
+	Data  json.RawMessage
+}
+
+// Decode decodes the data of the event into the JSON type of its topic, like *apimiddleware.EventHeadJson for
+// head events or *shared.AttesterSlashing for the attester slashings streamed by the slasher.
+func (e *Event) Decode() (interface{}, error) {
+	var data interface{}
+	switch e.Topic {
+	case events.HeadTopic:
+		data = &apimiddleware.EventHeadJson{}
+	case events.BlockTopic:
+		data = &apimiddleware.ReceivedBlockDataJson{}
+	case events.AttestationTopic:
+		data = &apimiddleware.AttestationJson{}
+	case events.VoluntaryExitTopic:
+		data = &apimiddleware.SignedVoluntaryExitJson{}
+	case events.FinalizedCheckpointTopic:
+		data = &apimiddleware.EventFinalizedCheckpointJson{}
+	case events.ChainReorgTopic:
+		data = &apimiddleware.EventChainReorgJson{}
+	case events.SyncCommitteeContributionTopic:
+		data = &apimiddleware.SignedContributionAndProofJson{}
+	case events.DilithiumToExecutionChangeTopic:
+		data = &apimiddleware.SignedDilithiumToExecutionChangeJson{}
+	case events.LightClientFinalityUpdateTopic:
+		data = &apimiddleware.EventLightClientFinalityUpdateJson{}
+	case events.LightClientOptimisticUpdateTopic:
+		data = &apimiddleware.EventLightClientOptimisticUpdateJson{}
+	case events.PayloadAttributesTopic:
+		v := &struct {
+			Version string `json:"version"`
+		}{}
+		if err := json.Unmarshal(e.Data, v); err != nil {
+			return nil, errors.Wrap(err, "could not decode payload attributes version")
+		}
+		if v.Version == version.String(version.Bellatrix) {
+			data = &apimiddleware.EventPayloadAttributeStreamV1Json{}
+		} else {
+			data = &apimiddleware.EventPayloadAttributeStreamV2Json{}
+		}
+	case slasherprysm.AttesterSlashingTopic:
+		data = &shared.AttesterSlashing{}
+	case slasherprysm.ProposerSlashingTopic:
+		data = &shared.ProposerSlashing{}
+	case "error":
+		data = &apimiddleware.EventErrorJson{}
+	default:
+		return nil, errors.Errorf("unknown event topic %s", e.Topic)
+	}
+	if err := json.Unmarshal(e.Data, data); err != nil {
+		return nil, errors.Wrapf(err, "could not decode %s event", e.Topic)
+	}
+	return data, nil
+}
+
+// SubscribeEvents streams the events of the given topics, defined in the events package, calling handler with
+// each of them. It returns when the context is done, the beacon node ends the stream, or the stream fails.
+func (c *Client) SubscribeEvents(ctx context.Context, topics []string, handler func(*Event)) error {
+	query := url.Values{"topics": []string{strings.Join(topics, ",")}}
+	if err := c.subscribe(ctx, eventsPath, query, handler); err != nil {
+		return errors.Wrap(err, "error streaming events")
+	}
+	return nil
+}
+
+// SubscribeSlasherEvents streams the slashings detected by the slasher of the beacon node, calling handler with
+// each of them. It returns when the context is done, the beacon node ends the stream, or the stream fails.
+func (c *Client) SubscribeSlasherEvents(ctx context.Context, handler func(*Event)) error {
+	if err := c.subscribe(ctx, slasherEventsPath, nil, handler); err != nil {
+		return errors.Wrap(err, "error streaming slasher events")
+	}
+	return nil
+}
+
+func (c *Client) subscribe(ctx context.Context, p string, query url.Values, handler func(*Event)) error {
+	u := c.BaseURL().ResolveReference(&url.URL{Path: p, RawQuery: query.Encode()})
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	// The stream is long-lived, so it must not be subject to the timeout of the client.
+	resp, err := (&http.Client{Transport: c.Transport()}).Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return client.Non200Err(resp)
+	}
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxEventSize)
+	event := &Event{}
+	var data []string
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			// A blank line dispatches the event.
+			if len(data) > 0 {
+				event.Data = json.RawMessage(strings.Join(data, "\n"))
+				handler(event)
+			}
+			event, data = &Event{}, nil
+		case strings.HasPrefix(line, "event:"):
+			event.Topic = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			data = append(data, strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return scanner.Err()
+}
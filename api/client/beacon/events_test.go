@@ -0,0 +1,64 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/theQRL/qrysm/v4/api/client"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/apimiddleware"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/events"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/theQRL/qrysm/v4/testing/require"
+)
+
+func TestSubscribeEvents(t *testing.T) {
+	stream := ": keepalive\n\n" +
+		"event: head\ndata: {\"slot\":\"4\",\"block\":\"0x01\"}\n\n" +
+		"event: proposer_slashing\ndata: {\"signed_header_1\":null,\n" +
+		"data: \"signed_header_2\":null}\n\n"
+	trans := &testRT{rt: func(req *http.Request) (*http.Response, error) {
+		require.Equal(t, "/zond/v1/events", req.URL.Path)
+		require.Equal(t, "head,proposer_slashing", req.URL.Query().Get("topics"))
+		require.Equal(t, "text/event-stream", req.Header.Get("Accept"))
+		return &http.Response{Request: req, StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewBufferString(stream))}, nil
+	}}
+	c, err := NewClient("http://localhost:3500", client.WithRoundTripper(trans))
+	require.NoError(t, err)
+
+	var received []*Event
+	err = c.SubscribeEvents(context.Background(), []string{events.HeadTopic, "proposer_slashing"}, func(e *Event) {
+		received = append(received, e)
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(received))
+
+	require.Equal(t, events.HeadTopic, received[0].Topic)
+	head, err := received[0].Decode()
+	require.NoError(t, err)
+	require.Equal(t, "4", head.(*apimiddleware.EventHeadJson).Slot)
+
+	require.Equal(t, "proposer_slashing", received[1].Topic)
+	slashing, err := received[1].Decode()
+	require.NoError(t, err)
+	_, ok := slashing.(*shared.ProposerSlashing)
+	require.Equal(t, true, ok)
+}
+
+func TestSubscribeEvents_Non200(t *testing.T) {
+	trans := &testRT{rt: func(req *http.Request) (*http.Response, error) {
+		return &http.Response{Request: req, StatusCode: http.StatusBadRequest, Body: io.NopCloser(bytes.NewBuffer(nil))}, nil
+	}}
+	c, err := NewClient("http://localhost:3500", client.WithRoundTripper(trans))
+	require.NoError(t, err)
+
+	err = c.SubscribeEvents(context.Background(), []string{"unknown"}, func(*Event) {})
+	require.ErrorIs(t, err, client.ErrNotOK)
+}
+
+func TestEventDecode_UnknownTopic(t *testing.T) {
+	_, err := (&Event{Topic: "unknown", Data: []byte("{}")}).Decode()
+	require.ErrorContains(t, "unknown event topic", err)
+}
@@ -0,0 +1,76 @@
+package beacon
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/go-zond/common/hexutil"
+	"github.com/theQRL/qrysm/v4/api/client"
+	lightclient "github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/light-client"
+)
+
+const (
+	lightClientBootstrapPath        = "/zond/v1/beacon/light_client/bootstrap/"
+	lightClientUpdatesPath          = "/zond/v1/beacon/light_client/updates"
+	lightClientFinalityUpdatePath   = "/zond/v1/beacon/light_client/finality_update"
+	lightClientOptimisticUpdatePath = "/zond/v1/beacon/light_client/optimistic_update"
+)
+
+// GetLightClientBootstrap retrieves the light client bootstrap of the block with the given root.
+func (c *Client) GetLightClientBootstrap(ctx context.Context, blockRoot [32]byte) (*lightclient.LightClientBootstrapResponse, error) {
+	resp := &lightclient.LightClientBootstrapResponse{}
+	if err := c.getJson(ctx, lightClientBootstrapPath+hexutil.Encode(blockRoot[:]), resp); err != nil {
+		return nil, errors.Wrapf(err, "error requesting light client bootstrap of block %#x", blockRoot)
+	}
+	return resp, nil
+}
+
+// GetLightClientUpdates retrieves the best light client updates of count sync committee periods starting at
+// startPeriod. The beacon node serves a limited number of updates per request, so the range is requested in
+// pages until it is covered or the beacon node runs out of updates.
+func (c *Client) GetLightClientUpdates(
+	ctx context.Context, startPeriod, count uint64,
+) ([]*lightclient.LightClientUpdateWithVersion, error) {
+	var updates []*lightclient.LightClientUpdateWithVersion
+	for count > 0 {
+		pageSize := count
+		if pageSize > lightclient.MaxRequestLightClientUpdates {
+			pageSize = lightclient.MaxRequestLightClientUpdates
+		}
+		query := url.Values{
+			"start_period": []string{strconv.FormatUint(startPeriod, 10)},
+			"count":        []string{strconv.FormatUint(pageSize, 10)},
+		}
+		var page []*lightclient.LightClientUpdateWithVersion
+		if err := c.getJson(ctx, lightClientUpdatesPath, &page, client.WithQueryParams(query)); err != nil {
+			return nil, errors.Wrapf(err, "error requesting light client updates from period %d", startPeriod)
+		}
+		updates = append(updates, page...)
+		if uint64(len(page)) < pageSize {
+			break
+		}
+		startPeriod += pageSize
+		count -= pageSize
+	}
+	return updates, nil
+}
+
+// GetLightClientFinalityUpdate retrieves the latest light client finality update known to the beacon node.
+func (c *Client) GetLightClientFinalityUpdate(ctx context.Context) (*lightclient.LightClientFinalityUpdateResponse, error) {
+	resp := &lightclient.LightClientFinalityUpdateResponse{}
+	if err := c.getJson(ctx, lightClientFinalityUpdatePath, resp); err != nil {
+		return nil, errors.Wrap(err, "error requesting light client finality update")
+	}
+	return resp, nil
+}
+
+// GetLightClientOptimisticUpdate retrieves the latest light client optimistic update known to the beacon node.
+func (c *Client) GetLightClientOptimisticUpdate(ctx context.Context) (*lightclient.LightClientOptimisticUpdateResponse, error) {
+	resp := &lightclient.LightClientOptimisticUpdateResponse{}
+	if err := c.getJson(ctx, lightClientOptimisticUpdatePath, resp); err != nil {
+		return nil, errors.Wrap(err, "error requesting light client optimistic update")
+	}
+	return resp, nil
+}
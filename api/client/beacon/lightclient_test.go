@@ -0,0 +1,46 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/theQRL/qrysm/v4/api/client"
+	lightclient "github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/light-client"
+	"github.com/theQRL/qrysm/v4/testing/require"
+)
+
+func TestGetLightClientUpdates_Paginated(t *testing.T) {
+	const available = lightclient.MaxRequestLightClientUpdates + 10
+	var starts []uint64
+	trans := &testRT{rt: func(req *http.Request) (*http.Response, error) {
+		start, err := strconv.ParseUint(req.URL.Query().Get("start_period"), 10, 64)
+		require.NoError(t, err)
+		count, err := strconv.ParseUint(req.URL.Query().Get("count"), 10, 64)
+		require.NoError(t, err)
+		require.Equal(t, true, count <= lightclient.MaxRequestLightClientUpdates)
+		starts = append(starts, start)
+		var updates []*lightclient.LightClientUpdateWithVersion
+		for p := start; p < start+count && p < available; p++ {
+			updates = append(updates, &lightclient.LightClientUpdateWithVersion{
+				Version: "capella",
+				Data:    &lightclient.LightClientUpdate{SignatureSlot: strconv.FormatUint(p, 10)},
+			})
+		}
+		b, err := json.Marshal(updates)
+		require.NoError(t, err)
+		return &http.Response{Request: req, StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewBuffer(b))}, nil
+	}}
+	c, err := NewClient("http://localhost:3500", client.WithRoundTripper(trans))
+	require.NoError(t, err)
+
+	updates, err := c.GetLightClientUpdates(context.Background(), 0, 1000)
+	require.NoError(t, err)
+	require.DeepEqual(t, []uint64{0, lightclient.MaxRequestLightClientUpdates}, starts)
+	require.Equal(t, available, len(updates))
+	require.Equal(t, strconv.Itoa(available-1), updates[available-1].Data.SignatureSlot)
+}
@@ -0,0 +1,70 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/v4/api/client"
+	monitorprysm "github.com/theQRL/qrysm/v4/beacon-chain/rpc/prysm/monitor"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+)
+
+const (
+	monitorValidatorsPath  = "/qrysm/v1/monitor/validators"
+	monitorValidatorPath   = "/qrysm/v1/monitor/validators/%d"
+	monitorPerformancePath = "/qrysm/v1/monitor/validators/%d/history"
+)
+
+// GetTrackedValidators retrieves the indices of the validators tracked by the validator monitor of the beacon node.
+func (c *Client) GetTrackedValidators(ctx context.Context) ([]primitives.ValidatorIndex, error) {
+	resp := &monitorprysm.TrackedValidatorsResponse{}
+	if err := c.getJson(ctx, monitorValidatorsPath, resp); err != nil {
+		return nil, errors.Wrap(err, "error requesting tracked validators")
+	}
+	return parseIndices(resp.Data)
+}
+
+// TrackValidators starts tracking the validators with the validator monitor of the beacon node, returning the
+// indices of all the tracked validators.
+func (c *Client) TrackValidators(ctx context.Context, indices []primitives.ValidatorIndex) ([]primitives.ValidatorIndex, error) {
+	resp := &monitorprysm.TrackedValidatorsResponse{}
+	if err := c.postJson(ctx, monitorValidatorsPath, indicesToStrings(indices), resp); err != nil {
+		return nil, errors.Wrap(err, "error tracking validators")
+	}
+	return parseIndices(resp.Data)
+}
+
+// UntrackValidator stops tracking the validator with the validator monitor of the beacon node.
+func (c *Client) UntrackValidator(ctx context.Context, idx primitives.ValidatorIndex) error {
+	if _, err := c.Delete(ctx, fmt.Sprintf(monitorValidatorPath, idx)); err != nil {
+		return errors.Wrapf(err, "error untracking validator %d", idx)
+	}
+	return nil
+}
+
+// GetPerformanceHistory retrieves the performance of the validator recorded by the validator monitor of the
+// beacon node at each epoch of the epoch range.
+func (c *Client) GetPerformanceHistory(
+	ctx context.Context, idx primitives.ValidatorIndex, epochs EpochRange,
+) ([]*monitorprysm.ValidatorEpochPerformance, error) {
+	resp := &monitorprysm.PerformanceHistoryResponse{}
+	query := epochs.query("from_epoch", "to_epoch")
+	if err := c.getJson(ctx, fmt.Sprintf(monitorPerformancePath, idx), resp, client.WithQueryParams(query)); err != nil {
+		return nil, errors.Wrapf(err, "error requesting performance history of validator %d", idx)
+	}
+	return resp.Data, nil
+}
+
+func parseIndices(s []string) ([]primitives.ValidatorIndex, error) {
+	indices := make([]primitives.ValidatorIndex, len(s))
+	for i, raw := range s {
+		idx, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid validator index %s", raw)
+		}
+		indices[i] = primitives.ValidatorIndex(idx)
+	}
+	return indices, nil
+}
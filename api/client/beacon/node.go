@@ -0,0 +1,151 @@
+package beacon
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/v4/api/client"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/apimiddleware"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/shared"
+	nodeprysm "github.com/theQRL/qrysm/v4/beacon-chain/rpc/prysm/node"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+)
+
+const (
+	getSyncStatusPath = "/zond/v1/node/syncing"
+	getIdentityPath   = "/zond/v1/node/identity"
+	getPeersPath      = "/zond/v1/node/peers"
+	getPeerCountPath  = "/zond/v1/node/peer_count"
+	getHealthPath     = "/zond/v1/node/health"
+	trustedPeersPath  = "/qrysm/node/trusted_peers"
+)
+
+// SyncStatus describes the sync status of the beacon node.
+type SyncStatus struct {
+	HeadSlot     primitives.Slot
+	SyncDistance primitives.Slot
+	IsSyncing    bool
+	IsOptimistic bool
+	ElOffline    bool
+}
+
+// GetSyncStatus retrieves the sync status of the beacon node.
+func (c *Client) GetSyncStatus(ctx context.Context) (*SyncStatus, error) {
+	body, err := c.Get(ctx, getSyncStatusPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting sync status")
+	}
+	resp := &shared.SyncDetailsContainer{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetSyncStatus")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("empty sync status response")
+	}
+	headSlot, err := strconv.ParseUint(resp.Data.HeadSlot, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid head slot %s", resp.Data.HeadSlot)
+	}
+	syncDistance, err := strconv.ParseUint(resp.Data.SyncDistance, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid sync distance %s", resp.Data.SyncDistance)
+	}
+	return &SyncStatus{
+		HeadSlot:     primitives.Slot(headSlot),
+		SyncDistance: primitives.Slot(syncDistance),
+		IsSyncing:    resp.Data.IsSyncing,
+		IsOptimistic: resp.Data.IsOptimistic,
+		ElOffline:    resp.Data.ElOffline,
+	}, nil
+}
+
+// GetIdentity retrieves the network identity of the beacon node.
+func (c *Client) GetIdentity(ctx context.Context) (*apimiddleware.IdentityJson, error) {
+	resp := &apimiddleware.IdentityResponseJson{}
+	if err := c.getJson(ctx, getIdentityPath, resp); err != nil {
+		return nil, errors.Wrap(err, "error requesting node identity")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("empty node identity response")
+	}
+	return resp.Data, nil
+}
+
+// GetPeers retrieves the peers of the beacon node, optionally filtered by connection states and directions.
+func (c *Client) GetPeers(ctx context.Context, states, directions []string) ([]*apimiddleware.PeerJson, error) {
+	query := url.Values{"state": states, "direction": directions}
+	resp := &apimiddleware.PeersResponseJson{}
+	if err := c.getJson(ctx, getPeersPath, resp, client.WithQueryParams(query)); err != nil {
+		return nil, errors.Wrap(err, "error requesting peers")
+	}
+	return resp.Data, nil
+}
+
+// GetPeer retrieves the peer of the beacon node with the given id.
+func (c *Client) GetPeer(ctx context.Context, peerId string) (*apimiddleware.PeerJson, error) {
+	resp := &apimiddleware.PeerResponseJson{}
+	if err := c.getJson(ctx, getPeersPath+"/"+url.PathEscape(peerId), resp); err != nil {
+		return nil, errors.Wrapf(err, "error requesting peer %s", peerId)
+	}
+	if resp.Data == nil {
+		return nil, errors.Errorf("empty peer response for id = %s", peerId)
+	}
+	return resp.Data, nil
+}
+
+// GetPeerCount retrieves the number of peers of the beacon node in each connection state.
+func (c *Client) GetPeerCount(ctx context.Context) (*apimiddleware.PeerCountResponse_PeerCountJson, error) {
+	resp := &apimiddleware.PeerCountResponseJson{}
+	if err := c.getJson(ctx, getPeerCountPath, resp); err != nil {
+		return nil, errors.Wrap(err, "error requesting peer count")
+	}
+	return &resp.Data, nil
+}
+
+// GetHealth returns the status code of the health endpoint of the beacon node: 200 when it is ready,
+// 206 while it is syncing, and 503 when it is not initialized or has issues.
+func (c *Client) GetHealth(ctx context.Context) (int, error) {
+	u := c.BaseURL().ResolveReference(&url.URL{Path: getHealthPath})
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return 0, errors.Wrap(err, "error requesting node health")
+	}
+	if err := resp.Body.Close(); err != nil {
+		return 0, err
+	}
+	return resp.StatusCode, nil
+}
+
+// GetTrustedPeers retrieves the peers of the beacon node's trusted peer set.
+func (c *Client) GetTrustedPeers(ctx context.Context) ([]*nodeprysm.Peer, error) {
+	resp := &nodeprysm.PeersResponse{}
+	if err := c.getJson(ctx, trustedPeersPath, resp); err != nil {
+		return nil, errors.Wrap(err, "error requesting trusted peers")
+	}
+	return resp.Peers, nil
+}
+
+// AddTrustedPeer adds the peer with the given multiaddress, which must include its peer id, to the beacon node's
+// trusted peer set.
+func (c *Client) AddTrustedPeer(ctx context.Context, addr string) error {
+	if err := c.postJson(ctx, trustedPeersPath, &nodeprysm.AddrRequest{Addr: addr}, nil); err != nil {
+		return errors.Wrapf(err, "error adding trusted peer %s", addr)
+	}
+	return nil
+}
+
+// RemoveTrustedPeer removes the peer with the given id from the beacon node's trusted peer set.
+func (c *Client) RemoveTrustedPeer(ctx context.Context, peerId string) error {
+	if _, err := c.Delete(ctx, trustedPeersPath+"/"+url.PathEscape(peerId)); err != nil {
+		return errors.Wrapf(err, "error removing trusted peer %s", peerId)
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package beacon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/go-zond/common/hexutil"
+	"github.com/theQRL/qrysm/v4/api/client"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/apimiddleware"
+	ethbeacon "github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/beacon"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+)
+
+const (
+	attesterSlashingsPath  = "/zond/v1/beacon/pool/attester_slashings"
+	proposerSlashingsPath  = "/zond/v1/beacon/pool/proposer_slashings"
+	poolAttestationsPath   = "/zond/v1/beacon/pool/attestations"
+	poolVoluntaryExitsPath = "/zond/v1/beacon/pool/voluntary_exits"
+	poolSyncCommitteesPath = "/zond/v1/beacon/pool/sync_committees"
+)
+
+// GetPoolAttestations retrieves the attestations in the operations pool of the beacon node, optionally filtered
+// by slot and committee index.
+func (c *Client) GetPoolAttestations(
+	ctx context.Context, slot *primitives.Slot, committeeIndex *primitives.CommitteeIndex,
+) ([]*shared.Attestation, error) {
+	query := url.Values{}
+	if slot != nil {
+		query.Set("slot", strconv.FormatUint(uint64(*slot), 10))
+	}
+	if committeeIndex != nil {
+		query.Set("committee_index", strconv.FormatUint(uint64(*committeeIndex), 10))
+	}
+	resp := &ethbeacon.ListAttestationsResponse{}
+	if err := c.getJson(ctx, poolAttestationsPath, resp, client.WithQueryParams(query)); err != nil {
+		return nil, errors.Wrap(err, "error requesting pool attestations")
+	}
+	return resp.Data, nil
+}
+
+// SubmitAttestations submits attestations to the beacon node, which verifies them before adding them to its
+// operations pool and broadcasting them.
+func (c *Client) SubmitAttestations(ctx context.Context, atts []*shared.Attestation) error {
+	if err := c.postJson(ctx, poolAttestationsPath, atts, nil); err != nil {
+		return errors.Wrap(err, "error submitting attestations")
+	}
+	return nil
+}
+
+// GetPoolVoluntaryExits retrieves the voluntary exits in the operations pool of the beacon node.
+func (c *Client) GetPoolVoluntaryExits(ctx context.Context) ([]*shared.SignedVoluntaryExit, error) {
+	resp := &ethbeacon.ListVoluntaryExitsResponse{}
+	if err := c.getJson(ctx, poolVoluntaryExitsPath, resp); err != nil {
+		return nil, errors.Wrap(err, "error requesting pool voluntary exits")
+	}
+	return resp.Data, nil
+}
+
+// SubmitVoluntaryExit submits a voluntary exit to the beacon node, which verifies it before adding it to its
+// operations pool and broadcasting it.
+func (c *Client) SubmitVoluntaryExit(ctx context.Context, exit *shared.SignedVoluntaryExit) error {
+	if err := c.postJson(ctx, poolVoluntaryExitsPath, exit, nil); err != nil {
+		return errors.Wrap(err, "error submitting voluntary exit")
+	}
+	return nil
+}
+
+// SubmitSyncCommitteeSignatures submits sync committee messages to the beacon node, which verifies them before
+// broadcasting them.
+func (c *Client) SubmitSyncCommitteeSignatures(ctx context.Context, msgs []*shared.SyncCommitteeMessage) error {
+	if err := c.postJson(ctx, poolSyncCommitteesPath, msgs, nil); err != nil {
+		return errors.Wrap(err, "error submitting sync committee signatures")
+	}
+	return nil
+}
+
+// GetPoolAttesterSlashings retrieves the attester slashings in the operations pool of the beacon node.
+func (c *Client) GetPoolAttesterSlashings(ctx context.Context) ([]*apimiddleware.AttesterSlashingJson, error) {
+	resp := &apimiddleware.AttesterSlashingsPoolResponseJson{}
+	if err := c.getJson(ctx, attesterSlashingsPath, resp); err != nil {
+		return nil, errors.Wrap(err, "error requesting pool attester slashings")
+	}
+	return resp.Data, nil
+}
+
+// GetPoolProposerSlashings retrieves the proposer slashings in the operations pool of the beacon node.
+func (c *Client) GetPoolProposerSlashings(ctx context.Context) ([]*apimiddleware.ProposerSlashingJson, error) {
+	resp := &apimiddleware.ProposerSlashingsPoolResponseJson{}
+	if err := c.getJson(ctx, proposerSlashingsPath, resp); err != nil {
+		return nil, errors.Wrap(err, "error requesting pool proposer slashings")
+	}
+	return resp.Data, nil
+}
+
+// SubmitAttesterSlashing submits an attester slashing to the beacon node, which verifies it before adding it to
+// its operations pool and broadcasting it.
+func (c *Client) SubmitAttesterSlashing(ctx context.Context, slashing *zondpb.AttesterSlashing) error {
+	body, err := json.Marshal(&apimiddleware.AttesterSlashingJson{
+		Attestation_1: indexedAttestationToJson(slashing.Attestation_1),
+		Attestation_2: indexedAttestationToJson(slashing.Attestation_2),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+	if _, err := c.Post(ctx, attesterSlashingsPath, body); err != nil {
+		return errors.Wrap(err, "error submitting attester slashing")
+	}
+	return nil
+}
+
+// SubmitProposerSlashing submits a proposer slashing to the beacon node, which verifies it before adding it to
+// its operations pool and broadcasting it.
+func (c *Client) SubmitProposerSlashing(ctx context.Context, slashing *zondpb.ProposerSlashing) error {
+	slashings, err := shared.ProposerSlashingsFromConsensus([]*zondpb.ProposerSlashing{slashing})
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(slashings[0])
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+	if _, err := c.Post(ctx, proposerSlashingsPath, body); err != nil {
+		return errors.Wrap(err, "error submitting proposer slashing")
+	}
+	return nil
+}
+
+func indexedAttestationToJson(att *zondpb.IndexedAttestation) *apimiddleware.IndexedAttestationJson {
+	attestingIndices := make([]string, len(att.AttestingIndices))
+	for i, idx := range att.AttestingIndices {
+		attestingIndices[i] = strconv.FormatUint(idx, 10)
+	}
+	signatureValidatorIndex := make([]string, len(att.SignatureValidatorIndex))
+	for i, idx := range att.SignatureValidatorIndex {
+		signatureValidatorIndex[i] = strconv.FormatUint(idx, 10)
+	}
+	return &apimiddleware.IndexedAttestationJson{
+		AttestingIndices: attestingIndices,
+		Data: &apimiddleware.AttestationDataJson{
+			Slot:            strconv.FormatUint(uint64(att.Data.Slot), 10),
+			CommitteeIndex:  strconv.FormatUint(uint64(att.Data.CommitteeIndex), 10),
+			BeaconBlockRoot: hexutil.Encode(att.Data.BeaconBlockRoot),
+			Source: &apimiddleware.CheckpointJson{
+				Epoch: strconv.FormatUint(uint64(att.Data.Source.Epoch), 10),
+				Root:  hexutil.Encode(att.Data.Source.Root),
+			},
+			Target: &apimiddleware.CheckpointJson{
+				Epoch: strconv.FormatUint(uint64(att.Data.Target.Epoch), 10),
+				Root:  hexutil.Encode(att.Data.Target.Root),
+			},
+		},
+		Signature:               hexutil.Encode(att.Signature),
+		SignatureValidatorIndex: signatureValidatorIndex,
+	}
+}
@@ -0,0 +1,61 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/rewards"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+)
+
+const (
+	blockRewardsPath         = "/zond/v1/beacon/rewards/blocks/{{.Id}}"
+	attestationRewardsPath   = "/zond/v1/beacon/rewards/attestations/%d"
+	syncCommitteeRewardsPath = "/zond/v1/beacon/rewards/sync_committee/{{.Id}}"
+)
+
+var (
+	blockRewardsTpl         = idTemplate(blockRewardsPath)
+	syncCommitteeRewardsTpl = idTemplate(syncCommitteeRewardsPath)
+)
+
+// GetBlockRewards retrieves the rewards of the proposer of the block identified by blockId.
+func (c *Client) GetBlockRewards(ctx context.Context, blockId StateOrBlockId) (*rewards.BlockRewardsResponse, error) {
+	resp := &rewards.BlockRewardsResponse{}
+	if err := c.getJson(ctx, blockRewardsTpl(blockId), resp); err != nil {
+		return nil, errors.Wrapf(err, "error requesting block rewards by id = %s", blockId)
+	}
+	return resp, nil
+}
+
+// GetAttestationRewards retrieves the attestation rewards of the given validators, which are either indices or
+// hex encoded public keys, in the epoch. The rewards of all the validators are retrieved when no ids are given.
+func (c *Client) GetAttestationRewards(
+	ctx context.Context, epoch primitives.Epoch, ids []string,
+) (*rewards.AttestationRewardsResponse, error) {
+	if ids == nil {
+		ids = []string{}
+	}
+	resp := &rewards.AttestationRewardsResponse{}
+	if err := c.postJson(ctx, fmt.Sprintf(attestationRewardsPath, epoch), ids, resp); err != nil {
+		return nil, errors.Wrapf(err, "error requesting attestation rewards of epoch %d", epoch)
+	}
+	return resp, nil
+}
+
+// GetSyncCommitteeRewards retrieves the sync committee rewards of the given validators, which are either indices
+// or hex encoded public keys, in the block identified by blockId. The rewards of the whole sync committee are
+// retrieved when no ids are given.
+func (c *Client) GetSyncCommitteeRewards(
+	ctx context.Context, blockId StateOrBlockId, ids []string,
+) (*rewards.SyncCommitteeRewardsResponse, error) {
+	if ids == nil {
+		ids = []string{}
+	}
+	resp := &rewards.SyncCommitteeRewardsResponse{}
+	if err := c.postJson(ctx, syncCommitteeRewardsTpl(blockId), ids, resp); err != nil {
+		return nil, errors.Wrapf(err, "error requesting sync committee rewards by id = %s", blockId)
+	}
+	return resp, nil
+}
@@ -0,0 +1,76 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/v4/api/client"
+	slasherprysm "github.com/theQRL/qrysm/v4/beacon-chain/rpc/prysm/slasher"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+)
+
+const (
+	slasherSlashingsPath    = "/qrysm/slasher/slashings/%d"
+	slasherAttestationsPath = "/qrysm/slasher/attestations/%d"
+	slasherSpansPath        = "/qrysm/slasher/spans/%d"
+	slasherEventsPath       = "/qrysm/slasher/events"
+)
+
+// EpochRange restricts a query to the epochs between Start and End, inclusive. A nil End leaves the range open.
+type EpochRange struct {
+	Start primitives.Epoch
+	End   *primitives.Epoch
+}
+
+func (r EpochRange) query(startParam, endParam string) url.Values {
+	query := url.Values{startParam: []string{strconv.FormatUint(uint64(r.Start), 10)}}
+	if r.End != nil {
+		query.Set(endParam, strconv.FormatUint(uint64(*r.End), 10))
+	}
+	return query
+}
+
+// GetSlasherSlashings retrieves the slashings detected by the slasher of the beacon node against the validator
+// in the epoch range.
+func (c *Client) GetSlasherSlashings(
+	ctx context.Context, idx primitives.ValidatorIndex, epochs EpochRange,
+) (*slasherprysm.Slashings, error) {
+	resp := &slasherprysm.SlashingsResponse{}
+	query := epochs.query("start_epoch", "end_epoch")
+	if err := c.getJson(ctx, fmt.Sprintf(slasherSlashingsPath, idx), resp, client.WithQueryParams(query)); err != nil {
+		return nil, errors.Wrapf(err, "error requesting slashings of validator %d", idx)
+	}
+	if resp.Data == nil {
+		return nil, errors.New("empty slashings response")
+	}
+	return resp.Data, nil
+}
+
+// GetSlasherAttestations retrieves the attestations of the validator stored by the slasher of the beacon node
+// whose target epochs are in the epoch range.
+func (c *Client) GetSlasherAttestations(
+	ctx context.Context, idx primitives.ValidatorIndex, epochs EpochRange,
+) ([]*slasherprysm.IndexedAttestation, error) {
+	resp := &slasherprysm.AttestationRecordsResponse{}
+	query := epochs.query("start_epoch", "end_epoch")
+	if err := c.getJson(ctx, fmt.Sprintf(slasherAttestationsPath, idx), resp, client.WithQueryParams(query)); err != nil {
+		return nil, errors.Wrapf(err, "error requesting slasher attestations of validator %d", idx)
+	}
+	return resp.Data, nil
+}
+
+// GetSlasherSpans retrieves the min and max spans of the validator kept by the slasher of the beacon node at each
+// epoch of the epoch range.
+func (c *Client) GetSlasherSpans(
+	ctx context.Context, idx primitives.ValidatorIndex, epochs EpochRange,
+) ([]*slasherprysm.Spans, error) {
+	resp := &slasherprysm.SpansResponse{}
+	query := epochs.query("start_epoch", "end_epoch")
+	if err := c.getJson(ctx, fmt.Sprintf(slasherSpansPath, idx), resp, client.WithQueryParams(query)); err != nil {
+		return nil, errors.Wrapf(err, "error requesting slasher spans of validator %d", idx)
+	}
+	return resp.Data, nil
+}
@@ -0,0 +1,178 @@
+package beacon
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/go-zond/common/hexutil"
+	"github.com/theQRL/qrysm/v4/api/client"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/apimiddleware"
+	ethbeacon "github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/beacon"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/blob"
+	rpcbuilder "github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/builder"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/shared"
+	fieldparams "github.com/theQRL/qrysm/v4/config/fieldparams"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
+)
+
+const (
+	getStateRootPath           = "/zond/v1/beacon/states/{{.Id}}/root"
+	getFinalityCheckpointsPath = "/zond/v1/beacon/states/{{.Id}}/finality_checkpoints"
+	getSyncCommitteesPath      = "/zond/v1/beacon/states/{{.Id}}/sync_committees"
+	getRandaoPath              = "/zond/v1/beacon/states/{{.Id}}/randao"
+	getExpectedWithdrawalsPath = "/zond/v1/builder/states/{{.Id}}/expected_withdrawals"
+	getBlockHeadersPath        = "/zond/v1/beacon/headers"
+	getBlobSidecarsPath        = "/zond/v1/beacon/blob_sidecars/{{.Id}}"
+	getDepositContractPath     = "/zond/v1/config/deposit_contract"
+)
+
+var (
+	getStateRootTpl           = idTemplate(getStateRootPath)
+	getFinalityCheckpointsTpl = idTemplate(getFinalityCheckpointsPath)
+	getSyncCommitteesTpl      = idTemplate(getSyncCommitteesPath)
+	getRandaoTpl              = idTemplate(getRandaoPath)
+	getExpectedWithdrawalsTpl = idTemplate(getExpectedWithdrawalsPath)
+	getBlobSidecarsTpl        = idTemplate(getBlobSidecarsPath)
+)
+
+// GetStateRoot retrieves the hash_tree_root of the state identified by stateId.
+func (c *Client) GetStateRoot(ctx context.Context, stateId StateOrBlockId) ([32]byte, error) {
+	resp := &apimiddleware.StateRootResponseJson{}
+	if err := c.getJson(ctx, getStateRootTpl(stateId), resp); err != nil {
+		return [32]byte{}, errors.Wrapf(err, "error requesting state root by id = %s", stateId)
+	}
+	if resp.Data == nil {
+		return [32]byte{}, errors.Errorf("empty state root response for id = %s", stateId)
+	}
+	root, err := shared.DecodeHexWithLength(resp.Data.StateRoot, fieldparams.RootLength)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "invalid state root")
+	}
+	return bytesutil.ToBytes32(root), nil
+}
+
+// GetFinalityCheckpoints retrieves the justified and finalized checkpoints of the state identified by stateId.
+func (c *Client) GetFinalityCheckpoints(ctx context.Context, stateId StateOrBlockId) (*ethbeacon.FinalityCheckpoints, error) {
+	resp := &ethbeacon.GetFinalityCheckpointsResponse{}
+	if err := c.getJson(ctx, getFinalityCheckpointsTpl(stateId), resp); err != nil {
+		return nil, errors.Wrapf(err, "error requesting finality checkpoints by state id = %s", stateId)
+	}
+	if resp.Data == nil {
+		return nil, errors.Errorf("empty finality checkpoints response for state id = %s", stateId)
+	}
+	return resp.Data, nil
+}
+
+// GetSyncCommittee retrieves the sync committee of the epoch, or of the epoch of the state when epoch is nil,
+// computed from the state identified by stateId.
+func (c *Client) GetSyncCommittee(
+	ctx context.Context, stateId StateOrBlockId, epoch *primitives.Epoch,
+) (*apimiddleware.SyncCommitteeValidatorsJson, error) {
+	resp := &apimiddleware.SyncCommitteesResponseJson{}
+	if err := c.getJson(ctx, getSyncCommitteesTpl(stateId), resp, client.WithQueryParams(epochQuery(epoch))); err != nil {
+		return nil, errors.Wrapf(err, "error requesting sync committee by state id = %s", stateId)
+	}
+	if resp.Data == nil {
+		return nil, errors.Errorf("empty sync committee response for state id = %s", stateId)
+	}
+	return resp.Data, nil
+}
+
+// GetRandao retrieves the randao mix of the epoch, or of the epoch of the state when epoch is nil, from the
+// state identified by stateId.
+func (c *Client) GetRandao(ctx context.Context, stateId StateOrBlockId, epoch *primitives.Epoch) ([32]byte, error) {
+	resp := &apimiddleware.RandaoResponseJson{}
+	if err := c.getJson(ctx, getRandaoTpl(stateId), resp, client.WithQueryParams(epochQuery(epoch))); err != nil {
+		return [32]byte{}, errors.Wrapf(err, "error requesting randao by state id = %s", stateId)
+	}
+	if resp.Data == nil {
+		return [32]byte{}, errors.Errorf("empty randao response for state id = %s", stateId)
+	}
+	randao, err := shared.DecodeHexWithLength(resp.Data.Randao, fieldparams.RootLength)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "invalid randao")
+	}
+	return bytesutil.ToBytes32(randao), nil
+}
+
+// GetExpectedWithdrawals retrieves the withdrawals the block proposed on top of the state identified by stateId
+// is expected to include, at the given proposal slot or the slot following the state when proposalSlot is nil.
+func (c *Client) GetExpectedWithdrawals(
+	ctx context.Context, stateId StateOrBlockId, proposalSlot *primitives.Slot,
+) ([]*rpcbuilder.ExpectedWithdrawal, error) {
+	query := url.Values{}
+	if proposalSlot != nil {
+		query.Set("proposal_slot", strconv.FormatUint(uint64(*proposalSlot), 10))
+	}
+	resp := &rpcbuilder.ExpectedWithdrawalsResponse{}
+	if err := c.getJson(ctx, getExpectedWithdrawalsTpl(stateId), resp, client.WithQueryParams(query)); err != nil {
+		return nil, errors.Wrapf(err, "error requesting expected withdrawals by state id = %s", stateId)
+	}
+	return resp.Data, nil
+}
+
+// GetBlockHeaders retrieves the headers of the blocks at the slot, or of the children of the block with the
+// given parent root. The header of the head block is retrieved when neither is given.
+func (c *Client) GetBlockHeaders(
+	ctx context.Context, slot *primitives.Slot, parentRoot *[32]byte,
+) ([]*shared.SignedBeaconBlockHeaderContainer, error) {
+	query := url.Values{}
+	if slot != nil {
+		query.Set("slot", strconv.FormatUint(uint64(*slot), 10))
+	}
+	if parentRoot != nil {
+		query.Set("parent_root", hexutil.Encode(parentRoot[:]))
+	}
+	resp := &ethbeacon.GetBlockHeadersResponse{}
+	if err := c.getJson(ctx, getBlockHeadersPath, resp, client.WithQueryParams(query)); err != nil {
+		return nil, errors.Wrap(err, "error requesting block headers")
+	}
+	return resp.Data, nil
+}
+
+// GetBlobSidecars retrieves the blob sidecars of the block identified by blockId with the given indices, or all
+// of them when no indices are given.
+func (c *Client) GetBlobSidecars(ctx context.Context, blockId StateOrBlockId, indices []uint64) ([]*blob.Sidecar, error) {
+	query := url.Values{}
+	for _, idx := range indices {
+		query.Add("indices", strconv.FormatUint(idx, 10))
+	}
+	resp := &blob.SidecarsResponse{}
+	if err := c.getJson(ctx, getBlobSidecarsTpl(blockId), resp, client.WithQueryParams(query)); err != nil {
+		return nil, errors.Wrapf(err, "error requesting blob sidecars by id = %s", blockId)
+	}
+	return resp.Data, nil
+}
+
+// DepositContract is the deposit contract the beacon node follows.
+type DepositContract struct {
+	ChainId uint64
+	Address string
+}
+
+// GetDepositContract retrieves the chain id and address of the deposit contract the beacon node follows.
+func (c *Client) GetDepositContract(ctx context.Context) (*DepositContract, error) {
+	resp := &ethbeacon.DepositContractResponse{}
+	if err := c.getJson(ctx, getDepositContractPath, resp); err != nil {
+		return nil, errors.Wrap(err, "error requesting deposit contract")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("empty deposit contract response")
+	}
+	chainId, err := strconv.ParseUint(resp.Data.ChainId, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid chain id %s", resp.Data.ChainId)
+	}
+	return &DepositContract{ChainId: chainId, Address: resp.Data.Address}, nil
+}
+
+func epochQuery(epoch *primitives.Epoch) url.Values {
+	query := url.Values{}
+	if epoch != nil {
+		query.Set("epoch", strconv.FormatUint(uint64(*epoch), 10))
+	}
+	return query
+}
@@ -0,0 +1,191 @@
+package beacon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/go-zond/common/hexutil"
+	"github.com/theQRL/qrysm/v4/api"
+	"github.com/theQRL/qrysm/v4/api/client"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/shared"
+	ethvalidator "github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/validator"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+)
+
+const (
+	attestationDataPath              = "/zond/v1/validator/attestation_data"
+	aggregateAttestationPath         = "/zond/v1/validator/aggregate_attestation"
+	aggregateAndProofsPath           = "/zond/v1/validator/aggregate_and_proofs"
+	syncCommitteeContributionPath    = "/zond/v1/validator/sync_committee_contribution"
+	contributionAndProofsPath        = "/zond/v1/validator/contribution_and_proofs"
+	beaconCommitteeSubscriptionsPath = "/zond/v1/validator/beacon_committee_subscriptions"
+	syncCommitteeSubscriptionsPath   = "/zond/v1/validator/sync_committee_subscriptions"
+	registerValidatorPath            = "/zond/v1/validator/register_validator"
+	prepareBeaconProposerPath        = "/zond/v1/validator/prepare_beacon_proposer"
+	produceBlockV3Path               = "/zond/v3/validator/blocks/%d"
+)
+
+// GetAttestationData retrieves the attestation data a validator of the committee at the slot should sign.
+func (c *Client) GetAttestationData(
+	ctx context.Context, slot primitives.Slot, committeeIndex primitives.CommitteeIndex,
+) (*shared.AttestationData, error) {
+	query := url.Values{
+		"slot":            []string{strconv.FormatUint(uint64(slot), 10)},
+		"committee_index": []string{strconv.FormatUint(uint64(committeeIndex), 10)},
+	}
+	resp := &ethvalidator.GetAttestationDataResponse{}
+	if err := c.getJson(ctx, attestationDataPath, resp, client.WithQueryParams(query)); err != nil {
+		return nil, errors.Wrapf(err, "error requesting attestation data of slot %d", slot)
+	}
+	if resp.Data == nil {
+		return nil, errors.New("empty attestation data response")
+	}
+	return resp.Data, nil
+}
+
+// GetAggregateAttestation retrieves the aggregate of the attestations known to the beacon node at the slot
+// whose attestation data has the given root.
+func (c *Client) GetAggregateAttestation(
+	ctx context.Context, slot primitives.Slot, attestationDataRoot [32]byte,
+) (*shared.Attestation, error) {
+	query := url.Values{
+		"slot":                  []string{strconv.FormatUint(uint64(slot), 10)},
+		"attestation_data_root": []string{hexutil.Encode(attestationDataRoot[:])},
+	}
+	resp := &ethvalidator.AggregateAttestationResponse{}
+	if err := c.getJson(ctx, aggregateAttestationPath, resp, client.WithQueryParams(query)); err != nil {
+		return nil, errors.Wrapf(err, "error requesting aggregate attestation of slot %d", slot)
+	}
+	if resp.Data == nil {
+		return nil, errors.New("empty aggregate attestation response")
+	}
+	return resp.Data, nil
+}
+
+// SubmitAggregateAndProofs submits signed aggregates for the beacon node to verify and broadcast.
+func (c *Client) SubmitAggregateAndProofs(ctx context.Context, aggregates []*shared.SignedAggregateAttestationAndProof) error {
+	if err := c.postJson(ctx, aggregateAndProofsPath, aggregates, nil); err != nil {
+		return errors.Wrap(err, "error submitting aggregate and proofs")
+	}
+	return nil
+}
+
+// GetSyncCommitteeContribution retrieves the contribution of the sync subcommittee for the block root at the slot.
+func (c *Client) GetSyncCommitteeContribution(
+	ctx context.Context, slot primitives.Slot, subcommitteeIndex uint64, blockRoot [32]byte,
+) (*shared.SyncCommitteeContribution, error) {
+	query := url.Values{
+		"slot":               []string{strconv.FormatUint(uint64(slot), 10)},
+		"subcommittee_index": []string{strconv.FormatUint(subcommitteeIndex, 10)},
+		"beacon_block_root":  []string{hexutil.Encode(blockRoot[:])},
+	}
+	resp := &ethvalidator.ProduceSyncCommitteeContributionResponse{}
+	if err := c.getJson(ctx, syncCommitteeContributionPath, resp, client.WithQueryParams(query)); err != nil {
+		return nil, errors.Wrapf(err, "error requesting sync committee contribution of slot %d", slot)
+	}
+	if resp.Data == nil {
+		return nil, errors.New("empty sync committee contribution response")
+	}
+	return resp.Data, nil
+}
+
+// SubmitContributionAndProofs submits signed sync committee contributions for the beacon node to verify and
+// broadcast.
+func (c *Client) SubmitContributionAndProofs(ctx context.Context, contributions []*shared.SignedContributionAndProof) error {
+	if err := c.postJson(ctx, contributionAndProofsPath, contributions, nil); err != nil {
+		return errors.Wrap(err, "error submitting contribution and proofs")
+	}
+	return nil
+}
+
+// SubmitBeaconCommitteeSubscriptions subscribes the beacon node to the attestation subnets of the committees.
+func (c *Client) SubmitBeaconCommitteeSubscriptions(ctx context.Context, subs []*shared.BeaconCommitteeSubscription) error {
+	if err := c.postJson(ctx, beaconCommitteeSubscriptionsPath, subs, nil); err != nil {
+		return errors.Wrap(err, "error submitting beacon committee subscriptions")
+	}
+	return nil
+}
+
+// SubmitSyncCommitteeSubscriptions subscribes the beacon node to the sync committee subnets of the validators.
+func (c *Client) SubmitSyncCommitteeSubscriptions(ctx context.Context, subs []*shared.SyncCommitteeSubscription) error {
+	if err := c.postJson(ctx, syncCommitteeSubscriptionsPath, subs, nil); err != nil {
+		return errors.Wrap(err, "error submitting sync committee subscriptions")
+	}
+	return nil
+}
+
+// RegisterValidators submits the signed registrations of the validators to the builder network.
+func (c *Client) RegisterValidators(ctx context.Context, registrations []*shared.SignedValidatorRegistration) error {
+	if err := c.postJson(ctx, registerValidatorPath, registrations, nil); err != nil {
+		return errors.Wrap(err, "error registering validators")
+	}
+	return nil
+}
+
+// PrepareBeaconProposers sets the fee recipients of the blocks the validators will propose.
+func (c *Client) PrepareBeaconProposers(ctx context.Context, recipients []*shared.FeeRecipient) error {
+	if err := c.postJson(ctx, prepareBeaconProposerPath, recipients, nil); err != nil {
+		return errors.Wrap(err, "error preparing beacon proposers")
+	}
+	return nil
+}
+
+// ProducedBlock is a block produced by the beacon node for a validator to sign.
+type ProducedBlock struct {
+	// Version is the fork version of the block. It is only known for JSON encoded blocks, SSZ encoded blocks
+	// must be decoded with the fork schedule.
+	Version               string
+	ExecutionPayloadValue string
+	Blinded               bool
+	SSZ                   bool
+	// Data is the SSZ encoding of the block, or its JSON encoding as the data of a v3 block response.
+	Data []byte
+}
+
+// ProduceBlock requests a block for the slot, signed with the given randao reveal and graffiti. The block is
+// SSZ encoded unless preferSSZ is false or the beacon node can't serve SSZ.
+func (c *Client) ProduceBlock(
+	ctx context.Context, slot primitives.Slot, randaoReveal []byte, graffiti [32]byte, preferSSZ bool,
+) (*ProducedBlock, error) {
+	query := url.Values{
+		"randao_reveal": []string{hexutil.Encode(randaoReveal)},
+		"graffiti":      []string{hexutil.Encode(graffiti[:])},
+	}
+	opts := []client.ReqOption{client.WithQueryParams(query)}
+	if preferSSZ {
+		opts = append(opts, client.WithSSZPreferred())
+	}
+	body, header, err := c.Request(ctx, http.MethodGet, fmt.Sprintf(produceBlockV3Path, slot), nil, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error producing block of slot %d", slot)
+	}
+	blk := &ProducedBlock{
+		Version:               header.Get(api.VersionHeader),
+		ExecutionPayloadValue: header.Get(api.ExecutionPayloadValueHeader),
+		Blinded:               header.Get(api.ExecutionPayloadBlindedHeader) == "true",
+		SSZ:                   isSSZResponse(header),
+		Data:                  body,
+	}
+	if blk.SSZ {
+		return blk, nil
+	}
+	resp := &ethvalidator.ProduceBlockV3Response{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in ProduceBlock")
+	}
+	blk.Version = resp.Version
+	blk.ExecutionPayloadValue = resp.ExecutionPayloadValue
+	blk.Blinded = resp.ExecutionPayloadBlinded
+	blk.Data = resp.Data
+	return blk, nil
+}
+
+func isSSZResponse(header http.Header) bool {
+	return strings.HasPrefix(header.Get("Content-Type"), client.OctetStreamMediaType)
+}
@@ -0,0 +1,61 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/theQRL/qrysm/v4/api"
+	"github.com/theQRL/qrysm/v4/api/client"
+	"github.com/theQRL/qrysm/v4/testing/require"
+)
+
+func TestProduceBlock(t *testing.T) {
+	cases := []struct {
+		name      string
+		preferSSZ bool
+		respond   func(req *http.Request) *http.Response
+		expected  *ProducedBlock
+	}{
+		{
+			name:      "ssz",
+			preferSSZ: true,
+			respond: func(req *http.Request) *http.Response {
+				header := http.Header{}
+				header.Set("Content-Type", client.OctetStreamMediaType)
+				header.Set(api.ExecutionPayloadBlindedHeader, "true")
+				header.Set(api.ExecutionPayloadValueHeader, "12")
+				return &http.Response{Request: req, StatusCode: http.StatusOK, Header: header, Body: io.NopCloser(bytes.NewBuffer([]byte{1, 2}))}
+			},
+			expected: &ProducedBlock{ExecutionPayloadValue: "12", Blinded: true, SSZ: true, Data: []byte{1, 2}},
+		},
+		{
+			name: "json",
+			respond: func(req *http.Request) *http.Response {
+				header := http.Header{}
+				header.Set("Content-Type", client.JsonMediaType)
+				body := `{"version":"capella","execution_payload_blinded":false,"execution_payload_value":"7","data":{"slot":"3"}}`
+				return &http.Response{Request: req, StatusCode: http.StatusOK, Header: header, Body: io.NopCloser(bytes.NewBufferString(body))}
+			},
+			expected: &ProducedBlock{Version: "capella", ExecutionPayloadValue: "7", Data: []byte(`{"slot":"3"}`)},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			trans := &testRT{rt: func(req *http.Request) (*http.Response, error) {
+				require.Equal(t, "/zond/v3/validator/blocks/3", req.URL.Path)
+				require.Equal(t, "0x0102", req.URL.Query().Get("randao_reveal"))
+				require.Equal(t, tc.preferSSZ, req.Header.Get("Accept") != "")
+				return tc.respond(req), nil
+			}}
+			c, err := NewClient("http://localhost:3500", client.WithRoundTripper(trans))
+			require.NoError(t, err)
+
+			blk, err := c.ProduceBlock(context.Background(), 3, []byte{1, 2}, [32]byte{}, tc.preferSSZ)
+			require.NoError(t, err)
+			require.DeepEqual(t, tc.expected, blk)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package beacon
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/v4/api/client"
+	ethbeacon "github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/beacon"
+	qrysmvalidator "github.com/theQRL/qrysm/v4/beacon-chain/rpc/prysm/validator"
+)
+
+const (
+	getValidatorBalancesPath = "/zond/v1/beacon/states/{{.Id}}/validator_balances"
+	getValidatorCountPath    = "/zond/v1/beacon/states/{{.Id}}/validator_count"
+	validatorPerformancePath = "/qrysm/validators/performance"
+)
+
+// ValidatorIdsPerRequest is the number of validator ids sent in a single request by the methods looking up
+// validators by id. Longer lists of ids are split into pages, so that request URLs stay within server limits.
+const ValidatorIdsPerRequest = 64
+
+var (
+	getValidatorBalancesTpl = idTemplate(getValidatorBalancesPath)
+	getValidatorCountTpl    = idTemplate(getValidatorCountPath)
+)
+
+// GetValidators retrieves the validators with the given ids, which are either indices or hex encoded public keys,
+// from the state identified by stateId, filtered by statuses. All the validators of the state are retrieved
+// when no ids are given.
+func (c *Client) GetValidators(
+	ctx context.Context, stateId StateOrBlockId, ids []string, statuses []string,
+) ([]*ethbeacon.ValidatorContainer, error) {
+	var validators []*ethbeacon.ValidatorContainer
+	err := forEachIdPage(ids, func(page []string) error {
+		query := url.Values{"id": page, "status": statuses}
+		resp := &ethbeacon.GetValidatorsResponse{}
+		if err := c.getJson(ctx, getValidatorsTpl(stateId), resp, client.WithQueryParams(query)); err != nil {
+			return err
+		}
+		validators = append(validators, resp.Data...)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting validators of state id = %s", stateId)
+	}
+	return validators, nil
+}
+
+// GetValidator retrieves the validator with the given id, which is either an index or a hex encoded public key,
+// from the state identified by stateId.
+func (c *Client) GetValidator(ctx context.Context, stateId StateOrBlockId, id string) (*ethbeacon.ValidatorContainer, error) {
+	resp := &ethbeacon.GetValidatorResponse{}
+	if err := c.getJson(ctx, getValidatorsTpl(stateId)+"/"+url.PathEscape(id), resp); err != nil {
+		return nil, errors.Wrapf(err, "error requesting validator %s of state id = %s", id, stateId)
+	}
+	if resp.Data == nil {
+		return nil, errors.Errorf("empty validator response for id = %s", id)
+	}
+	return resp.Data, nil
+}
+
+// GetValidatorBalances retrieves the balances of the validators with the given ids, which are either indices or
+// hex encoded public keys, from the state identified by stateId. The balances of all the validators of the state
+// are retrieved when no ids are given.
+func (c *Client) GetValidatorBalances(
+	ctx context.Context, stateId StateOrBlockId, ids []string,
+) ([]*ethbeacon.ValidatorBalance, error) {
+	var balances []*ethbeacon.ValidatorBalance
+	err := forEachIdPage(ids, func(page []string) error {
+		resp := &ethbeacon.GetValidatorBalancesResponse{}
+		query := url.Values{"id": page}
+		if err := c.getJson(ctx, getValidatorBalancesTpl(stateId), resp, client.WithQueryParams(query)); err != nil {
+			return err
+		}
+		balances = append(balances, resp.Data...)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting validator balances of state id = %s", stateId)
+	}
+	return balances, nil
+}
+
+// GetValidatorCountByStatus retrieves the number of validators of the state identified by stateId with each of
+// the given statuses, or with every status when none are given.
+func (c *Client) GetValidatorCountByStatus(
+	ctx context.Context, stateId StateOrBlockId, statuses []string,
+) ([]*qrysmvalidator.ValidatorCount, error) {
+	resp := &qrysmvalidator.ValidatorCountResponse{}
+	query := url.Values{"status": statuses}
+	if err := c.getJson(ctx, getValidatorCountTpl(stateId), resp, client.WithQueryParams(query)); err != nil {
+		return nil, errors.Wrapf(err, "error requesting validator count of state id = %s", stateId)
+	}
+	return resp.Data, nil
+}
+
+// GetValidatorPerformance retrieves the performance of the requested validators in the previous epoch.
+func (c *Client) GetValidatorPerformance(
+	ctx context.Context, req *qrysmvalidator.ValidatorPerformanceRequest,
+) (*qrysmvalidator.ValidatorPerformanceResponse, error) {
+	resp := &qrysmvalidator.ValidatorPerformanceResponse{}
+	if err := c.postJson(ctx, validatorPerformancePath, req, resp); err != nil {
+		return nil, errors.Wrap(err, "error requesting validator performance")
+	}
+	return resp, nil
+}
+
+// forEachIdPage calls f with consecutive pages of at most ValidatorIdsPerRequest ids, or once with no ids
+// when ids is empty.
+func forEachIdPage(ids []string, f func(page []string) error) error {
+	if len(ids) == 0 {
+		return f(nil)
+	}
+	for start := 0; start < len(ids); start += ValidatorIdsPerRequest {
+		end := start + ValidatorIdsPerRequest
+		if end > len(ids) {
+			end = len(ids)
+		}
+		if err := f(ids[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/theQRL/qrysm/v4/api/client"
+	ethbeacon "github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/beacon"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/testing/require"
+)
+
+func TestGetValidators_Paginated(t *testing.T) {
+	ids := make([]string, ValidatorIdsPerRequest*2+1)
+	for i := range ids {
+		ids[i] = strconv.Itoa(i)
+	}
+	var pages [][]string
+	trans := &testRT{rt: func(req *http.Request) (*http.Response, error) {
+		require.Equal(t, "/zond/v1/beacon/states/head/validators", req.URL.Path)
+		require.DeepEqual(t, []string{"active_ongoing"}, req.URL.Query()["status"])
+		page := req.URL.Query()["id"]
+		pages = append(pages, page)
+		data := make([]*ethbeacon.ValidatorContainer, len(page))
+		for i, id := range page {
+			data[i] = &ethbeacon.ValidatorContainer{Index: id, Status: "active_ongoing"}
+		}
+		b, err := json.Marshal(&ethbeacon.GetValidatorsResponse{Data: data})
+		require.NoError(t, err)
+		return &http.Response{Request: req, StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewBuffer(b))}, nil
+	}}
+	c, err := NewClient("http://localhost:3500", client.WithRoundTripper(trans))
+	require.NoError(t, err)
+
+	validators, err := c.GetValidators(context.Background(), IdHead, ids, []string{"active_ongoing"})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(pages))
+	require.Equal(t, ValidatorIdsPerRequest, len(pages[0]))
+	require.DeepEqual(t, []string{strconv.Itoa(len(ids) - 1)}, pages[2])
+	require.Equal(t, len(ids), len(validators))
+	for i, v := range validators {
+		require.Equal(t, ids[i], v.Index)
+	}
+}
+
+func TestGetValidators_AllValidators(t *testing.T) {
+	trans := &testRT{rt: func(req *http.Request) (*http.Response, error) {
+		require.Equal(t, "", req.URL.RawQuery)
+		b, err := marshalToEnvelope([]*ethbeacon.ValidatorContainer{{Index: "0"}, {Index: "1"}})
+		require.NoError(t, err)
+		return &http.Response{Request: req, StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewBuffer(b))}, nil
+	}}
+	c, err := NewClient("http://localhost:3500", client.WithRoundTripper(trans))
+	require.NoError(t, err)
+
+	validators, err := c.GetValidators(context.Background(), IdFinalized, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(validators))
+}
+
+func TestGetAttesterDuties(t *testing.T) {
+	trans := &testRT{rt: func(req *http.Request) (*http.Response, error) {
+		require.Equal(t, http.MethodPost, req.Method)
+		require.Equal(t, "/zond/v1/validator/duties/attester/5", req.URL.Path)
+		var indices []string
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&indices))
+		require.DeepEqual(t, []string{"3", "9"}, indices)
+		body := `{"dependent_root":"0x01","data":[{"validator_index":"3","slot":"161"}]}`
+		return &http.Response{Request: req, StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewBufferString(body))}, nil
+	}}
+	c, err := NewClient("http://localhost:3500", client.WithRoundTripper(trans))
+	require.NoError(t, err)
+
+	duties, err := c.GetAttesterDuties(context.Background(), 5, []primitives.ValidatorIndex{3, 9})
+	require.NoError(t, err)
+	require.Equal(t, "0x01", duties.DependentRoot)
+	require.Equal(t, 1, len(duties.Data))
+	require.Equal(t, "161", duties.Data[0].Slot)
+}
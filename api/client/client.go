@@ -1,20 +1,24 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"io"
 	"net"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/pkg/errors"
 )
 
 // Client is a wrapper object around the HTTP client.
 type Client struct {
-	hc      *http.Client
-	baseURL *url.URL
-	token   string
+	hc           *http.Client
+	baseURL      *url.URL
+	token        string
+	retries      int
+	retryBackoff time.Duration
 }
 
 // NewClient constructs a new client with the provided options (ex WithTimeout).
@@ -50,6 +54,12 @@ func (c *Client) Do(req *http.Request) (*http.Response, error) {
 	return c.hc.Do(req)
 }
 
+// Transport returns the round tripper of the wrapped http.Client, for long-lived requests like event streams
+// that must not be cut short by the client's timeout.
+func (c *Client) Transport() http.RoundTripper {
+	return c.hc.Transport
+}
+
 func urlForHost(h string) (*url.URL, error) {
 	// try to parse as url (being permissive)
 	u, err := url.Parse(h)
@@ -71,27 +81,83 @@ func (c *Client) NodeURL() string {
 
 // Get is a generic, opinionated GET function to reduce boilerplate amongst the getters in this package.
 func (c *Client) Get(ctx context.Context, path string, opts ...ReqOption) ([]byte, error) {
+	b, _, err := c.Request(ctx, http.MethodGet, path, nil, opts...)
+	return b, err
+}
+
+// Post is a generic, opinionated POST function sending a JSON body, the counterpart of Get.
+func (c *Client) Post(ctx context.Context, path string, body []byte, opts ...ReqOption) ([]byte, error) {
+	opts = append([]ReqOption{withContentType(JsonMediaType)}, opts...)
+	b, _, err := c.Request(ctx, http.MethodPost, path, body, opts...)
+	return b, err
+}
+
+// Delete is a generic, opinionated DELETE function, the counterpart of Get.
+func (c *Client) Delete(ctx context.Context, path string, opts ...ReqOption) ([]byte, error) {
+	b, _, err := c.Request(ctx, http.MethodDelete, path, nil, opts...)
+	return b, err
+}
+
+// Request sends a request with the given method and body to the path, returning the body and headers of the
+// response. Any response outside of the 2xx range is an error. Idempotent requests are retried as configured
+// by WithRetries when the API can't be reached or responds with a transient error.
+func (c *Client) Request(ctx context.Context, method, path string, body []byte, opts ...ReqOption) ([]byte, http.Header, error) {
 	u := c.baseURL.ResolveReference(&url.URL{Path: path})
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
-	if err != nil {
-		return nil, err
+	attempts := 1
+	if method == http.MethodGet || method == http.MethodHead {
+		attempts += c.retries
 	}
-	for _, o := range opts {
-		o(req)
+	for attempt := 0; ; attempt++ {
+		var bodyReader io.Reader
+		if body != nil {
+			bodyReader = bytes.NewReader(body)
+		}
+		req, err := http.NewRequestWithContext(ctx, method, u.String(), bodyReader)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, o := range opts {
+			o(req)
+		}
+		b, header, err := c.send(req)
+		if err == nil || attempt+1 >= attempts || !retryable(ctx, err) {
+			return b, header, err
+		}
+		select {
+		case <-time.After(c.retryBackoff * time.Duration(1<<attempt)):
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		}
 	}
+}
+
+func (c *Client) send(req *http.Request) ([]byte, http.Header, error) {
 	r, err := c.hc.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer func() {
 		err = r.Body.Close()
 	}()
-	if r.StatusCode != http.StatusOK {
-		return nil, Non200Err(r)
+	if r.StatusCode < http.StatusOK || r.StatusCode >= http.StatusMultipleChoices {
+		return nil, nil, Non200Err(r)
 	}
 	b, err := io.ReadAll(r.Body)
 	if err != nil {
-		return nil, errors.Wrap(err, "error reading http response body")
+		return nil, nil, errors.Wrap(err, "error reading http response body")
+	}
+	return b, r.Header, nil
+}
+
+// retryable reports whether the request failed because of a network error or a transient server error,
+// as opposed to a request the API rejected.
+func retryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.Code == http.StatusTooManyRequests || statusErr.Code >= http.StatusInternalServerError
 	}
-	return b, nil
+	return true
 }
@@ -1,9 +1,15 @@
 package client
 
 import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
 	"net/url"
 	"testing"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/theQRL/qrysm/v4/testing/require"
 )
 
@@ -46,3 +52,90 @@ func TestBaseURL(t *testing.T) {
 	require.Equal(t, "www.offchainlabs.com", cl.BaseURL().Hostname())
 	require.Equal(t, "3500", cl.BaseURL().Port())
 }
+
+type testRT struct {
+	rt func(*http.Request) (*http.Response, error)
+}
+
+func (rt *testRT) RoundTrip(req *http.Request) (*http.Response, error) {
+	return rt.rt(req)
+}
+
+func TestGet_Retries(t *testing.T) {
+	statuses := []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK}
+	calls := 0
+	trans := &testRT{rt: func(req *http.Request) (*http.Response, error) {
+		status := statuses[calls]
+		calls++
+		return &http.Response{Request: req, StatusCode: status, Body: io.NopCloser(bytes.NewBufferString("ok"))}, nil
+	}}
+	cl, err := NewClient("http://localhost:3500", WithRoundTripper(trans), WithRetries(2, time.Millisecond))
+	require.NoError(t, err)
+
+	b, err := cl.Get(context.Background(), "/zond/v1/node/version")
+	require.NoError(t, err)
+	require.Equal(t, "ok", string(b))
+	require.Equal(t, 3, calls)
+}
+
+func TestGet_RetriesExhausted(t *testing.T) {
+	calls := 0
+	trans := &testRT{rt: func(req *http.Request) (*http.Response, error) {
+		calls++
+		return &http.Response{Request: req, StatusCode: http.StatusInternalServerError, Body: io.NopCloser(bytes.NewBuffer(nil))}, nil
+	}}
+	cl, err := NewClient("http://localhost:3500", WithRoundTripper(trans), WithRetries(2, time.Millisecond))
+	require.NoError(t, err)
+
+	_, err = cl.Get(context.Background(), "/zond/v1/node/version")
+	require.ErrorIs(t, err, ErrNotOK)
+	var statusErr *StatusError
+	require.Equal(t, true, errors.As(err, &statusErr))
+	require.Equal(t, http.StatusInternalServerError, statusErr.Code)
+	require.Equal(t, 3, calls)
+}
+
+func TestRequest_NoRetry(t *testing.T) {
+	cases := []struct {
+		name   string
+		method string
+		status int
+	}{
+		{name: "rejected request", method: http.MethodGet, status: http.StatusNotFound},
+		{name: "non-idempotent request", method: http.MethodPost, status: http.StatusServiceUnavailable},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			calls := 0
+			trans := &testRT{rt: func(req *http.Request) (*http.Response, error) {
+				calls++
+				return &http.Response{Request: req, StatusCode: c.status, Body: io.NopCloser(bytes.NewBuffer(nil))}, nil
+			}}
+			cl, err := NewClient("http://localhost:3500", WithRoundTripper(trans), WithRetries(2, time.Millisecond))
+			require.NoError(t, err)
+
+			_, _, err = cl.Request(context.Background(), c.method, "/zond/v1/beacon/pool/attestations", nil)
+			require.ErrorIs(t, err, ErrNotOK)
+			require.Equal(t, 1, calls)
+		})
+	}
+}
+
+func TestRequest_ContentNegotiation(t *testing.T) {
+	trans := &testRT{rt: func(req *http.Request) (*http.Response, error) {
+		require.Equal(t, "application/octet-stream;q=1.0,application/json;q=0.9", req.Header.Get("Accept"))
+		require.Equal(t, OctetStreamMediaType, req.Header.Get("Content-Type"))
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		header := http.Header{}
+		header.Set("Content-Type", OctetStreamMediaType)
+		return &http.Response{Request: req, StatusCode: http.StatusOK, Header: header, Body: io.NopCloser(bytes.NewBuffer(body))}, nil
+	}}
+	cl, err := NewClient("http://localhost:3500", WithRoundTripper(trans))
+	require.NoError(t, err)
+
+	b, header, err := cl.Request(context.Background(), http.MethodPost, "/zond/v2/beacon/blocks", []byte{1, 2, 3}, WithSSZPreferred(), WithSSZBody())
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte{1, 2, 3}, b)
+	require.Equal(t, OctetStreamMediaType, header.Get("Content-Type"))
+}
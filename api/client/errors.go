@@ -21,6 +21,23 @@ var ErrNotFound = errors.Wrap(ErrNotOK, "recv 404 NotFound response from API")
 // ErrInvalidNodeVersion indicates that the /zond/v1/node/version API response format was not recognized.
 var ErrInvalidNodeVersion = errors.New("invalid node version response")
 
+// StatusError is the error of a request answered with a non-200 response. It wraps ErrNotFound or ErrNotOK,
+// and carries the status code so callers can tell transient failures from rejected requests.
+type StatusError struct {
+	Code int
+	err  error
+}
+
+// Error returns the underlying error message.
+func (e *StatusError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns ErrNotFound or ErrNotOK, depending on the status code.
+func (e *StatusError) Unwrap() error {
+	return e.err
+}
+
 // Non200Err is a function that parses an HTTP response to handle responses that are not 200 with a formatted error.
 func Non200Err(response *http.Response) error {
 	bodyBytes, err := io.ReadAll(response.Body)
@@ -33,8 +50,8 @@ func Non200Err(response *http.Response) error {
 	msg := fmt.Sprintf("code=%d, url=%s, body=%s", response.StatusCode, response.Request.URL, body)
 	switch response.StatusCode {
 	case 404:
-		return errors.Wrap(ErrNotFound, msg)
+		return &StatusError{Code: response.StatusCode, err: errors.Wrap(ErrNotFound, msg)}
 	default:
-		return errors.Wrap(ErrNotOK, msg)
+		return &StatusError{Code: response.StatusCode, err: errors.Wrap(ErrNotOK, msg)}
 	}
 }
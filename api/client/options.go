@@ -3,16 +3,50 @@ package client
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 )
 
+const (
+	// JsonMediaType is the media type of JSON request and response bodies.
+	JsonMediaType = "application/json"
+	// OctetStreamMediaType is the media type of SSZ encoded request and response bodies.
+	OctetStreamMediaType = "application/octet-stream"
+)
+
 // ReqOption is a request functional option.
 type ReqOption func(*http.Request)
 
 // WithSSZEncoding is a request functional option that adds SSZ encoding header.
 func WithSSZEncoding() ReqOption {
 	return func(req *http.Request) {
-		req.Header.Set("Accept", "application/octet-stream")
+		req.Header.Set("Accept", OctetStreamMediaType)
+	}
+}
+
+// WithSSZPreferred is a request functional option that asks for an SSZ encoded response, accepting JSON from
+// endpoints that can't serve SSZ. The Content-Type of the response tells which encoding was used.
+func WithSSZPreferred() ReqOption {
+	return func(req *http.Request) {
+		req.Header.Set("Accept", OctetStreamMediaType+";q=1.0,"+JsonMediaType+";q=0.9")
+	}
+}
+
+// WithSSZBody is a request functional option that marks the request body as SSZ encoded.
+func WithSSZBody() ReqOption {
+	return withContentType(OctetStreamMediaType)
+}
+
+// WithHeader is a request functional option that sets a header of the request.
+func WithHeader(key, value string) ReqOption {
+	return func(req *http.Request) {
+		req.Header.Set(key, value)
+	}
+}
+
+func withContentType(mediaType string) ReqOption {
+	return func(req *http.Request) {
+		req.Header.Set("Content-Type", mediaType)
 	}
 }
 
@@ -23,6 +57,13 @@ func WithAuthorizationToken(token string) ReqOption {
 	}
 }
 
+// WithQueryParams is a request functional option that sets the query string of the request.
+func WithQueryParams(params url.Values) ReqOption {
+	return func(req *http.Request) {
+		req.URL.RawQuery = params.Encode()
+	}
+}
+
 // ClientOpt is a functional option for the Client type (http.Client wrapper)
 type ClientOpt func(*Client)
 
@@ -40,6 +81,15 @@ func WithRoundTripper(t http.RoundTripper) ClientOpt {
 	}
 }
 
+// WithRetries retries idempotent requests up to n times when the API can't be reached or responds with
+// 429 Too Many Requests or a 5xx status, waiting backoff before the first retry and doubling the wait after each.
+func WithRetries(n int, backoff time.Duration) ClientOpt {
+	return func(c *Client) {
+		c.retries = n
+		c.retryBackoff = backoff
+	}
+}
+
 // WithAuthenticationToken sets an oauth token to be used.
 func WithAuthenticationToken(token string) ClientOpt {
 	return func(c *Client) {
@@ -0,0 +1,32 @@
+package validator
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/v4/io/file"
+)
+
+// AuthTokenFileName is the name of the file, in the wallet directory of a validator client, holding the secret
+// and the bearer token of its keymanager API.
+const AuthTokenFileName = "auth-token"
+
+// AuthTokenFromFile reads the keymanager API bearer token from an auth token file, whose first line is the hex
+// encoded JWT secret of the validator client and whose second line is the token.
+func AuthTokenFromFile(path string) (string, error) {
+	b, err := file.ReadFileAsBytes(path)
+	if err != nil {
+		return "", errors.Wrap(err, "could not read auth token file")
+	}
+	lines := strings.Split(string(b), "\n")
+	if len(lines) < 2 || strings.TrimSpace(lines[1]) == "" {
+		return "", errors.Errorf("auth token file %s does not contain a token", path)
+	}
+	return strings.TrimSpace(lines[1]), nil
+}
+
+// AuthTokenFromWalletDir reads the keymanager API bearer token from the auth token file of the wallet directory.
+func AuthTokenFromWalletDir(walletDir string) (string, error) {
+	return AuthTokenFromFile(filepath.Join(walletDir, AuthTokenFileName))
+}
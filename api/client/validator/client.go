@@ -12,9 +12,11 @@ import (
 )
 
 const (
-	localKeysPath    = "/zond/v1/keystores"
-	remoteKeysPath   = "/zond/v1/remotekeys"
-	feeRecipientPath = "/zond/v1/validator/{pubkey}/feerecipient"
+	localKeysPath     = "/zond/v1/keystores"
+	remoteKeysPath    = "/zond/v1/remotekeys"
+	feeRecipientPath  = "/zond/v1/validator/{pubkey}/feerecipient"
+	gasLimitPath      = "/zond/v1/validator/{pubkey}/gas_limit"
+	voluntaryExitPath = "/zond/v1/validator/{pubkey}/voluntary_exit"
 )
 
 // Client provides a collection of helper methods for calling the Keymanager API endpoints.
@@ -108,8 +110,7 @@ func (c *Client) GetFeeRecipientAddresses(ctx context.Context, validators []stri
 
 // GetFeeRecipientAddress takes a public key and calls the keymanager API to return its fee recipient.
 func (c *Client) GetFeeRecipientAddress(ctx context.Context, pubkey string) (*apimiddleware.GetFeeRecipientByPubkeyResponseJson, error) {
-	path := strings.Replace(feeRecipientPath, "{pubkey}", pubkey, 1)
-	b, err := c.Get(ctx, path, client.WithAuthorizationToken(c.Token()))
+	b, err := c.Get(ctx, pubkeyPath(feeRecipientPath, pubkey), client.WithAuthorizationToken(c.Token()))
 	if err != nil {
 		return nil, err
 	}
@@ -119,3 +120,33 @@ func (c *Client) GetFeeRecipientAddress(ctx context.Context, pubkey string) (*ap
 	}
 	return feejson, nil
 }
+
+// doJson sends an authorized request with the JSON encoding of req as its body, or without a body when req is nil,
+// and decodes the JSON response into resp unless it is nil.
+func (c *Client) doJson(ctx context.Context, method, path string, req, resp interface{}) error {
+	opts := []client.ReqOption{client.WithAuthorizationToken(c.Token())}
+	var body []byte
+	if req != nil {
+		var err error
+		body, err = json.Marshal(req)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal request")
+		}
+		opts = append(opts, client.WithHeader("Content-Type", client.JsonMediaType))
+	}
+	b, _, err := c.Request(ctx, method, path, body, opts...)
+	if err != nil {
+		return err
+	}
+	if resp == nil {
+		return nil
+	}
+	if err := json.Unmarshal(b, resp); err != nil {
+		return errors.Wrapf(err, "failed to parse response of %s %s", method, path)
+	}
+	return nil
+}
+
+func pubkeyPath(path, pubkey string) string {
+	return strings.Replace(path, "{pubkey}", pubkey, 1)
+}
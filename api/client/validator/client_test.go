@@ -0,0 +1,174 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/v4/api/client"
+	"github.com/theQRL/qrysm/v4/testing/require"
+	"github.com/theQRL/qrysm/v4/validator/rpc/apimiddleware"
+)
+
+const testPubkey = "0x855ae9c6184d6edd46351b375f16f541b2d33b0ed0da9be4571b13938588aee840ba606a946f0e8023ae3a4b2a43b4d4"
+
+type testRT struct {
+	rt func(*http.Request) (*http.Response, error)
+}
+
+func (rt *testRT) RoundTrip(req *http.Request) (*http.Response, error) {
+	if rt.rt != nil {
+		return rt.rt(req)
+	}
+	return nil, errors.New("RoundTripper not implemented")
+}
+
+var _ http.RoundTripper = &testRT{}
+
+func testClient(t *testing.T, rt func(*http.Request) (*http.Response, error)) *Client {
+	c, err := NewClient("http://localhost:7500", client.WithRoundTripper(&testRT{rt: rt}), client.WithAuthenticationToken("token"))
+	require.NoError(t, err)
+	return c
+}
+
+func jsonResponse(t *testing.T, req *http.Request, code int, val interface{}) *http.Response {
+	var body []byte
+	if val != nil {
+		var err error
+		body, err = json.Marshal(val)
+		require.NoError(t, err)
+	}
+	return &http.Response{
+		StatusCode: code,
+		Body:       io.NopCloser(bytes.NewBuffer(body)),
+		Request:    req,
+	}
+}
+
+func TestImportKeystores(t *testing.T) {
+	c := testClient(t, func(req *http.Request) (*http.Response, error) {
+		require.Equal(t, http.MethodPost, req.Method)
+		require.Equal(t, localKeysPath, req.URL.Path)
+		require.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+		require.Equal(t, client.JsonMediaType, req.Header.Get("Content-Type"))
+		body := &apimiddleware.ImportKeystoresRequestJson{}
+		require.NoError(t, json.NewDecoder(req.Body).Decode(body))
+		require.DeepEqual(t, []string{"keystore"}, body.Keystores)
+		require.DeepEqual(t, []string{"password"}, body.Passwords)
+		return jsonResponse(t, req, http.StatusOK, &apimiddleware.ImportKeystoresResponseJson{
+			Statuses: []*apimiddleware.StatusJson{{Status: "imported"}},
+		}), nil
+	})
+	statuses, err := c.ImportKeystores(context.Background(), []string{"keystore"}, []string{"password"}, "")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(statuses))
+	require.Equal(t, "imported", statuses[0].Status)
+
+	_, err = c.ImportKeystores(context.Background(), []string{"keystore"}, nil, "")
+	require.ErrorContains(t, "1 keystores were provided with 0 passwords", err)
+}
+
+func TestDeleteKeystores(t *testing.T) {
+	c := testClient(t, func(req *http.Request) (*http.Response, error) {
+		require.Equal(t, http.MethodDelete, req.Method)
+		require.Equal(t, localKeysPath, req.URL.Path)
+		body := &apimiddleware.DeleteKeystoresRequestJson{}
+		require.NoError(t, json.NewDecoder(req.Body).Decode(body))
+		require.DeepEqual(t, []string{testPubkey}, body.PublicKeys)
+		return jsonResponse(t, req, http.StatusOK, &apimiddleware.DeleteKeystoresResponseJson{
+			Statuses:           []*apimiddleware.StatusJson{{Status: "deleted"}},
+			SlashingProtection: "{}",
+		}), nil
+	})
+	resp, err := c.DeleteKeystores(context.Background(), []string{testPubkey})
+	require.NoError(t, err)
+	require.Equal(t, "deleted", resp.Statuses[0].Status)
+	require.Equal(t, "{}", resp.SlashingProtection)
+}
+
+func TestFeeRecipient(t *testing.T) {
+	path := "/zond/v1/validator/" + testPubkey + "/feerecipient"
+	address := "0xb698D697092822185bF0311052215d5B5e1F3944"
+	c := testClient(t, func(req *http.Request) (*http.Response, error) {
+		require.Equal(t, path, req.URL.Path)
+		switch req.Method {
+		case http.MethodPost:
+			body := &apimiddleware.SetFeeRecipientByPubkeyRequestJson{}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(body))
+			require.Equal(t, address, body.Ethaddress)
+			return jsonResponse(t, req, http.StatusAccepted, nil), nil
+		case http.MethodDelete:
+			body := &apimiddleware.DeleteFeeRecipientByPubkeyRequestJson{}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(body))
+			require.Equal(t, testPubkey, body.Pubkey)
+			return jsonResponse(t, req, http.StatusNoContent, nil), nil
+		}
+		return jsonResponse(t, req, http.StatusMethodNotAllowed, nil), nil
+	})
+	require.NoError(t, c.SetFeeRecipientAddress(context.Background(), testPubkey, address))
+	require.NoError(t, c.DeleteFeeRecipientAddress(context.Background(), testPubkey))
+}
+
+func TestGasLimit(t *testing.T) {
+	path := "/zond/v1/validator/" + testPubkey + "/gas_limit"
+	c := testClient(t, func(req *http.Request) (*http.Response, error) {
+		require.Equal(t, path, req.URL.Path)
+		switch req.Method {
+		case http.MethodGet:
+			return jsonResponse(t, req, http.StatusOK, &apimiddleware.GetGasLimitResponseJson{
+				Data: &apimiddleware.GasLimitJson{Pubkey: testPubkey, GasLimit: "30000000"},
+			}), nil
+		case http.MethodPost:
+			body := &apimiddleware.SetGasLimitRequestJson{}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(body))
+			require.Equal(t, "25000000", body.GasLimit)
+			return jsonResponse(t, req, http.StatusAccepted, nil), nil
+		}
+		return jsonResponse(t, req, http.StatusNotFound, nil), nil
+	})
+	gasLimit, err := c.GetGasLimit(context.Background(), testPubkey)
+	require.NoError(t, err)
+	require.Equal(t, uint64(30000000), gasLimit)
+	require.NoError(t, c.SetGasLimit(context.Background(), testPubkey, 25000000))
+	err = c.DeleteGasLimit(context.Background(), testPubkey)
+	require.ErrorIs(t, err, client.ErrNotFound)
+}
+
+func TestSetVoluntaryExit(t *testing.T) {
+	c := testClient(t, func(req *http.Request) (*http.Response, error) {
+		require.Equal(t, "/zond/v1/validator/"+testPubkey+"/voluntary_exit", req.URL.Path)
+		body := &apimiddleware.SetVoluntaryExitRequestJson{}
+		require.NoError(t, json.NewDecoder(req.Body).Decode(body))
+		require.Equal(t, "10", body.Epoch)
+		return jsonResponse(t, req, http.StatusOK, &apimiddleware.SetVoluntaryExitResponseJson{
+			SignedVoluntaryExit: &apimiddleware.SignedVoluntaryExitJson{
+				VoluntaryExit: &apimiddleware.VoluntaryExitJson{Epoch: "10", ValidatorIndex: "3"},
+				Signature:     "0x01",
+			},
+		}), nil
+	})
+	exit, err := c.SetVoluntaryExit(context.Background(), testPubkey, 10)
+	require.NoError(t, err)
+	require.Equal(t, "3", exit.VoluntaryExit.ValidatorIndex)
+}
+
+func TestAuthTokenFromWalletDir(t *testing.T) {
+	dir := t.TempDir()
+	_, err := AuthTokenFromWalletDir(dir)
+	require.ErrorContains(t, "could not read auth token file", err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, AuthTokenFileName), []byte("abcd\n"), 0600))
+	_, err = AuthTokenFromWalletDir(dir)
+	require.ErrorContains(t, "does not contain a token", err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, AuthTokenFileName), []byte("abcd\nmy.jwt.token\n"), 0600))
+	token, err := AuthTokenFromWalletDir(dir)
+	require.NoError(t, err)
+	require.Equal(t, "my.jwt.token", token)
+}
@@ -0,0 +1,62 @@
+package validator
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/v4/validator/rpc/apimiddleware"
+)
+
+// ImportKeystores imports the EIP-2335 keystores, each decrypted with the password at the same position, along
+// with the EIP-3076 slashing protection history of their keys if it is not empty. The returned statuses are in
+// the order of the keystores.
+func (c *Client) ImportKeystores(
+	ctx context.Context, keystores, passwords []string, slashingProtection string,
+) ([]*apimiddleware.StatusJson, error) {
+	if len(keystores) != len(passwords) {
+		return nil, errors.Errorf("%d keystores were provided with %d passwords", len(keystores), len(passwords))
+	}
+	req := &apimiddleware.ImportKeystoresRequestJson{
+		Keystores:          keystores,
+		Passwords:          passwords,
+		SlashingProtection: slashingProtection,
+	}
+	resp := &apimiddleware.ImportKeystoresResponseJson{}
+	if err := c.doJson(ctx, http.MethodPost, localKeysPath, req, resp); err != nil {
+		return nil, errors.Wrap(err, "failed to import keystores")
+	}
+	return resp.Statuses, nil
+}
+
+// DeleteKeystores deletes the local keys with the given public keys in hex format. The response holds a status
+// for each key, in the order of the public keys, and the slashing protection history of the deleted keys.
+func (c *Client) DeleteKeystores(ctx context.Context, pubkeys []string) (*apimiddleware.DeleteKeystoresResponseJson, error) {
+	req := &apimiddleware.DeleteKeystoresRequestJson{PublicKeys: pubkeys}
+	resp := &apimiddleware.DeleteKeystoresResponseJson{}
+	if err := c.doJson(ctx, http.MethodDelete, localKeysPath, req, resp); err != nil {
+		return nil, errors.Wrap(err, "failed to delete keystores")
+	}
+	return resp, nil
+}
+
+// ImportRemoteKeys adds web3signer keys to the validator. The returned statuses are in the order of the keys.
+func (c *Client) ImportRemoteKeys(ctx context.Context, keys []*apimiddleware.RemoteKeysJson) ([]*apimiddleware.StatusJson, error) {
+	req := &apimiddleware.ImportRemoteKeysRequestJson{Keystores: keys}
+	resp := &apimiddleware.ImportRemoteKeysResponseJson{}
+	if err := c.doJson(ctx, http.MethodPost, remoteKeysPath, req, resp); err != nil {
+		return nil, errors.Wrap(err, "failed to import remote keys")
+	}
+	return resp.Statuses, nil
+}
+
+// DeleteRemoteKeys removes the web3signer keys with the given public keys in hex format from the validator. The
+// returned statuses are in the order of the public keys.
+func (c *Client) DeleteRemoteKeys(ctx context.Context, pubkeys []string) ([]*apimiddleware.StatusJson, error) {
+	req := &apimiddleware.DeleteRemoteKeysRequestJson{PublicKeys: pubkeys}
+	resp := &apimiddleware.DeleteRemoteKeysResponseJson{}
+	if err := c.doJson(ctx, http.MethodDelete, remoteKeysPath, req, resp); err != nil {
+		return nil, errors.Wrap(err, "failed to delete remote keys")
+	}
+	return resp.Statuses, nil
+}
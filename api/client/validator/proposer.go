@@ -0,0 +1,86 @@
+package validator
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/validator/rpc/apimiddleware"
+)
+
+// SetFeeRecipientAddress sets the fee recipient, an execution address in hex format, of the validator with the
+// given public key, overriding the default fee recipient of the validator client.
+func (c *Client) SetFeeRecipientAddress(ctx context.Context, pubkey, address string) error {
+	req := &apimiddleware.SetFeeRecipientByPubkeyRequestJson{Ethaddress: address}
+	if err := c.doJson(ctx, http.MethodPost, pubkeyPath(feeRecipientPath, pubkey), req, nil); err != nil {
+		return errors.Wrapf(err, "failed to set fee recipient for validator %s", pubkey)
+	}
+	return nil
+}
+
+// DeleteFeeRecipientAddress removes the fee recipient of the validator with the given public key, which falls
+// back to the default fee recipient of the validator client.
+func (c *Client) DeleteFeeRecipientAddress(ctx context.Context, pubkey string) error {
+	req := &apimiddleware.DeleteFeeRecipientByPubkeyRequestJson{Pubkey: pubkey}
+	if err := c.doJson(ctx, http.MethodDelete, pubkeyPath(feeRecipientPath, pubkey), req, nil); err != nil {
+		return errors.Wrapf(err, "failed to delete fee recipient for validator %s", pubkey)
+	}
+	return nil
+}
+
+// GetGasLimit returns the gas limit of the blocks the validator with the given public key builds with a builder.
+func (c *Client) GetGasLimit(ctx context.Context, pubkey string) (uint64, error) {
+	resp := &apimiddleware.GetGasLimitResponseJson{}
+	if err := c.doJson(ctx, http.MethodGet, pubkeyPath(gasLimitPath, pubkey), nil, resp); err != nil {
+		return 0, errors.Wrapf(err, "failed to retrieve gas limit for validator %s", pubkey)
+	}
+	if resp.Data == nil {
+		return 0, errors.Errorf("empty gas limit response for validator %s", pubkey)
+	}
+	gasLimit, err := strconv.ParseUint(resp.Data.GasLimit, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid gas limit %s", resp.Data.GasLimit)
+	}
+	return gasLimit, nil
+}
+
+// SetGasLimit sets the gas limit of the blocks the validator with the given public key builds with a builder.
+func (c *Client) SetGasLimit(ctx context.Context, pubkey string, gasLimit uint64) error {
+	req := &apimiddleware.SetGasLimitRequestJson{GasLimit: strconv.FormatUint(gasLimit, 10)}
+	if err := c.doJson(ctx, http.MethodPost, pubkeyPath(gasLimitPath, pubkey), req, nil); err != nil {
+		return errors.Wrapf(err, "failed to set gas limit for validator %s", pubkey)
+	}
+	return nil
+}
+
+// DeleteGasLimit removes the gas limit of the validator with the given public key, which falls back to the
+// default gas limit of the validator client.
+func (c *Client) DeleteGasLimit(ctx context.Context, pubkey string) error {
+	req := &apimiddleware.DeleteGasLimitRequestJson{Pubkey: pubkey}
+	if err := c.doJson(ctx, http.MethodDelete, pubkeyPath(gasLimitPath, pubkey), req, nil); err != nil {
+		return errors.Wrapf(err, "failed to delete gas limit for validator %s", pubkey)
+	}
+	return nil
+}
+
+// SetVoluntaryExit has the validator client sign a voluntary exit of the validator with the given public key at
+// the epoch, or at the current epoch when epoch is zero. The signed exit is returned but not submitted to the
+// beacon node.
+func (c *Client) SetVoluntaryExit(
+	ctx context.Context, pubkey string, epoch primitives.Epoch,
+) (*apimiddleware.SignedVoluntaryExitJson, error) {
+	req := &apimiddleware.SetVoluntaryExitRequestJson{
+		Pubkey: pubkey,
+		Epoch:  strconv.FormatUint(uint64(epoch), 10),
+	}
+	resp := &apimiddleware.SetVoluntaryExitResponseJson{}
+	if err := c.doJson(ctx, http.MethodPost, pubkeyPath(voluntaryExitPath, pubkey), req, resp); err != nil {
+		return nil, errors.Wrapf(err, "failed to sign voluntary exit for validator %s", pubkey)
+	}
+	if resp.SignedVoluntaryExit == nil {
+		return nil, errors.Errorf("empty voluntary exit response for validator %s", pubkey)
+	}
+	return resp.SignedVoluntaryExit, nil
+}
@@ -0,0 +1,199 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	forkchoicetypes "github.com/theQRL/qrysm/v4/beacon-chain/forkchoice/types"
+	"github.com/theQRL/qrysm/v4/config/params"
+	"github.com/theQRL/qrysm/v4/consensus-types/blocks"
+	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	"github.com/theQRL/qrysm/v4/time/slots"
+	"go.opencensus.io/trace"
+)
+
+// saveForkChoiceStore serializes the fork choice store into the DB.
+func (s *Service) saveForkChoiceStore(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.saveForkChoiceStore")
+	defer span.End()
+
+	start := time.Now()
+	s.cfg.ForkChoiceStore.RLock()
+	enc, err := s.cfg.ForkChoiceStore.MarshalStore()
+	s.cfg.ForkChoiceStore.RUnlock()
+	if err != nil {
+		return errors.Wrap(err, "could not serialize fork choice store")
+	}
+	if err := s.cfg.BeaconDB.SaveForkChoiceStore(ctx, enc); err != nil {
+		return errors.Wrap(err, "could not save fork choice store")
+	}
+	log.WithField("size", len(enc)).WithField("duration", time.Since(start)).Debug("Saved fork choice store")
+	return nil
+}
+
+// spawnSaveForkChoiceRoutine saves the fork choice store at the start of every epoch, so that a restarting node
+// does not have to process the non-finalized blocks again.
+func (s *Service) spawnSaveForkChoiceRoutine() {
+	go func() {
+		if _, err := s.clockWaiter.WaitForClock(s.ctx); err != nil {
+			log.WithError(err).Error("spawnSaveForkChoiceRoutine failed to receive genesis data")
+			return
+		}
+		ticker := slots.NewSlotTicker(s.genesisTime, params.BeaconConfig().SecondsPerSlot)
+		defer ticker.Done()
+		for {
+			select {
+			case <-s.ctx.Done():
+				return
+			case slot := <-ticker.C():
+				if !slots.IsEpochStart(slot) {
+					continue
+				}
+				if err := s.saveForkChoiceStore(s.ctx); err != nil {
+					log.WithError(err).Error("Could not save fork choice store")
+				}
+			}
+		}
+	}()
+}
+
+// restoreForkChoiceStore replaces the fork choice store, initialized from the finalized checkpoint, with the
+// store saved in the DB if there is one and it is consistent with the DB and its head state. The head is then
+// set to the head of the restored store. A saved store which cannot be used is logged and ignored. The saved
+// store is deleted once it has been restored or rejected. The caller must hold the fork choice lock.
+func (s *Service) restoreForkChoiceStore(ctx context.Context, justified, finalized *zondpb.Checkpoint) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.restoreForkChoiceStore")
+	defer span.End()
+
+	enc, err := s.cfg.BeaconDB.ForkChoiceStore(ctx)
+	if err != nil {
+		log.WithError(err).Warn("Could not read saved fork choice store, starting from the finalized checkpoint")
+		return nil
+	}
+	if len(enc) == 0 {
+		return nil
+	}
+	// The saved store is only valid for this restart, the blocks processed from here on are not part of it.
+	defer func() {
+		if err := s.cfg.BeaconDB.DeleteForkChoiceStore(ctx); err != nil {
+			log.WithError(err).Error("Could not delete saved fork choice store")
+		}
+	}()
+	initial, err := s.cfg.ForkChoiceStore.MarshalStore()
+	if err != nil {
+		return errors.Wrap(err, "could not serialize fork choice store")
+	}
+	if err := s.cfg.ForkChoiceStore.RestoreStore(ctx, enc); err != nil {
+		log.WithError(err).Warn("Could not restore saved fork choice store, starting from the finalized checkpoint")
+		return nil
+	}
+	h, err := s.validateRestoredForkChoice(ctx, justified, finalized)
+	if err != nil {
+		log.WithError(err).Warn("Saved fork choice store is inconsistent, starting from the finalized checkpoint")
+		return s.cfg.ForkChoiceStore.RestoreStore(ctx, initial)
+	}
+	if err := s.setHead(h); err != nil {
+		return errors.Wrap(err, "could not set head")
+	}
+	log.WithField("nodes", s.cfg.ForkChoiceStore.NodeCount()).
+		WithField("headSlot", h.slot).
+		WithField("headRoot", fmt.Sprintf("%#x", h.root)).
+		Info("Restored saved fork choice store")
+	return nil
+}
+
+// validateRestoredForkChoice checks the restored fork choice store against the DB: the finalized block and the
+// head block of the DB, with its ancestors down to the finalized block, must be part of the store, every tip must
+// be a saved block, and the checkpoints of the head state must not be ahead of the store's. The store's checkpoints are advanced to the DB's if they are behind. It returns the head of the
+// restored store.
+func (s *Service) validateRestoredForkChoice(ctx context.Context, justified, finalized *zondpb.Checkpoint) (*head, error) {
+	fc := s.cfg.ForkChoiceStore
+	fRoot := s.ensureRootNotZeros(bytesutil.ToBytes32(finalized.Root))
+	if !fc.HasNode(fRoot) {
+		return nil, errors.Errorf("finalized block %#x is not in the store", fRoot)
+	}
+	dbHead, err := s.cfg.BeaconDB.HeadBlock(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head block from the database")
+	}
+	if err := blocks.BeaconBlockIsNil(dbHead); err != nil {
+		return nil, errors.Wrap(err, "no head block in the database")
+	}
+	dbHeadRoot, err := dbHead.Block().HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute database head root")
+	}
+	// Nodes of the store always descend from the store's root, so the head's ancestors down to the finalized
+	// block are in the store if the finalized block is one of them.
+	if !fc.HasNode(dbHeadRoot) {
+		return nil, errors.Errorf("database head block %#x is not in the store", dbHeadRoot)
+	}
+	fSlot, err := fc.Slot(fRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get finalized block slot")
+	}
+	ancestor, err := fc.AncestorRoot(ctx, dbHeadRoot, fSlot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get database head ancestor")
+	}
+	if ancestor != fRoot {
+		return nil, errors.Errorf("database head block %#x does not descend from finalized block %#x", dbHeadRoot, fRoot)
+	}
+	storeFinalized := fc.FinalizedCheckpoint()
+	if storeFinalized.Epoch > finalized.Epoch {
+		return nil, errors.Errorf("store finalized epoch %d is ahead of the database finalized epoch %d", storeFinalized.Epoch, finalized.Epoch)
+	}
+	if storeFinalized.Epoch < finalized.Epoch {
+		if err := fc.UpdateFinalizedCheckpoint(&forkchoicetypes.Checkpoint{Epoch: finalized.Epoch, Root: fRoot}); err != nil {
+			return nil, errors.Wrap(err, "could not update finalized checkpoint")
+		}
+	}
+	if fc.JustifiedCheckpoint().Epoch < justified.Epoch {
+		if err := fc.UpdateJustifiedCheckpoint(ctx, &forkchoicetypes.Checkpoint{Epoch: justified.Epoch,
+			Root: bytesutil.ToBytes32(justified.Root)}); err != nil {
+			return nil, errors.Wrap(err, "could not update justified checkpoint")
+		}
+	}
+	tips, _ := fc.Tips()
+	for _, tip := range tips {
+		if !s.cfg.BeaconDB.HasBlock(ctx, tip) {
+			return nil, errors.Errorf("block %#x is not in the database", tip)
+		}
+	}
+
+	headRoot, err := fc.Head(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute head")
+	}
+	headBlock, err := s.getBlock(ctx, headRoot)
+	if err != nil {
+		return nil, err
+	}
+	headState, err := s.cfg.StateGen.StateByRoot(ctx, headRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head state")
+	}
+	if headState == nil || headState.IsNil() {
+		return nil, errors.Errorf("no state for head %#x", headRoot)
+	}
+	if headState.FinalizedCheckpoint().Epoch > fc.FinalizedCheckpoint().Epoch {
+		return nil, errors.Errorf("head state finalized epoch %d is ahead of the store", headState.FinalizedCheckpoint().Epoch)
+	}
+	if headState.CurrentJustifiedCheckpoint().Epoch > fc.JustifiedCheckpoint().Epoch {
+		return nil, errors.Errorf("head state justified epoch %d is ahead of the store", headState.CurrentJustifiedCheckpoint().Epoch)
+	}
+	optimistic, err := fc.IsOptimistic(headRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check head optimistic status")
+	}
+	return &head{
+		root:       headRoot,
+		block:      headBlock,
+		state:      headState,
+		slot:       headBlock.Block().Slot(),
+		optimistic: optimistic,
+	}, nil
+}
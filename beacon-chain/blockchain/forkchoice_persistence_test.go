@@ -0,0 +1,118 @@
+package blockchain
+
+import (
+	"testing"
+
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	doublylinkedtree "github.com/theQRL/qrysm/v4/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/theQRL/qrysm/v4/config/params"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+	"github.com/theQRL/qrysm/v4/testing/util"
+)
+
+func TestService_SaveForkChoiceStore(t *testing.T) {
+	service, tr := minimalTestService(t)
+	ctx, fcs := tr.ctx, tr.fcs
+
+	cp := &zondpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	st, root, err := prepareForkchoiceState(ctx, 0, [32]byte{'a'}, params.BeaconConfig().ZeroHash, [32]byte{'A'}, cp, cp)
+	require.NoError(t, err)
+	require.NoError(t, fcs.InsertNode(ctx, st, root))
+
+	require.NoError(t, service.saveForkChoiceStore(ctx))
+	saved, err := tr.db.ForkChoiceStore(ctx)
+	require.NoError(t, err)
+	want, err := fcs.MarshalStore()
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, saved)
+}
+
+func TestService_RestoreForkChoiceStore_Inconsistent(t *testing.T) {
+	hook := logTest.NewGlobal()
+	service, tr := minimalTestService(t)
+	ctx, fcs := tr.ctx, tr.fcs
+
+	cp := &zondpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	st, root, err := prepareForkchoiceState(ctx, 0, [32]byte{'a'}, params.BeaconConfig().ZeroHash, [32]byte{'A'}, cp, cp)
+	require.NoError(t, err)
+	require.NoError(t, fcs.InsertNode(ctx, st, root))
+
+	// The saved store does not contain the finalized block.
+	other := doublylinkedtree.New()
+	st, root, err = prepareForkchoiceState(ctx, 0, [32]byte{'b'}, params.BeaconConfig().ZeroHash, [32]byte{'B'}, cp, cp)
+	require.NoError(t, err)
+	require.NoError(t, other.InsertNode(ctx, st, root))
+	enc, err := other.MarshalStore()
+	require.NoError(t, err)
+	require.NoError(t, tr.db.SaveForkChoiceStore(ctx, enc))
+
+	finalized := &zondpb.Checkpoint{Root: []byte{'a'}}
+	require.NoError(t, service.restoreForkChoiceStore(ctx, finalized, finalized))
+	require.LogsContain(t, hook, "Saved fork choice store is inconsistent")
+	assert.Equal(t, true, fcs.HasNode([32]byte{'a'}))
+	assert.Equal(t, false, fcs.HasNode([32]byte{'b'}))
+	saved, err := tr.db.ForkChoiceStore(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(saved))
+}
+
+func TestService_RestoreForkChoiceStore_HeadNotInStore(t *testing.T) {
+	hook := logTest.NewGlobal()
+	service, tr := minimalTestService(t)
+	ctx, fcs := tr.ctx, tr.fcs
+
+	cp := &zondpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	st, root, err := prepareForkchoiceState(ctx, 0, [32]byte{'a'}, params.BeaconConfig().ZeroHash, [32]byte{'A'}, cp, cp)
+	require.NoError(t, err)
+	require.NoError(t, fcs.InsertNode(ctx, st, root))
+
+	// The saved store contains the finalized block, but not the head block of the database.
+	other := doublylinkedtree.New()
+	st, root, err = prepareForkchoiceState(ctx, 0, [32]byte{'a'}, params.BeaconConfig().ZeroHash, [32]byte{'A'}, cp, cp)
+	require.NoError(t, err)
+	require.NoError(t, other.InsertNode(ctx, st, root))
+	st, root, err = prepareForkchoiceState(ctx, 1, [32]byte{'b'}, [32]byte{'a'}, [32]byte{'B'}, cp, cp)
+	require.NoError(t, err)
+	require.NoError(t, other.InsertNode(ctx, st, root))
+	enc, err := other.MarshalStore()
+	require.NoError(t, err)
+	require.NoError(t, tr.db.SaveForkChoiceStore(ctx, enc))
+
+	headBlock := util.NewBeaconBlock()
+	headBlock.Block.Slot = 2
+	util.SaveBlock(t, ctx, tr.db, headBlock)
+	headRoot, err := headBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, tr.db.SaveStateSummary(ctx, &zondpb.StateSummary{Root: headRoot[:], Slot: 2}))
+	require.NoError(t, tr.db.SaveHeadBlockRoot(ctx, headRoot))
+
+	finalized := &zondpb.Checkpoint{Root: []byte{'a'}}
+	require.NoError(t, service.restoreForkChoiceStore(ctx, finalized, finalized))
+	require.LogsContain(t, hook, "database head block")
+	assert.Equal(t, false, fcs.HasNode([32]byte{'b'}))
+	saved, err := tr.db.ForkChoiceStore(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(saved))
+}
+
+func TestService_RestoreForkChoiceStore_Invalid(t *testing.T) {
+	hook := logTest.NewGlobal()
+	service, tr := minimalTestService(t)
+	ctx, fcs := tr.ctx, tr.fcs
+
+	cp := &zondpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	st, root, err := prepareForkchoiceState(ctx, 0, [32]byte{'a'}, params.BeaconConfig().ZeroHash, [32]byte{'A'}, cp, cp)
+	require.NoError(t, err)
+	require.NoError(t, fcs.InsertNode(ctx, st, root))
+
+	require.NoError(t, tr.db.SaveForkChoiceStore(ctx, []byte{1, 2, 3}))
+	finalized := &zondpb.Checkpoint{Root: []byte{'a'}}
+	require.NoError(t, service.restoreForkChoiceStore(ctx, finalized, finalized))
+	require.LogsContain(t, hook, "Could not restore saved fork choice store")
+	assert.Equal(t, true, fcs.HasNode([32]byte{'a'}))
+	saved, err := tr.db.ForkChoiceStore(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(saved))
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/theQRL/qrysm/v4/beacon-chain/db"
 	forkchoicetypes "github.com/theQRL/qrysm/v4/beacon-chain/forkchoice/types"
 	"github.com/theQRL/qrysm/v4/beacon-chain/state"
+	"github.com/theQRL/qrysm/v4/cmd/beacon-chain/flags"
 	"github.com/theQRL/qrysm/v4/config/features"
 	"github.com/theQRL/qrysm/v4/config/params"
 	consensusblocks "github.com/theQRL/qrysm/v4/consensus-types/blocks"
@@ -115,6 +116,10 @@ func (s *Service) postBlockProcess(ctx context.Context, signed interfaces.ReadOn
 		},
 	})
 
+	if features.Get().EnableLightClient {
+		s.queueLightClientUpdates(signed, postState)
+	}
+
 	defer reportAttestationInclusion(b)
 	if headRoot == blockRoot {
 		// Updating next slot state cache can happen in the background
@@ -239,7 +244,7 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []consensusblocks.ROBlo
 	if features.Get().EnableVerboseSigVerification {
 		verify, err = sigSet.VerifyVerbosely()
 	} else {
-		verify, err = sigSet.Verify()
+		verify, err = sigSet.VerifyParallel(ctx, flags.Get().SignatureVerificationWorkers)
 	}
 	if err != nil {
 		return invalidBlock{error: err}
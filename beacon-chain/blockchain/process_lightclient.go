@@ -0,0 +1,282 @@
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"math/bits"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/v4/beacon-chain/core/feed"
+	statefeed "github.com/theQRL/qrysm/v4/beacon-chain/core/feed/state"
+	"github.com/theQRL/qrysm/v4/beacon-chain/state"
+	"github.com/theQRL/qrysm/v4/consensus-types/interfaces"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
+	zondpbv2 "github.com/theQRL/qrysm/v4/proto/zond/v2"
+	"github.com/theQRL/qrysm/v4/time/slots"
+	"go.opencensus.io/trace"
+)
+
+const (
+	syncCommitteeBranchNumOfLeaves = 5
+	// lightClientBlocksBufferSize is the number of imported blocks waiting for their light client updates to be
+	// computed. Blocks imported while the buffer is full are skipped.
+	lightClientBlocksBufferSize = 32
+)
+
+// lightClientBlock is an imported block whose light client updates are to be computed.
+type lightClientBlock struct {
+	signed    interfaces.ReadOnlySignedBeaconBlock
+	postState state.BeaconState
+}
+
+// LightClientFetcher retrieves the latest light client updates produced by the node.
+type LightClientFetcher interface {
+	LightClientFinalityUpdate() *zondpbv2.LightClientFinalityUpdate
+	LightClientOptimisticUpdate() *zondpbv2.LightClientOptimisticUpdate
+}
+
+// LightClientFinalityUpdate returns the latest light client finality update, or nil if none was produced yet.
+func (s *Service) LightClientFinalityUpdate() *zondpbv2.LightClientFinalityUpdate {
+	s.lightClientLock.RLock()
+	defer s.lightClientLock.RUnlock()
+	return s.lightClientFinalityUpdate
+}
+
+// LightClientOptimisticUpdate returns the latest light client optimistic update, or nil if none was produced yet.
+func (s *Service) LightClientOptimisticUpdate() *zondpbv2.LightClientOptimisticUpdate {
+	s.lightClientLock.RLock()
+	defer s.lightClientLock.RUnlock()
+	return s.lightClientOptimisticUpdate
+}
+
+// queueLightClientUpdates queues the imported block for the computation of its light client updates, which need
+// the parent state and a database write and therefore do not delay the import of the block. The block is skipped
+// when the queue is full, as the updates of the following blocks supersede its own.
+func (s *Service) queueLightClientUpdates(signed interfaces.ReadOnlySignedBeaconBlock, postState state.BeaconState) {
+	select {
+	case s.lightClientBlocks <- &lightClientBlock{signed: signed, postState: postState}:
+	default:
+		log.WithField("slot", signed.Block().Slot()).Debug("Light client updates queue is full, skipping block")
+	}
+}
+
+// runLightClientUpdates computes the light client updates of the queued blocks, one block at a time in the order
+// they were imported.
+func (s *Service) runLightClientUpdates() {
+	for {
+		select {
+		case b := <-s.lightClientBlocks:
+			if err := s.processLightClientUpdates(s.ctx, b.signed, b.postState); err != nil {
+				log.WithError(err).Debug("Could not process light client updates")
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			return
+		}
+	}
+}
+
+// processLightClientUpdates computes the light client updates attested by the sync aggregate of the given block.
+// Finality and optimistic updates newer than the ones previously produced are sent to the state feed and
+// gossiped to peers, and the best update for the attested sync committee period is persisted to the database.
+func (s *Service) processLightClientUpdates(ctx context.Context, signed interfaces.ReadOnlySignedBeaconBlock, postState state.BeaconState) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.processLightClientUpdates")
+	defer span.End()
+
+	attestedRoot := signed.Block().ParentRoot()
+	attestedState, err := s.cfg.StateGen.StateByRoot(ctx, attestedRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get attested state")
+	}
+	var finalizedBlock interfaces.ReadOnlySignedBeaconBlock
+	if cp := attestedState.FinalizedCheckpoint(); cp != nil {
+		finalizedBlock, err = s.cfg.BeaconDB.Block(ctx, bytesutil.ToBytes32(cp.Root))
+		if err != nil {
+			return errors.Wrap(err, "could not get finalized block")
+		}
+	}
+	update, err := NewLightClientFinalityUpdateFromBeaconState(ctx, postState, signed, attestedState, finalizedBlock)
+	if err != nil {
+		return errors.Wrap(err, "could not create light client update")
+	}
+
+	s.publishLightClientUpdates(ctx, signed.Version(), update)
+
+	if err := s.saveBestLightClientUpdate(ctx, update, attestedState); err != nil {
+		return errors.Wrap(err, "could not save light client update")
+	}
+	return nil
+}
+
+// publishLightClientUpdates sends finality and optimistic updates derived from the given update to the
+// state feed and to peers, but only if they are newer than the updates that were previously published.
+func (s *Service) publishLightClientUpdates(ctx context.Context, v int, update *zondpbv2.LightClientUpdate) {
+	finalityUpdate := CreateLightClientFinalityUpdate(update)
+	optimisticUpdate := CreateLightClientOptimisticUpdate(update)
+
+	s.lightClientLock.Lock()
+	newFinality := s.lightClientFinalityUpdate == nil ||
+		finalityUpdate.FinalizedHeader.Slot > s.lightClientFinalityUpdate.FinalizedHeader.Slot
+	if newFinality {
+		s.lightClientFinalityUpdate = finalityUpdate
+	}
+	newOptimistic := s.lightClientOptimisticUpdate == nil ||
+		optimisticUpdate.AttestedHeader.Slot > s.lightClientOptimisticUpdate.AttestedHeader.Slot
+	if newOptimistic {
+		s.lightClientOptimisticUpdate = optimisticUpdate
+	}
+	s.lightClientLock.Unlock()
+
+	if newFinality {
+		s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.LightClientFinalityUpdate,
+			Data: &zondpbv2.LightClientFinalityUpdateWithVersion{
+				Version: zondpbv2.Version(v),
+				Data:    finalityUpdate,
+			},
+		})
+		if err := s.cfg.P2p.Broadcast(ctx, finalityUpdate); err != nil {
+			log.WithError(err).Debug("Could not broadcast light client finality update")
+		}
+	}
+	if newOptimistic {
+		s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.LightClientOptimisticUpdate,
+			Data: &zondpbv2.LightClientOptimisticUpdateWithVersion{
+				Version: zondpbv2.Version(v),
+				Data:    optimisticUpdate,
+			},
+		})
+		if err := s.cfg.P2p.Broadcast(ctx, optimisticUpdate); err != nil {
+			log.WithError(err).Debug("Could not broadcast light client optimistic update")
+		}
+	}
+}
+
+// saveBestLightClientUpdate completes the update with the next sync committee of the attested state and
+// saves it for the attested sync committee period, if it is better than the update already stored.
+func (s *Service) saveBestLightClientUpdate(ctx context.Context, update *zondpbv2.LightClientUpdate, attestedState state.BeaconState) error {
+	attestedPeriod := slots.SyncCommitteePeriod(slots.ToEpoch(update.AttestedHeader.Slot))
+	signaturePeriod := slots.SyncCommitteePeriod(slots.ToEpoch(update.SignatureSlot))
+
+	// The next sync committee is only known to the light client if it is signed by the current one.
+	if attestedPeriod == signaturePeriod {
+		committee, err := attestedState.NextSyncCommittee()
+		if err != nil {
+			return errors.Wrap(err, "could not get next sync committee")
+		}
+		branch, err := attestedState.NextSyncCommitteeProof(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not get next sync committee proof")
+		}
+		update.NextSyncCommittee = &zondpbv2.SyncCommittee{
+			Pubkeys:         committee.Pubkeys,
+			AggregatePubkey: committee.AggregatePubkey,
+		}
+		update.NextSyncCommitteeBranch = branch
+	} else {
+		update.NextSyncCommitteeBranch = emptyBranch(syncCommitteeBranchNumOfLeaves)
+	}
+
+	old, err := s.cfg.BeaconDB.LightClientUpdate(ctx, attestedPeriod)
+	if err != nil {
+		return err
+	}
+	if old != nil && !IsBetterLightClientUpdate(update, old) {
+		return nil
+	}
+	return s.cfg.BeaconDB.SaveLightClientUpdate(ctx, attestedPeriod, update)
+}
+
+// IsBetterLightClientUpdate - implements https://github.com/ethereum/consensus-specs/blob/3d235740e5f1e641d3b160c8688f26e7dc5a1894/specs/altair/light-client/sync-protocol.md#is_better_update
+// It returns true if newUpdate should replace oldUpdate as the best update of a sync committee period.
+func IsBetterLightClientUpdate(newUpdate, oldUpdate *zondpbv2.LightClientUpdate) bool {
+	maxActiveParticipants := len(newUpdate.SyncAggregate.SyncCommitteeBits) * 8
+	newNumActiveParticipants := countBits(newUpdate.SyncAggregate.SyncCommitteeBits)
+	oldNumActiveParticipants := countBits(oldUpdate.SyncAggregate.SyncCommitteeBits)
+	newHasSupermajority := newNumActiveParticipants*3 >= maxActiveParticipants*2
+	oldHasSupermajority := oldNumActiveParticipants*3 >= maxActiveParticipants*2
+	if newHasSupermajority != oldHasSupermajority {
+		return newHasSupermajority
+	}
+	if !newHasSupermajority && newNumActiveParticipants != oldNumActiveParticipants {
+		return newNumActiveParticipants > oldNumActiveParticipants
+	}
+
+	// Compare presence of relevant sync committee
+	newHasRelevantSyncCommittee := isSyncCommitteeUpdate(newUpdate) &&
+		syncCommitteePeriodAtSlot(newUpdate.AttestedHeader.Slot) == syncCommitteePeriodAtSlot(newUpdate.SignatureSlot)
+	oldHasRelevantSyncCommittee := isSyncCommitteeUpdate(oldUpdate) &&
+		syncCommitteePeriodAtSlot(oldUpdate.AttestedHeader.Slot) == syncCommitteePeriodAtSlot(oldUpdate.SignatureSlot)
+	if newHasRelevantSyncCommittee != oldHasRelevantSyncCommittee {
+		return newHasRelevantSyncCommittee
+	}
+
+	// Compare indication of any finality
+	newHasFinality := isFinalityUpdate(newUpdate)
+	oldHasFinality := isFinalityUpdate(oldUpdate)
+	if newHasFinality != oldHasFinality {
+		return newHasFinality
+	}
+
+	// Compare sync committee finality
+	if newHasFinality {
+		newHasSyncCommitteeFinality :=
+			syncCommitteePeriodAtSlot(newUpdate.FinalizedHeader.Slot) == syncCommitteePeriodAtSlot(newUpdate.AttestedHeader.Slot)
+		oldHasSyncCommitteeFinality :=
+			syncCommitteePeriodAtSlot(oldUpdate.FinalizedHeader.Slot) == syncCommitteePeriodAtSlot(oldUpdate.AttestedHeader.Slot)
+		if newHasSyncCommitteeFinality != oldHasSyncCommitteeFinality {
+			return newHasSyncCommitteeFinality
+		}
+	}
+
+	// Tiebreaker 1: Sync committee participation beyond supermajority
+	if newNumActiveParticipants != oldNumActiveParticipants {
+		return newNumActiveParticipants > oldNumActiveParticipants
+	}
+
+	// Tiebreaker 2: Prefer older data (fewer changes to best)
+	if newUpdate.AttestedHeader.Slot != oldUpdate.AttestedHeader.Slot {
+		return newUpdate.AttestedHeader.Slot < oldUpdate.AttestedHeader.Slot
+	}
+	return newUpdate.SignatureSlot < oldUpdate.SignatureSlot
+}
+
+func isSyncCommitteeUpdate(update *zondpbv2.LightClientUpdate) bool {
+	return !isEmptyBranch(update.NextSyncCommitteeBranch)
+}
+
+func isFinalityUpdate(update *zondpbv2.LightClientUpdate) bool {
+	return !isEmptyBranch(update.FinalityBranch)
+}
+
+func isEmptyBranch(branch [][]byte) bool {
+	empty := make([]byte, 32)
+	for _, b := range branch {
+		if !bytes.Equal(b, empty) {
+			return false
+		}
+	}
+	return true
+}
+
+func emptyBranch(depth int) [][]byte {
+	branch := make([][]byte, depth)
+	for i := range branch {
+		branch[i] = make([]byte, 32)
+	}
+	return branch
+}
+
+func syncCommitteePeriodAtSlot(slot primitives.Slot) uint64 {
+	return slots.SyncCommitteePeriod(slots.ToEpoch(slot))
+}
+
+func countBits(b []byte) int {
+	count := 0
+	for _, x := range b {
+		count += bits.OnesCount8(x)
+	}
+	return count
+}
@@ -0,0 +1,171 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/theQRL/qrysm/v4/config/params"
+	"github.com/theQRL/qrysm/v4/consensus-types/blocks"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	zondpbv1 "github.com/theQRL/qrysm/v4/proto/zond/v1"
+	zondpbv2 "github.com/theQRL/qrysm/v4/proto/zond/v2"
+	"github.com/theQRL/qrysm/v4/runtime/version"
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+	"github.com/theQRL/qrysm/v4/testing/util"
+)
+
+func testLightClientUpdate(attestedSlot, finalizedSlot, signatureSlot primitives.Slot, bits []byte, finality bool) *zondpbv2.LightClientUpdate {
+	header := func(slot primitives.Slot) *zondpbv1.BeaconBlockHeader {
+		return &zondpbv1.BeaconBlockHeader{
+			Slot:       slot,
+			ParentRoot: make([]byte, 32),
+			StateRoot:  make([]byte, 32),
+			BodyRoot:   make([]byte, 32),
+		}
+	}
+	finalityBranch := emptyBranch(finalityBranchNumOfLeaves)
+	if finality {
+		finalityBranch[0][0] = 1
+	}
+	return &zondpbv2.LightClientUpdate{
+		AttestedHeader:          header(attestedSlot),
+		NextSyncCommitteeBranch: emptyBranch(syncCommitteeBranchNumOfLeaves),
+		FinalizedHeader:         header(finalizedSlot),
+		FinalityBranch:          finalityBranch,
+		SyncAggregate: &zondpbv1.SyncAggregate{
+			SyncCommitteeBits:      bits,
+			SyncCommitteeSignature: make([]byte, 73520),
+		},
+		SignatureSlot: signatureSlot,
+	}
+}
+
+func TestIsBetterLightClientUpdate(t *testing.T) {
+	periodSlots := primitives.Slot(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * params.BeaconConfig().SlotsPerEpoch
+	full := []byte{0xff, 0xff}
+	supermajority := []byte{0xff, 0x7f}
+	minority := []byte{0x0f, 0x00}
+	fewer := []byte{0x07, 0x00}
+
+	withSyncCommittee := func(u *zondpbv2.LightClientUpdate) *zondpbv2.LightClientUpdate {
+		u.NextSyncCommitteeBranch[0][0] = 1
+		return u
+	}
+
+	tests := []struct {
+		name      string
+		newUpdate *zondpbv2.LightClientUpdate
+		oldUpdate *zondpbv2.LightClientUpdate
+		want      bool
+	}{
+		{
+			name:      "supermajority beats minority",
+			newUpdate: testLightClientUpdate(10, 0, 11, supermajority, false),
+			oldUpdate: testLightClientUpdate(10, 0, 11, minority, true),
+			want:      true,
+		},
+		{
+			name:      "minority loses to supermajority",
+			newUpdate: testLightClientUpdate(10, 0, 11, minority, true),
+			oldUpdate: testLightClientUpdate(10, 0, 11, supermajority, false),
+			want:      false,
+		},
+		{
+			name:      "more participants without supermajority",
+			newUpdate: testLightClientUpdate(10, 0, 11, minority, false),
+			oldUpdate: testLightClientUpdate(10, 0, 11, fewer, true),
+			want:      true,
+		},
+		{
+			name:      "relevant sync committee",
+			newUpdate: withSyncCommittee(testLightClientUpdate(10, 0, 11, supermajority, false)),
+			oldUpdate: testLightClientUpdate(10, 0, 11, full, true),
+			want:      true,
+		},
+		{
+			name:      "sync committee signed by next period is not relevant",
+			newUpdate: withSyncCommittee(testLightClientUpdate(periodSlots-1, 0, periodSlots, full, false)),
+			oldUpdate: testLightClientUpdate(10, 0, 11, full, false),
+			want:      false,
+		},
+		{
+			name:      "finality",
+			newUpdate: testLightClientUpdate(10, 1, 11, supermajority, true),
+			oldUpdate: testLightClientUpdate(10, 0, 11, full, false),
+			want:      true,
+		},
+		{
+			name:      "sync committee finality",
+			newUpdate: testLightClientUpdate(periodSlots+10, periodSlots+1, periodSlots+11, supermajority, true),
+			oldUpdate: testLightClientUpdate(periodSlots+10, 1, periodSlots+11, full, true),
+			want:      true,
+		},
+		{
+			name:      "participation beyond supermajority",
+			newUpdate: testLightClientUpdate(10, 1, 11, full, true),
+			oldUpdate: testLightClientUpdate(10, 1, 11, supermajority, true),
+			want:      true,
+		},
+		{
+			name:      "older attested header",
+			newUpdate: testLightClientUpdate(9, 1, 11, full, true),
+			oldUpdate: testLightClientUpdate(10, 1, 11, full, true),
+			want:      true,
+		},
+		{
+			name:      "older signature slot",
+			newUpdate: testLightClientUpdate(10, 1, 12, full, true),
+			oldUpdate: testLightClientUpdate(10, 1, 11, full, true),
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsBetterLightClientUpdate(tt.newUpdate, tt.oldUpdate))
+		})
+	}
+}
+
+func TestService_publishLightClientUpdates(t *testing.T) {
+	ctx := context.Background()
+	b := &mockBroadcaster{}
+	s, _ := minimalTestService(t, WithP2PBroadcaster(b))
+	require.Equal(t, (*zondpbv2.LightClientFinalityUpdate)(nil), s.LightClientFinalityUpdate())
+	require.Equal(t, (*zondpbv2.LightClientOptimisticUpdate)(nil), s.LightClientOptimisticUpdate())
+
+	s.publishLightClientUpdates(ctx, version.Capella, testLightClientUpdate(10, 1, 11, []byte{0xff, 0xff}, true))
+	assert.Equal(t, true, b.broadcastCalled)
+	assert.Equal(t, primitives.Slot(1), s.LightClientFinalityUpdate().FinalizedHeader.Slot)
+	assert.Equal(t, primitives.Slot(10), s.LightClientOptimisticUpdate().AttestedHeader.Slot)
+
+	// An update for a newer attested header with the same finalized header only replaces the optimistic update.
+	b.broadcastCalled = false
+	s.publishLightClientUpdates(ctx, version.Capella, testLightClientUpdate(12, 1, 13, []byte{0xff, 0x7f}, true))
+	assert.Equal(t, true, b.broadcastCalled)
+	assert.Equal(t, primitives.Slot(11), s.LightClientFinalityUpdate().SignatureSlot)
+	assert.Equal(t, primitives.Slot(12), s.LightClientOptimisticUpdate().AttestedHeader.Slot)
+
+	// Older updates are not published.
+	b.broadcastCalled = false
+	s.publishLightClientUpdates(ctx, version.Capella, testLightClientUpdate(9, 0, 10, []byte{0xff, 0xff}, false))
+	assert.Equal(t, false, b.broadcastCalled)
+	assert.Equal(t, primitives.Slot(12), s.LightClientOptimisticUpdate().AttestedHeader.Slot)
+}
+
+func TestService_queueLightClientUpdates(t *testing.T) {
+	s, _ := minimalTestService(t)
+	for i := 0; i < lightClientBlocksBufferSize+1; i++ {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = primitives.Slot(i)
+		signed, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		s.queueLightClientUpdates(signed, nil)
+	}
+	// Blocks queued while the queue is full are skipped, the others are kept in import order.
+	require.Equal(t, lightClientBlocksBufferSize, len(s.lightClientBlocks))
+	for i := 0; i < lightClientBlocksBufferSize; i++ {
+		b := <-s.lightClientBlocks
+		assert.Equal(t, primitives.Slot(i), b.signed.Block().Slot())
+	}
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/theQRL/qrysm/v4/consensus-types/interfaces"
 	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
 	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	zondpbv2 "github.com/theQRL/qrysm/v4/proto/zond/v2"
 	prysmTime "github.com/theQRL/qrysm/v4/time"
 	"github.com/theQRL/qrysm/v4/time/slots"
 	"go.opencensus.io/trace"
@@ -46,23 +47,27 @@ import (
 // Service represents a service that handles the internal
 // logic of managing the full PoS beacon chain.
 type Service struct {
-	cfg                  *config
-	ctx                  context.Context
-	cancel               context.CancelFunc
-	genesisTime          time.Time
-	head                 *head
-	headLock             sync.RWMutex
-	originBlockRoot      [32]byte // genesis root, or weak subjectivity checkpoint root, depending on how the node is initialized
-	boundaryRoots        [][32]byte
-	checkpointStateCache *cache.CheckpointStateCache
-	initSyncBlocks       map[[32]byte]interfaces.ReadOnlySignedBeaconBlock
-	initSyncBlocksLock   sync.RWMutex
-	wsVerifier           *WeakSubjectivityVerifier
-	clockSetter          startup.ClockSetter
-	clockWaiter          startup.ClockWaiter
-	syncComplete         chan struct{}
-	blobNotifiers        *blobNotifierMap
-	blockBeingSynced     *currentlySyncingBlock
+	cfg                         *config
+	ctx                         context.Context
+	cancel                      context.CancelFunc
+	genesisTime                 time.Time
+	head                        *head
+	headLock                    sync.RWMutex
+	originBlockRoot             [32]byte // genesis root, or weak subjectivity checkpoint root, depending on how the node is initialized
+	boundaryRoots               [][32]byte
+	checkpointStateCache        *cache.CheckpointStateCache
+	initSyncBlocks              map[[32]byte]interfaces.ReadOnlySignedBeaconBlock
+	initSyncBlocksLock          sync.RWMutex
+	wsVerifier                  *WeakSubjectivityVerifier
+	clockSetter                 startup.ClockSetter
+	clockWaiter                 startup.ClockWaiter
+	syncComplete                chan struct{}
+	blobNotifiers               *blobNotifierMap
+	blockBeingSynced            *currentlySyncingBlock
+	lightClientBlocks           chan *lightClientBlock
+	lightClientLock             sync.RWMutex
+	lightClientFinalityUpdate   *zondpbv2.LightClientFinalityUpdate
+	lightClientOptimisticUpdate *zondpbv2.LightClientOptimisticUpdate
 }
 
 // config options for the service.
@@ -136,6 +141,7 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 		blobNotifiers:        bn,
 		cfg:                  &config{ProposerSlotIndexCache: cache.NewProposerPayloadIDsCache()},
 		blockBeingSynced:     &currentlySyncingBlock{roots: make(map[[32]byte]struct{})},
+		lightClientBlocks:    make(chan *lightClientBlock, lightClientBlocksBufferSize),
 	}
 	for _, opt := range opts {
 		if err := opt(srv); err != nil {
@@ -166,7 +172,13 @@ func (s *Service) Start() {
 		}
 	}
 	s.spawnProcessAttestationsRoutine()
+	if features.Get().EnableForkChoicePersistence {
+		s.spawnSaveForkChoiceRoutine()
+	}
 	go s.runLateBlockTasks()
+	if features.Get().EnableLightClient {
+		go s.runLightClientUpdates()
+	}
 }
 
 // Stop the blockchain service's main event loop and associated goroutines.
@@ -185,6 +197,11 @@ func (s *Service) Stop() error {
 	} else {
 		s.headLock.RUnlock()
 	}
+	if features.Get().EnableForkChoicePersistence && s.cfg.ForkChoiceStore.NodeCount() > 0 {
+		if err := s.saveForkChoiceStore(s.ctx); err != nil {
+			log.WithError(err).Error("Could not save fork choice store")
+		}
+	}
 	// Save initial sync cached blocks to the DB before stop.
 	return s.cfg.BeaconDB.SaveBlocks(s.ctx, s.getInitSyncBlocks())
 }
@@ -273,6 +290,11 @@ func (s *Service) StartFromSavedState(saved state.BeaconState) error {
 			}
 		}
 	}
+	if features.Get().EnableForkChoicePersistence {
+		if err := s.restoreForkChoiceStore(s.ctx, justified, finalized); err != nil {
+			return errors.Wrap(err, "could not restore fork choice store")
+		}
+	}
 	// not attempting to save initial sync blocks here, because there shouldn't be any until
 	// after the statefeed.Initialized event is fired (below)
 	if err := s.wsVerifier.VerifyWeakSubjectivity(s.ctx, finalized.Epoch); err != nil {
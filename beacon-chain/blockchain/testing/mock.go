@@ -30,6 +30,7 @@ import (
 	enginev1 "github.com/theQRL/qrysm/v4/proto/engine/v1"
 	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
 	zondpbv1 "github.com/theQRL/qrysm/v4/proto/zond/v1"
+	zondpbv2 "github.com/theQRL/qrysm/v4/proto/zond/v2"
 )
 
 var ErrNilState = errors.New("nil state")
@@ -72,6 +73,8 @@ type ChainService struct {
 	OptimisticRoots             map[[32]byte]bool
 	BlockSlot                   primitives.Slot
 	SyncingRoot                 [32]byte
+	FinalityUpdate              *zondpbv2.LightClientFinalityUpdate
+	OptimisticUpdate            *zondpbv2.LightClientOptimisticUpdate
 }
 
 func (s *ChainService) Ancestor(ctx context.Context, root []byte, slot primitives.Slot) ([]byte, error) {
@@ -580,6 +583,16 @@ func (s *ChainService) ForkChoiceDump(ctx context.Context) (*zondpbv1.ForkChoice
 	return nil, nil
 }
 
+// LightClientFinalityUpdate mocks the same method in the chain service
+func (s *ChainService) LightClientFinalityUpdate() *zondpbv2.LightClientFinalityUpdate {
+	return s.FinalityUpdate
+}
+
+// LightClientOptimisticUpdate mocks the same method in the chain service
+func (s *ChainService) LightClientOptimisticUpdate() *zondpbv2.LightClientOptimisticUpdate {
+	return s.OptimisticUpdate
+}
+
 // NewSlot mocks the same method in the chain service
 func (s *ChainService) NewSlot(ctx context.Context, slot primitives.Slot) error {
 	if s.ForkChoiceStore != nil {
@@ -27,4 +27,19 @@ var (
 			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000},
 		},
 	)
+	relayGetHeaderLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "relay_get_header_latency_milliseconds",
+			Help:    "Captures RPC latency for get header in milliseconds, by relay",
+			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000},
+		},
+		[]string{"relay"},
+	)
+	relayBidsCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "relay_bids_total",
+			Help: "The number of header requests to each relay, by result: accepted, rejected or none",
+		},
+		[]string{"relay", "result"},
+	)
 )
@@ -1,6 +1,8 @@
 package builder
 
 import (
+	"time"
+
 	"github.com/theQRL/qrysm/v4/api/client/builder"
 	"github.com/theQRL/qrysm/v4/beacon-chain/blockchain"
 	"github.com/theQRL/qrysm/v4/beacon-chain/cache"
@@ -13,17 +15,22 @@ type Option func(s *Service) error
 
 // FlagOptions for builder service flag configurations.
 func FlagOptions(c *cli.Context) ([]Option, error) {
-	endpoint := c.String(flags.MevRelayEndpoint.Name)
-	var client *builder.Client
-	if endpoint != "" {
-		var err error
-		client, err = builder.NewClient(endpoint)
+	endpoints := c.StringSlice(flags.MevRelayEndpoint.Name)
+	clients := make([]builder.BuilderClient, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		if endpoint == "" {
+			continue
+		}
+		client, err := builder.NewClient(endpoint)
 		if err != nil {
 			return nil, err
 		}
+		clients = append(clients, client)
 	}
 	opts := []Option{
-		WithBuilderClient(client),
+		WithBuilderClients(clients...),
+		WithRelayTimeout(c.Duration(flags.BuilderRelayTimeout.Name)),
+		WithMinBid(c.Uint64(flags.MinBuilderBid.Name)),
 	}
 	return opts, nil
 }
@@ -31,7 +38,32 @@ func FlagOptions(c *cli.Context) ([]Option, error) {
 // WithBuilderClient sets the builder client for the beacon chain builder service.
 func WithBuilderClient(client builder.BuilderClient) Option {
 	return func(s *Service) error {
-		s.cfg.builderClient = client
+		s.cfg.builderClients = append(s.cfg.builderClients, client)
+		return nil
+	}
+}
+
+// WithBuilderClients sets the clients of the relays queried by the beacon chain builder service.
+func WithBuilderClients(clients ...builder.BuilderClient) Option {
+	return func(s *Service) error {
+		s.cfg.builderClients = append(s.cfg.builderClients, clients...)
+		return nil
+	}
+}
+
+// WithRelayTimeout sets the maximum time allowed for each relay to respond to a header request. Zero means the
+// relays are only bound by the deadline of the request.
+func WithRelayTimeout(timeout time.Duration) Option {
+	return func(s *Service) error {
+		s.cfg.relayTimeout = timeout
+		return nil
+	}
+}
+
+// WithMinBid sets the minimum value, in Gwei, of the relay bids. Lower bids are ignored.
+func WithMinBid(gwei uint64) Option {
+	return func(s *Service) error {
+		s.cfg.minBid = gwei
 		return nil
 	}
 }
@@ -44,6 +76,14 @@ func WithHeadFetcher(svc blockchain.HeadFetcher) Option {
 	}
 }
 
+// WithTimeFetcher gets the genesis time from chain service, to check the timestamp of the relay bids.
+func WithTimeFetcher(svc blockchain.TimeFetcher) Option {
+	return func(s *Service) error {
+		s.cfg.timeFetcher = svc
+		return nil
+	}
+}
+
 // WithDatabase for head access.
 func WithDatabase(beaconDB db.HeadAccessDatabase) Option {
 	return func(s *Service) error {
@@ -0,0 +1,249 @@
+package builder
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/qrysm/v4/api/client/builder"
+	"github.com/theQRL/qrysm/v4/beacon-chain/core/signing"
+	"github.com/theQRL/qrysm/v4/config/params"
+	"github.com/theQRL/qrysm/v4/consensus-types/blocks"
+	"github.com/theQRL/qrysm/v4/consensus-types/interfaces"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
+	v1 "github.com/theQRL/qrysm/v4/proto/engine/v1"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	"github.com/theQRL/qrysm/v4/time/slots"
+)
+
+// bidSourcesRetentionSlots is the number of slots the relays which offered a payload are remembered for, so
+// that the blinded block can be submitted to them.
+const bidSourcesRetentionSlots = primitives.Slot(2)
+
+// relayBid is the bid of a relay for a slot.
+type relayBid struct {
+	relay     builder.BuilderClient
+	signedBid builder.SignedBid
+	value     *big.Int
+	blockHash [32]byte
+}
+
+// bidSources records the relays which offered a payload, by block hash.
+type bidSources struct {
+	slot   primitives.Slot
+	relays []builder.BuilderClient
+}
+
+// getHeaders requests a header from every relay in parallel, each with the relay timeout, and returns the bids
+// which are properly signed, build on the parent hash at the slot time and are above the minimum bid. Relays which fail are logged and skipped.
+func (s *Service) getHeaders(ctx context.Context, slot primitives.Slot, parentHash [32]byte, pubKey [dilithium2.CryptoPublicKeyBytes]byte) []*relayBid {
+	var wg sync.WaitGroup
+	bids := make([]*relayBid, len(s.relays))
+	for i, r := range s.relays {
+		wg.Add(1)
+		go func(i int, r builder.BuilderClient) {
+			defer wg.Done()
+			rctx := ctx
+			if s.cfg.relayTimeout > 0 {
+				var cancel context.CancelFunc
+				rctx, cancel = context.WithTimeout(ctx, s.cfg.relayTimeout)
+				defer cancel()
+			}
+			start := time.Now()
+			bid, err := s.relayBid(rctx, r, slot, parentHash, pubKey)
+			relayGetHeaderLatency.WithLabelValues(r.NodeURL()).Observe(float64(time.Since(start).Milliseconds()))
+			if err != nil {
+				relayBidsCount.WithLabelValues(r.NodeURL(), "rejected").Inc()
+				log.WithError(err).WithField("relay", r.NodeURL()).WithField("slot", slot).Warn("Could not get header from relay")
+				return
+			}
+			if bid == nil {
+				relayBidsCount.WithLabelValues(r.NodeURL(), "none").Inc()
+				return
+			}
+			relayBidsCount.WithLabelValues(r.NodeURL(), "accepted").Inc()
+			bids[i] = bid
+		}(i, r)
+	}
+	wg.Wait()
+
+	valid := make([]*relayBid, 0, len(bids))
+	for _, b := range bids {
+		if b != nil {
+			valid = append(valid, b)
+		}
+	}
+	return valid
+}
+
+// relayBid requests a header from the relay and checks the signature, parent hash, timestamp and value of the
+// bid. A nil bid is returned if the relay has no bid.
+func (s *Service) relayBid(ctx context.Context, r builder.BuilderClient, slot primitives.Slot, parentHash [32]byte, pubKey [dilithium2.CryptoPublicKeyBytes]byte) (*relayBid, error) {
+	signedBid, err := r.GetHeader(ctx, slot, parentHash, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	if signedBid == nil || signedBid.IsNil() {
+		return nil, nil
+	}
+	if err := ValidateBuilderSignature(signedBid); err != nil {
+		return nil, errors.Wrap(err, "invalid bid signature")
+	}
+	bid, err := signedBid.Message()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get bid")
+	}
+	header, err := bid.Header()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get bid header")
+	}
+	if !bytes.Equal(header.ParentHash(), parentHash[:]) {
+		return nil, fmt.Errorf("incorrect parent hash %#x != %#x", header.ParentHash(), parentHash)
+	}
+	if s.cfg.timeFetcher != nil {
+		t, err := slots.ToTime(uint64(s.cfg.timeFetcher.GenesisTime().Unix()), slot)
+		if err != nil {
+			return nil, err
+		}
+		if header.Timestamp() != uint64(t.Unix()) {
+			return nil, fmt.Errorf("incorrect timestamp %d != %d", header.Timestamp(), uint64(t.Unix()))
+		}
+	}
+	if gwei := blocks.PayloadValueToGwei(bid.Value()); uint64(gwei) < s.cfg.minBid {
+		return nil, fmt.Errorf("bid of %d gwei is below the minimum bid of %d gwei", gwei, s.cfg.minBid)
+	}
+	return &relayBid{
+		relay:     r,
+		signedBid: signedBid,
+		value:     bytesutil.LittleEndianBytesToBigInt(bid.Value()),
+		blockHash: bytesutil.ToBytes32(header.BlockHash()),
+	}, nil
+}
+
+// ValidateBuilderSignature validates builder signature and returns an error if the signature is invalid.
+func ValidateBuilderSignature(signedBid builder.SignedBid) error {
+	d, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder,
+		nil, /* fork version */
+		nil /* genesis val root */)
+	if err != nil {
+		return err
+	}
+	if signedBid.IsNil() {
+		return errors.New("nil builder bid")
+	}
+	bid, err := signedBid.Message()
+	if err != nil {
+		return errors.Wrap(err, "could not get bid")
+	}
+	if bid.IsNil() {
+		return errors.New("builder returned nil bid")
+	}
+	return signing.VerifySigningRoot(bid, bid.Pubkey(), signedBid.Signature(), d)
+}
+
+// bestBid returns the bid of highest value, the bid of the first relay winning ties.
+func bestBid(bids []*relayBid) *relayBid {
+	var best *relayBid
+	for _, b := range bids {
+		if best == nil || b.value.Cmp(best.value) > 0 {
+			best = b
+		}
+	}
+	return best
+}
+
+// recordBidSources remembers the relays which offered the payload of the winning bid, and forgets the
+// payloads of older slots.
+func (s *Service) recordBidSources(slot primitives.Slot, winner *relayBid, bids []*relayBid) {
+	sources := &bidSources{slot: slot}
+	for _, b := range bids {
+		if b.blockHash == winner.blockHash {
+			sources.relays = append(sources.relays, b.relay)
+		}
+	}
+	s.bidSourcesLock.Lock()
+	defer s.bidSourcesLock.Unlock()
+	for h, src := range s.bidSources {
+		if src.slot+bidSourcesRetentionSlots < slot {
+			delete(s.bidSources, h)
+		}
+	}
+	s.bidSources[winner.blockHash] = sources
+}
+
+// payloadRelays returns the relays which offered the payload of the block hash, or all relays if the payload is
+// not known.
+func (s *Service) payloadRelays(blockHash [32]byte) []builder.BuilderClient {
+	s.bidSourcesLock.RLock()
+	defer s.bidSourcesLock.RUnlock()
+	if src, ok := s.bidSources[blockHash]; ok {
+		return src.relays
+	}
+	return s.relays
+}
+
+type submitResult struct {
+	payload interfaces.ExecutionData
+	bundle  *v1.BlobsBundle
+	err     error
+}
+
+// submitToRelays submits the blinded block to the relays in parallel and returns the first payload revealed.
+func submitToRelays(ctx context.Context, relays []builder.BuilderClient, b interfaces.ReadOnlySignedBeaconBlock, blobs []*zondpb.SignedBlindedBlobSidecar) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
+	results := make(chan *submitResult, len(relays))
+	for _, r := range relays {
+		go func(r builder.BuilderClient) {
+			payload, bundle, err := r.SubmitBlindedBlock(ctx, b, blobs)
+			if err != nil {
+				err = errors.Wrapf(err, "relay %s", r.NodeURL())
+			}
+			results <- &submitResult{payload: payload, bundle: bundle, err: err}
+		}(r)
+	}
+	var err error
+	for range relays {
+		res := <-results
+		if res.err == nil {
+			return res.payload, res.bundle, nil
+		}
+		log.WithError(res.err).Warn("Could not submit blinded block to relay")
+		err = res.err
+	}
+	return nil, nil, err
+}
+
+// registerWithRelays sends the validator registrations to every relay in parallel. It fails only if no relay
+// accepted the registrations.
+func registerWithRelays(ctx context.Context, relays []builder.BuilderClient, reg []*zondpb.SignedValidatorRegistrationV1) error {
+	errs := make(chan error, len(relays))
+	for _, r := range relays {
+		go func(r builder.BuilderClient) {
+			err := r.RegisterValidator(ctx, reg)
+			if err != nil {
+				err = errors.Wrapf(err, "relay %s", r.NodeURL())
+			}
+			errs <- err
+		}(r)
+	}
+	var lastErr error
+	registered := 0
+	for range relays {
+		if err := <-errs; err != nil {
+			log.WithError(err).Warn("Could not register validators with relay")
+			lastErr = err
+			continue
+		}
+		registered++
+	}
+	if registered == 0 {
+		return lastErr
+	}
+	return nil
+}
@@ -0,0 +1,249 @@
+package builder
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/qrysm/v4/api/client/builder"
+	blockchainTesting "github.com/theQRL/qrysm/v4/beacon-chain/blockchain/testing"
+	"github.com/theQRL/qrysm/v4/beacon-chain/core/signing"
+	fieldparams "github.com/theQRL/qrysm/v4/config/fieldparams"
+	"github.com/theQRL/qrysm/v4/config/params"
+	"github.com/theQRL/qrysm/v4/consensus-types/blocks"
+	"github.com/theQRL/qrysm/v4/consensus-types/interfaces"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/crypto/dilithium"
+	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
+	v1 "github.com/theQRL/qrysm/v4/proto/engine/v1"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+	"github.com/theQRL/qrysm/v4/testing/util"
+)
+
+type mockRelay struct {
+	url        string
+	bid        builder.SignedBid
+	err        error
+	delay      time.Duration
+	lock       sync.Mutex
+	submitted  int
+	registered int
+}
+
+func (m *mockRelay) NodeURL() string {
+	return m.url
+}
+
+func (m *mockRelay) GetHeader(ctx context.Context, _ primitives.Slot, _ [32]byte, _ [dilithium2.CryptoPublicKeyBytes]byte) (builder.SignedBid, error) {
+	select {
+	case <-time.After(m.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return m.bid, m.err
+}
+
+func (m *mockRelay) RegisterValidator(_ context.Context, _ []*zondpb.SignedValidatorRegistrationV1) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.err != nil {
+		return m.err
+	}
+	m.registered++
+	return nil
+}
+
+func (m *mockRelay) SubmitBlindedBlock(_ context.Context, _ interfaces.ReadOnlySignedBeaconBlock, _ []*zondpb.SignedBlindedBlobSidecar) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.submitted++
+	if m.err != nil {
+		return nil, nil, m.err
+	}
+	payload, err := blocks.WrappedExecutionPayloadCapella(&v1.ExecutionPayloadCapella{}, 0)
+	return payload, nil, err
+}
+
+func (*mockRelay) Status(_ context.Context) error {
+	return nil
+}
+
+func signedBid(t *testing.T, blockHash byte, gwei uint64, validSignature bool) builder.SignedBid {
+	return signedBidWithHeader(t, blockHash, gwei, validSignature, func(*v1.ExecutionPayloadHeaderCapella) {})
+}
+
+func signedBidWithHeader(t *testing.T, blockHash byte, gwei uint64, validSignature bool, modify func(*v1.ExecutionPayloadHeaderCapella)) builder.SignedBid {
+	sk, err := dilithium.RandKey()
+	require.NoError(t, err)
+	value := bytesutil.PadTo(bytesutil.Uint64ToBytesLittleEndian(gwei*1e9), 32)
+	bid := &zondpb.BuilderBidCapella{
+		Header: &v1.ExecutionPayloadHeaderCapella{
+			ParentHash:       make([]byte, fieldparams.RootLength),
+			FeeRecipient:     make([]byte, fieldparams.FeeRecipientLength),
+			StateRoot:        make([]byte, fieldparams.RootLength),
+			ReceiptsRoot:     make([]byte, fieldparams.RootLength),
+			LogsBloom:        make([]byte, fieldparams.LogsBloomLength),
+			PrevRandao:       make([]byte, fieldparams.RootLength),
+			BaseFeePerGas:    make([]byte, fieldparams.RootLength),
+			BlockHash:        bytesutil.PadTo([]byte{blockHash}, fieldparams.RootLength),
+			TransactionsRoot: make([]byte, fieldparams.RootLength),
+			WithdrawalsRoot:  make([]byte, fieldparams.RootLength),
+		},
+		Value:  value,
+		Pubkey: sk.PublicKey().Marshal(),
+	}
+	modify(bid.Header)
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder, nil, nil)
+	require.NoError(t, err)
+	sr, err := signing.ComputeSigningRoot(bid, domain)
+	require.NoError(t, err)
+	if !validSignature {
+		sr[0] ^= 1
+	}
+	sBid, err := builder.WrappedSignedBuilderBidCapella(&zondpb.SignedBuilderBidCapella{
+		Message:   bid,
+		Signature: sk.Sign(sr[:]).Marshal(),
+	})
+	require.NoError(t, err)
+	return sBid
+}
+
+func bidBlockHash(t *testing.T, sBid builder.SignedBid) []byte {
+	bid, err := sBid.Message()
+	require.NoError(t, err)
+	header, err := bid.Header()
+	require.NoError(t, err)
+	return header.BlockHash()
+}
+
+func TestService_GetHeader_MultipleRelays(t *testing.T) {
+	ctx := context.Background()
+	low := &mockRelay{url: "low", bid: signedBid(t, 'a', 10, true)}
+	high := &mockRelay{url: "high", bid: signedBid(t, 'b', 30, true)}
+	badSignature := &mockRelay{url: "bad-signature", bid: signedBid(t, 'c', 100, false)}
+	slow := &mockRelay{url: "slow", bid: signedBid(t, 'd', 200, true), delay: time.Second}
+	failing := &mockRelay{url: "failing", err: errors.New("relay down")}
+	s, err := NewService(ctx, WithBuilderClients(low, high, badSignature, slow, failing), WithRelayTimeout(100*time.Millisecond))
+	require.NoError(t, err)
+
+	got, err := s.GetHeader(ctx, 1, [32]byte{}, [dilithium2.CryptoPublicKeyBytes]byte{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, bidBlockHash(t, high.bid), bidBlockHash(t, got))
+	assert.DeepEqual(t, []builder.BuilderClient{high}, s.payloadRelays(bytesutil.ToBytes32(bidBlockHash(t, got))))
+	// Unknown payloads are submitted to all relays.
+	assert.Equal(t, 5, len(s.payloadRelays([32]byte{'z'})))
+}
+
+func TestService_GetHeader_MinBid(t *testing.T) {
+	ctx := context.Background()
+	relay := &mockRelay{url: "relay", bid: signedBid(t, 'a', 10, true)}
+	s, err := NewService(ctx, WithBuilderClient(relay), WithMinBid(20))
+	require.NoError(t, err)
+	_, err = s.GetHeader(ctx, 1, [32]byte{}, [dilithium2.CryptoPublicKeyBytes]byte{})
+	assert.ErrorContains(t, "no valid bid from 1 relay(s)", err)
+
+	s, err = NewService(ctx, WithBuilderClient(relay), WithMinBid(10))
+	require.NoError(t, err)
+	_, err = s.GetHeader(ctx, 1, [32]byte{}, [dilithium2.CryptoPublicKeyBytes]byte{})
+	require.NoError(t, err)
+}
+
+func TestService_GetHeader_ParentHashAndTimestamp(t *testing.T) {
+	ctx := context.Background()
+	genesis := time.Now().Add(-time.Hour)
+	slotTime := uint64(genesis.Unix()) + params.BeaconConfig().SecondsPerSlot
+	parentHash := [32]byte{'p'}
+	valid := &mockRelay{url: "valid", bid: signedBidWithHeader(t, 'a', 10, true, func(h *v1.ExecutionPayloadHeaderCapella) {
+		h.ParentHash = parentHash[:]
+		h.Timestamp = slotTime
+	})}
+	wrongParent := &mockRelay{url: "wrong-parent", bid: signedBidWithHeader(t, 'b', 30, true, func(h *v1.ExecutionPayloadHeaderCapella) {
+		h.ParentHash = bytesutil.PadTo([]byte{'q'}, fieldparams.RootLength)
+		h.Timestamp = slotTime
+	})}
+	wrongTimestamp := &mockRelay{url: "wrong-timestamp", bid: signedBidWithHeader(t, 'c', 30, true, func(h *v1.ExecutionPayloadHeaderCapella) {
+		h.ParentHash = parentHash[:]
+		h.Timestamp = slotTime + 1
+	})}
+	s, err := NewService(ctx, WithBuilderClients(valid, wrongParent, wrongTimestamp), WithTimeFetcher(&blockchainTesting.ChainService{Genesis: genesis}))
+	require.NoError(t, err)
+
+	got, err := s.GetHeader(ctx, 1, parentHash, [dilithium2.CryptoPublicKeyBytes]byte{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, bidBlockHash(t, valid.bid), bidBlockHash(t, got))
+
+	_, err = s.relayBid(ctx, wrongParent, 1, parentHash, [dilithium2.CryptoPublicKeyBytes]byte{})
+	assert.ErrorContains(t, "incorrect parent hash", err)
+	_, err = s.relayBid(ctx, wrongTimestamp, 1, parentHash, [dilithium2.CryptoPublicKeyBytes]byte{})
+	assert.ErrorContains(t, "incorrect timestamp", err)
+}
+
+func TestValidateBuilderSignature(t *testing.T) {
+	require.NoError(t, ValidateBuilderSignature(signedBid(t, 'a', 10, true)))
+	require.ErrorIs(t, ValidateBuilderSignature(signedBid(t, 'a', 10, false)), signing.ErrSigFailedToVerify)
+}
+
+func TestService_SubmitBlindedBlock_WinningRelays(t *testing.T) {
+	ctx := context.Background()
+	winner := &mockRelay{url: "winner", bid: signedBid(t, 'a', 30, true)}
+	sameHash := &mockRelay{url: "same-hash", bid: winner.bid}
+	loser := &mockRelay{url: "loser", bid: signedBid(t, 'b', 10, true)}
+	s, err := NewService(ctx, WithBuilderClients(winner, sameHash, loser))
+	require.NoError(t, err)
+	_, err = s.GetHeader(ctx, 1, [32]byte{}, [dilithium2.CryptoPublicKeyBytes]byte{})
+	require.NoError(t, err)
+
+	blk := util.NewBlindedBeaconBlockCapella()
+	blk.Block.Body.ExecutionPayloadHeader.BlockHash = bidBlockHash(t, winner.bid)
+	sb, err := blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	payload, _, err := s.SubmitBlindedBlock(ctx, sb, nil)
+	require.NoError(t, err)
+	require.NotNil(t, payload)
+	// Wait for the submissions still in flight.
+	require.NoError(t, waitFor(func() bool {
+		winner.lock.Lock()
+		defer winner.lock.Unlock()
+		sameHash.lock.Lock()
+		defer sameHash.lock.Unlock()
+		return winner.submitted == 1 && sameHash.submitted == 1
+	}))
+	loser.lock.Lock()
+	defer loser.lock.Unlock()
+	assert.Equal(t, 0, loser.submitted)
+}
+
+func TestService_RecordBidSources_Prunes(t *testing.T) {
+	s, err := NewService(context.Background())
+	require.NoError(t, err)
+	relay := &mockRelay{url: "relay"}
+	s.recordBidSources(1, &relayBid{relay: relay, blockHash: [32]byte{'a'}}, []*relayBid{{relay: relay, blockHash: [32]byte{'a'}}})
+	s.recordBidSources(10, &relayBid{relay: relay, blockHash: [32]byte{'b'}}, []*relayBid{{relay: relay, blockHash: [32]byte{'b'}}})
+	assert.Equal(t, 1, len(s.bidSources))
+	_, ok := s.bidSources[[32]byte{'b'}]
+	assert.Equal(t, true, ok)
+}
+
+func TestRegisterWithRelays(t *testing.T) {
+	ctx := context.Background()
+	up := &mockRelay{url: "up"}
+	down := &mockRelay{url: "down", err: errors.New("relay down")}
+	require.NoError(t, registerWithRelays(ctx, []builder.BuilderClient{up, down}, nil))
+	assert.Equal(t, 1, up.registered)
+	assert.ErrorContains(t, "relay down", registerWithRelays(ctx, []builder.BuilderClient{down}, nil))
+}
+
+func waitFor(cond func() bool) error {
+	for i := 0; i < 100; i++ {
+		if cond() {
+			return nil
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return errors.New("condition not met")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -37,45 +38,57 @@ type BlockBuilder interface {
 
 // config defines a config struct for dependencies into the service.
 type config struct {
-	builderClient builder.BuilderClient
-	beaconDB      db.HeadAccessDatabase
-	headFetcher   blockchain.HeadFetcher
+	builderClients []builder.BuilderClient
+	beaconDB       db.HeadAccessDatabase
+	headFetcher    blockchain.HeadFetcher
+	timeFetcher    blockchain.TimeFetcher
+	relayTimeout   time.Duration
+	minBid         uint64
 }
 
 // Service defines a service that provides a client for interacting with the beacon chain and MEV relay network.
+// Several relays can be configured: headers are requested from all of them and the highest bid is used.
 type Service struct {
 	cfg               *config
-	c                 builder.BuilderClient
+	relays            []builder.BuilderClient
 	ctx               context.Context
 	cancel            context.CancelFunc
 	registrationCache *cache.RegistrationCache
+	bidSources        map[[32]byte]*bidSources
+	bidSourcesLock    sync.RWMutex
 }
 
 // NewService instantiates a new service.
 func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 	ctx, cancel := context.WithCancel(ctx)
 	s := &Service{
-		ctx:    ctx,
-		cancel: cancel,
-		cfg:    &config{},
+		ctx:        ctx,
+		cancel:     cancel,
+		cfg:        &config{},
+		bidSources: make(map[[32]byte]*bidSources),
 	}
 	for _, opt := range opts {
 		if err := opt(s); err != nil {
 			return nil, err
 		}
 	}
-	if s.cfg.builderClient != nil && !reflect.ValueOf(s.cfg.builderClient).IsNil() {
-		s.c = s.cfg.builderClient
+	for _, c := range s.cfg.builderClients {
+		if c == nil || reflect.ValueOf(c).IsNil() {
+			continue
+		}
+		s.relays = append(s.relays, c)
 
 		// Is the builder up?
-		if err := s.c.Status(ctx); err != nil {
-			log.WithError(err).Error("Failed to check builder status")
+		if err := c.Status(ctx); err != nil {
+			log.WithError(err).WithField("endpoint", c.NodeURL()).Error("Failed to check builder status")
 		} else {
-			log.WithField("endpoint", s.c.NodeURL()).Info("Builder has been configured")
-			log.Warn("Outsourcing block construction to external builders adds non-trivial delay to block propagation time.  " +
-				"Builder-constructed blocks or fallback blocks may get orphaned. Use at your own risk!")
+			log.WithField("endpoint", c.NodeURL()).Info("Builder has been configured")
 		}
 	}
+	if len(s.relays) > 0 {
+		log.Warn("Outsourcing block construction to external builders adds non-trivial delay to block propagation time.  " +
+			"Builder-constructed blocks or fallback blocks may get orphaned. Use at your own risk!")
+	}
 	return s, nil
 }
 
@@ -90,7 +103,9 @@ func (s *Service) Stop() error {
 	return nil
 }
 
-// SubmitBlindedBlock submits a blinded block to the builder relay network.
+// SubmitBlindedBlock submits a blinded block to the builder relay network. The block is sent to the relays which
+// offered its payload, or to all relays if the payload was not offered through this service, and the first
+// payload revealed is returned.
 func (s *Service) SubmitBlindedBlock(ctx context.Context, b interfaces.ReadOnlySignedBeaconBlock, blobs []*zondpb.SignedBlindedBlobSidecar) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
 	ctx, span := trace.StartSpan(ctx, "builder.SubmitBlindedBlock")
 	defer span.End()
@@ -98,17 +113,25 @@ func (s *Service) SubmitBlindedBlock(ctx context.Context, b interfaces.ReadOnlyS
 	defer func() {
 		submitBlindedBlockLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	if s.c == nil {
+	if !s.Configured() {
 		return nil, nil, ErrNoBuilder
 	}
 	if uint64(len(blobs)) > fieldparams.MaxBlobsPerBlock {
 		return nil, nil, fmt.Errorf("blob count %d beyond max limit of %d", len(blobs), fieldparams.MaxBlobsPerBlock)
 	}
-
-	return s.c.SubmitBlindedBlock(ctx, b, blobs)
+	relays := s.relays
+	if len(relays) > 1 {
+		header, err := b.Block().Body().Execution()
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not get execution header")
+		}
+		relays = s.payloadRelays(bytesutil.ToBytes32(header.BlockHash()))
+	}
+	return submitToRelays(ctx, relays, b, blobs)
 }
 
-// GetHeader retrieves the header for a given slot and parent hash from the builder relay network.
+// GetHeader retrieves the header for a given slot and parent hash from the builder relay network. Every relay
+// is queried in parallel, and the properly signed bid of highest value at least the minimum bid is returned.
 func (s *Service) GetHeader(ctx context.Context, slot primitives.Slot, parentHash [32]byte, pubKey [dilithium2.CryptoPublicKeyBytes]byte) (builder.SignedBid, error) {
 	ctx, span := trace.StartSpan(ctx, "builder.GetHeader")
 	defer span.End()
@@ -116,28 +139,42 @@ func (s *Service) GetHeader(ctx context.Context, slot primitives.Slot, parentHas
 	defer func() {
 		getHeaderLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	if s.c == nil {
+	if !s.Configured() {
 		tracing.AnnotateError(span, ErrNoBuilder)
 		return nil, ErrNoBuilder
 	}
 
-	h, err := s.c.GetHeader(ctx, slot, parentHash, pubKey)
-	tracing.AnnotateError(span, err)
-	return h, err
+	bids := s.getHeaders(ctx, slot, parentHash, pubKey)
+	best := bestBid(bids)
+	if best == nil {
+		err := errors.Errorf("no valid bid from %d relay(s)", len(s.relays))
+		tracing.AnnotateError(span, err)
+		return nil, err
+	}
+	s.recordBidSources(slot, best, bids)
+	if len(s.relays) > 1 {
+		log.WithFields(log.Fields{
+			"slot":  slot,
+			"relay": best.relay.NodeURL(),
+			"value": best.value.String(),
+			"bids":  len(bids),
+		}).Debug("Selected relay bid")
+	}
+	return best.signedBid, nil
 }
 
 // Status retrieves the status of the builder relay network.
 func (s *Service) Status() error {
 	// Return early if builder isn't initialized in service.
-	if s.c == nil {
+	if !s.Configured() {
 		return nil
 	}
 
 	return nil
 }
 
-// RegisterValidator registers a validator with the builder relay network.
-// It also saves the registration object to the DB.
+// RegisterValidator registers a validator with every relay of the builder relay network, and fails only if no
+// relay accepted the registrations. It also saves the registration object to the DB.
 func (s *Service) RegisterValidator(ctx context.Context, reg []*zondpb.SignedValidatorRegistrationV1) error {
 	ctx, span := trace.StartSpan(ctx, "builder.RegisterValidator")
 	defer span.End()
@@ -145,7 +182,7 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*zondpb.SignedVal
 	defer func() {
 		registerValidatorLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	if s.c == nil {
+	if !s.Configured() {
 		return ErrNoBuilder
 	}
 
@@ -170,7 +207,7 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*zondpb.SignedVal
 		valid = append(valid, r)
 		indexToRegistration[nx] = r.Message
 	}
-	if err := s.c.RegisterValidator(ctx, valid); err != nil {
+	if err := registerWithRelays(ctx, s.relays, valid); err != nil {
 		return errors.Wrap(err, "could not register validator(s)")
 	}
 
@@ -199,7 +236,7 @@ func (s *Service) RegistrationByValidatorID(ctx context.Context, id primitives.V
 
 // Configured returns true if the user has configured a builder client.
 func (s *Service) Configured() bool {
-	return s.c != nil && !reflect.ValueOf(s.c).IsNil()
+	return len(s.relays) > 0
 }
 
 func (s *Service) pollRelayerStatus(ctx context.Context) {
@@ -208,9 +245,9 @@ func (s *Service) pollRelayerStatus(ctx context.Context) {
 	for {
 		select {
 		case <-ticker.C:
-			if s.c != nil {
-				if err := s.c.Status(ctx); err != nil {
-					log.WithError(err).Error("Failed to call relayer status endpoint, perhaps mev-boost or relayers are down")
+			for _, c := range s.relays {
+				if err := c.Status(ctx); err != nil {
+					log.WithError(err).WithField("endpoint", c.NodeURL()).Error("Failed to call relayer status endpoint, perhaps mev-boost or relayers are down")
 				}
 			}
 		case <-ctx.Done():
@@ -7,6 +7,7 @@ import (
 	"math/big"
 	"testing"
 
+	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
 	"github.com/theQRL/qrysm/v4/beacon-chain/cache"
 	"github.com/theQRL/qrysm/v4/config/params"
 	"github.com/theQRL/qrysm/v4/container/trie"
@@ -1197,3 +1198,70 @@ func emptyEth1data() *zondpb.Eth1Data {
 		BlockHash:    make([]byte, 32),
 	}
 }
+
+func TestInsertFinalizedDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	var ctrs []*zondpb.DepositContainer
+	for i := 0; i < 6; i++ {
+		ctrs = append(ctrs, &zondpb.DepositContainer{
+			Deposit: &zondpb.Deposit{
+				Data: &zondpb.Deposit_Data{
+					PublicKey:             bytesutil.PadTo([]byte{byte(i)}, dilithium2.CryptoPublicKeyBytes),
+					WithdrawalCredentials: make([]byte, 32),
+					Signature:             make([]byte, dilithium2.CryptoBytes),
+				},
+			},
+			Eth1BlockHeight: uint64(10 + i),
+			Index:           int64(i),
+		})
+	}
+
+	// Build the snapshot of the first four deposits, as a trusted node would serve it.
+	full, err := New()
+	require.NoError(t, err)
+	for _, c := range ctrs {
+		root, err := c.Deposit.Data.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, full.finalizedDeposits.depositTree.Insert(root[:], int(c.Index)))
+		treeRoot := full.finalizedDeposits.depositTree.getRoot()
+		c.DepositRoot = treeRoot[:]
+	}
+	snapshotTree := NewDepositTree()
+	for _, c := range ctrs[:4] {
+		root, err := c.Deposit.Data.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, snapshotTree.Insert(root[:], int(c.Index)))
+	}
+	require.NoError(t, snapshotTree.Finalize(3, [32]byte{'a'}, 0))
+	snapshot, err := snapshotTree.ToProto()
+	require.NoError(t, err)
+
+	dc, err := New()
+	require.NoError(t, err)
+	require.NoError(t, dc.InsertFinalizedDepositSnapshot(ctx, snapshot))
+	require.ErrorContains(t, "non-empty deposit cache", dc.InsertFinalizedDepositSnapshot(ctx, snapshot))
+
+	count, root := dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(0))
+	assert.Equal(t, uint64(4), count)
+	assert.DeepEqual(t, bytesutil.ToBytes32(snapshot.DepositRoot), root)
+
+	// Deposits which are part of the snapshot cannot be inserted anymore.
+	require.ErrorContains(t, "wanted deposit with index 4", dc.InsertDeposit(ctx, ctrs[3].Deposit, 13, 3, [32]byte{}))
+	for _, c := range ctrs[4:] {
+		require.NoError(t, dc.InsertDeposit(ctx, c.Deposit, c.Eth1BlockHeight, c.Index, bytesutil.ToBytes32(c.DepositRoot)))
+	}
+	count, root = dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(14))
+	assert.Equal(t, uint64(5), count)
+	assert.DeepEqual(t, bytesutil.ToBytes32(ctrs[4].DepositRoot), root)
+
+	require.NoError(t, dc.InsertFinalizedDeposits(ctx, 5, [32]byte{'b'}, 0))
+	fd, err := dc.FinalizedDeposits(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), fd.MerkleTrieIndex())
+	got, err := fd.Deposits().HashTreeRoot()
+	require.NoError(t, err)
+	want, err := full.finalizedDeposits.depositTree.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	require.NoError(t, dc.PruneProofs(ctx, 5))
+}
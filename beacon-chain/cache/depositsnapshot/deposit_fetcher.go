@@ -32,6 +32,8 @@ type Cache struct {
 	finalizedDeposits finalizedDepositsContainer
 	depositsByKey     map[[dilithium2.CryptoPublicKeyBytes]byte][]*zondpb.DepositContainer
 	depositsLock      sync.RWMutex
+	// snapshotRoot is the deposit root of the snapshot the finalized deposits were initialized from, if any.
+	snapshotRoot [32]byte
 }
 
 // finalizedDepositsContainer stores the trie of deposits that have been included
@@ -132,12 +134,16 @@ func (c *Cache) DepositsNumberAndRootAtHeight(ctx context.Context, blockHeight *
 		dBlkHeight := big.NewInt(0).SetUint64(c.deposits[i].Eth1BlockHeight)
 		return dBlkHeight.Cmp(blockHeight) > 0
 	})
+	offset := c.depositIndexOffset()
 	// send the deposit root of the empty trie, if eth1follow distance is greater than the time of the earliest
-	// deposit.
+	// deposit. If the cache was initialized from a snapshot, the snapshot root is the earliest root known.
 	if heightIdx == 0 {
+		if offset > 0 && offset == int64(c.finalizedDeposits.depositTree.depositCount) {
+			return uint64(offset), c.snapshotRoot
+		}
 		return 0, [32]byte{}
 	}
-	return uint64(heightIdx), bytesutil.ToBytes32(c.deposits[heightIdx-1].DepositRoot)
+	return uint64(offset) + uint64(heightIdx), bytesutil.ToBytes32(c.deposits[heightIdx-1].DepositRoot)
 }
 
 // FinalizedDeposits returns the finalized deposits trie.
@@ -186,11 +192,13 @@ func (c *Cache) PruneProofs(ctx context.Context, untilDepositIndex int64) error
 	c.depositsLock.Lock()
 	defer c.depositsLock.Unlock()
 
-	if untilDepositIndex >= int64(len(c.deposits)) {
-		untilDepositIndex = int64(len(c.deposits) - 1)
+	// Deposits included in a snapshot are not held in the cache, so indices are relative to the first cached deposit.
+	untilPosition := untilDepositIndex - c.depositIndexOffset()
+	if untilPosition >= int64(len(c.deposits)) {
+		untilPosition = int64(len(c.deposits) - 1)
 	}
 
-	for i := untilDepositIndex; i >= 0; i-- {
+	for i := untilPosition; i >= 0; i-- {
 		// Finding a nil proof means that all proofs up to this deposit have been already pruned.
 		if c.deposits[i].Deposit.Proof == nil {
 			break
@@ -245,6 +253,16 @@ func (c *Cache) InsertPendingDeposit(ctx context.Context, d *zondpb.Deposit, blo
 	span.AddAttributes(trace.Int64Attribute("count", int64(len(c.pendingDeposits))))
 }
 
+// depositIndexOffset returns the Merkle index of the first deposit held by the cache. It is only non-zero
+// when the finalized deposits were initialized from a snapshot, as the deposits included in the snapshot
+// are only known by their roots.
+func (c *Cache) depositIndexOffset() int64 {
+	if len(c.deposits) > 0 {
+		return c.deposits[0].Index
+	}
+	return int64(c.finalizedDeposits.depositTree.depositCount)
+}
+
 // Deposits returns the cached internal deposit tree.
 func (fd *finalizedDepositsContainer) Deposits() cache.MerkleTree {
 	return fd.depositTree
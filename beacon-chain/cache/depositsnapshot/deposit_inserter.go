@@ -40,8 +40,8 @@ func (c *Cache) InsertDeposit(ctx context.Context, d *zondpb.Deposit, blockNum u
 	c.depositsLock.Lock()
 	defer c.depositsLock.Unlock()
 
-	if int(index) != len(c.deposits) {
-		return errors.Errorf("wanted deposit with index %d to be inserted but received %d", len(c.deposits), index)
+	if wanted := c.depositIndexOffset() + int64(len(c.deposits)); index != wanted {
+		return errors.Errorf("wanted deposit with index %d to be inserted but received %d", wanted, index)
 	}
 	// Keep the slice sorted on insertion in order to avoid costly sorting on retrieval.
 	heightIdx := sort.Search(len(c.deposits), func(i int) bool { return c.deposits[i].Index >= index })
@@ -99,8 +99,8 @@ func (c *Cache) InsertFinalizedDeposits(ctx context.Context, eth1DepositIndex in
 	}
 	// In the event we have less deposits than we need to
 	// finalize we finalize till the index on which we do have it.
-	if len(c.deposits) <= int(eth1DepositIndex) {
-		eth1DepositIndex = int64(len(c.deposits)) - 1
+	if lastIndex := c.deposits[len(c.deposits)-1].Index; lastIndex < eth1DepositIndex {
+		eth1DepositIndex = lastIndex
 	}
 	// If we finalize to some lower deposit index, we
 	// ignore it.
@@ -132,3 +132,24 @@ func (c *Cache) InsertFinalizedDeposits(ctx context.Context, eth1DepositIndex in
 	}
 	return nil
 }
+
+// InsertFinalizedDepositSnapshot initializes the finalized deposits of an empty cache from an EIP-4881 deposit
+// snapshot, so that a new node does not need to process every deposit made before the snapshot was taken.
+// Deposits inserted afterwards must continue from the deposit count of the snapshot.
+func (c *Cache) InsertFinalizedDepositSnapshot(ctx context.Context, snapshot *zondpb.DepositSnapshot) error {
+	ctx, span := trace.StartSpan(ctx, "Cache.InsertFinalizedDepositSnapshot")
+	defer span.End()
+	c.depositsLock.Lock()
+	defer c.depositsLock.Unlock()
+
+	if len(c.deposits) > 0 || c.finalizedDeposits.depositTree.depositCount > 0 {
+		return errors.New("cannot insert a deposit snapshot into a non-empty deposit cache")
+	}
+	tree, err := DepositTreeFromSnapshotProto(snapshot)
+	if err != nil {
+		return errors.Wrap(err, "could not create deposit tree from snapshot")
+	}
+	c.finalizedDeposits = toFinalizedDepositsContainer(tree, int64(snapshot.DepositCount)-1)
+	c.snapshotRoot = bytesutil.ToBytes32(snapshot.DepositRoot)
+	return nil
+}
@@ -1,6 +1,9 @@
 package depositsnapshot
 
 import (
+	"bytes"
+
+	"github.com/pkg/errors"
 	"github.com/theQRL/qrysm/v4/container/trie"
 	"github.com/theQRL/qrysm/v4/crypto/hash"
 
@@ -84,3 +87,32 @@ func DepositTreeFromSnapshotProto(snapshotProto *protodb.DepositSnapshot) (*Depo
 	}
 	return fromSnapshot(snapshot)
 }
+
+// VerifySnapshotProto checks that a deposit snapshot obtained from an untrusted source is well formed and matches
+// the finalized deposits of a finalized beacon state. Served snapshots hold the deposits up to the eth1 deposit index
+// of the finalized state, finalized at the execution block of its eth1 data. The deposit root can only be checked
+// against the eth1 data when no deposits of the eth1 data are pending.
+func VerifySnapshotProto(snapshotProto *protodb.DepositSnapshot, eth1DepositIndex uint64, eth1Data *protodb.Eth1Data) error {
+	if snapshotProto == nil {
+		return errors.New("nil deposit snapshot")
+	}
+	if eth1Data == nil {
+		return errors.New("nil eth1 data")
+	}
+	if _, err := DepositTreeFromSnapshotProto(snapshotProto); err != nil {
+		return err
+	}
+	if snapshotProto.DepositCount != eth1DepositIndex {
+		return errors.Errorf("snapshot deposit count %d does not match eth1 deposit index %d",
+			snapshotProto.DepositCount, eth1DepositIndex)
+	}
+	if !bytes.Equal(snapshotProto.ExecutionHash, eth1Data.BlockHash) {
+		return errors.Errorf("snapshot execution block hash %#x does not match eth1 data block hash %#x",
+			snapshotProto.ExecutionHash, eth1Data.BlockHash)
+	}
+	if snapshotProto.DepositCount == eth1Data.DepositCount && !bytes.Equal(snapshotProto.DepositRoot, eth1Data.DepositRoot) {
+		return errors.Errorf("snapshot deposit root %#x does not match eth1 data deposit root %#x",
+			snapshotProto.DepositRoot, eth1Data.DepositRoot)
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"testing"
 
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
 	"github.com/theQRL/qrysm/v4/testing/require"
 )
 
@@ -52,3 +53,43 @@ func TestDepositTreeSnapshot_CalculateRoot(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifySnapshotProto(t *testing.T) {
+	tree := NewDepositTree()
+	for i := 0; i < 3; i++ {
+		leaf := hexString(t, fmt.Sprintf("%064d", i))
+		require.NoError(t, tree.Insert(leaf[:], i))
+	}
+	require.NoError(t, tree.Finalize(2, [32]byte{'a'}, 0))
+	snapshot, err := tree.ToProto()
+	require.NoError(t, err)
+	root, err := tree.HashTreeRoot()
+	require.NoError(t, err)
+	eth1Data := func() *zondpb.Eth1Data {
+		return &zondpb.Eth1Data{
+			DepositRoot:  root[:],
+			DepositCount: 3,
+			BlockHash:    snapshot.ExecutionHash,
+		}
+	}
+
+	require.NoError(t, VerifySnapshotProto(snapshot, 3, eth1Data()))
+	require.ErrorContains(t, "deposit count", VerifySnapshotProto(snapshot, 2, eth1Data()))
+
+	// Deposits of the eth1 data which are still pending are not part of the snapshot.
+	pending := eth1Data()
+	pending.DepositCount = 4
+	pending.DepositRoot = make([]byte, 32)
+	require.NoError(t, VerifySnapshotProto(snapshot, 3, pending))
+
+	wrongRoot := eth1Data()
+	wrongRoot.DepositRoot = make([]byte, 32)
+	require.ErrorContains(t, "deposit root", VerifySnapshotProto(snapshot, 3, wrongRoot))
+
+	wrongHash := eth1Data()
+	wrongHash.BlockHash = make([]byte, 32)
+	require.ErrorContains(t, "execution block hash", VerifySnapshotProto(snapshot, 3, wrongHash))
+
+	snapshot.Finalized[0][0] ^= 1
+	require.ErrorIs(t, VerifySnapshotProto(snapshot, 3, eth1Data()), ErrInvalidSnapshotRoot)
+}
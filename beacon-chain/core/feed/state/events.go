@@ -27,6 +27,10 @@ const (
 	NewHead
 	// MissedSlot is sent when we need to notify users that a slot was missed.
 	MissedSlot
+	// LightClientFinalityUpdate event, sent when a new light client finality update is available.
+	LightClientFinalityUpdate
+	// LightClientOptimisticUpdate event, sent when a new light client optimistic update is available.
+	LightClientOptimisticUpdate
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
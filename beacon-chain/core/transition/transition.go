@@ -23,6 +23,7 @@ import (
 	"github.com/theQRL/qrysm/v4/consensus-types/blocks"
 	"github.com/theQRL/qrysm/v4/consensus-types/interfaces"
 	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/crypto/dilithium"
 	"github.com/theQRL/qrysm/v4/math"
 	"github.com/theQRL/qrysm/v4/monitoring/tracing"
 	"github.com/theQRL/qrysm/v4/runtime/version"
@@ -31,7 +32,8 @@ import (
 
 // ExecuteStateTransition defines the procedure for a state transition function.
 //
-// Note: This method differs from the spec pseudocode as it uses a batch signature verification.
+// Note: This method differs from the spec pseudocode as it uses a batch signature verification, spread over
+// the configured number of signature verification workers.
 // See: ExecuteStateTransitionNoVerifyAnySig
 //
 // Spec pseudocode definition:
@@ -73,7 +75,7 @@ func ExecuteStateTransition(
 	if features.Get().EnableVerboseSigVerification {
 		valid, err = set.VerifyVerbosely()
 	} else {
-		valid, err = set.Verify()
+		valid, err = set.VerifyParallel(ctx, dilithium.SignatureVerificationWorkers())
 	}
 	if err != nil {
 		return nil, errors.Wrap(err, "could not batch verify signature")
@@ -19,3 +19,6 @@ var ErrNotFoundBackfillBlockRoot = kv.ErrNotFoundBackfillBlockRoot
 
 // ErrNotFoundGenesisBlockRoot means no genesis block root was found, indicating the db was not initialized with genesis
 var ErrNotFoundGenesisBlockRoot = kv.ErrNotFoundGenesisBlockRoot
+
+// ErrStateDiffBase is returned when a full state which state diffs are stored against would be deleted or replaced.
+var ErrStateDiffBase = kv.ErrStateDiffBase
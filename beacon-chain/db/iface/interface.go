@@ -9,12 +9,16 @@ import (
 
 	"github.com/theQRL/go-zond/common"
 	"github.com/theQRL/qrysm/v4/beacon-chain/db/filters"
+	monitortypes "github.com/theQRL/qrysm/v4/beacon-chain/monitor/types"
 	slashertypes "github.com/theQRL/qrysm/v4/beacon-chain/slasher/types"
 	"github.com/theQRL/qrysm/v4/beacon-chain/state"
+	"github.com/theQRL/qrysm/v4/beacon-chain/state/statediff"
+	"github.com/theQRL/qrysm/v4/consensus-types/blocks"
 	"github.com/theQRL/qrysm/v4/consensus-types/interfaces"
 	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
 	"github.com/theQRL/qrysm/v4/monitoring/backup"
 	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	zondpbv2 "github.com/theQRL/qrysm/v4/proto/zond/v2"
 )
 
 // ReadOnlyDatabase defines a struct which only has read access to database methods.
@@ -36,6 +40,8 @@ type ReadOnlyDatabase interface {
 	StateOrError(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error)
 	GenesisState(ctx context.Context) (state.BeaconState, error)
 	HasState(ctx context.Context, blockRoot [32]byte) bool
+	StateDiff(ctx context.Context, blockRoot [32]byte) ([32]byte, *statediff.Diff, error)
+	HasStateDiff(ctx context.Context, blockRoot [32]byte) bool
 	StateSummary(ctx context.Context, blockRoot [32]byte) (*zondpb.StateSummary, error)
 	HasStateSummary(ctx context.Context, blockRoot [32]byte) bool
 	HighestSlotStatesBelow(ctx context.Context, slot primitives.Slot) ([]state.ReadOnlyBeaconState, error)
@@ -47,6 +53,8 @@ type ReadOnlyDatabase interface {
 	LastArchivedRoot(ctx context.Context) [32]byte
 	LastArchivedSlot(ctx context.Context) (primitives.Slot, error)
 	LastValidatedCheckpoint(ctx context.Context) (*zondpb.Checkpoint, error)
+	// Fork choice store operations.
+	ForkChoiceStore(ctx context.Context) ([]byte, error)
 	// Deposit contract related handlers.
 	DepositContractAddress(ctx context.Context) ([]byte, error)
 	// ExecutionChainData operations.
@@ -59,9 +67,20 @@ type ReadOnlyDatabase interface {
 	BlobSidecarsByRoot(ctx context.Context, beaconBlockRoot [32]byte, indices ...uint64) ([]*zondpb.BlobSidecar, error)
 	BlobSidecarsBySlot(ctx context.Context, slot primitives.Slot, indices ...uint64) ([]*zondpb.BlobSidecar, error)
 
+	// Light client operations.
+	LightClientUpdate(ctx context.Context, period uint64) (*zondpbv2.LightClientUpdate, error)
+	LightClientUpdates(ctx context.Context, startPeriod, endPeriod uint64) ([]*zondpbv2.LightClientUpdate, error)
+
+	// Validator monitor operations.
+	MonitoredValidators(ctx context.Context) ([]primitives.ValidatorIndex, error)
+	UnmonitoredValidators(ctx context.Context) ([]primitives.ValidatorIndex, error)
+	ValidatorPerformanceHistory(ctx context.Context, idx primitives.ValidatorIndex, startEpoch, endEpoch primitives.Epoch) ([]*monitortypes.ValidatorEpochPerformance, error)
+
 	// origin checkpoint sync support
 	OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error)
 	BackfillBlockRoot(ctx context.Context) ([32]byte, error)
+	// History pruning support.
+	EarliestAvailableSlot(ctx context.Context) (primitives.Slot, error)
 }
 
 // NoHeadAccessDatabase defines a struct without access to chain head data.
@@ -76,6 +95,7 @@ type NoHeadAccessDatabase interface {
 	// State related methods.
 	SaveState(ctx context.Context, state state.ReadOnlyBeaconState, blockRoot [32]byte) error
 	SaveStates(ctx context.Context, states []state.ReadOnlyBeaconState, blockRoots [][32]byte) error
+	SaveStateDiff(ctx context.Context, state state.ReadOnlyBeaconState, blockRoot, baseRoot [32]byte) error
 	DeleteState(ctx context.Context, blockRoot [32]byte) error
 	DeleteStates(ctx context.Context, blockRoots [][32]byte) error
 	SaveStateSummary(ctx context.Context, summary *zondpb.StateSummary) error
@@ -84,6 +104,9 @@ type NoHeadAccessDatabase interface {
 	SaveJustifiedCheckpoint(ctx context.Context, checkpoint *zondpb.Checkpoint) error
 	SaveFinalizedCheckpoint(ctx context.Context, checkpoint *zondpb.Checkpoint) error
 	SaveLastValidatedCheckpoint(ctx context.Context, checkpoint *zondpb.Checkpoint) error
+	// Fork choice store operations.
+	SaveForkChoiceStore(ctx context.Context, enc []byte) error
+	DeleteForkChoiceStore(ctx context.Context) error
 	// Deposit contract related handlers.
 	SaveDepositContractAddress(ctx context.Context, addr common.Address) error
 	// SaveExecutionChainData operations.
@@ -98,7 +121,16 @@ type NoHeadAccessDatabase interface {
 	SaveBlobSidecar(ctx context.Context, sidecars []*zondpb.BlobSidecar) error
 	DeleteBlobSidecar(ctx context.Context, beaconBlockRoot [32]byte) error
 
+	// Light client operations.
+	SaveLightClientUpdate(ctx context.Context, period uint64, update *zondpbv2.LightClientUpdate) error
+
+	// Validator monitor operations.
+	SaveMonitoredValidators(ctx context.Context, indices []primitives.ValidatorIndex) error
+	SaveUnmonitoredValidators(ctx context.Context, indices []primitives.ValidatorIndex) error
+	SaveValidatorPerformance(ctx context.Context, records []*monitortypes.ValidatorEpochPerformance) error
+
 	CleanUpDirtyStates(ctx context.Context, slotsPerArchivedPoint primitives.Slot) error
+	PruneHistory(ctx context.Context, beforeSlot primitives.Slot, batchSize uint64) (uint64, error)
 }
 
 // HeadAccessDatabase defines a struct with access to reading chain head data.
@@ -117,6 +149,7 @@ type HeadAccessDatabase interface {
 	// initialization method needed for origin checkpoint sync
 	SaveOrigin(ctx context.Context, serState, serBlock []byte) error
 	SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error
+	BackfillFinalizedIndex(ctx context.Context, blks []blocks.ROBlock, finalizedChildRoot [32]byte) error
 }
 
 // SlasherDatabase interface for persisting data related to detecting slashable offenses on Ethereum.
@@ -141,6 +174,9 @@ type SlasherDatabase interface {
 	AttestationRecordForValidator(
 		ctx context.Context, validatorIdx primitives.ValidatorIndex, targetEpoch primitives.Epoch,
 	) (*slashertypes.IndexedAttestationWrapper, error)
+	AttestationRecordsForValidator(
+		ctx context.Context, validatorIdx primitives.ValidatorIndex, start, end primitives.Epoch,
+	) ([]*slashertypes.IndexedAttestationWrapper, error)
 	BlockProposalForValidator(
 		ctx context.Context, validatorIdx primitives.ValidatorIndex, slot primitives.Slot,
 	) (*slashertypes.SignedBlockHeaderWrapper, error)
@@ -150,6 +186,8 @@ type SlasherDatabase interface {
 	LoadSlasherChunks(
 		ctx context.Context, kind slashertypes.ChunkKind, diskKeys [][]byte,
 	) ([][]uint16, []bool, error)
+	ChunkParameters(ctx context.Context) (*slashertypes.ChunkParameters, error)
+	SaveChunkParameters(ctx context.Context, p *slashertypes.ChunkParameters) error
 	CheckDoubleBlockProposals(
 		ctx context.Context, proposals []*slashertypes.SignedBlockHeaderWrapper,
 	) ([]*zondpb.ProposerSlashing, error)
@@ -163,6 +201,11 @@ type SlasherDatabase interface {
 		ctx context.Context,
 		indices []primitives.ValidatorIndex,
 	) ([]*zondpb.HighestAttestation, error)
+	SaveAttesterSlashings(ctx context.Context, slashings []*zondpb.AttesterSlashing) error
+	SaveProposerSlashings(ctx context.Context, slashings []*zondpb.ProposerSlashing) error
+	SlashingsForValidator(
+		ctx context.Context, validatorIdx primitives.ValidatorIndex, start, end primitives.Epoch,
+	) ([]*zondpb.AttesterSlashing, []*zondpb.ProposerSlashing, error)
 	DatabasePath() string
 	ClearDB() error
 }
@@ -14,6 +14,11 @@ import (
 
 const backupsDirectoryName = "backups"
 
+// BackupsDir returns the directory database backups are written to by default for the database in the directory path.
+func BackupsDir(dirPath string) string {
+	return path.Join(dirPath, backupsDirectoryName)
+}
+
 // Backup the database to the datadir backup directory.
 // Example for backup at slot 345: $DATADIR/backups/prysm_beacondb_at_slot_0000345.backup
 func (s *Store) Backup(ctx context.Context, outputDir string, permissionOverride bool) error {
@@ -28,7 +33,7 @@ func (s *Store) Backup(ctx context.Context, outputDir string, permissionOverride
 			return err
 		}
 	} else {
-		backupsDir = path.Join(s.databasePath, backupsDirectoryName)
+		backupsDir = BackupsDir(s.databasePath)
 	}
 	head, err := s.HeadBlock(ctx)
 	if err != nil {
@@ -0,0 +1,79 @@
+package kv
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/v4/config/params"
+	"github.com/theQRL/qrysm/v4/io/boltcompact"
+	"github.com/theQRL/qrysm/v4/io/file"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// CompactPrunedDatabase compacts the database in the directory path if history was pruned since it was last
+// compacted. Bolt reuses the pages freed by pruning but never shrinks its file, so the database must be closed
+// and rewritten to give the disk space back.
+func CompactPrunedDatabase(ctx context.Context, dirPath string) (bool, error) {
+	datafile := KVStoreDatafilePath(dirPath)
+	if !file.FileExists(datafile) {
+		return false, nil
+	}
+	boltDB, err := bolt.Open(
+		datafile,
+		params.BeaconIoConfig().ReadWritePermissions,
+		&bolt.Options{Timeout: 1 * time.Second, ReadOnly: true},
+	)
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return false, boltcompact.ErrDatabaseLocked
+		}
+		return false, err
+	}
+	var pruned bool
+	if err := boltDB.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(chainMetadataBucket)
+		pruned = bkt != nil && bkt.Get(historyPrunedKey) != nil
+		return nil
+	}); err != nil {
+		return false, err
+	}
+	if err := boltDB.Close(); err != nil {
+		return false, err
+	}
+	if !pruned {
+		return false, nil
+	}
+	_, err = CompactDatabase(ctx, dirPath, nil)
+	return err == nil, err
+}
+
+// CompactDatabase copies the live buckets of the closed database in the directory path into a new file, which
+// replaces the original file unless the config sets an output path. The history pruned marker is cleared in the
+// new file, as it no longer holds the pages freed by pruning.
+func CompactDatabase(ctx context.Context, dirPath string, cfg *boltcompact.Config) (*boltcompact.Result, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.CompactDatabase")
+	defer span.End()
+
+	c := boltcompact.Config{}
+	if cfg != nil {
+		c = *cfg
+	}
+	if c.AllocSize == 0 {
+		c.AllocSize = boltAllocSize
+	}
+	finalize := c.Finalize
+	c.Finalize = func(tx *bolt.Tx) error {
+		if bkt := tx.Bucket(chainMetadataBucket); bkt != nil {
+			if err := bkt.Delete(historyPrunedKey); err != nil {
+				return err
+			}
+		}
+		if finalize != nil {
+			return finalize(tx)
+		}
+		return nil
+	}
+	return boltcompact.CompactFile(ctx, KVStoreDatafilePath(dirPath), &c)
+}
@@ -5,6 +5,9 @@ import "github.com/pkg/errors"
 // ErrDeleteJustifiedAndFinalized is raised when we attempt to delete a finalized block/state
 var ErrDeleteJustifiedAndFinalized = errors.New("cannot delete finalized block or state")
 
+// ErrStateDiffBase is returned when a full state which state diffs are stored against would be deleted or replaced.
+var ErrStateDiffBase = errors.New("state is the base of a state diff")
+
 // ErrNotFound can be used directly, or as a wrapped DBError, whenever a db method needs to
 // indicate that a value couldn't be found.
 var ErrNotFound = errors.New("not found in db")
@@ -21,3 +24,6 @@ var ErrNotFoundBackfillBlockRoot = errors.Wrap(ErrNotFound, "BackfillBlockRoot")
 
 // ErrNotFoundFeeRecipient is a not found error specifically for the fee recipient getter
 var ErrNotFoundFeeRecipient = errors.Wrap(ErrNotFound, "fee recipient")
+
+// ErrNotConnectedToFinalized is returned when a segment of blocks can not be linked to the finalized block index.
+var ErrNotConnectedToFinalized = errors.New("unable to connect block segment to finalized block index")
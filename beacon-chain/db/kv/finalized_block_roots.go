@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 
+	"github.com/pkg/errors"
 	"github.com/theQRL/qrysm/v4/beacon-chain/db/filters"
 	"github.com/theQRL/qrysm/v4/consensus-types/blocks"
 	"github.com/theQRL/qrysm/v4/consensus-types/interfaces"
@@ -219,3 +220,67 @@ func (s *Store) FinalizedChildBlock(ctx context.Context, blockRoot [32]byte) (in
 	tracing.AnnotateError(span, err)
 	return blk, err
 }
+
+// BackfillFinalizedIndex updates the finalized index for a contiguous chain of blocks that are the ancestors of the
+// given finalized child root. This is needed to update the finalized index during backfill, because the usual
+// updateFinalizedBlockRoots walks forward from the finalized checkpoint and stops at the origin checkpoint root.
+// The given blocks must be sorted by slot and each block must be the parent of the next. The highest block
+// must be the parent of the block with root finalizedChildRoot, which must already be present in the index.
+func (s *Store) BackfillFinalizedIndex(ctx context.Context, blks []blocks.ROBlock, finalizedChildRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BackfillFinalizedIndex")
+	defer span.End()
+	if len(blks) == 0 {
+		return errors.New("no blocks given to index")
+	}
+
+	encs := make([][]byte, len(blks))
+	for i := range blks {
+		pr := blks[i].Block().ParentRoot()
+		container := &zondpb.FinalizedBlockRootContainer{
+			ParentRoot: pr[:],
+		}
+		if i < len(blks)-1 {
+			child := blks[i+1]
+			if child.Block().ParentRoot() != blks[i].Root() {
+				return errors.Wrapf(ErrNotConnectedToFinalized, "block at slot %d is not the parent of block at slot %d",
+					blks[i].Block().Slot(), child.Block().Slot())
+			}
+			cr := child.Root()
+			container.ChildRoot = cr[:]
+		} else {
+			container.ChildRoot = finalizedChildRoot[:]
+		}
+		enc, err := encode(ctx, container)
+		if err != nil {
+			tracing.AnnotateError(span, err)
+			return err
+		}
+		encs[i] = enc
+	}
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+		childBytes := bkt.Get(finalizedChildRoot[:])
+		if len(childBytes) == 0 || bytes.Equal(childBytes, containerFinalizedButNotCanonical) {
+			return errors.Wrapf(ErrNotConnectedToFinalized, "no finalized index entry for root=%#x", finalizedChildRoot)
+		}
+		child := &zondpb.FinalizedBlockRootContainer{}
+		if err := decode(ctx, childBytes, child); err != nil {
+			return err
+		}
+		highest := blks[len(blks)-1].Root()
+		if !bytes.Equal(child.ParentRoot, highest[:]) {
+			return errors.Wrapf(ErrNotConnectedToFinalized, "finalized index entry for root=%#x has parent_root=%#x, not %#x",
+				finalizedChildRoot, child.ParentRoot, highest)
+		}
+		for i := range blks {
+			root := blks[i].Root()
+			if err := bkt.Put(root[:], encs[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	tracing.AnnotateError(span, err)
+	return err
+}
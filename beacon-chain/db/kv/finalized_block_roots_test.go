@@ -14,6 +14,7 @@ import (
 	"github.com/theQRL/qrysm/v4/testing/assert"
 	"github.com/theQRL/qrysm/v4/testing/require"
 	"github.com/theQRL/qrysm/v4/testing/util"
+	"github.com/theQRL/qrysm/v4/time/slots"
 )
 
 var genesisBlockRoot = bytesutil.ToBytes32([]byte{'G', 'E', 'N', 'E', 'S', 'I', 'S'})
@@ -199,6 +200,68 @@ func sszRootOrDie(t *testing.T, block interfaces.ReadOnlySignedBeaconBlock) []by
 	return root[:]
 }
 
+func TestStore_BackfillFinalizedIndex(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	require.ErrorContains(t, "no blocks", db.BackfillFinalizedIndex(ctx, []consensusblocks.ROBlock{}, [32]byte{}))
+
+	blks := makeBlocks(t, 0, 66, genesisBlockRoot)
+	ro := make([]consensusblocks.ROBlock, len(blks))
+	for i := range blks {
+		var err error
+		ro[i], err = consensusblocks.NewROBlock(blks[i])
+		require.NoError(t, err)
+	}
+
+	// Simulate checkpoint sync, where only the origin block is in the db and finalized index.
+	origin := ro[65]
+	originRoot := origin.Root()
+	require.NoError(t, db.SaveBlock(ctx, origin))
+	require.NoError(t, db.SaveOriginCheckpointBlockRoot(ctx, originRoot))
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, originRoot))
+	cp := &zondpb.Checkpoint{
+		Epoch: slots.ToEpoch(origin.Block().Slot()),
+		Root:  originRoot[:],
+	}
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, cp))
+	require.Equal(t, true, db.IsFinalizedBlock(ctx, originRoot))
+	require.Equal(t, false, db.IsFinalizedBlock(ctx, ro[64].Root()))
+	require.NoError(t, db.SaveBlocks(ctx, blks[:65]))
+
+	// A segment that is not the direct ancestor of the finalized child must be rejected.
+	require.ErrorIs(t, db.BackfillFinalizedIndex(ctx, ro[10:20], originRoot), ErrNotConnectedToFinalized)
+	// A segment that is not contiguous must be rejected.
+	require.ErrorIs(t, db.BackfillFinalizedIndex(ctx, []consensusblocks.ROBlock{ro[62], ro[64]}, originRoot), ErrNotConnectedToFinalized)
+	// The finalized child must already be in the index.
+	require.ErrorIs(t, db.BackfillFinalizedIndex(ctx, ro[0:32], ro[32].Root()), ErrNotConnectedToFinalized)
+
+	require.NoError(t, db.BackfillFinalizedIndex(ctx, ro[32:65], originRoot))
+	for i := 32; i < 65; i++ {
+		require.Equal(t, true, db.IsFinalizedBlock(ctx, ro[i].Root()), "block at index %d not in finalized index", i)
+	}
+	for i := 0; i < 32; i++ {
+		require.Equal(t, false, db.IsFinalizedBlock(ctx, ro[i].Root()), "block at index %d unexpectedly in finalized index", i)
+	}
+
+	// The next segment connects to the lowest previously indexed block.
+	require.NoError(t, db.BackfillFinalizedIndex(ctx, ro[0:32], ro[32].Root()))
+	for i := range ro {
+		require.Equal(t, true, db.IsFinalizedBlock(ctx, ro[i].Root()), "block at index %d not in finalized index", i)
+	}
+	child, err := db.FinalizedChildBlock(ctx, ro[31].Root())
+	require.NoError(t, err)
+	childRoot, err := child.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, ro[32].Root(), childRoot)
+	child, err = db.FinalizedChildBlock(ctx, ro[64].Root())
+	require.NoError(t, err)
+	childRoot, err = child.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, originRoot, childRoot)
+}
+
 func makeBlocks(t *testing.T, i, n uint64, previousRoot [32]byte) []interfaces.ReadOnlySignedBeaconBlock {
 	blocks := make([]*zondpb.SignedBeaconBlock, n)
 	ifaceBlocks := make([]interfaces.ReadOnlySignedBeaconBlock, n)
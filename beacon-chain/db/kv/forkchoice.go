@@ -0,0 +1,53 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveForkChoiceStore saves the serialized fork choice store, replacing the previously saved one.
+func (s *Store) SaveForkChoiceStore(ctx context.Context, enc []byte) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveForkChoiceStore")
+	defer span.End()
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(chainMetadataBucket).Put(forkChoiceStoreKey, snappy.Encode(nil, enc))
+	})
+}
+
+// ForkChoiceStore returns the serialized fork choice store saved by SaveForkChoiceStore, or nil if there is none.
+func (s *Store) ForkChoiceStore(ctx context.Context) ([]byte, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ForkChoiceStore")
+	defer span.End()
+
+	var enc []byte
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		enc = bytesutil.SafeCopyBytes(tx.Bucket(chainMetadataBucket).Get(forkChoiceStoreKey))
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if enc == nil {
+		return nil, nil
+	}
+	dec, err := snappy.Decode(nil, enc)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decompress fork choice store")
+	}
+	return dec, nil
+}
+
+// DeleteForkChoiceStore deletes the saved fork choice store.
+func (s *Store) DeleteForkChoiceStore(ctx context.Context) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.DeleteForkChoiceStore")
+	defer span.End()
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(chainMetadataBucket).Delete(forkChoiceStoreKey)
+	})
+}
@@ -0,0 +1,29 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+)
+
+func TestStore_ForkChoiceStore(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	enc, err := db.ForkChoiceStore(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(enc))
+
+	require.NoError(t, db.SaveForkChoiceStore(ctx, []byte("first")))
+	require.NoError(t, db.SaveForkChoiceStore(ctx, []byte("second")))
+	enc, err = db.ForkChoiceStore(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte("second"), enc)
+
+	require.NoError(t, db.DeleteForkChoiceStore(ctx))
+	enc, err = db.ForkChoiceStore(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(enc))
+}
@@ -0,0 +1,387 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dgraph-io/ristretto"
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/v4/config/params"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
+	"github.com/theQRL/qrysm/v4/io/file"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+// InspectableBuckets are the buckets whose records Inspect can decode.
+var InspectableBuckets = []string{
+	string(blocksBucket),
+	string(stateBucket),
+	string(stateSummaryBucket),
+	string(checkpointBucket),
+	string(chainMetadataBucket),
+	string(powchainBucket),
+	string(blobsBucket),
+	string(blockSlotIndicesBucket),
+	string(stateSlotIndicesBucket),
+	string(finalizedBlockRootsIndexBucket),
+}
+
+// InspectFilter narrows down the records returned by Inspect. Records without a slot are not filtered by slot,
+// and records without a block root are not filtered by root.
+type InspectFilter struct {
+	// StartSlot is the lowest slot of the records returned.
+	StartSlot primitives.Slot
+	// EndSlot is the highest slot of the records returned, there is no upper bound when it is zero.
+	EndSlot primitives.Slot
+	// Root is the block root of the records returned, if set.
+	Root []byte
+	// Limit is the maximum number of records returned, there is no limit when it is zero.
+	Limit uint64
+}
+
+// InspectRecord is a decoded database record.
+type InspectRecord struct {
+	Key []byte
+	// Root is the block root the record refers to, nil if it does not refer to a block.
+	Root []byte
+	// Slot is the slot of the record, nil if it has none.
+	Slot *primitives.Slot
+	// Value is the decoded value, either a proto message or a plain value.
+	Value interface{}
+}
+
+func (f *InspectFilter) matches(r *InspectRecord) bool {
+	if f == nil {
+		return true
+	}
+	if r.Slot != nil && (*r.Slot < f.StartSlot || (f.EndSlot != 0 && *r.Slot > f.EndSlot)) {
+		return false
+	}
+	if len(f.Root) > 0 && r.Root != nil && !bytes.Equal(f.Root, r.Root) {
+		return false
+	}
+	return true
+}
+
+// NewReadOnlyKVStore opens the existing database in the directory path for reading only, without creating buckets
+// or running any of the setup steps of NewKVStore. Writing to the returned store fails.
+func NewReadOnlyKVStore(ctx context.Context, dirPath string) (*Store, error) {
+	datafile := KVStoreDatafilePath(dirPath)
+	if !file.FileExists(datafile) {
+		return nil, errors.Errorf("no database found at %s", datafile)
+	}
+	boltDB, err := bolt.Open(
+		datafile,
+		params.BeaconIoConfig().ReadWritePermissions,
+		&bolt.Options{Timeout: 1 * time.Second, ReadOnly: true},
+	)
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return nil, err
+	}
+	blockCache, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: 1000,
+		MaxCost:     BlockCacheSize,
+		BufferItems: 64,
+	})
+	if err != nil {
+		return nil, err
+	}
+	validatorCache, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: NumOfValidatorEntries,
+		MaxCost:     ValidatorEntryMaxCost,
+		BufferItems: 64,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Store{
+		db:                  boltDB,
+		databasePath:        dirPath,
+		blockCache:          blockCache,
+		validatorEntryCache: validatorCache,
+		stateSummaryCache:   newStateSummaryCache(),
+		ctx:                 ctx,
+		readOnly:            true,
+	}, nil
+}
+
+// Inspect decodes the records of one of the InspectableBuckets matching the filter, in key order, and calls fn
+// with each of them. Blocks and states are looked up through the slot indices when the filter has a slot range,
+// so that they are not all decoded.
+func (s *Store) Inspect(ctx context.Context, bucket string, f *InspectFilter, fn func(*InspectRecord) error) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.Inspect")
+	defer span.End()
+
+	decode, ok := s.inspectDecoders()[bucket]
+	if !ok {
+		return errors.Errorf("bucket %s cannot be inspected, must be one of %v", bucket, InspectableBuckets)
+	}
+	keys, err := s.inspectKeys(bucket, f)
+	if err != nil {
+		return err
+	}
+	var n uint64
+	for _, k := range keys {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if f != nil && f.Limit != 0 && n >= f.Limit {
+			return nil
+		}
+		r, err := decode(ctx, k)
+		if err != nil {
+			return errors.Wrapf(err, "could not decode key %#x of bucket %s", k, bucket)
+		}
+		if r == nil || !f.matches(r) {
+			continue
+		}
+		if err := fn(r); err != nil {
+			return err
+		}
+		n++
+	}
+	return nil
+}
+
+// inspectKeys returns the keys of the bucket that may match the filter.
+func (s *Store) inspectKeys(bucket string, f *InspectFilter) ([][]byte, error) {
+	var keys [][]byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket([]byte(bucket))
+		if bkt == nil {
+			return nil
+		}
+		if f != nil && len(f.Root) > 0 && (bucket == string(blocksBucket) || bucket == string(stateBucket) || bucket == string(stateSummaryBucket)) {
+			if bkt.Get(f.Root) != nil {
+				keys = append(keys, bytesutil.SafeCopyBytes(f.Root))
+			}
+			return nil
+		}
+		if f != nil && (f.StartSlot != 0 || f.EndSlot != 0) {
+			var idx *bolt.Bucket
+			switch bucket {
+			case string(blocksBucket):
+				idx = tx.Bucket(blockSlotIndicesBucket)
+			case string(stateBucket):
+				idx = tx.Bucket(stateSlotIndicesBucket)
+			}
+			if idx != nil {
+				var err error
+				keys, err = rootsInSlotRange(idx, f.StartSlot, f.EndSlot)
+				return err
+			}
+		}
+		return bkt.ForEach(func(k, _ []byte) error {
+			keys = append(keys, bytesutil.SafeCopyBytes(k))
+			return nil
+		})
+	})
+	return keys, err
+}
+
+// rootsInSlotRange returns the roots held by a slot index bucket for the slots in the range.
+func rootsInSlotRange(idx *bolt.Bucket, start, end primitives.Slot) ([][]byte, error) {
+	var roots [][]byte
+	c := idx.Cursor()
+	for k, v := c.Seek(bytesutil.SlotToBytesBigEndian(start)); k != nil; k, v = c.Next() {
+		if end != 0 && bytesutil.BytesToSlotBigEndian(k) > end {
+			break
+		}
+		rs, err := splitRoots(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "corrupt value in slot index for slot=%d", bytesutil.BytesToSlotBigEndian(k))
+		}
+		for _, r := range rs {
+			roots = append(roots, bytesutil.SafeCopyBytes(r[:]))
+		}
+	}
+	return roots, nil
+}
+
+type inspectDecoder func(ctx context.Context, key []byte) (*InspectRecord, error)
+
+func (s *Store) inspectDecoders() map[string]inspectDecoder {
+	return map[string]inspectDecoder{
+		string(blocksBucket):                   s.inspectBlock,
+		string(stateBucket):                    s.inspectState,
+		string(stateSummaryBucket):             s.inspectStateSummary,
+		string(checkpointBucket):               s.inspectCheckpoint,
+		string(chainMetadataBucket):            s.inspectChainMetadata,
+		string(powchainBucket):                 s.inspectExecutionChainData,
+		string(blobsBucket):                    s.inspectBlobSidecars,
+		string(blockSlotIndicesBucket):         s.inspectSlotIndex(blockSlotIndicesBucket),
+		string(stateSlotIndicesBucket):         s.inspectSlotIndex(stateSlotIndicesBucket),
+		string(finalizedBlockRootsIndexBucket): s.inspectFinalizedBlockRoot,
+	}
+}
+
+// value returns a copy of the value stored under the key of the bucket.
+func (s *Store) value(bucket, key []byte) ([]byte, error) {
+	var v []byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		v = bytesutil.SafeCopyBytes(tx.Bucket(bucket).Get(key))
+		return nil
+	})
+	return v, err
+}
+
+func (s *Store) inspectBlock(ctx context.Context, key []byte) (*InspectRecord, error) {
+	if len(key) != hashLength {
+		// Keys such as the head or genesis root point at a block root.
+		root, err := s.value(blocksBucket, key)
+		if err != nil {
+			return nil, err
+		}
+		return &InspectRecord{Key: key, Root: root, Value: root}, nil
+	}
+	blk, err := s.Block(ctx, bytesutil.ToBytes32(key))
+	if err != nil {
+		return nil, err
+	}
+	if blk == nil || blk.IsNil() {
+		return nil, nil
+	}
+	pb, err := blk.Proto()
+	if err != nil {
+		return nil, err
+	}
+	slot := blk.Block().Slot()
+	return &InspectRecord{Key: key, Root: key, Slot: &slot, Value: pb}, nil
+}
+
+func (s *Store) inspectState(ctx context.Context, key []byte) (*InspectRecord, error) {
+	st, err := s.State(ctx, bytesutil.ToBytes32(key))
+	if err != nil {
+		return nil, err
+	}
+	if st == nil || st.IsNil() {
+		return nil, nil
+	}
+	slot := st.Slot()
+	return &InspectRecord{Key: key, Root: key, Slot: &slot, Value: st.ToProtoUnsafe()}, nil
+}
+
+func (s *Store) inspectStateSummary(ctx context.Context, key []byte) (*InspectRecord, error) {
+	summary, err := s.StateSummary(ctx, bytesutil.ToBytes32(key))
+	if err != nil || summary == nil {
+		return nil, err
+	}
+	return &InspectRecord{Key: key, Root: key, Slot: &summary.Slot, Value: summary}, nil
+}
+
+func (s *Store) inspectCheckpoint(ctx context.Context, key []byte) (*InspectRecord, error) {
+	enc, err := s.value(checkpointBucket, key)
+	if err != nil || enc == nil {
+		return nil, err
+	}
+	cp := &zondpb.Checkpoint{}
+	if err := decode(ctx, enc, cp); err != nil {
+		return nil, err
+	}
+	return &InspectRecord{Key: key, Root: cp.Root, Value: cp}, nil
+}
+
+func (s *Store) inspectChainMetadata(_ context.Context, key []byte) (*InspectRecord, error) {
+	enc, err := s.value(chainMetadataBucket, key)
+	if err != nil {
+		return nil, err
+	}
+	r := &InspectRecord{Key: key, Value: enc}
+	switch {
+	case bytes.Equal(key, earliestAvailableSlotKey):
+		slot := bytesutil.BytesToSlotBigEndian(enc)
+		r.Value = slot
+	case bytes.Equal(key, blobRetentionEpochsKey):
+		r.Value = primitives.Epoch(bytesutil.BytesToUint64BigEndian(enc))
+	case bytes.Equal(key, historyPrunedKey), bytes.Equal(key, saveBlindedBeaconBlocksKey):
+		r.Value = len(enc) > 0
+	case bytes.Equal(key, forkChoiceStoreKey):
+		r.Value = fmt.Sprintf("%d bytes", len(enc))
+	}
+	return r, nil
+}
+
+func (s *Store) inspectExecutionChainData(_ context.Context, key []byte) (*InspectRecord, error) {
+	enc, err := s.value(powchainBucket, key)
+	if err != nil || len(enc) == 0 {
+		return nil, err
+	}
+	if !bytes.Equal(key, powchainDataKey) {
+		return &InspectRecord{Key: key, Value: enc}, nil
+	}
+	data := &zondpb.ETH1ChainData{}
+	if err := proto.Unmarshal(enc, data); err != nil {
+		return nil, err
+	}
+	return &InspectRecord{Key: key, Value: data}, nil
+}
+
+func (s *Store) inspectBlobSidecars(ctx context.Context, key []byte) (*InspectRecord, error) {
+	enc, err := s.value(blobsBucket, key)
+	if err != nil || enc == nil {
+		return nil, err
+	}
+	sc := &zondpb.BlobSidecars{}
+	if err := decode(ctx, enc, sc); err != nil {
+		return nil, err
+	}
+	// Blob keys are made of the rotating slot, the slot and the block root.
+	r := &InspectRecord{Key: key, Value: sc}
+	if len(key) == 16+hashLength {
+		slot := bytesutil.BytesToSlotBigEndian(key[8:16])
+		r.Slot = &slot
+		r.Root = key[16:]
+	}
+	return r, nil
+}
+
+func (s *Store) inspectSlotIndex(bucket []byte) inspectDecoder {
+	return func(_ context.Context, key []byte) (*InspectRecord, error) {
+		enc, err := s.value(bucket, key)
+		if err != nil {
+			return nil, err
+		}
+		roots, err := splitRoots(enc)
+		if err != nil {
+			return nil, err
+		}
+		rs := make([][]byte, len(roots))
+		for i := range roots {
+			rs[i] = roots[i][:]
+		}
+		slot := bytesutil.BytesToSlotBigEndian(key)
+		return &InspectRecord{Key: key, Slot: &slot, Value: rs}, nil
+	}
+}
+
+func (s *Store) inspectFinalizedBlockRoot(ctx context.Context, key []byte) (*InspectRecord, error) {
+	enc, err := s.value(finalizedBlockRootsIndexBucket, key)
+	if err != nil || enc == nil {
+		return nil, err
+	}
+	if bytes.Equal(key, previousFinalizedCheckpointKey) {
+		cp := &zondpb.Checkpoint{}
+		if err := decode(ctx, enc, cp); err != nil {
+			return nil, err
+		}
+		return &InspectRecord{Key: key, Root: cp.Root, Value: cp}, nil
+	}
+	// Recent finalized blocks are indexed with a sentinel until they are re-indexed on the next finalized checkpoint.
+	if bytes.Equal(enc, containerFinalizedButNotCanonical) {
+		return &InspectRecord{Key: key, Root: key, Value: string(enc)}, nil
+	}
+	ctr := &zondpb.FinalizedBlockRootContainer{}
+	if err := decode(ctx, enc, ctr); err != nil {
+		return nil, err
+	}
+	return &InspectRecord{Key: key, Root: key, Value: ctr}, nil
+}
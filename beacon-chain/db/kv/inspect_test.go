@@ -0,0 +1,111 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/theQRL/qrysm/v4/consensus-types/blocks"
+	"github.com/theQRL/qrysm/v4/consensus-types/interfaces"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+	"github.com/theQRL/qrysm/v4/testing/util"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestStore_Inspect(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	db, err := NewKVStore(ctx, dir)
+	require.NoError(t, err)
+
+	numBlocks := 10
+	totalBlocks := make([]interfaces.ReadOnlySignedBeaconBlock, numBlocks)
+	roots := make([][32]byte, numBlocks)
+	for i := 0; i < numBlocks; i++ {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = primitives.Slot(i)
+		totalBlocks[i], err = blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		roots[i], err = totalBlocks[i].Block().HashTreeRoot()
+		require.NoError(t, err)
+	}
+	require.NoError(t, db.SaveBlocks(ctx, totalBlocks))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, roots[0]))
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(4))
+	require.NoError(t, db.SaveState(ctx, st, roots[4]))
+	cp := &zondpb.Checkpoint{Epoch: 1, Root: roots[4][:]}
+	require.NoError(t, db.SaveJustifiedCheckpoint(ctx, cp))
+	ctr := &zondpb.FinalizedBlockRootContainer{ParentRoot: roots[0][:], ChildRoot: roots[2][:]}
+	enc, err := encode(ctx, ctr)
+	require.NoError(t, err)
+	require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+		if err := bkt.Put(roots[1][:], enc); err != nil {
+			return err
+		}
+		return bkt.Put(roots[2][:], containerFinalizedButNotCanonical)
+	}))
+	require.NoError(t, db.Close())
+
+	ro, err := NewReadOnlyKVStore(ctx, dir)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, ro.Close())
+	})
+
+	var slots []primitives.Slot
+	require.NoError(t, ro.Inspect(ctx, string(blocksBucket), &InspectFilter{StartSlot: 3, EndSlot: 6}, func(r *InspectRecord) error {
+		_, ok := r.Value.(*zondpb.SignedBeaconBlock)
+		assert.Equal(t, true, ok, "unexpected block type %T", r.Value)
+		slots = append(slots, *r.Slot)
+		return nil
+	}))
+	assert.DeepEqual(t, []primitives.Slot{3, 4, 5, 6}, slots)
+
+	var count int
+	require.NoError(t, ro.Inspect(ctx, string(blocksBucket), &InspectFilter{Root: roots[7][:]}, func(r *InspectRecord) error {
+		assert.Equal(t, primitives.Slot(7), *r.Slot)
+		count++
+		return nil
+	}))
+	assert.Equal(t, 1, count)
+
+	count = 0
+	require.NoError(t, ro.Inspect(ctx, string(blocksBucket), &InspectFilter{Limit: 2}, func(r *InspectRecord) error {
+		count++
+		return nil
+	}))
+	assert.Equal(t, 2, count)
+
+	count = 0
+	require.NoError(t, ro.Inspect(ctx, string(stateBucket), nil, func(r *InspectRecord) error {
+		_, ok := r.Value.(*zondpb.BeaconState)
+		assert.Equal(t, true, ok, "unexpected state type %T", r.Value)
+		assert.Equal(t, primitives.Slot(4), *r.Slot)
+		count++
+		return nil
+	}))
+	assert.Equal(t, 1, count)
+
+	require.NoError(t, ro.Inspect(ctx, string(checkpointBucket), nil, func(r *InspectRecord) error {
+		assert.DeepEqual(t, cp, r.Value)
+		return nil
+	}))
+
+	finalized := make(map[[32]byte]interface{})
+	require.NoError(t, ro.Inspect(ctx, string(finalizedBlockRootsIndexBucket), nil, func(r *InspectRecord) error {
+		finalized[bytesutil.ToBytes32(r.Root)] = r.Value
+		return nil
+	}))
+	assert.Equal(t, 2, len(finalized))
+	assert.DeepEqual(t, ctr, finalized[roots[1]])
+	assert.Equal(t, string(containerFinalizedButNotCanonical), finalized[roots[2]])
+
+	err = ro.Inspect(ctx, string(attestationsBucket), nil, func(*InspectRecord) error { return nil })
+	require.ErrorContains(t, "cannot be inspected", err)
+}
@@ -90,6 +90,7 @@ type Store struct {
 	validatorEntryCache *ristretto.Cache
 	stateSummaryCache   *stateSummaryCache
 	ctx                 context.Context
+	readOnly            bool
 }
 
 // KVStoreDatafilePath is the canonical construction of a full
@@ -131,6 +132,13 @@ var Buckets = [][]byte{
 	registrationBucket,
 
 	blobsBucket,
+
+	lightClientUpdatesBucket,
+
+	stateDiffBucket,
+	stateDiffBasesBucket,
+
+	validatorPerformanceBucket,
 }
 
 // NewKVStore initializes a new boltDB key-value store at the directory
@@ -223,6 +231,9 @@ func (s *Store) ClearDB() error {
 
 // Close closes the underlying BoltDB database.
 func (s *Store) Close() error {
+	if s.readOnly {
+		return s.db.Close()
+	}
 	prometheus.Unregister(createBoltCollector(s.db))
 
 	// Before DB closes, we should dump the cached state summary objects to DB.
@@ -248,6 +259,10 @@ func (s *Store) setupBlockStorageType(ctx context.Context) error {
 	err = blocks.BeaconBlockIsNil(headBlock)
 	isNilBlk := err != nil
 	saveFull := features.Get().SaveFullExecutionPayloads
+	forceBlinded := features.Get().BlindedBlockStorage
+	if saveFull && forceBlinded {
+		return fmt.Errorf("the %s and %s flags can not be used together", features.SaveFullExecutionPayloads.Name, features.BlindedBlockStorage.Name)
+	}
 
 	var saveBlinded bool
 	if err := s.db.Update(func(tx *bolt.Tx) error {
@@ -266,7 +281,10 @@ func (s *Store) setupBlockStorageType(ctx context.Context) error {
 			}
 			saveBlinded = true
 		}
-		if isNilBlk && !saveFull {
+		// New blocks are saved as blinded when the database is empty, unless the user wants full execution payloads,
+		// or when the user wants to only store blinded blocks from now on. Like for a blinded head block, the key is
+		// persisted, so the switch to blinded blocks is one-way and outlives the flag.
+		if (isNilBlk && !saveFull) || forceBlinded {
 			if err := metadataBkt.Put(saveBlindedBeaconBlocksKey, []byte{1}); err != nil {
 				return err
 			}
@@ -287,6 +305,10 @@ func (s *Store) setupBlockStorageType(ctx context.Context) error {
 			features.SaveFullExecutionPayloads.Name,
 		)
 	}
+	if forceBlinded && !isNilBlk && !headBlock.IsBlinded() {
+		log.Info("Saving new beacon blocks as blinded blocks to the database, the full beacon blocks already stored are kept as they are. " +
+			"The database keeps saving blinded blocks from now on, even without the flag")
+	}
 	if saveFull {
 		log.Warn("Saving full beacon blocks to the database. For greater disk space savings, we recommend resyncing from an empty database with " +
 			"checkpoint sync to save only blinded beacon blocks by default")
@@ -188,4 +188,62 @@ func Test_setupBlockStorageType(t *testing.T) {
 		errMsg := "cannot use the %s flag with this existing database, as it has already been initialized"
 		require.ErrorContains(t, fmt.Sprintf(errMsg, features.SaveFullExecutionPayloads.Name), err)
 	})
+	t.Run("existing database with full blocks type should store blinded blocks if user enables blinded block storage", func(t *testing.T) {
+		store := setupDB(t)
+		require.NoError(t, store.db.Update(func(tx *bolt.Tx) error {
+			return tx.Bucket(chainMetadataBucket).Delete(saveBlindedBeaconBlocksKey)
+		}))
+
+		blk := util.NewBeaconBlockBellatrix()
+		blk.Block.Body.ExecutionPayload.BlockNumber = 1
+		wrappedBlock, err := blocks.NewSignedBeaconBlock(blk)
+		require.NoError(t, err)
+		root, err := wrappedBlock.Block().HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, store.SaveBlock(ctx, wrappedBlock))
+		require.NoError(t, store.SaveStateSummary(ctx, &zondpb.StateSummary{Root: root[:]}))
+		require.NoError(t, store.SaveHeadBlockRoot(ctx, root))
+
+		resetFn := features.InitWithReset(&features.Flags{
+			BlindedBlockStorage: true,
+		})
+		defer resetFn()
+		require.NoError(t, store.setupBlockStorageType(ctx))
+
+		// The full block already stored is kept as it is.
+		retrievedBlk, err := store.Block(ctx, root)
+		require.NoError(t, err)
+		require.Equal(t, false, retrievedBlk.IsBlinded())
+
+		blk = util.NewBeaconBlockBellatrix()
+		blk.Block.Body.ExecutionPayload.BlockNumber = 2
+		wrappedBlock, err = blocks.NewSignedBeaconBlock(blk)
+		require.NoError(t, err)
+		root, err = wrappedBlock.Block().HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, store.SaveBlock(ctx, wrappedBlock))
+		retrievedBlk, err = store.Block(ctx, root)
+		require.NoError(t, err)
+		require.Equal(t, true, retrievedBlk.IsBlinded())
+
+		// The switch is one-way, full blocks can not be stored again once the flag is removed.
+		resetFn()
+		resetFull := features.InitWithReset(&features.Flags{
+			SaveFullExecutionPayloads: true,
+		})
+		defer resetFull()
+		err = store.setupBlockStorageType(ctx)
+		errMsg := "cannot use the %s flag with this existing database, as it has already been initialized"
+		require.ErrorContains(t, fmt.Sprintf(errMsg, features.SaveFullExecutionPayloads.Name), err)
+	})
+	t.Run("blinded block storage can not be used with full blocks feature flag", func(t *testing.T) {
+		store := setupDB(t)
+		resetFn := features.InitWithReset(&features.Flags{
+			SaveFullExecutionPayloads: true,
+			BlindedBlockStorage:       true,
+		})
+		defer resetFn()
+		err := store.setupBlockStorageType(ctx)
+		require.ErrorContains(t, "can not be used together", err)
+	})
 }
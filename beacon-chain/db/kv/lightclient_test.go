@@ -0,0 +1,78 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	zondpbv1 "github.com/theQRL/qrysm/v4/proto/zond/v1"
+	zondpbv2 "github.com/theQRL/qrysm/v4/proto/zond/v2"
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func testLightClientUpdate(slot primitives.Slot) *zondpbv2.LightClientUpdate {
+	return &zondpbv2.LightClientUpdate{
+		AttestedHeader: &zondpbv1.BeaconBlockHeader{
+			Slot:       slot,
+			ParentRoot: make([]byte, 32),
+			StateRoot:  make([]byte, 32),
+			BodyRoot:   make([]byte, 32),
+		},
+		SyncAggregate: &zondpbv1.SyncAggregate{
+			SyncCommitteeBits:      []byte{0xff, 0x01},
+			SyncCommitteeSignature: make([]byte, 73520),
+		},
+		SignatureSlot: slot + 1,
+	}
+}
+
+func TestStore_LightClientUpdate_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	update, err := db.LightClientUpdate(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, (*zondpbv2.LightClientUpdate)(nil), update)
+
+	want := testLightClientUpdate(10)
+	require.NoError(t, db.SaveLightClientUpdate(ctx, 1, want))
+	update, err = db.LightClientUpdate(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(want, update), "Wanted %v, received %v", want, update)
+
+	// A later update for the same period replaces the earlier one.
+	want = testLightClientUpdate(20)
+	require.NoError(t, db.SaveLightClientUpdate(ctx, 1, want))
+	update, err = db.LightClientUpdate(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(want, update), "Wanted %v, received %v", want, update)
+}
+
+func TestStore_LightClientUpdates(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	for _, period := range []uint64{1, 2, 4, 300} {
+		require.NoError(t, db.SaveLightClientUpdate(ctx, period, testLightClientUpdate(primitives.Slot(period))))
+	}
+
+	updates, err := db.LightClientUpdates(ctx, 2, 5)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(updates))
+	assert.Equal(t, primitives.Slot(2), updates[0].AttestedHeader.Slot)
+	assert.Equal(t, primitives.Slot(4), updates[1].AttestedHeader.Slot)
+
+	updates, err = db.LightClientUpdates(ctx, 0, 1000)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(updates))
+	assert.Equal(t, primitives.Slot(300), updates[3].AttestedHeader.Slot)
+
+	updates, err = db.LightClientUpdates(ctx, 5, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(updates))
+
+	_, err = db.LightClientUpdates(ctx, 3, 2)
+	require.ErrorContains(t, "start period 3 is greater than end period 2", err)
+}
@@ -0,0 +1,188 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// EarliestAvailableSlot returns the lowest slot above genesis from which the database holds the block history.
+// It is zero unless history has been pruned with PruneHistory.
+func (s *Store) EarliestAvailableSlot(ctx context.Context) (primitives.Slot, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.EarliestAvailableSlot")
+	defer span.End()
+	var slot primitives.Slot
+	err := s.db.View(func(tx *bolt.Tx) error {
+		if enc := tx.Bucket(chainMetadataBucket).Get(earliestAvailableSlotKey); enc != nil {
+			slot = bytesutil.BytesToSlotBigEndian(enc)
+		}
+		return nil
+	})
+	return slot, err
+}
+
+// PruneHistory deletes the blocks below the given slot, along with their states, state summaries and indices,
+// processing at most batchSize slots. It returns the number of slots pruned, which is zero once there is nothing
+// left to prune, so that callers can prune in batches without holding the write lock for too long.
+//
+// History is only pruned down to the highest archived state at or below the given slot, so that every state above
+// it can still be regenerated. The full state this archived state is diffed against, if any, is kept as well. The
+// genesis block and state, the finalized and justified checkpoints and the origin checkpoint and backfill blocks
+// are always kept.
+func (s *Store) PruneHistory(ctx context.Context, beforeSlot primitives.Slot, batchSize uint64) (uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneHistory")
+	defer span.End()
+	if batchSize == 0 {
+		return 0, errors.New("history pruning batch size must be greater than 0")
+	}
+
+	var pruned uint64
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		anchorSlot, anchorRoots := highestArchivedPointAtOrBelow(tx, beforeSlot)
+		if anchorSlot == 0 {
+			return nil
+		}
+		keep, err := protectedHistoryRoots(ctx, tx)
+		if err != nil {
+			return err
+		}
+		for _, r := range anchorRoots {
+			keep[r] = true
+			// A state stored as a diff needs the full state it applies to.
+			if base, ok := stateDiffBase(tx, r); ok {
+				keep[base] = true
+			}
+		}
+
+		// Slots below the earliest available slot have already been pruned, apart from protected blocks.
+		metadataBkt := tx.Bucket(chainMetadataBucket)
+		start := primitives.Slot(1)
+		if enc := metadataBkt.Get(earliestAvailableSlotKey); enc != nil && bytesutil.BytesToSlotBigEndian(enc) > start {
+			start = bytesutil.BytesToSlotBigEndian(enc)
+		}
+
+		// Collect the slots to prune first, as bolt cursors must not be used while their bucket is modified.
+		slotBkt := tx.Bucket(blockSlotIndicesBucket)
+		c := slotBkt.Cursor()
+		end := bytesutil.SlotToBytesBigEndian(anchorSlot)
+		type slotRoots struct {
+			key   []byte
+			roots [][32]byte
+		}
+		batch := make([]slotRoots, 0, batchSize)
+		for k, v := c.Seek(bytesutil.SlotToBytesBigEndian(start)); k != nil && bytes.Compare(k, end) < 0 && uint64(len(batch)) < batchSize; k, v = c.Next() {
+			roots, err := splitRoots(v)
+			if err != nil {
+				return errors.Wrapf(err, "corrupt value in block slot index for slot=%d", bytesutil.BytesToSlotBigEndian(k))
+			}
+			batch = append(batch, slotRoots{key: bytesutil.SafeCopyBytes(k), roots: roots})
+		}
+
+		for _, sr := range batch {
+			kept := make([]byte, 0)
+			for _, r := range sr.roots {
+				if keep[r] {
+					kept = append(kept, r[:]...)
+					continue
+				}
+				if err := s.deleteBlockHistory(ctx, tx, r); err != nil {
+					return err
+				}
+			}
+			if len(kept) > 0 {
+				if err := slotBkt.Put(sr.key, kept); err != nil {
+					return err
+				}
+			} else if err := slotBkt.Delete(sr.key); err != nil {
+				return err
+			}
+		}
+		pruned = uint64(len(batch))
+
+		// Until the last batch, history is available from the slot after the last pruned slot.
+		earliest := anchorSlot
+		if pruned == batchSize {
+			earliest = bytesutil.BytesToSlotBigEndian(batch[len(batch)-1].key) + 1
+		}
+		if pruned > 0 {
+			if err := metadataBkt.Put(historyPrunedKey, []byte{1}); err != nil {
+				return err
+			}
+		}
+		if enc := metadataBkt.Get(earliestAvailableSlotKey); enc != nil && bytesutil.BytesToSlotBigEndian(enc) >= earliest {
+			return nil
+		}
+		return metadataBkt.Put(earliestAvailableSlotKey, bytesutil.SlotToBytesBigEndian(earliest))
+	})
+	return pruned, err
+}
+
+// deleteBlockHistory removes a block, its state, its state summary and its indices within the given transaction.
+func (s *Store) deleteBlockHistory(ctx context.Context, tx *bolt.Tx, root [32]byte) error {
+	if err := s.deleteState(ctx, tx, root); err != nil {
+		return errors.Wrapf(err, "could not delete state for block root %#x", root)
+	}
+	s.stateSummaryCache.delete(root)
+	if err := tx.Bucket(stateSummaryBucket).Delete(root[:]); err != nil {
+		return err
+	}
+	if err := tx.Bucket(finalizedBlockRootsIndexBucket).Delete(root[:]); err != nil {
+		return err
+	}
+	if err := tx.Bucket(blockParentRootIndicesBucket).Delete(root[:]); err != nil {
+		return err
+	}
+	if err := tx.Bucket(blocksBucket).Delete(root[:]); err != nil {
+		return err
+	}
+	s.blockCache.Del(string(root[:]))
+	return nil
+}
+
+// highestArchivedPointAtOrBelow returns the slot and block roots of the highest state saved at or below the given slot.
+func highestArchivedPointAtOrBelow(tx *bolt.Tx, slot primitives.Slot) (primitives.Slot, [][32]byte) {
+	c := tx.Bucket(stateSlotIndicesBucket).Cursor()
+	key := bytesutil.SlotToBytesBigEndian(slot)
+	k, v := c.Seek(key)
+	if k == nil || !bytes.Equal(k, key) {
+		k, v = c.Prev()
+	}
+	if k == nil {
+		return 0, nil
+	}
+	roots, err := splitRoots(v)
+	if err != nil {
+		return 0, nil
+	}
+	return bytesutil.BytesToSlotBigEndian(k), roots
+}
+
+// protectedHistoryRoots returns the block roots that history pruning must never delete.
+func protectedHistoryRoots(ctx context.Context, tx *bolt.Tx) (map[[32]byte]bool, error) {
+	keep := make(map[[32]byte]bool)
+	blocksBkt := tx.Bucket(blocksBucket)
+	for _, key := range [][]byte{genesisBlockRootKey, originCheckpointBlockRootKey, backfillBlockRootKey, headBlockRootKey} {
+		if r := blocksBkt.Get(key); r != nil {
+			keep[bytesutil.ToBytes32(r)] = true
+		}
+	}
+	checkpointBkt := tx.Bucket(checkpointBucket)
+	for _, key := range [][]byte{finalizedCheckpointKey, justifiedCheckpointKey} {
+		enc := checkpointBkt.Get(key)
+		if enc == nil {
+			continue
+		}
+		cp := &zondpb.Checkpoint{}
+		if err := decode(ctx, enc, cp); err != nil {
+			return nil, err
+		}
+		keep[bytesutil.ToBytes32(cp.Root)] = true
+	}
+	return keep, nil
+}
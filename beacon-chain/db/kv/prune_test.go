@@ -0,0 +1,80 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/theQRL/qrysm/v4/consensus-types/blocks"
+	"github.com/theQRL/qrysm/v4/consensus-types/interfaces"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/testing/require"
+	"github.com/theQRL/qrysm/v4/testing/util"
+)
+
+func TestStore_PruneHistory(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	numBlocks := 21
+	totalBlocks := make([]interfaces.ReadOnlySignedBeaconBlock, numBlocks)
+	roots := make([][32]byte, numBlocks)
+	for i := 0; i < numBlocks; i++ {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = primitives.Slot(i)
+		var err error
+		totalBlocks[i], err = blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		roots[i], err = totalBlocks[i].Block().HashTreeRoot()
+		require.NoError(t, err)
+	}
+	require.NoError(t, db.SaveBlocks(ctx, totalBlocks))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, roots[0]))
+	for _, i := range []int{0, 8, 16} {
+		st, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(primitives.Slot(i)))
+		require.NoError(t, db.SaveState(ctx, st, roots[i]))
+	}
+
+	earliest, err := db.EarliestAvailableSlot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, primitives.Slot(0), earliest)
+
+	// Slots 1 to 15 are pruned in batches of 4, as 16 is the highest archived state below slot 18.
+	for _, want := range []uint64{4, 4, 4, 3, 0} {
+		pruned, err := db.PruneHistory(ctx, 18, 4)
+		require.NoError(t, err)
+		require.Equal(t, want, pruned)
+	}
+	earliest, err = db.EarliestAvailableSlot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, primitives.Slot(16), earliest)
+
+	for i := 0; i < numBlocks; i++ {
+		want := i == 0 || i >= 16
+		require.Equal(t, want, db.HasBlock(ctx, roots[i]), "unexpected block presence at slot %d", i)
+	}
+	require.Equal(t, false, db.HasState(ctx, roots[8]))
+	require.Equal(t, true, db.HasState(ctx, roots[0]))
+	require.Equal(t, true, db.HasState(ctx, roots[16]))
+
+	pruned, err := db.PruneHistory(ctx, 18, 0)
+	require.ErrorContains(t, "batch size must be greater than 0", err)
+	require.Equal(t, uint64(0), pruned)
+}
+
+func TestStore_PruneHistory_NoArchivedState(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	b := util.NewBeaconBlock()
+	b.Block.Slot = 5
+	util.SaveBlock(t, ctx, db, b)
+
+	pruned, err := db.PruneHistory(ctx, 10, 4)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), pruned)
+	earliest, err := db.EarliestAvailableSlot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, primitives.Slot(0), earliest)
+}
@@ -22,6 +22,19 @@ var (
 	feeRecipientBucket      = []byte("fee-recipient")
 	registrationBucket      = []byte("registration")
 
+	// Light client updates bucket, keyed by sync committee period.
+	lightClientUpdatesBucket = []byte("light-client-updates")
+
+	// State diffs bucket, keyed by block root. Holds the states stored as a diff against a full state.
+	stateDiffBucket = []byte("state-diff")
+	// State diff bases bucket, keyed by block root. Holds the number of state diffs stored against the full state
+	// of the block root.
+	stateDiffBasesBucket = []byte("state-diff-bases")
+
+	// Validator monitor bucket, keyed by validator index and epoch. Holds the per epoch performance of the
+	// validators tracked by the validator monitor.
+	validatorPerformanceBucket = []byte("validator-performance")
+
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
 	slotsHasObjectBucket = []byte("slots-has-objects")
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
@@ -51,6 +64,16 @@ var (
 	// determined. If this value changes, the existing data is invalidated, so storing it in the db
 	// allows us to assert at runtime that the db state is still consistent with the runtime state.
 	blobRetentionEpochsKey = []byte("blob-retention-epochs")
+	// earliestAvailableSlotKey is the lowest slot above genesis from which blocks are kept once history is pruned.
+	earliestAvailableSlotKey = []byte("earliest-available-slot")
+	// historyPrunedKey is set when history is pruned and cleared once the database file has been compacted.
+	historyPrunedKey = []byte("history-pruned")
+	// forkChoiceStoreKey holds the fork choice store serialized periodically, to be restored on restart.
+	forkChoiceStoreKey = []byte("fork-choice-store")
+	// monitoredValidatorsKey holds the indices of the validators tracked by the validator monitor.
+	monitoredValidatorsKey = []byte("monitored-validators")
+	// unmonitoredValidatorsKey holds the indices of the validators removed from the validator monitor at runtime.
+	unmonitoredValidatorsKey = []byte("unmonitored-validators")
 
 	// Below keys are used to identify objects are to be fork compatible.
 	// Objects that are only compatible with specific forks should be prefixed with such keys.
@@ -456,60 +456,86 @@ func (s *Store) DeleteState(ctx context.Context, blockRoot [32]byte) error {
 			return err
 		}
 
-		bkt = tx.Bucket(stateBucket)
 		// Safeguard against deleting genesis, finalized, head state.
 		if bytes.Equal(blockRoot[:], finalized.Root) || bytes.Equal(blockRoot[:], genesisBlockRoot) || bytes.Equal(blockRoot[:], justified.Root) {
 			return ErrDeleteJustifiedAndFinalized
 		}
-
-		// Nothing to delete if state doesn't exist.
-		enc = bkt.Get(blockRoot[:])
-		if enc == nil {
-			return nil
+		// Safeguard against deleting the full state state diffs are stored against.
+		if isStateDiffBase(tx, blockRoot) {
+			return errors.Wrapf(ErrStateDiffBase, "could not delete state of block root %#x", blockRoot)
 		}
 
-		slot, err := s.slotByBlockRoot(ctx, tx, blockRoot[:])
-		if err != nil {
+		return s.deleteState(ctx, tx, blockRoot)
+	})
+}
+
+// deleteState removes the state of the block root, whether stored in full or as a diff, its slot index and its
+// validator entries within the given transaction, without the safeguards of DeleteState.
+func (s *Store) deleteState(ctx context.Context, tx *bolt.Tx, blockRoot [32]byte) error {
+	diffBkt := tx.Bucket(stateDiffBucket)
+	// Nothing to delete if state doesn't exist.
+	if tx.Bucket(stateBucket).Get(blockRoot[:]) == nil && diffBkt.Get(blockRoot[:]) == nil {
+		return nil
+	}
+
+	slot, err := s.slotByBlockRoot(ctx, tx, blockRoot[:])
+	if err != nil {
+		return err
+	}
+	indicesByBucket := createStateIndicesFromStateSlot(ctx, slot)
+	if err := deleteValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
+		return errors.Wrap(err, "could not delete root for DB indices")
+	}
+	if base, ok := stateDiffBase(tx, blockRoot); ok {
+		if err := updateStateDiffBaseCount(tx, base, -1); err != nil {
 			return err
 		}
-		indicesByBucket := createStateIndicesFromStateSlot(ctx, slot)
-		if err := deleteValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
-			return errors.Wrap(err, "could not delete root for DB indices")
-		}
+	}
+	if err := diffBkt.Delete(blockRoot[:]); err != nil {
+		return err
+	}
+	return s.deleteFullState(tx, blockRoot)
+}
 
-		ok, err := s.isStateValidatorMigrationOver()
+// deleteFullState removes the full state of the block root and its validator entries, leaving its slot index.
+func (s *Store) deleteFullState(tx *bolt.Tx, blockRoot [32]byte) error {
+	bkt := tx.Bucket(stateBucket)
+	if bkt.Get(blockRoot[:]) == nil {
+		return nil
+	}
+
+	ok, err := s.isStateValidatorMigrationOver()
+	if err != nil {
+		return err
+	}
+	if ok {
+		// remove the validator entry keys for the corresponding state.
+		idxBkt := tx.Bucket(blockRootValidatorHashesBucket)
+		compressedValidatorHashes := idxBkt.Get(blockRoot[:])
+		err = idxBkt.Delete(blockRoot[:])
 		if err != nil {
 			return err
 		}
-		if ok {
-			// remove the validator entry keys for the corresponding state.
-			idxBkt := tx.Bucket(blockRootValidatorHashesBucket)
-			compressedValidatorHashes := idxBkt.Get(blockRoot[:])
-			err = idxBkt.Delete(blockRoot[:])
-			if err != nil {
-				return err
-			}
 
-			// remove the respective validator entries from the cache.
-			if len(compressedValidatorHashes) == 0 {
-				return errors.Errorf("invalid compressed validator keys length")
-			}
-			validatorHashes, sErr := snappy.Decode(nil, compressedValidatorHashes)
-			if sErr != nil {
-				return errors.Wrap(sErr, "failed to uncompress validator keys")
-			}
-			if len(validatorHashes)%hashLength != 0 {
-				return errors.Errorf("invalid validator keys length: %d", len(validatorHashes))
-			}
-			for i := 0; i < len(validatorHashes); i += hashLength {
-				key := validatorHashes[i : i+hashLength]
-				s.validatorEntryCache.Del(key)
-				validatorEntryCacheDelete.Inc()
-			}
+		// remove the respective validator entries from the cache.
+		if len(compressedValidatorHashes) == 0 {
+			return errors.Errorf("invalid compressed validator keys length")
 		}
+		validatorHashes, sErr := snappy.Decode(nil, compressedValidatorHashes)
+		if sErr != nil {
+			return errors.Wrap(sErr, "failed to uncompress validator keys")
+		}
+		if len(validatorHashes)%hashLength != 0 {
+			return errors.Errorf("invalid validator keys length: %d", len(validatorHashes))
+		}
+		for i := 0; i < len(validatorHashes); i += hashLength {
+			key := validatorHashes[i : i+hashLength]
+			s.validatorEntryCache.Del(key)
+			validatorEntryCacheDelete.Inc()
+		}
+	}
 
-		return bkt.Delete(blockRoot[:])
-	})
+	return bkt.Delete(blockRoot[:])
 }
 
 // DeleteStates by block roots.
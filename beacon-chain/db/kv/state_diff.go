@@ -0,0 +1,143 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/v4/beacon-chain/state"
+	"github.com/theQRL/qrysm/v4/beacon-chain/state/statediff"
+	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+// SaveStateDiff stores the state of the block root as a diff against the full state saved for the base block
+// root, replacing the full state of the block root if there is one. The state is indexed by slot like a full
+// state. statediff.ErrTypeMismatch is returned if the states do not belong to the same fork, and ErrStateDiffBase
+// if other diffs are stored against the full state of the block root.
+func (s *Store) SaveStateDiff(ctx context.Context, st state.ReadOnlyBeaconState, blockRoot, baseRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveStateDiff")
+	defer span.End()
+	if st == nil || st.IsNil() {
+		return errors.New("nil state")
+	}
+	if blockRoot == baseRoot {
+		return errors.New("cannot store a state as a diff against itself")
+	}
+	base, err := s.State(ctx, baseRoot)
+	if err != nil {
+		return errors.Wrapf(err, "could not retrieve base state %#x", baseRoot)
+	}
+	if base == nil || base.IsNil() {
+		return errors.Wrapf(ErrNotFoundState, "no full state with blockroot=%#x to diff against", baseRoot)
+	}
+	basePb, ok := base.ToProtoUnsafe().(proto.Message)
+	if !ok {
+		return errors.New("base state is not a protobuf message")
+	}
+	targetPb, ok := st.ToProtoUnsafe().(proto.Message)
+	if !ok {
+		return errors.New("state is not a protobuf message")
+	}
+	d, err := statediff.Compute(basePb, targetPb)
+	if err != nil {
+		return err
+	}
+	enc := append(bytesutil.SafeCopyBytes(baseRoot[:]), snappy.Encode(nil, d.Marshal())...)
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if isStateDiffBase(tx, blockRoot) {
+			return errors.Wrapf(ErrStateDiffBase, "could not replace state of block root %#x with a diff", blockRoot)
+		}
+		if err := updateValueForIndices(ctx, createStateIndicesFromStateSlot(ctx, st.Slot()), blockRoot[:], tx); err != nil {
+			return errors.Wrap(err, "could not update DB indices")
+		}
+		if err := s.deleteFullState(tx, blockRoot); err != nil {
+			return err
+		}
+		if oldBase, ok := stateDiffBase(tx, blockRoot); ok {
+			if err := updateStateDiffBaseCount(tx, oldBase, -1); err != nil {
+				return err
+			}
+		}
+		if err := updateStateDiffBaseCount(tx, baseRoot, 1); err != nil {
+			return err
+		}
+		return tx.Bucket(stateDiffBucket).Put(blockRoot[:], enc)
+	})
+}
+
+// StateDiff returns the diff the state of the block root is stored as, and the block root of the full state
+// the diff applies to. A nil diff is returned if the state is not stored as a diff.
+func (s *Store) StateDiff(ctx context.Context, blockRoot [32]byte) ([32]byte, *statediff.Diff, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.StateDiff")
+	defer span.End()
+	var enc []byte
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		enc = bytesutil.SafeCopyBytes(tx.Bucket(stateDiffBucket).Get(blockRoot[:]))
+		return nil
+	}); err != nil {
+		return [32]byte{}, nil, err
+	}
+	if enc == nil {
+		return [32]byte{}, nil, nil
+	}
+	if len(enc) < hashLength {
+		return [32]byte{}, nil, errors.Errorf("invalid state diff length %d", len(enc))
+	}
+	decoded, err := snappy.Decode(nil, enc[hashLength:])
+	if err != nil {
+		return [32]byte{}, nil, errors.Wrap(err, "could not decompress state diff")
+	}
+	d, err := statediff.Unmarshal(decoded)
+	if err != nil {
+		return [32]byte{}, nil, err
+	}
+	return bytesutil.ToBytes32(enc[:hashLength]), d, nil
+}
+
+// HasStateDiff checks if the state of the block root is stored as a diff.
+func (s *Store) HasStateDiff(ctx context.Context, blockRoot [32]byte) bool {
+	_, span := trace.StartSpan(ctx, "BeaconDB.HasStateDiff")
+	defer span.End()
+	var has bool
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		has = tx.Bucket(stateDiffBucket).Get(blockRoot[:]) != nil
+		return nil
+	}); err != nil { // This view never returns an error, but we'll handle anyway for sanity.
+		panic(err)
+	}
+	return has
+}
+
+// stateDiffBase returns the block root of the full state the diff of the block root applies to, if the state of
+// the block root is stored as a diff.
+func stateDiffBase(tx *bolt.Tx, blockRoot [32]byte) ([32]byte, bool) {
+	enc := tx.Bucket(stateDiffBucket).Get(blockRoot[:])
+	if len(enc) < hashLength {
+		return [32]byte{}, false
+	}
+	return bytesutil.ToBytes32(enc[:hashLength]), true
+}
+
+// isStateDiffBase returns true if any state diff is stored against the full state of the block root.
+func isStateDiffBase(tx *bolt.Tx, blockRoot [32]byte) bool {
+	return bytesutil.BytesToUint64BigEndian(tx.Bucket(stateDiffBasesBucket).Get(blockRoot[:])) > 0
+}
+
+// updateStateDiffBaseCount adds delta to the number of state diffs stored against the full state of the block
+// root. It is called in the transaction which saves or deletes the diff.
+func updateStateDiffBaseCount(tx *bolt.Tx, blockRoot [32]byte, delta int) error {
+	bkt := tx.Bucket(stateDiffBasesBucket)
+	count := bytesutil.BytesToUint64BigEndian(bkt.Get(blockRoot[:]))
+	if delta < 0 && count < uint64(-delta) {
+		return errors.Errorf("state diff base count of block root %#x would be negative", blockRoot)
+	}
+	count = uint64(int64(count) + int64(delta))
+	if count == 0 {
+		return bkt.Delete(blockRoot[:])
+	}
+	return bkt.Put(blockRoot[:], bytesutil.Uint64ToBytesBigEndian(count))
+}
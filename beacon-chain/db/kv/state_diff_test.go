@@ -0,0 +1,101 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/theQRL/qrysm/v4/beacon-chain/state/statediff"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+	"github.com/theQRL/qrysm/v4/testing/util"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestStore_SaveStateDiff(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	baseRoot, root := [32]byte{'A'}, [32]byte{'B'}
+	base, err := util.NewBeaconStateCapella()
+	require.NoError(t, err)
+	require.NoError(t, base.SetSlot(2048))
+	require.NoError(t, db.SaveState(ctx, base, baseRoot))
+
+	st := base.Copy()
+	require.NoError(t, st.SetSlot(4096))
+	require.NoError(t, db.SaveStateSummary(ctx, &zondpb.StateSummary{Slot: 4096, Root: root[:]}))
+	require.NoError(t, st.SetGenesisTime(20))
+	// The state saved in full is replaced by the diff.
+	require.NoError(t, db.SaveState(ctx, st, root))
+	require.NoError(t, db.SaveStateDiff(ctx, st, root, baseRoot))
+	assert.Equal(t, false, db.HasState(ctx, root))
+	assert.Equal(t, true, db.HasStateDiff(ctx, root))
+	assert.Equal(t, root, db.ArchivedPointRoot(ctx, 4096))
+
+	gotBase, d, err := db.StateDiff(ctx, root)
+	require.NoError(t, err)
+	assert.Equal(t, baseRoot, gotBase)
+	pb, ok := base.ToProto().(*zondpb.BeaconStateCapella)
+	require.Equal(t, true, ok)
+	require.NoError(t, d.Apply(pb))
+	require.Equal(t, true, proto.Equal(st.ToProtoUnsafe().(proto.Message), pb))
+
+	require.NoError(t, db.DeleteState(ctx, root))
+	assert.Equal(t, false, db.HasStateDiff(ctx, root))
+	assert.Equal(t, false, db.HasArchivedPoint(ctx, 4096))
+}
+
+func TestStore_SaveStateDiff_Errors(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	st, err := util.NewBeaconStateCapella()
+	require.NoError(t, err)
+	require.ErrorIs(t, db.SaveStateDiff(ctx, st, [32]byte{'B'}, [32]byte{'A'}), ErrNotFoundState)
+
+	base, err := util.NewBeaconStateBellatrix()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, base, [32]byte{'A'}))
+	require.ErrorIs(t, db.SaveStateDiff(ctx, st, [32]byte{'B'}, [32]byte{'A'}), statediff.ErrTypeMismatch)
+	assert.Equal(t, false, db.HasStateDiff(ctx, [32]byte{'B'}))
+
+	_, d, err := db.StateDiff(ctx, [32]byte{'B'})
+	require.NoError(t, err)
+	assert.Equal(t, true, d == nil)
+}
+
+func TestStore_StateDiffBase(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	oldBaseRoot, newBaseRoot, root, otherRoot := [32]byte{'A'}, [32]byte{'B'}, [32]byte{'C'}, [32]byte{'D'}
+	newBase, err := util.NewBeaconStateCapella()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, newBase, newBaseRoot))
+	oldBase := newBase.Copy()
+	require.NoError(t, oldBase.SetSlot(1024))
+	require.NoError(t, db.SaveStateSummary(ctx, &zondpb.StateSummary{Slot: 1024, Root: oldBaseRoot[:]}))
+	require.NoError(t, db.SaveState(ctx, oldBase, oldBaseRoot))
+	st := oldBase.Copy()
+	require.NoError(t, st.SetSlot(1088))
+	require.NoError(t, db.SaveStateSummary(ctx, &zondpb.StateSummary{Slot: 1088, Root: root[:]}))
+	require.NoError(t, db.SaveStateDiff(ctx, st, root, oldBaseRoot))
+	other := oldBase.Copy()
+	require.NoError(t, other.SetSlot(1120))
+	require.NoError(t, db.SaveStateSummary(ctx, &zondpb.StateSummary{Slot: 1120, Root: otherRoot[:]}))
+	require.NoError(t, db.SaveStateDiff(ctx, other, otherRoot, oldBaseRoot))
+
+	// The full state the diffs are stored against can neither be replaced by a diff nor deleted.
+	require.ErrorIs(t, db.SaveStateDiff(ctx, oldBase, oldBaseRoot, newBaseRoot), ErrStateDiffBase)
+	require.ErrorIs(t, db.DeleteState(ctx, oldBaseRoot), ErrStateDiffBase)
+	assert.Equal(t, true, db.HasState(ctx, oldBaseRoot))
+	assert.Equal(t, false, db.HasStateDiff(ctx, oldBaseRoot))
+
+	// Once all the diffs are gone or stored against another full state, the state is no longer protected.
+	require.NoError(t, db.DeleteState(ctx, root))
+	require.ErrorIs(t, db.DeleteState(ctx, oldBaseRoot), ErrStateDiffBase)
+	require.NoError(t, db.SaveStateDiff(ctx, other, otherRoot, newBaseRoot))
+	require.NoError(t, db.SaveStateDiff(ctx, oldBase, oldBaseRoot, newBaseRoot))
+	assert.Equal(t, true, db.HasStateDiff(ctx, oldBaseRoot))
+}
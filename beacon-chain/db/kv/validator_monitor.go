@@ -0,0 +1,194 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	monitortypes "github.com/theQRL/qrysm/v4/beacon-chain/monitor/types"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// validatorPerformanceSize is the size of an encoded validator epoch performance: a byte of flags,
+// followed by the inclusion distance, proposed blocks, sync contributions, expected sync contributions,
+// aggregations, start balance and end balance as 8 byte big endian integers.
+const validatorPerformanceSize = 1 + 7*8
+
+const (
+	attestedFlag byte = 1 << iota
+	correctSourceFlag
+	correctTargetFlag
+	correctHeadFlag
+)
+
+// SaveValidatorPerformance saves the epoch performance records of the validator monitor, replacing
+// any record previously stored for the same validator and epoch.
+func (s *Store) SaveValidatorPerformance(ctx context.Context, records []*monitortypes.ValidatorEpochPerformance) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveValidatorPerformance")
+	defer span.End()
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(validatorPerformanceBucket)
+		for _, r := range records {
+			if err := bkt.Put(validatorPerformanceKey(r.ValidatorIndex, r.Epoch), encodeValidatorPerformance(r)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ValidatorPerformanceHistory retrieves the epoch performance records of the validator for the epochs
+// in the range [startEpoch, endEpoch], ordered by epoch. Epochs without a record are skipped.
+func (s *Store) ValidatorPerformanceHistory(
+	ctx context.Context, idx primitives.ValidatorIndex, startEpoch, endEpoch primitives.Epoch,
+) ([]*monitortypes.ValidatorEpochPerformance, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ValidatorPerformanceHistory")
+	defer span.End()
+
+	if startEpoch > endEpoch {
+		return nil, errors.Errorf("start epoch %d is greater than end epoch %d", startEpoch, endEpoch)
+	}
+	records := make([]*monitortypes.ValidatorEpochPerformance, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(validatorPerformanceBucket).Cursor()
+		end := validatorPerformanceKey(idx, endEpoch)
+		for k, v := c.Seek(validatorPerformanceKey(idx, startEpoch)); k != nil; k, v = c.Next() {
+			if bytes.Compare(k, end) > 0 {
+				break
+			}
+			r, err := decodeValidatorPerformance(k, v)
+			if err != nil {
+				return err
+			}
+			records = append(records, r)
+		}
+		return nil
+	})
+	return records, err
+}
+
+// SaveMonitoredValidators saves the indices of the validators tracked by the validator monitor,
+// replacing the previously saved indices.
+func (s *Store) SaveMonitoredValidators(ctx context.Context, indices []primitives.ValidatorIndex) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveMonitoredValidators")
+	defer span.End()
+	return s.saveValidatorIndices(monitoredValidatorsKey, indices)
+}
+
+// MonitoredValidators returns the indices of the validators tracked by the validator monitor, as saved
+// by SaveMonitoredValidators.
+func (s *Store) MonitoredValidators(ctx context.Context) ([]primitives.ValidatorIndex, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.MonitoredValidators")
+	defer span.End()
+	return s.validatorIndices(monitoredValidatorsKey)
+}
+
+// SaveUnmonitoredValidators saves the indices of the validators removed from the validator monitor at
+// runtime, replacing the previously saved indices.
+func (s *Store) SaveUnmonitoredValidators(ctx context.Context, indices []primitives.ValidatorIndex) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveUnmonitoredValidators")
+	defer span.End()
+	return s.saveValidatorIndices(unmonitoredValidatorsKey, indices)
+}
+
+// UnmonitoredValidators returns the indices of the validators removed from the validator monitor at
+// runtime, as saved by SaveUnmonitoredValidators.
+func (s *Store) UnmonitoredValidators(ctx context.Context) ([]primitives.ValidatorIndex, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.UnmonitoredValidators")
+	defer span.End()
+	return s.validatorIndices(unmonitoredValidatorsKey)
+}
+
+func (s *Store) saveValidatorIndices(key []byte, indices []primitives.ValidatorIndex) error {
+	enc := make([]byte, 0, len(indices)*8)
+	for _, idx := range indices {
+		enc = append(enc, bytesutil.Uint64ToBytesBigEndian(uint64(idx))...)
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(chainMetadataBucket).Put(key, enc)
+	})
+}
+
+func (s *Store) validatorIndices(key []byte) ([]primitives.ValidatorIndex, error) {
+	var indices []primitives.ValidatorIndex
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(chainMetadataBucket).Get(key)
+		if len(enc)%8 != 0 {
+			return errors.Errorf("invalid validator indices length %d", len(enc))
+		}
+		indices = make([]primitives.ValidatorIndex, 0, len(enc)/8)
+		for i := 0; i < len(enc); i += 8 {
+			indices = append(indices, primitives.ValidatorIndex(binary.BigEndian.Uint64(enc[i:i+8])))
+		}
+		return nil
+	})
+	return indices, err
+}
+
+// validatorPerformanceKey sorts the records by validator index, then by epoch.
+func validatorPerformanceKey(idx primitives.ValidatorIndex, epoch primitives.Epoch) []byte {
+	return append(bytesutil.Uint64ToBytesBigEndian(uint64(idx)), bytesutil.Uint64ToBytesBigEndian(uint64(epoch))...)
+}
+
+func encodeValidatorPerformance(r *monitortypes.ValidatorEpochPerformance) []byte {
+	enc := make([]byte, validatorPerformanceSize)
+	var flags byte
+	if r.Attested {
+		flags |= attestedFlag
+	}
+	if r.CorrectSource {
+		flags |= correctSourceFlag
+	}
+	if r.CorrectTarget {
+		flags |= correctTargetFlag
+	}
+	if r.CorrectHead {
+		flags |= correctHeadFlag
+	}
+	enc[0] = flags
+	for i, v := range []uint64{
+		uint64(r.InclusionDistance),
+		r.ProposedBlocks,
+		r.SyncContributions,
+		r.SyncExpected,
+		r.Aggregations,
+		r.StartBalance,
+		r.EndBalance,
+	} {
+		binary.BigEndian.PutUint64(enc[1+i*8:], v)
+	}
+	return enc
+}
+
+func decodeValidatorPerformance(key, enc []byte) (*monitortypes.ValidatorEpochPerformance, error) {
+	if len(key) != 16 {
+		return nil, errors.Errorf("invalid validator performance key length %d", len(key))
+	}
+	if len(enc) != validatorPerformanceSize {
+		return nil, errors.Errorf("invalid validator performance length %d", len(enc))
+	}
+	u := func(i int) uint64 {
+		return binary.BigEndian.Uint64(enc[1+i*8:])
+	}
+	flags := enc[0]
+	return &monitortypes.ValidatorEpochPerformance{
+		ValidatorIndex:    primitives.ValidatorIndex(binary.BigEndian.Uint64(key[:8])),
+		Epoch:             primitives.Epoch(binary.BigEndian.Uint64(key[8:])),
+		Attested:          flags&attestedFlag != 0,
+		CorrectSource:     flags&correctSourceFlag != 0,
+		CorrectTarget:     flags&correctTargetFlag != 0,
+		CorrectHead:       flags&correctHeadFlag != 0,
+		InclusionDistance: primitives.Slot(u(0)),
+		ProposedBlocks:    u(1),
+		SyncContributions: u(2),
+		SyncExpected:      u(3),
+		Aggregations:      u(4),
+		StartBalance:      u(5),
+		EndBalance:        u(6),
+	}, nil
+}
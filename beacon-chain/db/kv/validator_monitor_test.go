@@ -0,0 +1,85 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	monitortypes "github.com/theQRL/qrysm/v4/beacon-chain/monitor/types"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+)
+
+func TestStore_ValidatorPerformanceHistory(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	records := []*monitortypes.ValidatorEpochPerformance{
+		{ValidatorIndex: 1, Epoch: 1, Attested: true, CorrectSource: true, InclusionDistance: 1, StartBalance: 32, EndBalance: 33},
+		{ValidatorIndex: 1, Epoch: 2, Attested: true, CorrectSource: true, CorrectTarget: true, CorrectHead: true, ProposedBlocks: 1},
+		{ValidatorIndex: 1, Epoch: 4, SyncContributions: 3, SyncExpected: 4, Aggregations: 2, StartBalance: 34, EndBalance: 30},
+		{ValidatorIndex: 2, Epoch: 2, Attested: true},
+		{ValidatorIndex: 256, Epoch: 1, Attested: true},
+	}
+	require.NoError(t, db.SaveValidatorPerformance(ctx, records))
+
+	got, err := db.ValidatorPerformanceHistory(ctx, 1, 0, 10)
+	require.NoError(t, err)
+	assert.DeepEqual(t, records[:3], got)
+	assert.Equal(t, int64(-4), got[2].BalanceChange())
+
+	got, err = db.ValidatorPerformanceHistory(ctx, 1, 2, 3)
+	require.NoError(t, err)
+	assert.DeepEqual(t, records[1:2], got)
+
+	got, err = db.ValidatorPerformanceHistory(ctx, 3, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(got))
+
+	_, err = db.ValidatorPerformanceHistory(ctx, 1, 3, 2)
+	assert.ErrorContains(t, "start epoch 3 is greater than end epoch 2", err)
+
+	// Saving a record again replaces it.
+	updated := &monitortypes.ValidatorEpochPerformance{ValidatorIndex: 2, Epoch: 2, ProposedBlocks: 1}
+	require.NoError(t, db.SaveValidatorPerformance(ctx, []*monitortypes.ValidatorEpochPerformance{updated}))
+	got, err = db.ValidatorPerformanceHistory(ctx, 2, 2, 2)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*monitortypes.ValidatorEpochPerformance{updated}, got)
+}
+
+func TestStore_MonitoredValidators(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	indices, err := db.MonitoredValidators(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(indices))
+
+	require.NoError(t, db.SaveMonitoredValidators(ctx, []primitives.ValidatorIndex{1, 5, 3}))
+	indices, err = db.MonitoredValidators(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []primitives.ValidatorIndex{1, 5, 3}, indices)
+
+	require.NoError(t, db.SaveMonitoredValidators(ctx, nil))
+	indices, err = db.MonitoredValidators(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(indices))
+}
+
+func TestStore_UnmonitoredValidators(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	indices, err := db.UnmonitoredValidators(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(indices))
+
+	require.NoError(t, db.SaveMonitoredValidators(ctx, []primitives.ValidatorIndex{1, 2}))
+	require.NoError(t, db.SaveUnmonitoredValidators(ctx, []primitives.ValidatorIndex{4}))
+	indices, err = db.UnmonitoredValidators(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []primitives.ValidatorIndex{4}, indices)
+	indices, err = db.MonitoredValidators(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []primitives.ValidatorIndex{1, 2}, indices)
+}
@@ -17,17 +17,12 @@ import (
 // syncing, using the provided values as their point of origin. This is an alternative
 // to syncing from genesis, and should only be run on an empty database.
 func (s *Store) SaveOrigin(ctx context.Context, serState, serBlock []byte) error {
-	genesisRoot, err := s.GenesisBlockRoot(ctx)
-	if err != nil {
+	if _, err := s.GenesisBlockRoot(ctx); err != nil {
 		if errors.Is(err, ErrNotFoundGenesisBlockRoot) {
 			return errors.Wrap(err, "genesis block root not found: genesis must be provided for checkpoint sync")
 		}
 		return errors.Wrap(err, "genesis block root query error: checkpoint sync must verify genesis to proceed")
 	}
-	err = s.SaveBackfillBlockRoot(ctx, genesisRoot)
-	if err != nil {
-		return errors.Wrap(err, "unable to save genesis root as initial backfill starting point for checkpoint sync")
-	}
 
 	cf, err := detect.FromState(serState)
 	if err != nil {
@@ -83,6 +78,11 @@ func (s *Store) SaveOrigin(ctx context.Context, serState, serBlock []byte) error
 		return errors.Wrap(err, "could not save origin block root")
 	}
 
+	// backfill works its way down from the origin block towards genesis
+	if err = s.SaveBackfillBlockRoot(ctx, blockRoot); err != nil {
+		return errors.Wrap(err, "unable to save origin root as initial backfill starting point for checkpoint sync")
+	}
+
 	// rebuild the checkpoint from the block
 	// use it to mark the block as justified and finalized
 	slotEpoch, err := wblk.Block().Slot().SafeDivSlot(params.BeaconConfig().SlotsPerEpoch)
@@ -0,0 +1,27 @@
+package pruner
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	prunedHistorySlots = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "db_pruned_history_slots_total",
+			Help: "Number of slots whose blocks and states were pruned from the beacon database.",
+		},
+	)
+	earliestAvailableSlot = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "db_earliest_available_slot",
+			Help: "Lowest slot above genesis from which the beacon database holds the block history.",
+		},
+	)
+	pruneTime = promauto.NewSummary(
+		prometheus.SummaryOpts{
+			Name: "db_history_prune_milliseconds",
+			Help: "Milliseconds it takes to prune the history behind the retention window.",
+		},
+	)
+)
@@ -0,0 +1,258 @@
+// Package pruner defines a service which deletes the block and state history of the beacon database that is
+// older than a configurable retention window, in background batches.
+package pruner
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/theQRL/qrysm/v4/beacon-chain/db"
+	"github.com/theQRL/qrysm/v4/beacon-chain/startup"
+	"github.com/theQRL/qrysm/v4/config/params"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/runtime"
+	"github.com/theQRL/qrysm/v4/time/slots"
+)
+
+const (
+	// DefaultBatchSize is the default number of slots pruned from the database in a single write transaction.
+	DefaultBatchSize = 64
+	// batchDelay is how long the pruner waits between batches, so that it does not starve other database writers.
+	batchDelay = 100 * time.Millisecond
+)
+
+var (
+	_ runtime.Service = (*Service)(nil)
+
+	errMissingDependency = errors.New("history pruner service is missing a required dependency")
+)
+
+// PrunedStatus records how far the history has been pruned. It is implemented by backfill.Status.
+type PrunedStatus interface {
+	MarkPruned(earliest primitives.Slot)
+}
+
+// Service prunes the history of the beacon database once per epoch. Only finalized history is ever pruned.
+// By default the history is kept for RetentionEpochs behind the finalized checkpoint. When the block requests
+// retention is used, only the blocks needed to serve the MIN_EPOCHS_FOR_BLOCK_REQUESTS window are kept.
+type Service struct {
+	ctx                 context.Context
+	cancel              context.CancelFunc
+	db                  db.NoHeadAccessDatabase
+	clockWaiter         startup.ClockWaiter
+	status              PrunedStatus
+	retentionEpochs     primitives.Epoch
+	retainBlockRequests bool
+	batchSize           uint64
+	lock                sync.RWMutex
+	lastPruneErr        error
+}
+
+// ServiceOption represents a functional option for the history pruner service constructor.
+type ServiceOption func(*Service) error
+
+// WithDatabase sets the database to prune.
+func WithDatabase(d db.NoHeadAccessDatabase) ServiceOption {
+	return func(s *Service) error {
+		s.db = d
+		return nil
+	}
+}
+
+// WithClockWaiter sets the ClockWaiter used to wait for the genesis clock before pruning begins.
+func WithClockWaiter(cw startup.ClockWaiter) ServiceOption {
+	return func(s *Service) error {
+		s.clockWaiter = cw
+		return nil
+	}
+}
+
+// WithPrunedStatus sets the status updated with the earliest available slot after each pruned batch.
+func WithPrunedStatus(ps PrunedStatus) ServiceOption {
+	return func(s *Service) error {
+		s.status = ps
+		return nil
+	}
+}
+
+// WithRetentionEpochs keeps the history of the given number of epochs behind the finalized checkpoint.
+func WithRetentionEpochs(e primitives.Epoch) ServiceOption {
+	return func(s *Service) error {
+		s.retentionEpochs = e
+		return nil
+	}
+}
+
+// WithBlockRequestsRetention only keeps the history needed to serve block requests within the
+// MIN_EPOCHS_FOR_BLOCK_REQUESTS window, or the finalized history if it is shorter.
+func WithBlockRequestsRetention() ServiceOption {
+	return func(s *Service) error {
+		s.retainBlockRequests = true
+		return nil
+	}
+}
+
+// WithBatchSize configures the number of slots pruned in a single database transaction.
+func WithBatchSize(n uint64) ServiceOption {
+	return func(s *Service) error {
+		if n == 0 {
+			return errors.New("history pruning batch size must be greater than 0")
+		}
+		s.batchSize = n
+		return nil
+	}
+}
+
+// New initializes the history pruner Service. Pruning does not begin until Start() is called.
+func New(ctx context.Context, opts ...ServiceOption) (*Service, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		ctx:       ctx,
+		cancel:    cancel,
+		batchSize: DefaultBatchSize,
+	}
+	for _, o := range opts {
+		if err := o(s); err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+	if s.db == nil || s.clockWaiter == nil {
+		cancel()
+		return nil, errMissingDependency
+	}
+	return s, nil
+}
+
+// Start begins pruning in the background.
+func (s *Service) Start() {
+	go s.run()
+}
+
+// Stop cancels the pruning loop.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status reports the error of the last pruning attempt, if any.
+func (s *Service) Status() error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.lastPruneErr
+}
+
+func (s *Service) run() {
+	clock, err := s.clockWaiter.WaitForClock(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("History pruner failed to start while waiting for genesis data")
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"retentionEpochs":     s.retentionEpochs,
+		"retainBlockRequests": s.retainBlockRequests,
+	}).Info("History pruner starting")
+
+	epochDuration := time.Duration(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().SecondsPerSlot)) * time.Second
+	ticker := time.NewTicker(epochDuration)
+	defer ticker.Stop()
+	for {
+		err := s.prune(s.ctx, clock.CurrentSlot())
+		if err != nil && !errors.Is(err, context.Canceled) {
+			log.WithError(err).Error("Could not prune history")
+		}
+		s.lock.Lock()
+		s.lastPruneErr = err
+		s.lock.Unlock()
+		select {
+		case <-ticker.C:
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// prune deletes the history below the prune slot in batches, updating the pruned status after each batch.
+func (s *Service) prune(ctx context.Context, current primitives.Slot) error {
+	beforeSlot, err := s.pruneSlot(ctx, current)
+	if err != nil {
+		return err
+	}
+	if beforeSlot == 0 {
+		return nil
+	}
+	start := time.Now()
+	var total uint64
+	var earliest primitives.Slot
+	for {
+		n, err := s.db.PruneHistory(ctx, beforeSlot, s.batchSize)
+		if err != nil {
+			return errors.Wrapf(err, "could not prune history below slot %d", beforeSlot)
+		}
+		earliest, err = s.updateEarliest(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			break
+		}
+		total += n
+		prunedHistorySlots.Add(float64(n))
+		select {
+		case <-time.After(batchDelay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if total > 0 {
+		pruneTime.Observe(float64(time.Since(start).Milliseconds()))
+		log.WithFields(logrus.Fields{
+			"prunedSlots":           total,
+			"earliestAvailableSlot": earliest,
+		}).Info("Pruned history")
+	}
+	return nil
+}
+
+func (s *Service) updateEarliest(ctx context.Context) (primitives.Slot, error) {
+	earliest, err := s.db.EarliestAvailableSlot(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get earliest available slot")
+	}
+	earliestAvailableSlot.Set(float64(earliest))
+	if s.status != nil {
+		s.status.MarkPruned(earliest)
+	}
+	return earliest, nil
+}
+
+// pruneSlot returns the slot below which the history can be pruned. History is never pruned past the finalized
+// checkpoint, and zero means there is nothing to prune.
+func (s *Service) pruneSlot(ctx context.Context, current primitives.Slot) (primitives.Slot, error) {
+	cp, err := s.db.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	epoch := pruneEpoch(cp.Epoch, slots.ToEpoch(current), s.retentionEpochs, s.retainBlockRequests)
+	return slots.EpochStart(epoch)
+}
+
+// pruneEpoch returns the epoch below which the history can be pruned, given the finalized and current epochs.
+func pruneEpoch(finalized, current, retentionEpochs primitives.Epoch, retainBlockRequests bool) primitives.Epoch {
+	var epoch primitives.Epoch
+	if retainBlockRequests {
+		minEpochs := params.BeaconNetworkConfig().MinEpochsForBlockRequests
+		if current > minEpochs {
+			epoch = current - minEpochs
+		}
+	} else if finalized > retentionEpochs {
+		epoch = finalized - retentionEpochs
+	}
+	if epoch > finalized {
+		return finalized
+	}
+	return epoch
+}
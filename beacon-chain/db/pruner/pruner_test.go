@@ -0,0 +1,77 @@
+package pruner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/theQRL/qrysm/v4/config/params"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/testing/require"
+)
+
+func TestPruneEpoch(t *testing.T) {
+	minEpochs := params.BeaconNetworkConfig().MinEpochsForBlockRequests
+	tests := []struct {
+		name                string
+		finalized           primitives.Epoch
+		current             primitives.Epoch
+		retentionEpochs     primitives.Epoch
+		retainBlockRequests bool
+		want                primitives.Epoch
+	}{
+		{
+			name:            "retention epochs behind finalized",
+			finalized:       100,
+			current:         102,
+			retentionEpochs: 10,
+			want:            90,
+		},
+		{
+			name:            "retention epochs longer than history",
+			finalized:       5,
+			current:         7,
+			retentionEpochs: 10,
+			want:            0,
+		},
+		{
+			name:      "no retention epochs prunes up to finalized",
+			finalized: 100,
+			current:   102,
+			want:      100,
+		},
+		{
+			name:                "block requests window",
+			finalized:           minEpochs + 100,
+			current:             minEpochs + 102,
+			retainBlockRequests: true,
+			want:                102,
+		},
+		{
+			name:                "block requests window longer than history",
+			finalized:           100,
+			current:             102,
+			retainBlockRequests: true,
+			want:                0,
+		},
+		{
+			name:                "block requests window never past finalized",
+			finalized:           10,
+			current:             minEpochs + 102,
+			retainBlockRequests: true,
+			want:                10,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, pruneEpoch(tt.finalized, tt.current, tt.retentionEpochs, tt.retainBlockRequests))
+		})
+	}
+}
+
+func TestNew_MissingDependency(t *testing.T) {
+	_, err := New(context.Background())
+	require.ErrorIs(t, err, errMissingDependency)
+
+	_, err = New(context.Background(), WithBatchSize(0))
+	require.ErrorContains(t, "batch size must be greater than 0", err)
+}
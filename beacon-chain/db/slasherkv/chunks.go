@@ -0,0 +1,275 @@
+package slasherkv
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
+	slashertypes "github.com/theQRL/qrysm/v4/beacon-chain/slasher/types"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// chunkEncoding identifies how slasher chunks are encoded on disk.
+type chunkEncoding byte
+
+const (
+	// snappyChunkEncoding stores every distance of a chunk as a little endian uint16, compressed with snappy.
+	snappyChunkEncoding chunkEncoding = iota
+	// runLengthChunkEncoding stores every run of equal distances of a chunk as a little endian uint16 followed
+	// by the uvarint length of the run, compressed with snappy. Min and max spans mostly hold long runs of
+	// neutral distances, so this is much smaller than storing every distance.
+	runLengthChunkEncoding
+)
+
+// legacyChunkParameters are the chunk parameters of the databases written before they were recorded.
+var legacyChunkParameters = &slashertypes.ChunkParameters{
+	ChunkSize:          16,
+	ValidatorChunkSize: 256,
+	HistoryLength:      4096,
+}
+
+// setupChunkEncoding loads the encoding of the chunks on disk. Databases without any chunk are set up with the
+// run length encoding, while databases written before the encoding was recorded keep using snappy encoding until
+// their chunks are migrated.
+func (s *Store) setupChunkEncoding() error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(slasherMetadataBucket)
+		if enc := bkt.Get(chunkEncodingKey); len(enc) == 1 {
+			if chunkEncoding(enc[0]) > runLengthChunkEncoding {
+				return fmt.Errorf("unknown slasher chunk encoding %d", enc[0])
+			}
+			s.chunkEncoding = chunkEncoding(enc[0])
+			return nil
+		}
+		s.chunkEncoding = runLengthChunkEncoding
+		if k, _ := tx.Bucket(slasherChunksBucket).Cursor().First(); k != nil {
+			s.chunkEncoding = snappyChunkEncoding
+			if bkt.Get(chunkParametersKey) == nil {
+				if err := bkt.Put(chunkParametersKey, encodeChunkParameters(legacyChunkParameters)); err != nil {
+					return err
+				}
+			}
+		}
+		return bkt.Put(chunkEncodingKey, []byte{byte(s.chunkEncoding)})
+	})
+}
+
+// ChunkParameters returns the parameters the slasher chunks on disk were written with,
+// or nil if no chunk parameters were saved yet.
+func (s *Store) ChunkParameters(ctx context.Context) (*slashertypes.ChunkParameters, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ChunkParameters")
+	defer span.End()
+	var p *slashertypes.ChunkParameters
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(slasherMetadataBucket).Get(chunkParametersKey)
+		if enc == nil {
+			return nil
+		}
+		var err error
+		p, err = decodeChunkParameters(enc)
+		return err
+	})
+	return p, err
+}
+
+// SaveChunkParameters records the parameters the slasher chunks are written with.
+func (s *Store) SaveChunkParameters(ctx context.Context, p *slashertypes.ChunkParameters) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveChunkParameters")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(slasherMetadataBucket).Put(chunkParametersKey, encodeChunkParameters(p))
+	})
+}
+
+// SlasherChunkKeys returns the disk keys of all the chunks of the given kind.
+func (s *Store) SlasherChunkKeys(ctx context.Context, kind slashertypes.ChunkKind) ([][]byte, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SlasherChunkKeys")
+	defer span.End()
+	keys := make([][]byte, 0)
+	prefix := ssz.MarshalUint8(make([]byte, 0), uint8(kind))
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(slasherChunksBucket).Cursor()
+		for k, _ := c.Seek(prefix); k != nil && k[0] == prefix[0]; k, _ = c.Next() {
+			keys = append(keys, append([]byte{}, k[1:]...))
+		}
+		return nil
+	})
+	return keys, err
+}
+
+// HighestEpochWritten returns the highest epoch written for any validator.
+func (s *Store) HighestEpochWritten(ctx context.Context) (primitives.Epoch, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.HighestEpochWritten")
+	defer span.End()
+	var highest primitives.Epoch
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(attestedEpochsByValidator).ForEach(func(_, v []byte) error {
+			var epoch primitives.Epoch
+			if err := epoch.UnmarshalSSZ(v); err != nil {
+				return err
+			}
+			if epoch > highest {
+				highest = epoch
+			}
+			return nil
+		})
+	})
+	return highest, err
+}
+
+// StartSlasherChunksMigration discards the chunks staged by a previous, unfinished migration.
+func (s *Store) StartSlasherChunksMigration(ctx context.Context) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.StartSlasherChunksMigration")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if tx.Bucket(slasherChunksMigrationBucket) != nil {
+			if err := tx.DeleteBucket(slasherChunksMigrationBucket); err != nil {
+				return err
+			}
+		}
+		_, err := tx.CreateBucket(slasherChunksMigrationBucket)
+		return err
+	})
+}
+
+// SaveMigratedSlasherChunks stages chunks written with new chunk parameters, which only replace the current
+// chunks once CommitSlasherChunksMigration is called.
+func (s *Store) SaveMigratedSlasherChunks(
+	ctx context.Context, kind slashertypes.ChunkKind, chunkKeys [][]byte, chunks [][]uint16,
+) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveMigratedSlasherChunks")
+	defer span.End()
+	encodedChunks := make([][]byte, len(chunkKeys))
+	for i := 0; i < len(chunkKeys); i++ {
+		encodedChunk, err := encodeRunLengthSlasherChunk(chunks[i])
+		if err != nil {
+			return err
+		}
+		encodedChunks[i] = encodedChunk
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(slasherChunksMigrationBucket)
+		if bkt == nil {
+			return errors.New("no slasher chunks migration in progress")
+		}
+		for i := 0; i < len(chunkKeys); i++ {
+			key := append(ssz.MarshalUint8(make([]byte, 0), uint8(kind)), chunkKeys[i]...)
+			if err := bkt.Put(key, encodedChunks[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// CommitSlasherChunksMigration replaces the slasher chunks with the chunks staged by SaveMigratedSlasherChunks,
+// and records the parameters they were written with.
+func (s *Store) CommitSlasherChunksMigration(ctx context.Context, p *slashertypes.ChunkParameters) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.CommitSlasherChunksMigration")
+	defer span.End()
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		staged := tx.Bucket(slasherChunksMigrationBucket)
+		if staged == nil {
+			return errors.New("no slasher chunks migration in progress")
+		}
+		if err := tx.DeleteBucket(slasherChunksBucket); err != nil {
+			return err
+		}
+		bkt, err := tx.CreateBucket(slasherChunksBucket)
+		if err != nil {
+			return err
+		}
+		if err := staged.ForEach(bkt.Put); err != nil {
+			return err
+		}
+		if err := tx.DeleteBucket(slasherChunksMigrationBucket); err != nil {
+			return err
+		}
+		metadata := tx.Bucket(slasherMetadataBucket)
+		if err := metadata.Put(chunkParametersKey, encodeChunkParameters(p)); err != nil {
+			return err
+		}
+		return metadata.Put(chunkEncodingKey, []byte{byte(runLengthChunkEncoding)})
+	})
+	if err != nil {
+		return err
+	}
+	s.chunkEncoding = runLengthChunkEncoding
+	return nil
+}
+
+func (s *Store) encodeSlasherChunk(chunk []uint16) ([]byte, error) {
+	if s.chunkEncoding == runLengthChunkEncoding {
+		return encodeRunLengthSlasherChunk(chunk)
+	}
+	return encodeSlasherChunk(chunk)
+}
+
+func (s *Store) decodeSlasherChunk(enc []byte) ([]uint16, error) {
+	if s.chunkEncoding == runLengthChunkEncoding {
+		return decodeRunLengthSlasherChunk(enc)
+	}
+	return decodeSlasherChunk(enc)
+}
+
+func encodeRunLengthSlasherChunk(chunk []uint16) ([]byte, error) {
+	if len(chunk) == 0 {
+		return nil, errors.New("cannot encode empty chunk")
+	}
+	val := make([]byte, 0)
+	for i := 0; i < len(chunk); {
+		run := 1
+		for i+run < len(chunk) && chunk[i+run] == chunk[i] {
+			run++
+		}
+		val = ssz.MarshalUint16(val, chunk[i])
+		val = binary.AppendUvarint(val, uint64(run))
+		i += run
+	}
+	return snappy.Encode(nil, val), nil
+}
+
+func decodeRunLengthSlasherChunk(enc []byte) ([]uint16, error) {
+	runs, err := snappy.Decode(nil, enc)
+	if err != nil {
+		return nil, err
+	}
+	chunk := make([]uint16, 0)
+	for len(runs) > 0 {
+		if len(runs) < 3 {
+			return nil, fmt.Errorf("cannot decode slasher chunk run from %d bytes", len(runs))
+		}
+		distance := ssz.UnmarshallUint16(runs[:2])
+		run, n := binary.Uvarint(runs[2:])
+		if n <= 0 || run == 0 {
+			return nil, errors.New("cannot decode slasher chunk run length")
+		}
+		for j := uint64(0); j < run; j++ {
+			chunk = append(chunk, distance)
+		}
+		runs = runs[2+n:]
+	}
+	return chunk, nil
+}
+
+func encodeChunkParameters(p *slashertypes.ChunkParameters) []byte {
+	enc := ssz.MarshalUint64(make([]byte, 0), p.ChunkSize)
+	enc = ssz.MarshalUint64(enc, p.ValidatorChunkSize)
+	return ssz.MarshalUint64(enc, uint64(p.HistoryLength))
+}
+
+func decodeChunkParameters(enc []byte) (*slashertypes.ChunkParameters, error) {
+	if len(enc) != 24 {
+		return nil, fmt.Errorf("cannot decode slasher chunk parameters from %d bytes", len(enc))
+	}
+	return &slashertypes.ChunkParameters{
+		ChunkSize:          ssz.UnmarshallUint64(enc[:8]),
+		ValidatorChunkSize: ssz.UnmarshallUint64(enc[8:16]),
+		HistoryLength:      primitives.Epoch(ssz.UnmarshallUint64(enc[16:])),
+	}, nil
+}
@@ -0,0 +1,185 @@
+package slasherkv
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	ssz "github.com/prysmaticlabs/fastssz"
+	slashertypes "github.com/theQRL/qrysm/v4/beacon-chain/slasher/types"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/testing/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func Test_encodeDecodeRunLengthSlasherChunk(t *testing.T) {
+	tests := []struct {
+		name  string
+		chunk []uint16
+	}{
+		{name: "single element", chunk: []uint16{7}},
+		{name: "all neutral", chunk: neutralChunk(4096, math.MaxUint16)},
+		{name: "distinct elements", chunk: []uint16{1, 2, 3, 4, 5}},
+		{name: "mixed runs", chunk: append(append([]uint16{0, 0, 0, 5, 5}, neutralChunk(300, 2)...), 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enc, err := encodeRunLengthSlasherChunk(tt.chunk)
+			require.NoError(t, err)
+			dec, err := decodeRunLengthSlasherChunk(enc)
+			require.NoError(t, err)
+			require.DeepEqual(t, tt.chunk, dec)
+		})
+	}
+
+	_, err := encodeRunLengthSlasherChunk([]uint16{})
+	require.ErrorContains(t, "cannot encode empty chunk", err)
+}
+
+func Test_encodeDecodeChunkParameters(t *testing.T) {
+	p := &slashertypes.ChunkParameters{ChunkSize: 8, ValidatorChunkSize: 512, HistoryLength: 2048}
+	dec, err := decodeChunkParameters(encodeChunkParameters(p))
+	require.NoError(t, err)
+	require.DeepEqual(t, p, dec)
+
+	_, err = decodeChunkParameters([]byte{1, 2, 3})
+	require.ErrorContains(t, "cannot decode slasher chunk parameters", err)
+}
+
+func TestStore_ChunkEncoding_NewDatabase(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	beaconDB, err := NewKVStore(ctx, dir)
+	require.NoError(t, err)
+	require.Equal(t, runLengthChunkEncoding, beaconDB.chunkEncoding)
+
+	p, err := beaconDB.ChunkParameters(ctx)
+	require.NoError(t, err)
+	require.Equal(t, true, p == nil)
+
+	want := &slashertypes.ChunkParameters{ChunkSize: 8, ValidatorChunkSize: 128, HistoryLength: 1024}
+	require.NoError(t, beaconDB.SaveChunkParameters(ctx, want))
+	require.NoError(t, beaconDB.Close())
+
+	beaconDB, err = NewKVStore(ctx, dir)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, beaconDB.Close())
+	}()
+	require.Equal(t, runLengthChunkEncoding, beaconDB.chunkEncoding)
+	p, err = beaconDB.ChunkParameters(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, want, p)
+}
+
+func TestStore_ChunkEncoding_LegacyDatabase(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	beaconDB, err := NewKVStore(ctx, dir)
+	require.NoError(t, err)
+
+	// Write a chunk the way databases did before the chunk encoding was recorded.
+	chunk := neutralChunk(16, math.MaxUint16)
+	enc, err := encodeSlasherChunk(chunk)
+	require.NoError(t, err)
+	key := append(ssz.MarshalUint8(make([]byte, 0), uint8(slashertypes.MinSpan)), ssz.MarshalUint64(make([]byte, 0), 3)...)
+	require.NoError(t, beaconDB.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(slasherMetadataBucket).Delete(chunkEncodingKey); err != nil {
+			return err
+		}
+		return tx.Bucket(slasherChunksBucket).Put(key, enc)
+	}))
+	require.NoError(t, beaconDB.Close())
+
+	beaconDB, err = NewKVStore(ctx, dir)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, beaconDB.Close())
+	}()
+	require.Equal(t, snappyChunkEncoding, beaconDB.chunkEncoding)
+	p, err := beaconDB.ChunkParameters(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, legacyChunkParameters, p)
+
+	chunks, exist, err := beaconDB.LoadSlasherChunks(ctx, slashertypes.MinSpan, [][]byte{ssz.MarshalUint64(make([]byte, 0), 3)})
+	require.NoError(t, err)
+	require.Equal(t, true, exist[0])
+	require.DeepEqual(t, chunk, chunks[0])
+}
+
+func TestStore_SlasherChunksMigration(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+	beaconDB.chunkEncoding = snappyChunkEncoding
+
+	oldKeys := [][]byte{ssz.MarshalUint64(make([]byte, 0), 0), ssz.MarshalUint64(make([]byte, 0), 1)}
+	oldChunks := [][]uint16{neutralChunk(16, 1), neutralChunk(16, 2)}
+	require.NoError(t, beaconDB.SaveSlasherChunks(ctx, slashertypes.MinSpan, oldKeys, oldChunks))
+	require.NoError(t, beaconDB.SaveSlasherChunks(ctx, slashertypes.MaxSpan, oldKeys[:1], oldChunks[:1]))
+
+	keys, err := beaconDB.SlasherChunkKeys(ctx, slashertypes.MinSpan)
+	require.NoError(t, err)
+	require.DeepEqual(t, oldKeys, keys)
+	keys, err = beaconDB.SlasherChunkKeys(ctx, slashertypes.MaxSpan)
+	require.NoError(t, err)
+	require.DeepEqual(t, oldKeys[:1], keys)
+
+	// Saving migrated chunks requires a migration in progress.
+	newKeys := [][]byte{ssz.MarshalUint64(make([]byte, 0), 5)}
+	newChunks := [][]uint16{neutralChunk(32, 3)}
+	err = beaconDB.SaveMigratedSlasherChunks(ctx, slashertypes.MinSpan, newKeys, newChunks)
+	require.ErrorContains(t, "no slasher chunks migration in progress", err)
+
+	require.NoError(t, beaconDB.StartSlasherChunksMigration(ctx))
+	require.NoError(t, beaconDB.SaveMigratedSlasherChunks(ctx, slashertypes.MinSpan, newKeys, newChunks))
+
+	// The current chunks are untouched until the migration is committed.
+	chunks, exist, err := beaconDB.LoadSlasherChunks(ctx, slashertypes.MinSpan, oldKeys)
+	require.NoError(t, err)
+	require.DeepEqual(t, []bool{true, true}, exist)
+	require.DeepEqual(t, oldChunks, chunks)
+
+	want := &slashertypes.ChunkParameters{ChunkSize: 32, ValidatorChunkSize: 1, HistoryLength: 4096}
+	require.NoError(t, beaconDB.CommitSlasherChunksMigration(ctx, want))
+	require.Equal(t, runLengthChunkEncoding, beaconDB.chunkEncoding)
+
+	keys, err = beaconDB.SlasherChunkKeys(ctx, slashertypes.MinSpan)
+	require.NoError(t, err)
+	require.DeepEqual(t, newKeys, keys)
+	keys, err = beaconDB.SlasherChunkKeys(ctx, slashertypes.MaxSpan)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(keys))
+	chunks, exist, err = beaconDB.LoadSlasherChunks(ctx, slashertypes.MinSpan, newKeys)
+	require.NoError(t, err)
+	require.Equal(t, true, exist[0])
+	require.DeepEqual(t, newChunks[0], chunks[0])
+
+	p, err := beaconDB.ChunkParameters(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, want, p)
+}
+
+func TestStore_HighestEpochWritten(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+	highest, err := beaconDB.HighestEpochWritten(ctx)
+	require.NoError(t, err)
+	require.Equal(t, primitives.Epoch(0), highest)
+
+	require.NoError(t, beaconDB.SaveLastEpochsWrittenForValidators(ctx, map[primitives.ValidatorIndex]primitives.Epoch{
+		1: 10,
+		2: 42,
+		3: 7,
+	}))
+	highest, err = beaconDB.HighestEpochWritten(ctx)
+	require.NoError(t, err)
+	require.Equal(t, primitives.Epoch(42), highest)
+}
+
+func neutralChunk(length int, neutral uint16) []uint16 {
+	chunk := make([]uint16, length)
+	for i := range chunk {
+		chunk[i] = neutral
+	}
+	return chunk
+}
@@ -28,9 +28,10 @@ const (
 // Store defines an implementation of the Prysm Database interface
 // using BoltDB as the underlying persistent kv-store for Ethereum consensus.
 type Store struct {
-	db           *bolt.DB
-	databasePath string
-	ctx          context.Context
+	db            *bolt.DB
+	databasePath  string
+	ctx           context.Context
+	chunkEncoding chunkEncoding
 }
 
 // NewKVStore initializes a new boltDB key-value store at the directory
@@ -77,10 +78,16 @@ func NewKVStore(ctx context.Context, dirPath string) (*Store, error) {
 			attestationDataRootsBucket,
 			proposalRecordsBucket,
 			slasherChunksBucket,
+			slasherMetadataBucket,
+			detectedSlashingsBucket,
+			slashingsByValidatorBucket,
 		)
 	}); err != nil {
 		return nil, err
 	}
+	if err := kv.setupChunkEncoding(); err != nil {
+		return nil, errors.Wrap(err, "could not set up slasher chunk encoding")
+	}
 
 	return kv, err
 }
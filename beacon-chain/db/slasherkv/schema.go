@@ -13,4 +13,15 @@ var (
 	attestationDataRootsBucket = []byte("attestation-data-roots")
 	proposalRecordsBucket      = []byte("proposal-records")
 	slasherChunksBucket        = []byte("slasher-chunks")
+	// slasherChunksMigrationBucket holds the chunks written by a chunk parameters migration until it is committed.
+	slasherChunksMigrationBucket = []byte("slasher-chunks-migration")
+	slasherMetadataBucket        = []byte("slasher-metadata")
+	// detectedSlashingsBucket holds the slashings detected by the slasher by hash tree root, and
+	// slashingsByValidatorBucket indexes them by slashed validator and epoch.
+	detectedSlashingsBucket    = []byte("detected-slashings")
+	slashingsByValidatorBucket = []byte("slashings-by-validator")
+
+	// Specific item keys.
+	chunkParametersKey = []byte("chunk-parameters")
+	chunkEncodingKey   = []byte("chunk-encoding")
 )
@@ -210,6 +210,43 @@ func (s *Store) AttestationRecordForValidator(
 	return record, err
 }
 
+// AttestationRecordsForValidator retrieves the attestation records stored for the validator
+// with a target epoch between the start and end epochs, inclusive, by increasing target epoch.
+func (s *Store) AttestationRecordsForValidator(
+	ctx context.Context, validatorIdx primitives.ValidatorIndex, start, end primitives.Epoch,
+) ([]*slashertypes.IndexedAttestationWrapper, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.AttestationRecordsForValidator")
+	defer span.End()
+	records := make([]*slashertypes.IndexedAttestationWrapper, 0)
+	encIdx := encodeValidatorIndex(validatorIdx)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		signingRootsBkt := tx.Bucket(attestationDataRootsBucket)
+		attRecordsBkt := tx.Bucket(attestationRecordsBucket)
+		for epoch := start; epoch <= end; epoch++ {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			var indexedAttBytes []byte
+			if attRecordKey := signingRootsBkt.Get(append(encodeTargetEpoch(epoch), encIdx...)); attRecordKey != nil {
+				indexedAttBytes = attRecordsBkt.Get(attRecordKey)
+			}
+			if indexedAttBytes != nil {
+				decoded, err := decodeAttestationRecord(indexedAttBytes)
+				if err != nil {
+					return err
+				}
+				records = append(records, decoded)
+			}
+			if epoch == end {
+				// Avoid wrapping around when the range ends at the highest epoch.
+				break
+			}
+		}
+		return nil
+	})
+	return records, err
+}
+
 // SaveAttestationRecordsForValidators saves attestation records for the specified indices.
 func (s *Store) SaveAttestationRecordsForValidators(
 	ctx context.Context,
@@ -273,7 +310,7 @@ func (s *Store) LoadSlasherChunks(
 				exists = append(exists, false)
 				continue
 			}
-			chunk, err := decodeSlasherChunk(chunkBytes)
+			chunk, err := s.decodeSlasherChunk(chunkBytes)
 			if err != nil {
 				return err
 			}
@@ -296,7 +333,7 @@ func (s *Store) SaveSlasherChunks(
 	encodedChunks := make([][]byte, len(chunkKeys))
 	for i := 0; i < len(chunkKeys); i++ {
 		encodedKeys[i] = append(ssz.MarshalUint8(make([]byte, 0), uint8(kind)), chunkKeys[i]...)
-		encodedChunk, err := encodeSlasherChunk(chunks[i])
+		encodedChunk, err := s.encodeSlasherChunk(chunks[i])
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,156 @@
+package slasherkv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/container/slice"
+	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	"github.com/theQRL/qrysm/v4/time/slots"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// Prefixes of the detected slashings stored, identifying their kind.
+const (
+	attesterSlashingPrefix byte = iota
+	proposerSlashingPrefix
+)
+
+// SaveAttesterSlashings records attester slashings detected by the slasher, indexed by slashed validator
+// and by the highest target epoch of their attestations.
+func (s *Store) SaveAttesterSlashings(ctx context.Context, slashings []*zondpb.AttesterSlashing) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveAttesterSlashings")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		for _, sl := range slashings {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if sl == nil || sl.Attestation_1 == nil || sl.Attestation_2 == nil {
+				return errors.New("nil attester slashing")
+			}
+			epoch := sl.Attestation_1.Data.Target.Epoch
+			if sl.Attestation_2.Data.Target.Epoch > epoch {
+				epoch = sl.Attestation_2.Data.Target.Epoch
+			}
+			slashed := slice.IntersectionUint64(sl.Attestation_1.AttestingIndices, sl.Attestation_2.AttestingIndices)
+			indices := make([]primitives.ValidatorIndex, len(slashed))
+			for i, idx := range slashed {
+				indices[i] = primitives.ValidatorIndex(idx)
+			}
+			if err := saveDetectedSlashing(tx, attesterSlashingPrefix, sl, indices, epoch); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// SaveProposerSlashings records proposer slashings detected by the slasher, indexed by slashed validator
+// and by the epoch of their proposals.
+func (s *Store) SaveProposerSlashings(ctx context.Context, slashings []*zondpb.ProposerSlashing) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveProposerSlashings")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		for _, sl := range slashings {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if sl == nil || sl.Header_1 == nil || sl.Header_1.Header == nil {
+				return errors.New("nil proposer slashing")
+			}
+			header := sl.Header_1.Header
+			indices := []primitives.ValidatorIndex{header.ProposerIndex}
+			if err := saveDetectedSlashing(tx, proposerSlashingPrefix, sl, indices, slots.ToEpoch(header.Slot)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// SlashingsForValidator retrieves the attester and proposer slashings detected against the validator
+// whose epoch lies between the start and end epochs, inclusive.
+func (s *Store) SlashingsForValidator(
+	ctx context.Context, validatorIdx primitives.ValidatorIndex, start, end primitives.Epoch,
+) ([]*zondpb.AttesterSlashing, []*zondpb.ProposerSlashing, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SlashingsForValidator")
+	defer span.End()
+	attesterSlashings := make([]*zondpb.AttesterSlashing, 0)
+	proposerSlashings := make([]*zondpb.ProposerSlashing, 0)
+	prefix := bytesutil.Uint64ToBytesBigEndian(uint64(validatorIdx))
+	seekKey := append(prefix, bytesutil.Uint64ToBytesBigEndian(uint64(start))...)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		slashingsBkt := tx.Bucket(detectedSlashingsBucket)
+		c := tx.Bucket(slashingsByValidatorBucket).Cursor()
+		for k, _ := c.Seek(seekKey); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+			if primitives.Epoch(bytesutil.BytesToUint64BigEndian(k[8:16])) > end {
+				break
+			}
+			enc := slashingsBkt.Get(k[16:])
+			if len(enc) == 0 {
+				return errors.Errorf("no detected slashing with root %#x", k[16:])
+			}
+			decoded, err := snappy.Decode(nil, enc[1:])
+			if err != nil {
+				return err
+			}
+			switch enc[0] {
+			case attesterSlashingPrefix:
+				sl := &zondpb.AttesterSlashing{}
+				if err := sl.UnmarshalSSZ(decoded); err != nil {
+					return err
+				}
+				attesterSlashings = append(attesterSlashings, sl)
+			case proposerSlashingPrefix:
+				sl := &zondpb.ProposerSlashing{}
+				if err := sl.UnmarshalSSZ(decoded); err != nil {
+					return err
+				}
+				proposerSlashings = append(proposerSlashings, sl)
+			default:
+				return errors.Errorf("unknown detected slashing kind %d", enc[0])
+			}
+		}
+		return nil
+	})
+	return attesterSlashings, proposerSlashings, err
+}
+
+type sszSlashing interface {
+	HashTreeRoot() ([32]byte, error)
+	MarshalSSZ() ([]byte, error)
+}
+
+// saveDetectedSlashing stores the slashing under its hash tree root, and indexes it under
+// validator index + epoch + root for each slashed validator.
+func saveDetectedSlashing(
+	tx *bolt.Tx, kind byte, sl sszSlashing, indices []primitives.ValidatorIndex, epoch primitives.Epoch,
+) error {
+	root, err := sl.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not hash slashing")
+	}
+	enc, err := sl.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "could not encode slashing")
+	}
+	if err := tx.Bucket(detectedSlashingsBucket).Put(root[:], append([]byte{kind}, snappy.Encode(nil, enc)...)); err != nil {
+		return err
+	}
+	encEpoch := bytesutil.Uint64ToBytesBigEndian(uint64(epoch))
+	byValidatorBkt := tx.Bucket(slashingsByValidatorBucket)
+	for _, idx := range indices {
+		key := append(bytesutil.Uint64ToBytesBigEndian(uint64(idx)), encEpoch...)
+		key = append(key, root[:]...)
+		if err := byValidatorBkt.Put(key, []byte{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
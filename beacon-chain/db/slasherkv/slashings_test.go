@@ -0,0 +1,112 @@
+package slasherkv
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
+	slashertypes "github.com/theQRL/qrysm/v4/beacon-chain/slasher/types"
+	"github.com/theQRL/qrysm/v4/config/params"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	"github.com/theQRL/qrysm/v4/testing/require"
+)
+
+func TestStore_SlashingsForValidator(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+
+	attesterSlashings := []*zondpb.AttesterSlashing{
+		{
+			Attestation_1: createAttestationWrapper(1, 2, []uint64{1, 2, 3}, []byte{1}).IndexedAttestation,
+			Attestation_2: createAttestationWrapper(1, 2, []uint64{2, 3, 4}, []byte{2}).IndexedAttestation,
+		},
+		{
+			Attestation_1: createAttestationWrapper(3, 6, []uint64{2}, []byte{1}).IndexedAttestation,
+			Attestation_2: createAttestationWrapper(4, 5, []uint64{2}, []byte{2}).IndexedAttestation,
+		},
+	}
+	require.NoError(t, beaconDB.SaveAttesterSlashings(ctx, attesterSlashings))
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	header := func(signingRoot []byte) *zondpb.SignedBeaconBlockHeader {
+		h := createProposalWrapper(t, 4*slotsPerEpoch, 2, signingRoot).SignedBeaconBlockHeader
+		h.Signature = make([]byte, dilithium2.CryptoBytes)
+		return h
+	}
+	proposerSlashings := []*zondpb.ProposerSlashing{
+		{Header_1: header([]byte{1}), Header_2: header([]byte{2})},
+	}
+	require.NoError(t, beaconDB.SaveProposerSlashings(ctx, proposerSlashings))
+
+	tests := []struct {
+		name         string
+		validatorIdx primitives.ValidatorIndex
+		start, end   primitives.Epoch
+		wantAttester []*zondpb.AttesterSlashing
+		wantProposer []*zondpb.ProposerSlashing
+	}{
+		{
+			name:         "all epochs",
+			validatorIdx: 2,
+			start:        0,
+			end:          math.MaxUint64,
+			wantAttester: attesterSlashings,
+			wantProposer: proposerSlashings,
+		},
+		{
+			name:         "attester slashing epoch is the highest target epoch",
+			validatorIdx: 2,
+			start:        5,
+			end:          6,
+			wantAttester: attesterSlashings[1:],
+			wantProposer: []*zondpb.ProposerSlashing{},
+		},
+		{
+			name:         "only validators attesting twice are indexed",
+			validatorIdx: 1,
+			start:        0,
+			end:          math.MaxUint64,
+			wantAttester: []*zondpb.AttesterSlashing{},
+			wantProposer: []*zondpb.ProposerSlashing{},
+		},
+		{
+			name:         "validator in one slashing",
+			validatorIdx: 3,
+			start:        0,
+			end:          2,
+			wantAttester: attesterSlashings[:1],
+			wantProposer: []*zondpb.ProposerSlashing{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotAttester, gotProposer, err := beaconDB.SlashingsForValidator(ctx, tt.validatorIdx, tt.start, tt.end)
+			require.NoError(t, err)
+			require.DeepSSZEqual(t, tt.wantAttester, gotAttester)
+			require.DeepSSZEqual(t, tt.wantProposer, gotProposer)
+		})
+	}
+}
+
+func TestStore_AttestationRecordsForValidator(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+	atts := []*slashertypes.IndexedAttestationWrapper{
+		createAttestationWrapper(0, 1, []uint64{1}, []byte{1}),
+		createAttestationWrapper(1, 2, []uint64{1, 2}, []byte{2}),
+		createAttestationWrapper(2, 3, []uint64{2}, []byte{3}),
+	}
+	require.NoError(t, beaconDB.SaveAttestationRecordsForValidators(ctx, atts))
+
+	records, err := beaconDB.AttestationRecordsForValidator(ctx, 1, 0, 3)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(records))
+	require.Equal(t, primitives.Epoch(1), records[0].IndexedAttestation.Data.Target.Epoch)
+	require.Equal(t, primitives.Epoch(2), records[1].IndexedAttestation.Data.Target.Epoch)
+
+	records, err = beaconDB.AttestationRecordsForValidator(ctx, 2, 3, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(records))
+	require.Equal(t, atts[2].SigningRoot, records[0].SigningRoot)
+}
@@ -0,0 +1,117 @@
+package execution
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/theQRL/go-zond/common"
+	"github.com/theQRL/go-zond/common/hexutil"
+	"github.com/theQRL/qrysm/v4/beacon-chain/cache/depositsnapshot"
+	"github.com/theQRL/qrysm/v4/config/features"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+)
+
+// initializeFromDepositSnapshot saves the execution chain data of a new node from the deposit snapshot
+// given at startup, after verifying it against the eth1 deposit index and eth1 data of the finalized state.
+// The deposit contract logs are then only requested from the execution block of the snapshot onwards. A
+// snapshot which does not match the finalized state is an error, so that the node does not start from it.
+func (s *Service) initializeFromDepositSnapshot(ctx context.Context) error {
+	snapshot := s.cfg.depositSnapshot
+	if snapshot == nil {
+		return nil
+	}
+	eth1Data, err := s.cfg.beaconDB.ExecutionChainData(ctx)
+	if err != nil {
+		return errors.Wrap(err, "unable to retrieve eth1 data")
+	}
+	if eth1Data != nil {
+		log.Info("Execution chain data already exists in the database, ignoring deposit snapshot")
+		s.cfg.depositSnapshot = nil
+		return nil
+	}
+	if !features.Get().EnableEIP4881 {
+		return errors.New("deposit snapshots can only be used with EIP-4881 deposit trees enabled")
+	}
+	fState := s.cfg.finalizedStateAtStartup
+	if fState == nil || fState.IsNil() {
+		return errors.New("a finalized state is required to verify the deposit snapshot")
+	}
+	if err := depositsnapshot.VerifySnapshotProto(snapshot, fState.Eth1DepositIndex(), fState.Eth1Data()); err != nil {
+		return errors.Wrap(err, "deposit snapshot does not match the finalized state")
+	}
+	// Deposit logs are only processed from the execution block of the snapshot onwards, which would miss the
+	// deposits of the eth1 data that are still pending in the finalized state.
+	if snapshot.DepositCount < fState.Eth1Data().DepositCount {
+		return errors.Errorf("finalized state has pending deposits, snapshot deposit count %d is less than eth1 data deposit count %d",
+			snapshot.DepositCount, fState.Eth1Data().DepositCount)
+	}
+
+	chainStartEth1Data := &zondpb.Eth1Data{}
+	genState, err := s.cfg.beaconDB.GenesisState(ctx)
+	if err != nil {
+		return err
+	}
+	if genState != nil && !genState.IsNil() {
+		chainStartEth1Data = genState.Eth1Data()
+	}
+	s.chainStartData = &zondpb.ChainStartData{
+		Chainstarted:       true,
+		GenesisTime:        fState.GenesisTime(),
+		Eth1Data:           chainStartEth1Data,
+		ChainstartDeposits: make([]*zondpb.Deposit, 0),
+	}
+	s.latestEth1Data = &zondpb.LatestETH1Data{
+		BlockHeight:        snapshot.ExecutionDepth,
+		BlockHash:          snapshot.ExecutionHash,
+		LastRequestedBlock: snapshot.ExecutionDepth,
+	}
+	if err := s.cfg.beaconDB.SaveExecutionChainData(ctx, &zondpb.ETH1ChainData{
+		CurrentEth1Data: s.latestEth1Data,
+		ChainstartData:  s.chainStartData,
+		DepositSnapshot: snapshot,
+	}); err != nil {
+		return errors.Wrap(err, "could not save execution chain data")
+	}
+	log.WithFields(logrus.Fields{
+		"depositCount":       snapshot.DepositCount,
+		"depositRoot":        hexutil.Encode(snapshot.DepositRoot),
+		"executionBlockHash": hexutil.Encode(snapshot.ExecutionHash),
+	}).Info("Initialized deposit tree from deposit snapshot")
+	return nil
+}
+
+// initDepositCacheFromSnapshot initializes the finalized deposits of the deposit cache from the deposit
+// snapshot in the database, if the deposits it covers are not held by the node.
+func (s *Service) initDepositCacheFromSnapshot(ctx context.Context, eth1DataInDB *zondpb.ETH1ChainData) error {
+	snapshot := eth1DataInDB.DepositSnapshot
+	if !features.Get().EnableEIP4881 || snapshot == nil || snapshot.DepositCount == 0 {
+		return nil
+	}
+	ctrs := eth1DataInDB.DepositContainers
+	if len(ctrs) > 0 && ctrs[0].Index == 0 {
+		return nil
+	}
+	dc, ok := s.cfg.depositCache.(*depositsnapshot.Cache)
+	if !ok {
+		return errors.Errorf("deposit cache was not EIP4881 cache: %T", s.cfg.depositCache)
+	}
+	return dc.InsertFinalizedDepositSnapshot(ctx, snapshot)
+}
+
+// depositSnapshotBlockHeight returns the height of the execution block of the deposit snapshot the node
+// was initialized from, as served snapshots do not necessarily carry it.
+func (s *Service) depositSnapshotBlockHeight(ctx context.Context) (uint64, error) {
+	snapshot := s.cfg.depositSnapshot
+	if snapshot == nil || snapshot.ExecutionDepth > 0 {
+		return s.latestEth1Data.LastRequestedBlock, nil
+	}
+	header, err := s.HeaderByHash(ctx, common.BytesToHash(snapshot.ExecutionHash))
+	if err != nil {
+		return 0, errors.Wrapf(err, "HeaderByHash, hash=%#x", snapshot.ExecutionHash)
+	}
+	if header == nil || header.Number == nil {
+		return 0, errors.Errorf("could not find execution block of deposit snapshot %#x", snapshot.ExecutionHash)
+	}
+	return header.Number.Uint64(), nil
+}
@@ -0,0 +1,138 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/theQRL/qrysm/v4/beacon-chain/cache/depositsnapshot"
+	dbutil "github.com/theQRL/qrysm/v4/beacon-chain/db/testing"
+	"github.com/theQRL/qrysm/v4/config/features"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+	"github.com/theQRL/qrysm/v4/testing/util"
+)
+
+func testDepositSnapshot(t *testing.T, count int) (*zondpb.DepositSnapshot, *zondpb.Eth1Data) {
+	tree := depositsnapshot.NewDepositTree()
+	for i := 0; i < count; i++ {
+		var leaf [32]byte
+		copy(leaf[:], fmt.Sprintf("leaf%d", i))
+		require.NoError(t, tree.Insert(leaf[:], i))
+	}
+	require.NoError(t, tree.Finalize(int64(count-1), [32]byte{'a'}, 0))
+	snapshot, err := tree.ToProto()
+	require.NoError(t, err)
+	root, err := tree.HashTreeRoot()
+	require.NoError(t, err)
+	return snapshot, &zondpb.Eth1Data{
+		DepositRoot:  root[:],
+		DepositCount: uint64(count),
+		BlockHash:    snapshot.ExecutionHash,
+	}
+}
+
+func TestNewService_DepositSnapshot(t *testing.T) {
+	resetFn := features.InitWithReset(&features.Flags{
+		EnableEIP4881: true,
+	})
+	defer resetFn()
+	ctx := context.Background()
+	snapshot, eth1Data := testDepositSnapshot(t, 4)
+
+	t.Run("ok", func(t *testing.T) {
+		beaconDB := dbutil.SetupDB(t)
+		fState, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, fState.SetEth1DepositIndex(4))
+		require.NoError(t, fState.SetEth1Data(eth1Data))
+		depositCache, err := depositsnapshot.New()
+		require.NoError(t, err)
+
+		s, err := NewService(ctx,
+			WithDatabase(beaconDB),
+			WithDepositCache(depositCache),
+			WithFinalizedStateAtStartup(fState),
+			WithDepositSnapshot(snapshot),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), s.lastReceivedMerkleIndex)
+		assert.Equal(t, true, s.chainStartData.Chainstarted)
+
+		fDeposits, err := depositCache.FinalizedDeposits(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), fDeposits.MerkleTrieIndex())
+		count, root := depositCache.DepositsNumberAndRootAtHeight(ctx, nil)
+		assert.Equal(t, uint64(4), count)
+		assert.DeepEqual(t, eth1Data.DepositRoot, root[:])
+
+		saved, err := beaconDB.ExecutionChainData(ctx)
+		require.NoError(t, err)
+		assert.DeepEqual(t, snapshot.ExecutionHash, saved.CurrentEth1Data.BlockHash)
+		assert.DeepEqual(t, snapshot.DepositRoot, saved.DepositSnapshot.DepositRoot)
+	})
+	t.Run("mismatching eth1 data", func(t *testing.T) {
+		beaconDB := dbutil.SetupDB(t)
+		fState, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, fState.SetEth1DepositIndex(4))
+		require.NoError(t, fState.SetEth1Data(&zondpb.Eth1Data{
+			DepositRoot:  make([]byte, 32),
+			DepositCount: 4,
+			BlockHash:    snapshot.ExecutionHash,
+		}))
+		depositCache, err := depositsnapshot.New()
+		require.NoError(t, err)
+
+		_, err = NewService(ctx,
+			WithDatabase(beaconDB),
+			WithDepositCache(depositCache),
+			WithFinalizedStateAtStartup(fState),
+			WithDepositSnapshot(snapshot),
+		)
+		require.ErrorContains(t, "deposit snapshot does not match the finalized state", err)
+	})
+	t.Run("pending deposits", func(t *testing.T) {
+		beaconDB := dbutil.SetupDB(t)
+		fState, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, fState.SetEth1DepositIndex(4))
+		require.NoError(t, fState.SetEth1Data(&zondpb.Eth1Data{
+			DepositRoot:  make([]byte, 32),
+			DepositCount: 5,
+			BlockHash:    snapshot.ExecutionHash,
+		}))
+		depositCache, err := depositsnapshot.New()
+		require.NoError(t, err)
+
+		_, err = NewService(ctx,
+			WithDatabase(beaconDB),
+			WithDepositCache(depositCache),
+			WithFinalizedStateAtStartup(fState),
+			WithDepositSnapshot(snapshot),
+		)
+		require.ErrorContains(t, "finalized state has pending deposits", err)
+	})
+	t.Run("existing execution chain data", func(t *testing.T) {
+		beaconDB := dbutil.SetupDB(t)
+		emptySnapshot, err := depositsnapshot.NewDepositTree().ToProto()
+		require.NoError(t, err)
+		require.NoError(t, beaconDB.SaveExecutionChainData(ctx, &zondpb.ETH1ChainData{
+			CurrentEth1Data: &zondpb.LatestETH1Data{LastRequestedBlock: 100},
+			ChainstartData:  &zondpb.ChainStartData{Chainstarted: true},
+			DepositSnapshot: emptySnapshot,
+		}))
+		depositCache, err := depositsnapshot.New()
+		require.NoError(t, err)
+
+		s, err := NewService(ctx,
+			WithDatabase(beaconDB),
+			WithDepositCache(depositCache),
+			WithDepositSnapshot(snapshot),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(100), s.latestEth1Data.LastRequestedBlock)
+		assert.Equal(t, int64(-1), s.lastReceivedMerkleIndex)
+	})
+}
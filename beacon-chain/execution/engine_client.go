@@ -28,6 +28,7 @@ import (
 	"github.com/theQRL/qrysm/v4/runtime/version"
 	"github.com/theQRL/qrysm/v4/time/slots"
 	"go.opencensus.io/trace"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -572,59 +573,44 @@ func (s *Service) GetPayloadBodiesByRange(ctx context.Context, start, count uint
 	return result, handleRPCError(err)
 }
 
+const (
+	// payloadReconstructionBatchSize is the number of execution payloads requested from the execution
+	// client in a single call when reconstructing a batch of blinded blocks.
+	payloadReconstructionBatchSize = 32
+	// maxConcurrentPayloadReconstructions bounds the number of calls to the execution client in flight
+	// for a single batch of blinded blocks, so that one large request can't monopolize the execution client.
+	maxConcurrentPayloadReconstructions = 4
+)
+
 // ReconstructFullBlock takes in a blinded beacon block and reconstructs
 // a beacon block with a full execution payload via the engine API.
 func (s *Service) ReconstructFullBlock(
 	ctx context.Context, blindedBlock interfaces.ReadOnlySignedBeaconBlock,
 ) (interfaces.SignedBeaconBlock, error) {
-	if err := blocks.BeaconBlockIsNil(blindedBlock); err != nil {
-		return nil, errors.Wrap(err, "cannot reconstruct bellatrix block from nil data")
-	}
-	if !blindedBlock.Block().IsBlinded() {
-		return nil, errors.New("can only reconstruct block from blinded block format")
-	}
-	header, err := blindedBlock.Block().Body().Execution()
-	if err != nil {
-		return nil, err
-	}
-	if header.IsNil() {
-		return nil, errors.New("execution payload header in blinded block was nil")
-	}
-
-	// If the payload header has a block hash of 0x0, it means we are pre-merge and should
-	// simply return the block with an empty execution payload.
-	if bytes.Equal(header.BlockHash(), params.BeaconConfig().ZeroHash[:]) {
-		payload, err := buildEmptyExecutionPayload(blindedBlock.Version())
-		if err != nil {
-			return nil, err
-		}
-		return blocks.BuildSignedBeaconBlockFromExecutionPayload(blindedBlock, payload)
-	}
-
-	executionBlockHash := common.BytesToHash(header.BlockHash())
-	payload, err := s.retrievePayloadFromExecutionHash(ctx, executionBlockHash, header, blindedBlock.Version())
+	fullBlocks, err := s.ReconstructFullBellatrixBlockBatch(ctx, []interfaces.ReadOnlySignedBeaconBlock{blindedBlock})
 	if err != nil {
 		return nil, err
 	}
-	fullBlock, err := blocks.BuildSignedBeaconBlockFromExecutionPayload(blindedBlock, payload.Proto())
-	if err != nil {
-		return nil, err
-	}
-	reconstructedExecutionPayloadCount.Add(1)
-	return fullBlock, nil
+	return fullBlocks[0], nil
 }
 
 // ReconstructFullBellatrixBlockBatch takes in a batch of blinded beacon blocks and reconstructs
-// them with a full execution payload for each block via the engine API.
+// them with a full execution payload for each block via the engine API. Payloads are served from
+// the cache of recently reconstructed payloads when possible, and the others are requested from the
+// execution client in batches of payloadReconstructionBatchSize, with at most
+// maxConcurrentPayloadReconstructions batches in flight.
 func (s *Service) ReconstructFullBellatrixBlockBatch(
 	ctx context.Context, blindedBlocks []interfaces.ReadOnlySignedBeaconBlock,
 ) ([]interfaces.SignedBeaconBlock, error) {
+	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.ReconstructFullBellatrixBlockBatch")
+	defer span.End()
+
 	if len(blindedBlocks) == 0 {
 		return []interfaces.SignedBeaconBlock{}, nil
 	}
-	executionHashes := []common.Hash{}
-	validExecPayloads := []int{}
-	zeroExecPayloads := []int{}
+	payloads := make([]interfaces.ExecutionData, len(blindedBlocks))
+	headers := make([]interfaces.ExecutionData, len(blindedBlocks))
+	var missing []int
 	for i, b := range blindedBlocks {
 		if err := blocks.BeaconBlockIsNil(b); err != nil {
 			return nil, errors.Wrap(err, "cannot reconstruct bellatrix block from nil data")
@@ -639,126 +625,151 @@ func (s *Service) ReconstructFullBellatrixBlockBatch(
 		if header.IsNil() {
 			return nil, errors.New("execution payload header in blinded block was nil")
 		}
-		// Determine if the block is pre-merge or post-merge. Depending on the result,
-		// we will ask the execution engine for the full payload.
+		headers[i] = header
+		// If the payload header has a block hash of 0x0, it means we are pre-merge and should
+		// simply return the block with an empty execution payload.
 		if bytes.Equal(header.BlockHash(), params.BeaconConfig().ZeroHash[:]) {
-			zeroExecPayloads = append(zeroExecPayloads, i)
-		} else {
-			executionBlockHash := common.BytesToHash(header.BlockHash())
-			validExecPayloads = append(validExecPayloads, i)
-			executionHashes = append(executionHashes, executionBlockHash)
+			continue
+		}
+		if payload, ok := s.payloadCache.get(common.BytesToHash(header.BlockHash())); ok {
+			payloads[i] = payload
+			continue
 		}
+		missing = append(missing, i)
 	}
-	fullBlocks, err := s.retrievePayloadsFromExecutionHashes(ctx, executionHashes, validExecPayloads, blindedBlocks)
-	if err != nil {
+
+	if err := s.retrievePayloads(ctx, blindedBlocks, headers, payloads, missing); err != nil {
 		return nil, err
 	}
-	// For blocks that are pre-merge we simply reconstruct them via an empty
-	// execution payload.
-	for _, realIdx := range zeroExecPayloads {
-		bblock := blindedBlocks[realIdx]
-		payload, err := buildEmptyExecutionPayload(bblock.Version())
-		if err != nil {
-			return nil, err
+
+	fullBlocks := make([]interfaces.SignedBeaconBlock, len(blindedBlocks))
+	for i, b := range blindedBlocks {
+		var payload interface{}
+		if payloads[i] == nil {
+			// Pre-merge blocks are reconstructed with an empty execution payload.
+			p, err := buildEmptyExecutionPayload(b.Version())
+			if err != nil {
+				return nil, err
+			}
+			payload = p
+		} else {
+			payload = payloads[i].Proto()
 		}
-		fullBlock, err := blocks.BuildSignedBeaconBlockFromExecutionPayload(blindedBlocks[realIdx], payload)
+		fullBlock, err := blocks.BuildSignedBeaconBlockFromExecutionPayload(b, payload)
 		if err != nil {
 			return nil, err
 		}
-		fullBlocks[realIdx] = fullBlock
+		fullBlocks[i] = fullBlock
 	}
 	reconstructedExecutionPayloadCount.Add(float64(len(blindedBlocks)))
 	return fullBlocks, nil
 }
 
-func (s *Service) retrievePayloadFromExecutionHash(ctx context.Context, executionBlockHash common.Hash, header interfaces.ExecutionData, version int) (interfaces.ExecutionData, error) {
-	if features.Get().EnableOptionalEngineMethods {
-		pBodies, err := s.GetPayloadBodiesByHash(ctx, []common.Hash{executionBlockHash})
-		if err != nil {
-			return nil, fmt.Errorf("could not get payload body by hash %#x: %v", executionBlockHash, err)
-		}
-		if len(pBodies) != 1 {
-			return nil, errors.Errorf("could not retrieve the correct number of payload bodies: wanted 1 but got %d", len(pBodies))
+// retrievePayloads requests the payloads of the blinded blocks at the given indices from the execution client,
+// in concurrent batches, storing each of them in payloads at the index of its block.
+func (s *Service) retrievePayloads(
+	ctx context.Context,
+	blindedBlocks []interfaces.ReadOnlySignedBeaconBlock,
+	headers []interfaces.ExecutionData,
+	payloads []interfaces.ExecutionData,
+	indices []int,
+) error {
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentPayloadReconstructions)
+	for start := 0; start < len(indices); start += payloadReconstructionBatchSize {
+		end := start + payloadReconstructionBatchSize
+		if end > len(indices) {
+			end = len(indices)
 		}
-		bdy := pBodies[0]
-		return fullPayloadFromPayloadBody(header, bdy, version)
-	}
-
-	executionBlock, err := s.ExecutionBlockByHash(ctx, executionBlockHash, true /* with txs */)
-	if err != nil {
-		return nil, fmt.Errorf("could not fetch execution block with txs by hash %#x: %v", executionBlockHash, err)
-	}
-	if executionBlock == nil {
-		return nil, fmt.Errorf("received nil execution block for request by hash %#x", executionBlockHash)
-	}
-	if bytes.Equal(executionBlock.Hash.Bytes(), []byte{}) {
-		return nil, EmptyBlockHash
+		batch := indices[start:end]
+		g.Go(func() error {
+			hashes := make([]common.Hash, len(batch))
+			batchHeaders := make([]interfaces.ExecutionData, len(batch))
+			versions := make([]int, len(batch))
+			for i, idx := range batch {
+				hashes[i] = common.BytesToHash(headers[idx].BlockHash())
+				batchHeaders[i] = headers[idx]
+				versions[i] = blindedBlocks[idx].Version()
+			}
+			batchPayloads, err := s.retrievePayloadsFromExecutionHashes(ctx, hashes, batchHeaders, versions)
+			if err != nil {
+				return err
+			}
+			for i, idx := range batch {
+				payloads[idx] = batchPayloads[i]
+				s.payloadCache.add(hashes[i], batchPayloads[i])
+			}
+			return nil
+		})
 	}
-
-	executionBlock.Version = version
-	return fullPayloadFromExecutionBlock(version, header, executionBlock)
+	return g.Wait()
 }
 
+// retrievePayloadsFromExecutionHashes builds the execution payloads with the given hashes from their headers
+// and their bodies, fetched with engine_getPayloadBodiesByHashV1. It falls back to fetching the execution blocks
+// with their transactions when the optional engine methods are disabled or the execution client lacks them.
 func (s *Service) retrievePayloadsFromExecutionHashes(
-	ctx context.Context,
-	executionHashes []common.Hash,
-	validExecPayloads []int,
-	blindedBlocks []interfaces.ReadOnlySignedBeaconBlock) ([]interfaces.SignedBeaconBlock, error) {
-	fullBlocks := make([]interfaces.SignedBeaconBlock, len(blindedBlocks))
+	ctx context.Context, executionHashes []common.Hash, headers []interfaces.ExecutionData, versions []int,
+) ([]interfaces.ExecutionData, error) {
+	payloads := make([]interfaces.ExecutionData, len(executionHashes))
+	if features.Get().EnableOptionalEngineMethods && !s.payloadBodiesDisabled.Load() {
+		payloadBodies, err := s.GetPayloadBodiesByHash(ctx, executionHashes)
+		switch {
+		case errors.Is(err, ErrMethodNotFound):
+			log.Warn("Execution client does not support engine_getPayloadBodiesByHashV1, " +
+				"falling back to fetching full execution blocks to reconstruct payloads")
+			s.payloadBodiesDisabled.Store(true)
+		case err != nil:
+			return nil, fmt.Errorf("could not fetch payload bodies by hash %#x: %v", executionHashes, err)
+		default:
+			if len(payloadBodies) != len(executionHashes) {
+				return nil, errors.Errorf(
+					"could not retrieve the correct number of payload bodies: wanted %d but got %d",
+					len(executionHashes), len(payloadBodies),
+				)
+			}
+			for i, b := range payloadBodies {
+				if b == nil {
+					return nil, fmt.Errorf("received nil payload body for request by hash %#x", executionHashes[i])
+				}
+				payloads[i], err = fullPayloadFromPayloadBody(headers[i], b, versions[i])
+				if err != nil {
+					return nil, err
+				}
+			}
+			return payloads, nil
+		}
+	}
+
 	var execBlocks []*pb.ExecutionBlock
-	var payloadBodies []*pb.ExecutionPayloadBodyV1
-	var err error
-	if features.Get().EnableOptionalEngineMethods {
-		payloadBodies, err = s.GetPayloadBodiesByHash(ctx, executionHashes)
+	if len(executionHashes) == 1 {
+		execBlock, err := s.ExecutionBlockByHash(ctx, executionHashes[0], true /* with txs */)
 		if err != nil {
-			return nil, fmt.Errorf("could not fetch payload bodies by hash %#x: %v", executionHashes, err)
+			return nil, fmt.Errorf("could not fetch execution block with txs by hash %#x: %v", executionHashes[0], err)
 		}
+		execBlocks = []*pb.ExecutionBlock{execBlock}
 	} else {
-		execBlocks, err = s.ExecutionBlocksByHashes(ctx, executionHashes, true /* with txs*/)
+		var err error
+		execBlocks, err = s.ExecutionBlocksByHashes(ctx, executionHashes, true /* with txs */)
 		if err != nil {
 			return nil, fmt.Errorf("could not fetch execution blocks with txs by hash %#x: %v", executionHashes, err)
 		}
 	}
-
-	// For each valid payload, we reconstruct the full block from it with the
-	// blinded block.
-	for sliceIdx, realIdx := range validExecPayloads {
-		var payload interfaces.ExecutionData
-		bblock := blindedBlocks[realIdx]
-		if features.Get().EnableOptionalEngineMethods {
-			b := payloadBodies[sliceIdx]
-			if b == nil {
-				return nil, fmt.Errorf("received nil payload body for request by hash %#x", executionHashes[sliceIdx])
-			}
-			header, err := bblock.Block().Body().Execution()
-			if err != nil {
-				return nil, err
-			}
-			payload, err = fullPayloadFromPayloadBody(header, b, bblock.Version())
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			b := execBlocks[sliceIdx]
-			if b == nil {
-				return nil, fmt.Errorf("received nil execution block for request by hash %#x", executionHashes[sliceIdx])
-			}
-			header, err := bblock.Block().Body().Execution()
-			if err != nil {
-				return nil, err
-			}
-			payload, err = fullPayloadFromExecutionBlock(bblock.Version(), header, b)
-			if err != nil {
-				return nil, err
-			}
+	for i, b := range execBlocks {
+		if b == nil {
+			return nil, fmt.Errorf("received nil execution block for request by hash %#x", executionHashes[i])
 		}
-		fullBlock, err := blocks.BuildSignedBeaconBlockFromExecutionPayload(bblock, payload.Proto())
+		if bytes.Equal(b.Hash.Bytes(), []byte{}) {
+			return nil, EmptyBlockHash
+		}
+		b.Version = versions[i]
+		payload, err := fullPayloadFromExecutionBlock(versions[i], headers[i], b)
 		if err != nil {
 			return nil, err
 		}
-		fullBlocks[realIdx] = fullBlock
+		payloads[i] = payload
 	}
-	return fullBlocks, nil
+	return payloads, nil
 }
 
 func fullPayloadFromExecutionBlock(
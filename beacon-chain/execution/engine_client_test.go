@@ -1006,6 +1006,109 @@ func TestReconstructFullBellatrixBlockBatch(t *testing.T) {
 	})
 }
 
+func TestReconstructFullBlock_CachedPayloadsAndFallback(t *testing.T) {
+	resetFn := features.InitWithReset(&features.Flags{
+		EnableOptionalEngineMethods: true,
+	})
+	defer resetFn()
+	ctx := context.Background()
+	fix := fixtures()
+	payload, ok := fix["ExecutionPayload"].(*pb.ExecutionPayload)
+	require.Equal(t, true, ok)
+	payload.Transactions = [][]byte{}
+
+	jsonPayload := make(map[string]interface{})
+	encodedNum := hexutil.EncodeBig(big.NewInt(1))
+	jsonPayload["transactions"] = []*zondtypes.Transaction{}
+	jsonPayload["hash"] = hexutil.Encode(payload.BlockHash)
+	jsonPayload["parentHash"] = common.BytesToHash([]byte("parent"))
+	jsonPayload["sha3Uncles"] = common.BytesToHash([]byte("uncles"))
+	jsonPayload["miner"] = common.BytesToAddress([]byte("miner"))
+	jsonPayload["stateRoot"] = common.BytesToHash([]byte("state"))
+	jsonPayload["transactionsRoot"] = common.BytesToHash([]byte("txs"))
+	jsonPayload["receiptsRoot"] = common.BytesToHash([]byte("receipts"))
+	jsonPayload["logsBloom"] = zondtypes.BytesToBloom([]byte("bloom"))
+	jsonPayload["gasLimit"] = hexutil.EncodeUint64(1)
+	jsonPayload["gasUsed"] = hexutil.EncodeUint64(2)
+	jsonPayload["timestamp"] = hexutil.EncodeUint64(3)
+	jsonPayload["number"] = encodedNum
+	jsonPayload["extraData"] = common.BytesToHash([]byte("extra"))
+	jsonPayload["totalDifficulty"] = "0x123456"
+	jsonPayload["difficulty"] = encodedNum
+	jsonPayload["size"] = encodedNum
+	jsonPayload["baseFeePerGas"] = encodedNum
+
+	wrappedPayload, err := blocks.WrappedExecutionPayload(payload)
+	require.NoError(t, err)
+	header, err := blocks.PayloadToHeader(wrappedPayload)
+	require.NoError(t, err)
+
+	var payloadBodiesCalls, blockByHashCalls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		defer func() {
+			require.NoError(t, r.Body.Close())
+		}()
+		req := make(map[string]interface{})
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		resp := map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      req["id"],
+		}
+		switch req["method"] {
+		case GetPayloadBodiesByHashV1:
+			payloadBodiesCalls++
+			resp["error"] = map[string]interface{}{
+				"code":    -32601,
+				"message": "the method engine_getPayloadBodiesByHashV1 does not exist/is not available",
+			}
+		case ExecutionBlockByHashMethod:
+			blockByHashCalls++
+			resp["result"] = jsonPayload
+		default:
+			t.Errorf("unexpected method %v", req["method"])
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer srv.Close()
+
+	rpcClient, err := rpc.DialHTTP(srv.URL)
+	require.NoError(t, err)
+	defer rpcClient.Close()
+
+	service := &Service{payloadCache: newPayloadCache()}
+	service.rpcClient = rpcClient
+	blindedBlock := util.NewBlindedBeaconBlockBellatrix()
+	blindedBlock.Block.Body.ExecutionPayloadHeader = header
+	wrapped, err := blocks.NewSignedBeaconBlock(blindedBlock)
+	require.NoError(t, err)
+
+	// The execution client lacks the payload bodies method, so the payload is built from the execution block.
+	reconstructed, err := service.ReconstructFullBlock(ctx, wrapped)
+	require.NoError(t, err)
+	got, err := reconstructed.Block().Body().Execution()
+	require.NoError(t, err)
+	require.DeepEqual(t, payload, got.Proto())
+	require.Equal(t, 1, payloadBodiesCalls)
+	require.Equal(t, 1, blockByHashCalls)
+
+	// The payload is now served from the cache.
+	reconstructed, err = service.ReconstructFullBlock(ctx, wrapped)
+	require.NoError(t, err)
+	got, err = reconstructed.Block().Body().Execution()
+	require.NoError(t, err)
+	require.DeepEqual(t, payload, got.Proto())
+	require.Equal(t, 1, payloadBodiesCalls)
+	require.Equal(t, 1, blockByHashCalls)
+
+	// Without the cache, the payload bodies method isn't tried again.
+	service.payloadCache = nil
+	_, err = service.ReconstructFullBlock(ctx, wrapped)
+	require.NoError(t, err)
+	require.Equal(t, 1, payloadBodiesCalls)
+	require.Equal(t, 2, blockByHashCalls)
+}
+
 func TestServer_getPowBlockHashAtTerminalTotalDifficulty(t *testing.T) {
 	tests := []struct {
 		name                  string
@@ -290,6 +290,13 @@ func createGenesisTime(timeStamp uint64) uint64 {
 // updates the deposit trie with the data from each individual log.
 func (s *Service) processPastLogs(ctx context.Context) error {
 	currentBlockNum := s.latestEth1Data.LastRequestedBlock
+	if currentBlockNum == 0 {
+		height, err := s.depositSnapshotBlockHeight(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not get block height of deposit snapshot")
+		}
+		currentBlockNum = height
+	}
 	deploymentBlock := params.BeaconNetworkConfig().ContractDeploymentBlock
 	// Start from the deployment block if our last requested block
 	// is behind it. This is as the deposit logs can only start from the
@@ -9,6 +9,7 @@ import (
 	"github.com/theQRL/qrysm/v4/beacon-chain/state/stategen"
 	"github.com/theQRL/qrysm/v4/network"
 	"github.com/theQRL/qrysm/v4/network/authorization"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
 )
 
 type Option func(s *Service) error
@@ -108,3 +109,11 @@ func WithFinalizedStateAtStartup(st state.BeaconState) Option {
 		return nil
 	}
 }
+
+// WithDepositSnapshot to initialize the deposit tree and cache of a new node from a deposit snapshot.
+func WithDepositSnapshot(snapshot *zondpb.DepositSnapshot) Option {
+	return func(s *Service) error {
+		s.cfg.depositSnapshot = snapshot
+		return nil
+	}
+}
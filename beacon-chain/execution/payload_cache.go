@@ -0,0 +1,58 @@
+package execution
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/theQRL/go-zond/common"
+	lruwrpr "github.com/theQRL/qrysm/v4/cache/lru"
+	"github.com/theQRL/qrysm/v4/consensus-types/interfaces"
+)
+
+// payloadCacheSize is the number of reconstructed execution payloads kept in memory. Peers syncing from the node
+// request the same ranges of recent blocks, so a few batches worth of payloads spare most of the round trips to
+// the execution client.
+const payloadCacheSize = 128
+
+var (
+	payloadCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "reconstructed_payload_cache_hit",
+		Help: "The number of execution payloads to reconstruct that are present in the cache.",
+	})
+	payloadCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "reconstructed_payload_cache_miss",
+		Help: "The number of execution payloads to reconstruct that aren't present in the cache.",
+	})
+)
+
+// payloadCache is an LRU cache of the execution payloads reconstructed from the execution client, keyed by
+// execution block hash. A nil cache caches nothing.
+type payloadCache struct {
+	cache *lru.Cache
+}
+
+func newPayloadCache() *payloadCache {
+	return &payloadCache{cache: lruwrpr.New(payloadCacheSize)}
+}
+
+// get returns the payload of the execution block with the given hash, if it is cached.
+func (c *payloadCache) get(hash common.Hash) (interfaces.ExecutionData, bool) {
+	if c == nil {
+		return nil, false
+	}
+	item, ok := c.cache.Get(hash)
+	if !ok {
+		payloadCacheMiss.Inc()
+		return nil, false
+	}
+	payloadCacheHit.Inc()
+	return item.(interfaces.ExecutionData), true
+}
+
+// add caches the payload of the execution block with the given hash.
+func (c *payloadCache) add(hash common.Hash, payload interfaces.ExecutionData) {
+	if c == nil {
+		return
+	}
+	c.cache.Add(hash, payload)
+}
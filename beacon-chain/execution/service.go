@@ -11,6 +11,7 @@ import (
 	"runtime/debug"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -129,6 +130,7 @@ type config struct {
 	currHttpEndpoint        network.Endpoint
 	headers                 []string
 	finalizedStateAtStartup state.BeaconState
+	depositSnapshot         *zondpb.DepositSnapshot
 }
 
 // Service fetches important information about the canonical
@@ -148,7 +150,9 @@ type Service struct {
 	eth1HeadTicker          *time.Ticker
 	httpLogger              bind.ContractFilterer
 	rpcClient               RPCClient
-	headerCache             *headerCache // cache to store block hash/block height.
+	headerCache             *headerCache  // cache to store block hash/block height.
+	payloadCache            *payloadCache // cache to store reconstructed execution payloads by block hash.
+	payloadBodiesDisabled   atomic.Bool   // set once the execution client is found to lack engine_getPayloadBodies*.
 	latestEth1Data          *zondpb.LatestETH1Data
 	depositContractCaller   *contracts.DepositContractCaller
 	depositTrie             cache.MerkleTree
@@ -191,8 +195,9 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 			BlockHash:          []byte{},
 			LastRequestedBlock: 0,
 		},
-		headerCache: newHeaderCache(),
-		depositTrie: depositTrie,
+		headerCache:  newHeaderCache(),
+		payloadCache: newPayloadCache(),
+		depositTrie:  depositTrie,
 		chainStartData: &zondpb.ChainStartData{
 			Eth1Data:           &zondpb.Eth1Data{},
 			ChainstartDeposits: make([]*zondpb.Deposit, 0),
@@ -208,6 +213,10 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 		}
 	}
 
+	if err := s.initializeFromDepositSnapshot(ctx); err != nil {
+		return nil, errors.Wrap(err, "could not initialize from deposit snapshot")
+	}
+
 	if err := s.ensureValidPowchainData(ctx); err != nil {
 		return nil, errors.Wrap(err, "unable to validate powchain data")
 	}
@@ -387,10 +396,10 @@ func (s *Service) initDepositCaches(ctx context.Context, ctrs []*zondpb.DepositC
 		}
 	}
 	validDepositsCount.Add(float64(currIndex))
-	// Only add pending deposits if the container slice length
-	// is more than the current index in state.
-	if uint64(len(ctrs)) > currIndex {
-		for _, c := range ctrs[currIndex:] {
+	// Only add pending deposits which have not been included
+	// in the state yet.
+	for _, c := range ctrs {
+		if c.Index >= int64(currIndex) { // lint:ignore uintcast -- deposit index will not exceed int64 in your lifetime.
 			s.cfg.depositCache.InsertPendingDeposit(ctx, c.Deposit, c.Eth1BlockHeight, c.Index, bytesutil.ToBytes32(c.DepositRoot))
 		}
 	}
@@ -791,6 +800,9 @@ func (s *Service) initializeEth1Data(ctx context.Context, eth1DataInDB *zondpb.E
 	}
 	numOfItems := s.depositTrie.NumOfItems()
 	s.lastReceivedMerkleIndex = int64(numOfItems - 1)
+	if err := s.initDepositCacheFromSnapshot(ctx, eth1DataInDB); err != nil {
+		return errors.Wrap(err, "could not initialize deposit cache from snapshot")
+	}
 	if err := s.initDepositCaches(ctx, eth1DataInDB.DepositContainers); err != nil {
 		return errors.Wrap(err, "could not initialize caches")
 	}
@@ -798,8 +810,9 @@ func (s *Service) initializeEth1Data(ctx context.Context, eth1DataInDB *zondpb.E
 }
 
 // Validates that all deposit containers are valid and have their relevant indices
-// in order.
-func validateDepositContainers(ctrs []*zondpb.DepositContainer) bool {
+// in order. Containers may start after index 0 if the preceding deposits are covered
+// by a deposit snapshot of the given size.
+func validateDepositContainers(ctrs []*zondpb.DepositContainer, snapshotDepositCount uint64) bool {
 	ctrLen := len(ctrs)
 	// Exit for empty containers.
 	if ctrLen == 0 {
@@ -810,6 +823,9 @@ func validateDepositContainers(ctrs []*zondpb.DepositContainer) bool {
 		return ctrs[i].Index < ctrs[j].Index
 	})
 	startIndex := int64(0)
+	if ctrs[0].Index > 0 && uint64(ctrs[0].Index) <= snapshotDepositCount {
+		startIndex = ctrs[0].Index
+	}
 	for _, c := range ctrs {
 		if c.Index != startIndex {
 			log.Info("Recovering missing deposit containers, node is re-requesting missing deposit data")
@@ -835,7 +851,11 @@ func (s *Service) ensureValidPowchainData(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "unable to retrieve eth1 data")
 	}
-	if eth1Data == nil || !eth1Data.ChainstartData.Chainstarted || !validateDepositContainers(eth1Data.DepositContainers) {
+	var snapshotDepositCount uint64
+	if features.Get().EnableEIP4881 && eth1Data != nil && eth1Data.DepositSnapshot != nil {
+		snapshotDepositCount = eth1Data.DepositSnapshot.DepositCount
+	}
+	if eth1Data == nil || !eth1Data.ChainstartData.Chainstarted || !validateDepositContainers(eth1Data.DepositContainers, snapshotDepositCount) {
 		pbState, err := native.ProtobufBeaconStatePhase0(s.preGenesisState.ToProtoUnsafe())
 		if err != nil {
 			return err
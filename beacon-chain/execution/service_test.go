@@ -647,9 +647,10 @@ func TestService_EnsureValidPowchainData(t *testing.T) {
 
 func TestService_ValidateDepositContainers(t *testing.T) {
 	var tt = []struct {
-		name        string
-		ctrsFunc    func() []*zondpb.DepositContainer
-		expectedRes bool
+		name                 string
+		ctrsFunc             func() []*zondpb.DepositContainer
+		snapshotDepositCount uint64
+		expectedRes          bool
 	}{
 		{
 			name: "zero containers",
@@ -680,6 +681,30 @@ func TestService_ValidateDepositContainers(t *testing.T) {
 			},
 			expectedRes: false,
 		},
+		{
+			name: "containers after deposit snapshot",
+			ctrsFunc: func() []*zondpb.DepositContainer {
+				ctrs := make([]*zondpb.DepositContainer, 0)
+				for i := 5; i < 10; i++ {
+					ctrs = append(ctrs, &zondpb.DepositContainer{Index: int64(i), Eth1BlockHeight: uint64(i + 10)})
+				}
+				return ctrs
+			},
+			snapshotDepositCount: 5,
+			expectedRes:          true,
+		},
+		{
+			name: "containers missing after deposit snapshot",
+			ctrsFunc: func() []*zondpb.DepositContainer {
+				ctrs := make([]*zondpb.DepositContainer, 0)
+				for i := 6; i < 10; i++ {
+					ctrs = append(ctrs, &zondpb.DepositContainer{Index: int64(i), Eth1BlockHeight: uint64(i + 10)})
+				}
+				return ctrs
+			},
+			snapshotDepositCount: 5,
+			expectedRes:          false,
+		},
 		{
 			name: "skipped containers",
 			ctrsFunc: func() []*zondpb.DepositContainer {
@@ -697,7 +722,7 @@ func TestService_ValidateDepositContainers(t *testing.T) {
 	}
 
 	for _, test := range tt {
-		assert.Equal(t, test.expectedRes, validateDepositContainers(test.ctrsFunc()))
+		assert.Equal(t, test.expectedRes, validateDepositContainers(test.ctrsFunc(), test.snapshotDepositCount))
 	}
 }
 
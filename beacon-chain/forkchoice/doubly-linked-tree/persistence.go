@@ -0,0 +1,312 @@
+package doublylinkedtree
+
+import (
+	"context"
+	"encoding/binary"
+	"sort"
+
+	"github.com/pkg/errors"
+	forkchoicetypes "github.com/theQRL/qrysm/v4/beacon-chain/forkchoice/types"
+	fieldparams "github.com/theQRL/qrysm/v4/config/fieldparams"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+)
+
+// storeEncodingVersion is the version of the fork choice store encoding, bumped whenever the layout changes.
+const storeEncodingVersion byte = 1
+
+var errInvalidStoreEncoding = errors.New("invalid fork choice store encoding")
+
+// MarshalStore serializes the fork choice store: the block nodes with their weights, the validator votes and
+// balances, the checkpoints and the proposer boost state. The caller must hold the fork choice read lock.
+func (f *ForkChoice) MarshalStore() ([]byte, error) {
+	s := f.store
+	if s.treeRootNode == nil {
+		return nil, ErrNilNode
+	}
+	w := &storeWriter{}
+	w.byte(storeEncodingVersion)
+	for _, cp := range []*forkchoicetypes.Checkpoint{
+		s.justifiedCheckpoint,
+		s.unrealizedJustifiedCheckpoint,
+		s.unrealizedFinalizedCheckpoint,
+		s.prevJustifiedCheckpoint,
+		s.finalizedCheckpoint,
+	} {
+		w.uint64(uint64(cp.Epoch))
+		w.root(cp.Root)
+	}
+	w.root(s.proposerBoostRoot)
+	w.root(s.previousProposerBoostRoot)
+	w.uint64(s.previousProposerBoostScore)
+	w.uint64(s.committeeWeight)
+	w.root(s.originRoot)
+	w.uint64(s.genesisTime)
+	w.bool(s.allTipsAreInvalid)
+	for _, slot := range s.receivedBlocksLastEpoch {
+		w.uint64(uint64(slot))
+	}
+	slashed := make([]primitives.ValidatorIndex, 0, len(s.slashedIndices))
+	for idx := range s.slashedIndices {
+		slashed = append(slashed, idx)
+	}
+	sort.Slice(slashed, func(i, j int) bool { return slashed[i] < slashed[j] })
+	w.uint64(uint64(len(slashed)))
+	for _, idx := range slashed {
+		w.uint64(uint64(idx))
+	}
+
+	// Nodes are written parents first, so that the tree can be rebuilt in a single pass.
+	w.uint64(uint64(len(s.nodeByRoot)))
+	queue := []*Node{s.treeRootNode}
+	written := 0
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		var parentRoot [fieldparams.RootLength]byte
+		if n.parent != nil {
+			parentRoot = n.parent.root
+		}
+		var bestDescendant [fieldparams.RootLength]byte
+		if n.bestDescendant != nil {
+			bestDescendant = n.bestDescendant.root
+		}
+		w.uint64(uint64(n.slot))
+		w.root(n.root)
+		w.root(parentRoot)
+		w.root(n.payloadHash)
+		w.uint64(uint64(n.justifiedEpoch))
+		w.uint64(uint64(n.unrealizedJustifiedEpoch))
+		w.uint64(uint64(n.finalizedEpoch))
+		w.uint64(uint64(n.unrealizedFinalizedEpoch))
+		w.uint64(n.balance)
+		w.uint64(n.weight)
+		w.root(bestDescendant)
+		w.bool(n.optimistic)
+		w.uint64(n.timestamp)
+		written++
+		queue = append(queue, n.children...)
+	}
+	if written != len(s.nodeByRoot) {
+		return nil, errors.Errorf("fork choice tree has %d nodes, %d are indexed", written, len(s.nodeByRoot))
+	}
+	var head, highest [fieldparams.RootLength]byte
+	if s.headNode != nil {
+		head = s.headNode.root
+	}
+	if s.highestReceivedNode != nil {
+		highest = s.highestReceivedNode.root
+	}
+	w.root(head)
+	w.root(highest)
+
+	w.uint64(uint64(len(f.votes)))
+	for _, v := range f.votes {
+		w.root(v.currentRoot)
+		w.root(v.nextRoot)
+		w.uint64(uint64(v.nextEpoch))
+	}
+	w.uint64s(f.balances)
+	w.uint64s(f.justifiedBalances)
+	w.uint64(f.numActiveValidators)
+	return w.buf, nil
+}
+
+// RestoreStore replaces the fork choice store with a store serialized by MarshalStore. The fork choice is left
+// untouched if the encoding is invalid. The caller must hold the fork choice lock.
+func (f *ForkChoice) RestoreStore(_ context.Context, enc []byte) error {
+	r := &storeReader{buf: enc}
+	if v := r.byte(); r.err == nil && v != storeEncodingVersion {
+		return errors.Wrapf(errInvalidStoreEncoding, "unknown version %d", v)
+	}
+	s := &Store{
+		nodeByRoot:     make(map[[fieldparams.RootLength]byte]*Node),
+		nodeByPayload:  make(map[[fieldparams.RootLength]byte]*Node),
+		slashedIndices: make(map[primitives.ValidatorIndex]bool),
+	}
+	for _, cp := range []**forkchoicetypes.Checkpoint{
+		&s.justifiedCheckpoint,
+		&s.unrealizedJustifiedCheckpoint,
+		&s.unrealizedFinalizedCheckpoint,
+		&s.prevJustifiedCheckpoint,
+		&s.finalizedCheckpoint,
+	} {
+		*cp = &forkchoicetypes.Checkpoint{Epoch: primitives.Epoch(r.uint64()), Root: r.root()}
+	}
+	s.proposerBoostRoot = r.root()
+	s.previousProposerBoostRoot = r.root()
+	s.previousProposerBoostScore = r.uint64()
+	s.committeeWeight = r.uint64()
+	s.originRoot = r.root()
+	s.genesisTime = r.uint64()
+	s.allTipsAreInvalid = r.bool()
+	for i := range s.receivedBlocksLastEpoch {
+		s.receivedBlocksLastEpoch[i] = primitives.Slot(r.uint64())
+	}
+	numSlashed := r.uint64()
+	for i := uint64(0); i < numSlashed && r.err == nil; i++ {
+		s.slashedIndices[primitives.ValidatorIndex(r.uint64())] = true
+	}
+
+	numNodes := r.uint64()
+	bestDescendants := make(map[*Node][fieldparams.RootLength]byte)
+	for i := uint64(0); i < numNodes && r.err == nil; i++ {
+		n := &Node{slot: primitives.Slot(r.uint64()), root: r.root()}
+		parentRoot := r.root()
+		n.payloadHash = r.root()
+		n.justifiedEpoch = primitives.Epoch(r.uint64())
+		n.unrealizedJustifiedEpoch = primitives.Epoch(r.uint64())
+		n.finalizedEpoch = primitives.Epoch(r.uint64())
+		n.unrealizedFinalizedEpoch = primitives.Epoch(r.uint64())
+		n.balance = r.uint64()
+		n.weight = r.uint64()
+		bestDescendants[n] = r.root()
+		n.optimistic = r.bool()
+		n.timestamp = r.uint64()
+		if r.err != nil {
+			break
+		}
+		if i == 0 {
+			s.treeRootNode = n
+		} else {
+			parent, ok := s.nodeByRoot[parentRoot]
+			if !ok {
+				return errors.Wrapf(errInvalidStoreEncoding, "unknown parent %#x of node %#x", parentRoot, n.root)
+			}
+			n.parent = parent
+			parent.children = append(parent.children, n)
+		}
+		s.nodeByRoot[n.root] = n
+		s.nodeByPayload[n.payloadHash] = n
+	}
+	head, highest := r.root(), r.root()
+
+	numVotes := r.uint64()
+	votes := make([]Vote, 0)
+	for i := uint64(0); i < numVotes && r.err == nil; i++ {
+		votes = append(votes, Vote{currentRoot: r.root(), nextRoot: r.root(), nextEpoch: primitives.Epoch(r.uint64())})
+	}
+	balances := r.uint64s()
+	justifiedBalances := r.uint64s()
+	numActiveValidators := r.uint64()
+	if r.err != nil {
+		return errors.Wrap(errInvalidStoreEncoding, r.err.Error())
+	}
+	if len(r.buf) != 0 {
+		return errors.Wrapf(errInvalidStoreEncoding, "%d trailing bytes", len(r.buf))
+	}
+	if s.treeRootNode == nil {
+		return errors.Wrap(errInvalidStoreEncoding, "no tree root node")
+	}
+
+	for n, root := range bestDescendants {
+		if root == [fieldparams.RootLength]byte{} {
+			continue
+		}
+		d, ok := s.nodeByRoot[root]
+		if !ok {
+			return errors.Wrapf(errInvalidStoreEncoding, "unknown best descendant %#x of node %#x", root, n.root)
+		}
+		n.bestDescendant = d
+	}
+	var ok bool
+	if s.headNode, ok = s.nodeByRoot[head]; !ok {
+		return errors.Wrapf(errInvalidStoreEncoding, "unknown head node %#x", head)
+	}
+	if s.highestReceivedNode, ok = s.nodeByRoot[highest]; !ok {
+		return errors.Wrapf(errInvalidStoreEncoding, "unknown highest received node %#x", highest)
+	}
+
+	f.store = s
+	f.votes = votes
+	f.balances = balances
+	f.justifiedBalances = justifiedBalances
+	f.numActiveValidators = numActiveValidators
+	nodeCount.Set(float64(len(s.nodeByRoot)))
+	return nil
+}
+
+// storeWriter appends the little-endian encoding of the fork choice store fields.
+type storeWriter struct {
+	buf []byte
+}
+
+func (w *storeWriter) byte(b byte) {
+	w.buf = append(w.buf, b)
+}
+
+func (w *storeWriter) bool(b bool) {
+	if b {
+		w.byte(1)
+	} else {
+		w.byte(0)
+	}
+}
+
+func (w *storeWriter) uint64(v uint64) {
+	w.buf = binary.LittleEndian.AppendUint64(w.buf, v)
+}
+
+func (w *storeWriter) uint64s(vs []uint64) {
+	w.uint64(uint64(len(vs)))
+	for _, v := range vs {
+		w.uint64(v)
+	}
+}
+
+func (w *storeWriter) root(r [fieldparams.RootLength]byte) {
+	w.buf = append(w.buf, r[:]...)
+}
+
+// storeReader decodes the fields written by storeWriter, recording the first error encountered.
+type storeReader struct {
+	buf []byte
+	err error
+}
+
+func (r *storeReader) next(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if len(r.buf) < n {
+		r.err = errors.New("unexpected end of data")
+		return nil
+	}
+	b := r.buf[:n]
+	r.buf = r.buf[n:]
+	return b
+}
+
+func (r *storeReader) byte() byte {
+	b := r.next(1)
+	if b == nil {
+		return 0
+	}
+	return b[0]
+}
+
+func (r *storeReader) bool() bool {
+	return r.byte() == 1
+}
+
+func (r *storeReader) uint64() uint64 {
+	b := r.next(8)
+	if b == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint64(b)
+}
+
+func (r *storeReader) uint64s() []uint64 {
+	n := r.uint64()
+	vs := make([]uint64, 0)
+	for i := uint64(0); i < n && r.err == nil; i++ {
+		vs = append(vs, r.uint64())
+	}
+	return vs
+}
+
+func (r *storeReader) root() [fieldparams.RootLength]byte {
+	var root [fieldparams.RootLength]byte
+	copy(root[:], r.next(fieldparams.RootLength))
+	return root
+}
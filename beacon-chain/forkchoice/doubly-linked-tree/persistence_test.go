@@ -0,0 +1,96 @@
+package doublylinkedtree
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	forkchoicetypes "github.com/theQRL/qrysm/v4/beacon-chain/forkchoice/types"
+	"github.com/theQRL/qrysm/v4/config/params"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+)
+
+func TestForkChoice_MarshalRestoreStore(t *testing.T) {
+	ctx := context.Background()
+	f := setup(1, 1)
+	// Insert two branches: 1 <- 2 <- 3 and 1 <- 4.
+	st, root, err := prepareForkchoiceState(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, indexToHash(101), 1, 1)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, root))
+	st, root, err = prepareForkchoiceState(ctx, 2, indexToHash(2), indexToHash(1), indexToHash(102), 1, 1)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, root))
+	st, root, err = prepareForkchoiceState(ctx, 3, indexToHash(3), indexToHash(2), indexToHash(103), 1, 1)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, root))
+	st, root, err = prepareForkchoiceState(ctx, 2, indexToHash(4), indexToHash(1), indexToHash(104), 1, 1)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, root))
+
+	f.justifiedBalances = []uint64{10, 20, 30}
+	f.ProcessAttestation(ctx, []uint64{0}, indexToHash(3), 2)
+	f.ProcessAttestation(ctx, []uint64{1, 2}, indexToHash(4), 2)
+	f.InsertSlashedIndex(ctx, 0)
+	f.store.proposerBoostRoot = indexToHash(4)
+	require.NoError(t, f.SetOptimisticToValid(ctx, indexToHash(2)))
+	headRoot, err := f.Head(ctx)
+	require.NoError(t, err)
+	require.Equal(t, indexToHash(4), headRoot)
+
+	enc, err := f.MarshalStore()
+	require.NoError(t, err)
+
+	restored := New()
+	restored.SetBalancesByRooter(func(_ context.Context, _ [32]byte) ([]uint64, error) { return restored.justifiedBalances, nil })
+	require.NoError(t, restored.RestoreStore(ctx, enc))
+	assert.Equal(t, f.NodeCount(), restored.NodeCount())
+	assert.Equal(t, headRoot, restored.CachedHeadRoot())
+	assert.Equal(t, indexToHash(4), restored.ProposerBoost())
+	assert.DeepEqual(t, f.votes, restored.votes)
+	assert.DeepEqual(t, f.balances, restored.balances)
+	assert.DeepEqual(t, f.JustifiedCheckpoint(), restored.JustifiedCheckpoint())
+	assert.DeepEqual(t, f.FinalizedCheckpoint(), restored.FinalizedCheckpoint())
+	assert.Equal(t, true, restored.store.slashedIndices[0])
+	for _, r := range [][32]byte{indexToHash(1), indexToHash(2), indexToHash(3), indexToHash(4)} {
+		want, err := f.Weight(r)
+		require.NoError(t, err)
+		got, err := restored.Weight(r)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+		wantOptimistic, err := f.IsOptimistic(r)
+		require.NoError(t, err)
+		gotOptimistic, err := restored.IsOptimistic(r)
+		require.NoError(t, err)
+		assert.Equal(t, wantOptimistic, gotOptimistic)
+	}
+	assert.Equal(t, restored.store.nodeByRoot[indexToHash(3)], restored.store.nodeByPayload[indexToHash(103)])
+
+	// The restored store keeps working and serializes identically.
+	reenc, err := restored.MarshalStore()
+	require.NoError(t, err)
+	assert.Equal(t, true, bytes.Equal(enc, reenc))
+	restoredHead, err := restored.Head(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, headRoot, restoredHead)
+	st, root, err = prepareForkchoiceState(ctx, 4, indexToHash(5), indexToHash(3), indexToHash(105), 1, 1)
+	require.NoError(t, err)
+	require.NoError(t, restored.InsertNode(ctx, st, root))
+	assert.Equal(t, f.NodeCount()+1, restored.NodeCount())
+}
+
+func TestForkChoice_RestoreStore_Invalid(t *testing.T) {
+	ctx := context.Background()
+	f := setup(1, 1)
+	enc, err := f.MarshalStore()
+	require.NoError(t, err)
+
+	restored := setup(0, 0)
+	restored.store.finalizedCheckpoint = &forkchoicetypes.Checkpoint{Epoch: 5}
+	require.ErrorIs(t, restored.RestoreStore(ctx, enc[:len(enc)-1]), errInvalidStoreEncoding)
+	require.ErrorIs(t, restored.RestoreStore(ctx, append(enc, 0)), errInvalidStoreEncoding)
+	require.ErrorIs(t, restored.RestoreStore(ctx, append([]byte{storeEncodingVersion + 1}, enc[1:]...)), errInvalidStoreEncoding)
+	// The store is left untouched.
+	assert.Equal(t, primitives.Epoch(5), restored.FinalizedCheckpoint().Epoch)
+}
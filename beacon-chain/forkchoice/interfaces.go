@@ -25,6 +25,7 @@ type ForkChoicer interface {
 	AttestationProcessor // to track new attestation for fork choice.
 	Getter               // to retrieve fork choice information.
 	Setter               // to set fork choice information.
+	Persister            // to save and restore the fork choice store.
 }
 
 // HeadRetriever retrieves head root and optimistic info of the current chain.
@@ -71,6 +72,12 @@ type Getter interface {
 	LastRoot(primitives.Epoch) [32]byte
 }
 
+// Persister serializes the fork choice store so that it can be restored after a restart.
+type Persister interface {
+	MarshalStore() ([]byte, error)
+	RestoreStore(context.Context, []byte) error
+}
+
 // Setter allows to set forkchoice information
 type Setter interface {
 	SetOptimisticToValid(context.Context, [fieldparams.RootLength]byte) error
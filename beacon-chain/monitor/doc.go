@@ -2,6 +2,8 @@
 Package monitor defines a runtime service which receives
 notifications triggered by events related to performance of tracked
 validating keys. It then logs and emits metrics for a user to keep finely
-detailed performance measures.
+detailed performance measures. The tracked validators can be changed at
+runtime and their performance for each epoch is persisted to the beacon
+database.
 */
 package monitor
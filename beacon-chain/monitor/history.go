@@ -0,0 +1,250 @@
+package monitor
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	monitortypes "github.com/theQRL/qrysm/v4/beacon-chain/monitor/types"
+	"github.com/theQRL/qrysm/v4/beacon-chain/state"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/time/slots"
+)
+
+// ErrUnknownValidator is returned when tracking a validator which is not in the head state.
+var ErrUnknownValidator = errors.New("unknown validator")
+
+// ValidatorTracker allows to change the validators tracked by the monitor at runtime
+// and to read back their epoch performance.
+type ValidatorTracker interface {
+	TrackedValidatorIndices() []primitives.ValidatorIndex
+	TrackValidators(ctx context.Context, indices []primitives.ValidatorIndex) error
+	UntrackValidators(ctx context.Context, indices []primitives.ValidatorIndex) error
+	PerformanceHistory(ctx context.Context, idx primitives.ValidatorIndex, startEpoch, endEpoch primitives.Epoch) ([]*monitortypes.ValidatorEpochPerformance, error)
+}
+
+// epochPerformance is the performance of a validator during an epoch that is not yet persisted.
+type epochPerformance struct {
+	monitortypes.ValidatorEpochPerformance
+	balanceRecorded bool
+}
+
+// epochPerformanceFor returns the pending performance of the validator for the epoch, creating it if needed.
+// It assumes the caller holds the service Lock.
+func (s *Service) epochPerformanceFor(idx primitives.ValidatorIndex, epoch primitives.Epoch) *epochPerformance {
+	perf, ok := s.pendingPerformance[epoch]
+	if !ok {
+		perf = make(map[primitives.ValidatorIndex]*epochPerformance)
+		s.pendingPerformance[epoch] = perf
+	}
+	p, ok := perf[idx]
+	if !ok {
+		p = &epochPerformance{
+			ValidatorEpochPerformance: monitortypes.ValidatorEpochPerformance{
+				ValidatorIndex: idx,
+				Epoch:          epoch,
+			},
+		}
+		perf[idx] = p
+	}
+	return p
+}
+
+// updateEpochBalances records the balances of the tracked validators in the state as their
+// balances for the epoch of the state.
+func (s *Service) updateEpochBalances(state state.BeaconState) {
+	epoch := slots.ToEpoch(state.Slot())
+	s.Lock()
+	defer s.Unlock()
+	for idx := range s.TrackedValidators {
+		balance, err := state.BalanceAtIndex(idx)
+		if err != nil {
+			log.WithError(err).WithField("ValidatorIndex", idx).Error("Could not get balance")
+			continue
+		}
+		p := s.epochPerformanceFor(idx, epoch)
+		if !p.balanceRecorded {
+			p.StartBalance = balance
+			p.balanceRecorded = true
+		}
+		p.EndBalance = balance
+	}
+}
+
+// flushPerformance persists the pending epoch performance of the epochs before the given epoch.
+func (s *Service) flushPerformance(ctx context.Context, before primitives.Epoch) {
+	s.Lock()
+	records := make([]*monitortypes.ValidatorEpochPerformance, 0)
+	for e, perf := range s.pendingPerformance {
+		if e >= before {
+			continue
+		}
+		for _, p := range perf {
+			r := p.ValidatorEpochPerformance
+			records = append(records, &r)
+		}
+		delete(s.pendingPerformance, e)
+	}
+	s.Unlock()
+
+	if len(records) == 0 || s.config.BeaconDB == nil {
+		return
+	}
+	if err := s.config.BeaconDB.SaveValidatorPerformance(ctx, records); err != nil {
+		log.WithError(err).Error("Could not save validator performance")
+	}
+}
+
+// TrackedValidatorIndices returns the sorted indices of the tracked validators.
+func (s *Service) TrackedValidatorIndices() []primitives.ValidatorIndex {
+	s.RLock()
+	defer s.RUnlock()
+	return s.trackedIndices()
+}
+
+// trackedIndices returns the sorted indices of the tracked validators.
+// It assumes the caller holds the service Lock.
+func (s *Service) trackedIndices() []primitives.ValidatorIndex {
+	return sortedIndices(s.TrackedValidators)
+}
+
+func sortedIndices(set map[primitives.ValidatorIndex]bool) []primitives.ValidatorIndex {
+	indices := make([]primitives.ValidatorIndex, 0, len(set))
+	for idx := range set {
+		indices = append(indices, idx)
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+	return indices
+}
+
+// TrackValidators adds the validators to the tracked validators. If the monitor is already
+// reporting, their performance is tracked from the head state onwards.
+func (s *Service) TrackValidators(ctx context.Context, indices []primitives.ValidatorIndex) error {
+	s.RLock()
+	isLogging := s.isLogging
+	s.RUnlock()
+
+	var st state.BeaconState
+	if isLogging {
+		var err error
+		st, err = s.config.HeadFetcher.HeadState(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not get head state")
+		}
+		if st == nil {
+			return errors.New("head state is nil")
+		}
+		for _, idx := range indices {
+			if uint64(idx) >= uint64(st.NumValidators()) {
+				return errors.Wrapf(ErrUnknownValidator, "validator index %d", idx)
+			}
+		}
+	}
+
+	s.Lock()
+	added := make([]primitives.ValidatorIndex, 0, len(indices))
+	for _, idx := range indices {
+		if s.trackedIndex(idx) {
+			continue
+		}
+		s.TrackedValidators[idx] = true
+		delete(s.untrackedValidators, idx)
+		added = append(added, idx)
+		if st != nil {
+			s.initializeValidatorPerformance(st, idx, slots.ToEpoch(st.Slot()))
+		}
+	}
+	tracked := s.trackedIndices()
+	untracked := sortedIndices(s.untrackedValidators)
+	s.Unlock()
+
+	if len(added) == 0 {
+		return nil
+	}
+	if st != nil {
+		s.updateSyncCommitteeTrackedVals(st)
+	}
+	log.WithFields(logrus.Fields{
+		"ValidatorIndices": added,
+	}).Info("Started tracking validators")
+	return s.saveTrackedValidators(ctx, tracked, untracked)
+}
+
+// UntrackValidators removes the validators from the tracked validators. Their performance
+// history is kept. They stay untracked after a restart, even if they are in the --monitor-indices flag.
+func (s *Service) UntrackValidators(ctx context.Context, indices []primitives.ValidatorIndex) error {
+	s.Lock()
+	removed := make([]primitives.ValidatorIndex, 0, len(indices))
+	for _, idx := range indices {
+		if !s.trackedIndex(idx) {
+			continue
+		}
+		delete(s.TrackedValidators, idx)
+		delete(s.latestPerformance, idx)
+		delete(s.aggregatedPerformance, idx)
+		delete(s.trackedSyncCommitteeIndices, idx)
+		s.untrackedValidators[idx] = true
+		removed = append(removed, idx)
+	}
+	tracked := s.trackedIndices()
+	untracked := sortedIndices(s.untrackedValidators)
+	s.Unlock()
+
+	if len(removed) == 0 {
+		return nil
+	}
+	log.WithFields(logrus.Fields{
+		"ValidatorIndices": removed,
+	}).Info("Stopped tracking validators")
+	return s.saveTrackedValidators(ctx, tracked, untracked)
+}
+
+func (s *Service) saveTrackedValidators(ctx context.Context, tracked, untracked []primitives.ValidatorIndex) error {
+	if s.config.BeaconDB == nil {
+		return nil
+	}
+	if err := s.config.BeaconDB.SaveMonitoredValidators(ctx, tracked); err != nil {
+		return errors.Wrap(err, "could not save tracked validators")
+	}
+	return errors.Wrap(s.config.BeaconDB.SaveUnmonitoredValidators(ctx, untracked), "could not save untracked validators")
+}
+
+// PerformanceHistory returns the epoch performance of the validator for the epochs in the range
+// [startEpoch, endEpoch], ordered by epoch. It includes the epochs which are not yet persisted.
+func (s *Service) PerformanceHistory(
+	ctx context.Context, idx primitives.ValidatorIndex, startEpoch, endEpoch primitives.Epoch,
+) ([]*monitortypes.ValidatorEpochPerformance, error) {
+	if startEpoch > endEpoch {
+		return nil, errors.Errorf("start epoch %d is greater than end epoch %d", startEpoch, endEpoch)
+	}
+	byEpoch := make(map[primitives.Epoch]*monitortypes.ValidatorEpochPerformance)
+	if s.config.BeaconDB != nil {
+		records, err := s.config.BeaconDB.ValidatorPerformanceHistory(ctx, idx, startEpoch, endEpoch)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get validator performance")
+		}
+		for _, r := range records {
+			byEpoch[r.Epoch] = r
+		}
+	}
+
+	s.RLock()
+	for e, perf := range s.pendingPerformance {
+		if e < startEpoch || e > endEpoch {
+			continue
+		}
+		if p, ok := perf[idx]; ok {
+			r := p.ValidatorEpochPerformance
+			byEpoch[e] = &r
+		}
+	}
+	s.RUnlock()
+
+	history := make([]*monitortypes.ValidatorEpochPerformance, 0, len(byEpoch))
+	for _, r := range byEpoch {
+		history = append(history, r)
+	}
+	sort.Slice(history, func(i, j int) bool { return history[i].Epoch < history[j].Epoch })
+	return history, nil
+}
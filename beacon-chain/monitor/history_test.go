@@ -0,0 +1,83 @@
+package monitor
+
+import (
+	"context"
+	"testing"
+
+	monitortypes "github.com/theQRL/qrysm/v4/beacon-chain/monitor/types"
+	"github.com/theQRL/qrysm/v4/config/params"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/testing/require"
+)
+
+func TestTrackValidators(t *testing.T) {
+	ctx := context.Background()
+	s := setupService(t)
+
+	require.NoError(t, s.TrackValidators(ctx, []primitives.ValidatorIndex{20, 1}))
+	require.DeepEqual(t, []primitives.ValidatorIndex{1, 2, 12, 15, 20}, s.TrackedValidatorIndices())
+	saved, err := s.config.BeaconDB.MonitoredValidators(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, []primitives.ValidatorIndex{1, 2, 12, 15, 20}, saved)
+
+	require.NoError(t, s.UntrackValidators(ctx, []primitives.ValidatorIndex{1, 20, 100}))
+	require.DeepEqual(t, []primitives.ValidatorIndex{2, 12, 15}, s.TrackedValidatorIndices())
+	_, ok := s.latestPerformance[1]
+	require.Equal(t, false, ok)
+	_, ok = s.trackedSyncCommitteeIndices[1]
+	require.Equal(t, false, ok)
+	saved, err = s.config.BeaconDB.MonitoredValidators(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, []primitives.ValidatorIndex{2, 12, 15}, saved)
+	untracked, err := s.config.BeaconDB.UnmonitoredValidators(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, []primitives.ValidatorIndex{1, 20}, untracked)
+}
+
+func TestTrackValidators_WhileReporting(t *testing.T) {
+	ctx := context.Background()
+	s := setupService(t)
+	s.isLogging = true
+
+	require.ErrorIs(t, s.TrackValidators(ctx, []primitives.ValidatorIndex{1000}), ErrUnknownValidator)
+	require.NoError(t, s.TrackValidators(ctx, []primitives.ValidatorIndex{20}))
+	require.Equal(t, uint64(32000000000), s.latestPerformance[20].balance)
+	require.Equal(t, uint64(32000000000), s.aggregatedPerformance[20].startBalance)
+}
+
+func TestPerformanceHistory(t *testing.T) {
+	ctx := context.Background()
+	s := setupService(t)
+	st, err := s.config.HeadFetcher.HeadState(ctx)
+	require.NoError(t, err)
+
+	s.updateEpochBalances(st)
+	require.NoError(t, st.UpdateBalancesAtIndex(1, 31000000000))
+	s.updateEpochBalances(st)
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch))
+	s.updateEpochBalances(st)
+	s.Lock()
+	s.epochPerformanceFor(1, 1).ProposedBlocks++
+	s.Unlock()
+
+	s.flushPerformance(ctx, 1)
+	saved, err := s.config.BeaconDB.ValidatorPerformanceHistory(ctx, 1, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(saved))
+	require.Equal(t, primitives.Epoch(0), saved[0].Epoch)
+	require.Equal(t, int64(-1000000000), saved[0].BalanceChange())
+
+	history, err := s.PerformanceHistory(ctx, 1, 0, 10)
+	require.NoError(t, err)
+	require.DeepEqual(t, []*monitortypes.ValidatorEpochPerformance{
+		saved[0],
+		{ValidatorIndex: 1, Epoch: 1, ProposedBlocks: 1, StartBalance: 31000000000, EndBalance: 31000000000},
+	}, history)
+
+	history, err = s.PerformanceHistory(ctx, 1, 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(history))
+
+	_, err = s.PerformanceHistory(ctx, 1, 2, 1)
+	require.ErrorContains(t, "start epoch 2 is greater than end epoch 1", err)
+}
@@ -93,7 +93,7 @@ func (s *Service) processIncludedAttestation(ctx context.Context, state state.Be
 			inclusionSlotGauge.WithLabelValues(fmt.Sprintf("%d", idx)).Set(float64(latestPerf.inclusionSlot))
 			aggregatedPerf.totalDistance += uint64(latestPerf.inclusionSlot - latestPerf.attestedSlot)
 
-			if state.Version() == version.Altair {
+			if state.Version() >= version.Altair {
 				targetIdx := params.BeaconConfig().TimelyTargetFlagIndex
 				sourceIdx := params.BeaconConfig().TimelySourceFlagIndex
 				headIdx := params.BeaconConfig().TimelyHeadFlagIndex
@@ -153,6 +153,13 @@ func (s *Service) processIncludedAttestation(ctx context.Context, state state.Be
 			logFields["NewBalance"] = balance
 			logFields["BalanceChange"] = balanceChg
 
+			epochPerf := s.epochPerformanceFor(primitives.ValidatorIndex(idx), att.Data.Target.Epoch)
+			epochPerf.Attested = true
+			epochPerf.InclusionDistance = latestPerf.inclusionSlot - latestPerf.attestedSlot
+			epochPerf.CorrectSource = latestPerf.timelySource
+			epochPerf.CorrectTarget = latestPerf.timelyTarget
+			epochPerf.CorrectHead = latestPerf.timelyHead
+
 			s.latestPerformance[primitives.ValidatorIndex(idx)] = latestPerf
 			s.aggregatedPerformance[primitives.ValidatorIndex(idx)] = aggregatedPerf
 			log.WithFields(logFields).Info("Attestation included")
@@ -202,6 +209,7 @@ func (s *Service) processAggregatedAttestation(ctx context.Context, att *zondpb.
 		aggregatedPerf := s.aggregatedPerformance[att.AggregatorIndex]
 		aggregatedPerf.totalAggregations++
 		s.aggregatedPerformance[att.AggregatorIndex] = aggregatedPerf
+		s.epochPerformanceFor(att.AggregatorIndex, slots.ToEpoch(att.Aggregate.Data.Slot)).Aggregations++
 		aggregationCounter.WithLabelValues(fmt.Sprintf("%d", att.AggregatorIndex)).Inc()
 	}
 
@@ -9,6 +9,7 @@ import (
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/theQRL/go-bitfield"
 	"github.com/theQRL/qrysm/v4/consensus-types/blocks"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
 	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
 	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
 	"github.com/theQRL/qrysm/v4/testing/require"
@@ -59,6 +60,14 @@ func TestProcessIncludedAttestationTwoTracked(t *testing.T) {
 	wanted2 := "\"Attestation included\" BalanceChange=100000000 CorrectHead=true CorrectSource=true CorrectTarget=true Head=0x68656c6c6f2d InclusionSlot=2 NewBalance=32000000000 Slot=1 Source=0x68656c6c6f2d Target=0x68656c6c6f2d ValidatorIndex=12 prefix=monitor"
 	require.LogsContain(t, hook, wanted1)
 	require.LogsContain(t, hook, wanted2)
+
+	epochPerf := s.pendingPerformance[1][2]
+	require.NotNil(t, epochPerf)
+	require.Equal(t, true, epochPerf.Attested)
+	require.Equal(t, primitives.Slot(1), epochPerf.InclusionDistance)
+	require.Equal(t, true, epochPerf.CorrectSource)
+	require.Equal(t, true, epochPerf.CorrectTarget)
+	require.Equal(t, true, epochPerf.CorrectHead)
 }
 
 func TestProcessUnaggregatedAttestationStateNotCached(t *testing.T) {
@@ -57,6 +57,12 @@ func (s *Service) processBlock(ctx context.Context, b interfaces.ReadOnlySignedB
 	s.processSyncAggregate(st, blk)
 	s.processProposedBlock(st, root, blk)
 	s.processAttestations(ctx, st, blk)
+	s.updateEpochBalances(st)
+
+	// Attestations targeting the previous epoch can still be included, so only the epochs before it are final.
+	if currEpoch > 0 {
+		s.flushPerformance(ctx, currEpoch-1)
+	}
 
 	if blk.Slot()%(AggregateReportingPeriod*params.BeaconConfig().SlotsPerEpoch) == 0 {
 		s.logAggregatedPerformance()
@@ -87,6 +93,7 @@ func (s *Service) processProposedBlock(state state.BeaconState, root [32]byte, b
 		aggPerf := s.aggregatedPerformance[blk.ProposerIndex()]
 		aggPerf.totalProposedCount++
 		s.aggregatedPerformance[blk.ProposerIndex()] = aggPerf
+		s.epochPerformanceFor(blk.ProposerIndex(), slots.ToEpoch(blk.Slot())).ProposedBlocks++
 
 		parentRoot := blk.ParentRoot()
 		log.WithFields(logrus.Fields{
@@ -8,6 +8,7 @@ import (
 	"github.com/theQRL/qrysm/v4/consensus-types/interfaces"
 	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
 	"github.com/theQRL/qrysm/v4/runtime/version"
+	"github.com/theQRL/qrysm/v4/time/slots"
 )
 
 // processSyncCommitteeContribution logs the event when tracked validators' aggregated sync contribution has been processed.
@@ -65,6 +66,10 @@ func (s *Service) processSyncAggregate(state state.BeaconState, blk interfaces.R
 			aggPerf.totalSyncCommitteeContributions += uint64(contrib)
 			s.aggregatedPerformance[validatorIdx] = aggPerf
 
+			epochPerf := s.epochPerformanceFor(validatorIdx, slots.ToEpoch(blk.Slot()))
+			epochPerf.SyncContributions += uint64(contrib)
+			epochPerf.SyncExpected += uint64(len(committeeIndices))
+
 			syncCommitteeContributionCounter.WithLabelValues(
 				fmt.Sprintf("%d", validatorIdx)).Add(float64(contrib))
 
@@ -3,7 +3,6 @@ package monitor
 import (
 	"context"
 	"errors"
-	"sort"
 	"sync"
 
 	"github.com/sirupsen/logrus"
@@ -13,8 +12,10 @@ import (
 	"github.com/theQRL/qrysm/v4/beacon-chain/core/feed/operation"
 	statefeed "github.com/theQRL/qrysm/v4/beacon-chain/core/feed/state"
 	"github.com/theQRL/qrysm/v4/beacon-chain/core/helpers"
+	"github.com/theQRL/qrysm/v4/beacon-chain/db"
 	"github.com/theQRL/qrysm/v4/beacon-chain/state"
 	"github.com/theQRL/qrysm/v4/beacon-chain/state/stategen"
+	"github.com/theQRL/qrysm/v4/config/params"
 	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
 	"github.com/theQRL/qrysm/v4/time/slots"
 )
@@ -52,12 +53,14 @@ type ValidatorAggregatedPerformance struct {
 
 // ValidatorMonitorConfig contains the list of validator indices that the
 // monitor service tracks, and the event feed notifier that the
-// monitor needs to subscribe.
+// monitor needs to subscribe. The tracked validators and their epoch
+// performance are persisted to the BeaconDB, if any.
 type ValidatorMonitorConfig struct {
 	StateNotifier       statefeed.Notifier
 	AttestationNotifier operation.Notifier
 	HeadFetcher         blockchain.HeadFetcher
 	StateGen            stategen.StateManager
+	BeaconDB            db.NoHeadAccessDatabase
 	InitialSyncComplete chan struct{}
 }
 
@@ -70,7 +73,7 @@ type Service struct {
 	isLogging bool
 
 	// Locks access to TrackedValidators, latestPerformance, aggregatedPerformance,
-	// trackedSyncedCommitteeIndices and lastSyncedEpoch
+	// trackedSyncedCommitteeIndices, lastSyncedEpoch, pendingPerformance and untrackedValidators
 	sync.RWMutex
 
 	TrackedValidators           map[primitives.ValidatorIndex]bool
@@ -78,9 +81,13 @@ type Service struct {
 	aggregatedPerformance       map[primitives.ValidatorIndex]ValidatorAggregatedPerformance
 	trackedSyncCommitteeIndices map[primitives.ValidatorIndex][]primitives.CommitteeIndex
 	lastSyncedEpoch             primitives.Epoch
+	pendingPerformance          map[primitives.Epoch]map[primitives.ValidatorIndex]*epochPerformance
+	untrackedValidators         map[primitives.ValidatorIndex]bool
 }
 
 // NewService sets up a new validator monitor service instance when given a list of validator indices to track.
+// The validators tracked before a restart are tracked as well, while the validators removed at runtime stay
+// untracked even if they are in the given list, until they are tracked again at runtime.
 func NewService(ctx context.Context, config *ValidatorMonitorConfig, tracked []primitives.ValidatorIndex) (*Service, error) {
 	ctx, cancel := context.WithCancel(ctx)
 	r := &Service{
@@ -91,11 +98,32 @@ func NewService(ctx context.Context, config *ValidatorMonitorConfig, tracked []p
 		latestPerformance:           make(map[primitives.ValidatorIndex]ValidatorLatestPerformance),
 		aggregatedPerformance:       make(map[primitives.ValidatorIndex]ValidatorAggregatedPerformance),
 		trackedSyncCommitteeIndices: make(map[primitives.ValidatorIndex][]primitives.CommitteeIndex),
+		pendingPerformance:          make(map[primitives.Epoch]map[primitives.ValidatorIndex]*epochPerformance),
+		untrackedValidators:         make(map[primitives.ValidatorIndex]bool),
 		isLogging:                   false,
 	}
 	for _, idx := range tracked {
 		r.TrackedValidators[idx] = true
 	}
+	if config.BeaconDB != nil {
+		saved, err := config.BeaconDB.MonitoredValidators(ctx)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		for _, idx := range saved {
+			r.TrackedValidators[idx] = true
+		}
+		untracked, err := config.BeaconDB.UnmonitoredValidators(ctx)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		for _, idx := range untracked {
+			delete(r.TrackedValidators, idx)
+			r.untrackedValidators[idx] = true
+		}
+	}
 	return r, nil
 }
 
@@ -104,14 +132,8 @@ func (s *Service) Start() {
 	s.Lock()
 	defer s.Unlock()
 
-	tracked := make([]primitives.ValidatorIndex, 0, len(s.TrackedValidators))
-	for idx := range s.TrackedValidators {
-		tracked = append(tracked, idx)
-	}
-	sort.Slice(tracked, func(i, j int) bool { return tracked[i] < tracked[j] })
-
 	log.WithFields(logrus.Fields{
-		"ValidatorIndices": tracked,
+		"ValidatorIndices": s.trackedIndices(),
 	}).Info("Starting service")
 
 	go s.run()
@@ -155,19 +177,26 @@ func (s *Service) run() {
 // and validatorAggregatedPerformance for each tracked validator.
 func (s *Service) initializePerformanceStructures(state state.BeaconState, epoch primitives.Epoch) {
 	for idx := range s.TrackedValidators {
-		balance, err := state.BalanceAtIndex(idx)
-		if err != nil {
-			log.WithError(err).WithField("ValidatorIndex", idx).Error(
-				"Could not fetch starting balance, skipping aggregated logs.")
-			balance = 0
-		}
-		s.aggregatedPerformance[idx] = ValidatorAggregatedPerformance{
-			startEpoch:   epoch,
-			startBalance: balance,
-		}
-		s.latestPerformance[idx] = ValidatorLatestPerformance{
-			balance: balance,
-		}
+		s.initializeValidatorPerformance(state, idx, epoch)
+	}
+}
+
+// initializeValidatorPerformance initializes the validatorLatestPerformance
+// and validatorAggregatedPerformance of the validator.
+// It assumes the caller holds the service Lock.
+func (s *Service) initializeValidatorPerformance(state state.BeaconState, idx primitives.ValidatorIndex, epoch primitives.Epoch) {
+	balance, err := state.BalanceAtIndex(idx)
+	if err != nil {
+		log.WithError(err).WithField("ValidatorIndex", idx).Error(
+			"Could not fetch starting balance, skipping aggregated logs.")
+		balance = 0
+	}
+	s.aggregatedPerformance[idx] = ValidatorAggregatedPerformance{
+		startEpoch:   epoch,
+		startBalance: balance,
+	}
+	s.latestPerformance[idx] = ValidatorLatestPerformance{
+		balance: balance,
 	}
 }
 
@@ -179,10 +208,11 @@ func (s *Service) Status() error {
 	return errors.New("not running")
 }
 
-// Stop stops the service.
+// Stop stops the service, persisting the pending epoch performance.
 func (s *Service) Stop() error {
 	defer s.cancel()
 	s.isLogging = false
+	s.flushPerformance(s.ctx, params.BeaconConfig().FarFutureEpoch)
 	return nil
 }
 
@@ -215,8 +245,8 @@ func (s *Service) monitorRoutine(stateChannel chan *feed.Event, stateSub event.S
 				data, ok := e.Data.(*statefeed.BlockProcessedData)
 				if !ok {
 					log.Error("Event feed data is not of type *statefeed.BlockProcessedData")
-				} else if data.Verified {
-					// We only process blocks that have been verified
+				} else if data.Verified && s.hasTrackedValidators() {
+					// We only process blocks that have been verified, and only if a validator is tracked
 					s.processBlock(s.ctx, data.SignedBlock)
 				}
 			}
@@ -261,6 +291,13 @@ func (s *Service) monitorRoutine(stateChannel chan *feed.Event, stateSub event.S
 	}
 }
 
+// hasTrackedValidators returns true if at least one validator is tracked.
+func (s *Service) hasTrackedValidators() bool {
+	s.RLock()
+	defer s.RUnlock()
+	return len(s.TrackedValidators) > 0
+}
+
 // TrackedIndex returns true if input  validator index exists in tracked validator list.
 // It assumes the caller holds the service Lock
 func (s *Service) trackedIndex(idx primitives.ValidatorIndex) bool {
@@ -93,6 +93,7 @@ func setupService(t *testing.T) *Service {
 			StateNotifier:       chainService.StateNotifier(),
 			HeadFetcher:         chainService,
 			AttestationNotifier: chainService.OperationNotifier(),
+			BeaconDB:            beaconDB,
 			InitialSyncComplete: make(chan struct{}),
 		},
 
@@ -102,6 +103,8 @@ func setupService(t *testing.T) *Service {
 		aggregatedPerformance:       aggregatedPerformance,
 		trackedSyncCommitteeIndices: trackedSyncCommitteeIndices,
 		lastSyncedEpoch:             0,
+		pendingPerformance:          make(map[primitives.Epoch]map[primitives.ValidatorIndex]*epochPerformance),
+		untrackedValidators:         make(map[primitives.ValidatorIndex]bool),
 	}
 }
 
@@ -138,6 +141,34 @@ func TestNewService(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestNewService_RestoresTrackedValidators(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	require.NoError(t, beaconDB.SaveMonitoredValidators(ctx, []primitives.ValidatorIndex{3, 7}))
+
+	s, err := NewService(ctx, &ValidatorMonitorConfig{BeaconDB: beaconDB}, []primitives.ValidatorIndex{1, 3})
+	require.NoError(t, err)
+	require.DeepEqual(t, []primitives.ValidatorIndex{1, 3, 7}, s.TrackedValidatorIndices())
+}
+
+func TestNewService_KeepsUntrackedValidators(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	s, err := NewService(ctx, &ValidatorMonitorConfig{BeaconDB: beaconDB}, []primitives.ValidatorIndex{1, 3})
+	require.NoError(t, err)
+	require.NoError(t, s.UntrackValidators(ctx, []primitives.ValidatorIndex{3}))
+
+	// The validator removed at runtime is not tracked again on restart, even though it is in the flag.
+	s, err = NewService(ctx, &ValidatorMonitorConfig{BeaconDB: beaconDB}, []primitives.ValidatorIndex{1, 3})
+	require.NoError(t, err)
+	require.DeepEqual(t, []primitives.ValidatorIndex{1}, s.TrackedValidatorIndices())
+
+	require.NoError(t, s.TrackValidators(ctx, []primitives.ValidatorIndex{3}))
+	s, err = NewService(ctx, &ValidatorMonitorConfig{BeaconDB: beaconDB}, []primitives.ValidatorIndex{1})
+	require.NoError(t, err)
+	require.DeepEqual(t, []primitives.ValidatorIndex{1, 3}, s.TrackedValidatorIndices())
+}
+
 func TestStart(t *testing.T) {
 	hook := logTest.NewGlobal()
 	s := setupService(t)
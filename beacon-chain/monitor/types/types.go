@@ -0,0 +1,36 @@
+// Package types defines the performance records kept by the validator monitor.
+package types
+
+import (
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+)
+
+// ValidatorEpochPerformance is the performance of a tracked validator during an epoch.
+// Attestation duties are recorded under the target epoch of the attestation, block
+// proposals and sync committee contributions under the epoch of the block.
+type ValidatorEpochPerformance struct {
+	ValidatorIndex primitives.ValidatorIndex
+	Epoch          primitives.Epoch
+	// Attested is set when an attestation of the validator was included in a block.
+	Attested bool
+	// InclusionDistance is the number of slots between the attestation and the block including it.
+	InclusionDistance primitives.Slot
+	CorrectSource     bool
+	CorrectTarget     bool
+	CorrectHead       bool
+	ProposedBlocks    uint64
+	// SyncContributions is the number of sync committee bits set for the validator in the blocks of
+	// the epoch, out of SyncExpected.
+	SyncContributions uint64
+	SyncExpected      uint64
+	Aggregations      uint64
+	// StartBalance and EndBalance are the balances in Gwei of the validator at the first and last
+	// block of the epoch processed by the monitor.
+	StartBalance uint64
+	EndBalance   uint64
+}
+
+// BalanceChange returns the change of the balance in Gwei of the validator over the epoch.
+func (p *ValidatorEpochPerformance) BalanceChange() int64 {
+	return int64(p.EndBalance) - int64(p.StartBalance)
+}
@@ -7,8 +7,10 @@ import (
 	"github.com/theQRL/go-zond/common"
 	"github.com/theQRL/qrysm/v4/cmd"
 	"github.com/theQRL/qrysm/v4/cmd/beacon-chain/flags"
+	"github.com/theQRL/qrysm/v4/config/features"
 	"github.com/theQRL/qrysm/v4/config/params"
 	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/crypto/dilithium"
 	tracing2 "github.com/theQRL/qrysm/v4/monitoring/tracing"
 	"github.com/urfave/cli/v2"
 )
@@ -201,3 +203,10 @@ func configureExecutionSetting(cliCtx *cli.Context) error {
 func configureFastSSZHashingAlgorithm() {
 	fastssz.EnableVectorizedHTR = true
 }
+
+func configureSignatureVerification() {
+	dilithium.SetSignatureVerificationWorkers(flags.Get().SignatureVerificationWorkers)
+	if features.Get().EnableVerifiedSignatureCache {
+		dilithium.SetVerifiedSignatureCacheSize(features.Get().VerifiedSignatureCacheSize)
+	}
+}
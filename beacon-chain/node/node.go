@@ -27,6 +27,7 @@ import (
 	"github.com/theQRL/qrysm/v4/beacon-chain/cache/depositsnapshot"
 	"github.com/theQRL/qrysm/v4/beacon-chain/db"
 	"github.com/theQRL/qrysm/v4/beacon-chain/db/kv"
+	"github.com/theQRL/qrysm/v4/beacon-chain/db/pruner"
 	"github.com/theQRL/qrysm/v4/beacon-chain/db/slasherkv"
 	interopcoldstart "github.com/theQRL/qrysm/v4/beacon-chain/deterministic-genesis"
 	"github.com/theQRL/qrysm/v4/beacon-chain/execution"
@@ -53,12 +54,15 @@ import (
 	"github.com/theQRL/qrysm/v4/beacon-chain/sync/genesis"
 	initialsync "github.com/theQRL/qrysm/v4/beacon-chain/sync/initial-sync"
 	"github.com/theQRL/qrysm/v4/cmd"
+	prunerflags "github.com/theQRL/qrysm/v4/cmd/beacon-chain/db/pruner"
 	"github.com/theQRL/qrysm/v4/cmd/beacon-chain/flags"
 	"github.com/theQRL/qrysm/v4/config/features"
 	"github.com/theQRL/qrysm/v4/config/params"
 	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
 	"github.com/theQRL/qrysm/v4/container/slice"
 	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
+	"github.com/theQRL/qrysm/v4/io/boltcompact"
+	"github.com/theQRL/qrysm/v4/io/file"
 	"github.com/theQRL/qrysm/v4/monitoring/prometheus"
 	"github.com/theQRL/qrysm/v4/runtime"
 	"github.com/theQRL/qrysm/v4/runtime/debug"
@@ -69,6 +73,13 @@ import (
 
 const testSkipPowFlag = "test-skip-pow"
 
+// Values accepted by the --history-retention flag.
+const (
+	historyRetentionArchive       = "archive"
+	historyRetentionFinality      = "finality"
+	historyRetentionBlockRequests = "block-requests"
+)
+
 // Used as a struct to keep cli flag options for configuring services
 // for the beacon node. We keep this as a separate struct to not pollute the actual BeaconNode
 // struct, as it is merely used to pass down configuration options into the appropriate services.
@@ -108,9 +119,11 @@ type BeaconNode struct {
 	serviceFlagOpts         *serviceFlagOpts
 	GenesisInitializer      genesis.Initializer
 	CheckpointInitializer   checkpoint.Initializer
+	DepositSnapshotFetcher  checkpoint.DepositSnapshotFetcher
 	forkChoicer             forkchoice.ForkChoicer
 	clockWaiter             startup.ClockWaiter
 	initialSyncComplete     chan struct{}
+	BackfillOpts            []backfill.ServiceOption
 }
 
 // New creates a new node instance, sets up configuration options, and registers
@@ -157,6 +170,7 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		return nil, err
 	}
 	configureFastSSZHashingAlgorithm()
+	configureSignatureVerification()
 
 	// Initializes any forks here.
 	params.BeaconConfig().InitializeForkSchedule()
@@ -255,7 +269,17 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 	}
 
 	log.Debugln("Registering Sync Service")
-	if err := beacon.registerSyncService(beacon.initialSyncComplete); err != nil {
+	if err := beacon.registerSyncService(beacon.initialSyncComplete, bfs); err != nil {
+		return nil, err
+	}
+
+	log.Debugln("Registering Backfill Service")
+	if err := beacon.registerBackfillService(bfs); err != nil {
+		return nil, err
+	}
+
+	log.Debugln("Registering History Pruner Service")
+	if err := beacon.registerPrunerService(cliCtx, bfs); err != nil {
 		return nil, err
 	}
 
@@ -269,6 +293,11 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	log.Debugln("Registering Validator Monitoring Service")
+	if err := beacon.registerValidatorMonitorService(beacon.initialSyncComplete); err != nil {
+		return nil, err
+	}
+
 	log.Debugln("Registering RPC Service")
 	router := mux.NewRouter()
 	router.Use(middleware)
@@ -281,11 +310,6 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		return nil, err
 	}
 
-	log.Debugln("Registering Validator Monitoring Service")
-	if err := beacon.registerValidatorMonitorService(beacon.initialSyncComplete); err != nil {
-		return nil, err
-	}
-
 	if !cliCtx.Bool(cmd.DisableMonitoringFlag.Name) {
 		log.Debugln("Registering Prometheus Service")
 		if err := beacon.registerPrometheusService(cliCtx); err != nil {
@@ -377,6 +401,16 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 
 	log.WithField("database-path", dbPath).Info("Checking DB")
 
+	if cliCtx.Bool(cmd.CompactDB.Name) && file.FileExists(kv.KVStoreDatafilePath(dbPath)) {
+		if _, err := kv.CompactDatabase(b.ctx, dbPath, &boltcompact.Config{BackupDir: kv.BackupsDir(dbPath)}); err != nil {
+			return errors.Wrap(err, "could not compact database")
+		}
+	} else if cliCtx.String(prunerflags.HistoryRetention.Name) != historyRetentionArchive {
+		if _, err := kv.CompactPrunedDatabase(b.ctx, dbPath); err != nil {
+			return errors.Wrap(err, "could not compact pruned database")
+		}
+	}
+
 	d, err := db.NewDB(b.ctx, dbPath)
 	if err != nil {
 		return err
@@ -514,7 +548,13 @@ func (b *BeaconNode) startSlasherDB(cliCtx *cli.Context) error {
 
 func (b *BeaconNode) startStateGen(ctx context.Context, bfs *backfill.Status, fc forkchoice.ForkChoicer) error {
 	opts := []stategen.StateGenOption{stategen.WithBackfillStatus(bfs)}
+	if features.Get().EnableStateDiffs {
+		opts = append(opts, stategen.WithStateDiffs(primitives.Epoch(b.cliCtx.Uint64(flags.StateDiffSnapshotEpochs.Name))))
+	}
 	sg := stategen.New(b.db, fc, opts...)
+	if err := sg.MigrateToStateDiffs(ctx); err != nil {
+		return errors.Wrap(err, "could not convert archived states to diffs")
+	}
 
 	cp, err := b.db.FinalizedCheckpoint(ctx)
 	if err != nil {
@@ -671,6 +711,22 @@ func (b *BeaconNode) registerPOWChainService() error {
 		execution.WithBeaconNodeStatsUpdater(bs),
 		execution.WithFinalizedStateAtStartup(b.finalizedStateAtStartUp),
 	)
+	if b.DepositSnapshotFetcher != nil {
+		eth1Data, err := b.db.ExecutionChainData(b.ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not retrieve execution chain data")
+		}
+		// The deposit snapshot is only needed to initialize the deposit tree of a new node.
+		if eth1Data == nil {
+			snapshot, err := b.DepositSnapshotFetcher.DepositSnapshot(b.ctx)
+			if err != nil {
+				return errors.Wrap(err, "could not fetch deposit snapshot")
+			}
+			if snapshot != nil {
+				opts = append(opts, execution.WithDepositSnapshot(snapshot))
+			}
+		}
+	}
 	web3Service, err := execution.NewService(b.ctx, opts...)
 	if err != nil {
 		return errors.Wrap(err, "could not register proof-of-work chain web3Service")
@@ -679,7 +735,7 @@ func (b *BeaconNode) registerPOWChainService() error {
 	return b.services.RegisterService(web3Service)
 }
 
-func (b *BeaconNode) registerSyncService(initialSyncComplete chan struct{}) error {
+func (b *BeaconNode) registerSyncService(initialSyncComplete chan struct{}, bfs *backfill.Status) error {
 	var web3Service *execution.Service
 	if err := b.services.FetchService(&web3Service); err != nil {
 		return err
@@ -715,6 +771,7 @@ func (b *BeaconNode) registerSyncService(initialSyncComplete chan struct{}) erro
 		regularsync.WithExecutionPayloadReconstructor(web3Service),
 		regularsync.WithClockWaiter(b.clockWaiter),
 		regularsync.WithInitialSyncComplete(initialSyncComplete),
+		regularsync.WithBackfillStatus(bfs),
 	)
 	return b.services.RegisterService(rs)
 }
@@ -737,6 +794,46 @@ func (b *BeaconNode) registerInitialSyncService(complete chan struct{}) error {
 	return b.services.RegisterService(is)
 }
 
+func (b *BeaconNode) registerBackfillService(bfs *backfill.Status) error {
+	opts := append(b.BackfillOpts,
+		backfill.WithDatabase(b.db),
+		backfill.WithP2P(b.fetchP2P()),
+		backfill.WithClockWaiter(b.clockWaiter),
+		backfill.WithVerifierState(b.finalizedStateAtStartUp),
+		backfill.WithInitialSyncComplete(b.initialSyncComplete),
+	)
+	bf, err := backfill.NewService(b.ctx, bfs, opts...)
+	if err != nil {
+		return errors.Wrap(err, "error initializing backfill service")
+	}
+	return b.services.RegisterService(bf)
+}
+
+func (b *BeaconNode) registerPrunerService(cliCtx *cli.Context, bfs *backfill.Status) error {
+	opts := []pruner.ServiceOption{
+		pruner.WithDatabase(b.db),
+		pruner.WithClockWaiter(b.clockWaiter),
+		pruner.WithPrunedStatus(bfs),
+		pruner.WithBatchSize(cliCtx.Uint64(prunerflags.HistoryPruningBatchSize.Name)),
+	}
+	switch mode := cliCtx.String(prunerflags.HistoryRetention.Name); mode {
+	case historyRetentionArchive:
+		return nil
+	case historyRetentionFinality:
+		opts = append(opts, pruner.WithRetentionEpochs(primitives.Epoch(cliCtx.Uint64(prunerflags.HistoryRetentionEpochs.Name))))
+	case historyRetentionBlockRequests:
+		opts = append(opts, pruner.WithBlockRequestsRetention())
+	default:
+		return fmt.Errorf("unknown --%s value %q, must be one of %s, %s or %s", prunerflags.HistoryRetention.Name, mode,
+			historyRetentionArchive, historyRetentionFinality, historyRetentionBlockRequests)
+	}
+	p, err := pruner.New(b.ctx, opts...)
+	if err != nil {
+		return errors.Wrap(err, "error initializing history pruner service")
+	}
+	return b.services.RegisterService(p)
+}
+
 func (b *BeaconNode) registerSlasherService() error {
 	if !features.Get().EnableSlasher {
 		return nil
@@ -749,6 +846,14 @@ func (b *BeaconNode) registerSlasherService() error {
 	if err := b.services.FetchService(&syncService); err != nil {
 		return err
 	}
+	slasherParams, err := slasher.NewParams(
+		b.cliCtx.Uint64(flags.SlasherChunkSize.Name),
+		b.cliCtx.Uint64(flags.SlasherValidatorChunkSize.Name),
+		primitives.Epoch(b.cliCtx.Uint64(flags.SlasherHistoryLength.Name)),
+	)
+	if err != nil {
+		return errors.Wrap(err, "invalid slasher parameters")
+	}
 
 	slasherSrv, err := slasher.New(b.ctx, &slasher.ServiceConfig{
 		IndexedAttestationsFeed: b.slasherAttestationsFeed,
@@ -761,6 +866,7 @@ func (b *BeaconNode) registerSlasherService() error {
 		SyncChecker:             syncService,
 		HeadStateFetcher:        chainService,
 		ClockWaiter:             b.clockWaiter,
+		Params:                  slasherParams,
 	})
 	if err != nil {
 		return err
@@ -785,10 +891,17 @@ func (b *BeaconNode) registerRPCService(router *mux.Router) error {
 	}
 
 	var slasherService *slasher.Service
+	var slasherEvidenceFetcher slasher.EvidenceFetcher
 	if features.Get().EnableSlasher {
 		if err := b.services.FetchService(&slasherService); err != nil {
 			return err
 		}
+		slasherEvidenceFetcher = slasherService
+	}
+
+	var monitorService *monitor.Service
+	if err := b.services.FetchService(&monitorService); err != nil {
+		return err
 	}
 
 	genesisValidators := b.cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name)
@@ -843,11 +956,14 @@ func (b *BeaconNode) registerRPCService(router *mux.Router) error {
 		GenesisTimeFetcher:            chainService,
 		GenesisFetcher:                chainService,
 		OptimisticModeFetcher:         chainService,
+		LightClientFetcher:            chainService,
 		AttestationsPool:              b.attestationPool,
 		ExitPool:                      b.exitPool,
 		SlashingsPool:                 b.slashingsPool,
 		DilithiumChangesPool:          b.dilithiumToExecPool,
 		SlashingChecker:               slasherService,
+		SlasherEvidenceFetcher:        slasherEvidenceFetcher,
+		ValidatorMonitor:              monitorService,
 		SyncCommitteeObjectPool:       b.syncCommitteePool,
 		ExecutionChainService:         web3Service,
 		ExecutionChainInfoFetcher:     web3Service,
@@ -968,9 +1084,6 @@ func (b *BeaconNode) registerDeterministicGenesisService() error {
 
 func (b *BeaconNode) registerValidatorMonitorService(initialSyncComplete chan struct{}) error {
 	cliSlice := b.cliCtx.IntSlice(cmd.ValidatorMonitorIndicesFlag.Name)
-	if cliSlice == nil {
-		return nil
-	}
 	tracked := make([]primitives.ValidatorIndex, len(cliSlice))
 	for i := range tracked {
 		tracked[i] = primitives.ValidatorIndex(cliSlice[i])
@@ -985,6 +1098,7 @@ func (b *BeaconNode) registerValidatorMonitorService(initialSyncComplete chan st
 		AttestationNotifier: b,
 		StateGen:            b.stateGen,
 		HeadFetcher:         chainService,
+		BeaconDB:            b.db,
 		InitialSyncComplete: initialSyncComplete,
 	}
 	svc, err := monitor.NewService(b.ctx, monitorConfig, tracked)
@@ -1002,6 +1116,7 @@ func (b *BeaconNode) registerBuilderService(cliCtx *cli.Context) error {
 
 	opts := append(b.serviceFlagOpts.builderOpts,
 		builder.WithHeadFetcher(chainService),
+		builder.WithTimeFetcher(chainService),
 		builder.WithDatabase(b.db))
 	// make cache the default.
 	if !cliCtx.Bool(features.DisableRegistrationCache.Name) {
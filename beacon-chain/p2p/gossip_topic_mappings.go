@@ -6,6 +6,7 @@ import (
 	"github.com/theQRL/qrysm/v4/config/params"
 	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
 	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	zondpbv2 "github.com/theQRL/qrysm/v4/proto/zond/v2"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -22,6 +23,8 @@ var gossipTopicMappings = map[string]proto.Message{
 	SyncCommitteeSubnetTopicFormat:              &zondpb.SyncCommitteeMessage{},
 	DilithiumToExecutionChangeSubnetTopicFormat: &zondpb.SignedDilithiumToExecutionChange{},
 	BlobSubnetTopicFormat:                       &zondpb.SignedBlobSidecar{},
+	LightClientFinalityUpdateTopicFormat:        &zondpbv2.LightClientFinalityUpdate{},
+	LightClientOptimisticUpdateTopicFormat:      &zondpbv2.LightClientOptimisticUpdate{},
 }
 
 // GossipTopicMappings is a function to return the assigned data type
@@ -300,6 +300,10 @@ func receiveEvents(eventChan <-chan *sse.Event, w http.ResponseWriter, req *http
 				data = &SignedContributionAndProofJson{}
 			case events.DilithiumToExecutionChangeTopic:
 				data = &SignedDilithiumToExecutionChangeJson{}
+			case events.LightClientFinalityUpdateTopic:
+				data = &EventLightClientFinalityUpdateJson{}
+			case events.LightClientOptimisticUpdateTopic:
+				data = &EventLightClientOptimisticUpdateJson{}
 			case events.PayloadAttributesTopic:
 				dataSubset := &dataSubset{}
 				if err := json.Unmarshal(msg.Data, dataSubset); err != nil {
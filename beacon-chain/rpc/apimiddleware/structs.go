@@ -678,9 +678,10 @@ type AttesterSlashingJson struct {
 }
 
 type IndexedAttestationJson struct {
-	AttestingIndices []string             `json:"attesting_indices"`
-	Data             *AttestationDataJson `json:"data"`
-	Signature        string               `json:"signature" hex:"true"`
+	AttestingIndices        []string             `json:"attesting_indices"`
+	Data                    *AttestationDataJson `json:"data"`
+	Signature               string               `json:"signature" hex:"true"`
+	SignatureValidatorIndex []string             `json:"signature_validator_index"`
 }
 
 type AttestationJson struct {
@@ -1176,6 +1177,30 @@ type PayloadAttributesV2Json struct {
 	Withdrawals           []*WithdrawalJson `json:"withdrawals"`
 }
 
+type EventLightClientFinalityUpdateJson struct {
+	Version string                         `json:"version" enum:"true"`
+	Data    *LightClientFinalityUpdateJson `json:"data"`
+}
+
+type LightClientFinalityUpdateJson struct {
+	AttestedHeader  *BeaconBlockHeaderJson `json:"attested_header"`
+	FinalizedHeader *BeaconBlockHeaderJson `json:"finalized_header"`
+	FinalityBranch  []string               `json:"finality_branch" hex:"true"`
+	SyncAggregate   *SyncAggregateJson     `json:"sync_aggregate"`
+	SignatureSlot   string                 `json:"signature_slot"`
+}
+
+type EventLightClientOptimisticUpdateJson struct {
+	Version string                           `json:"version" enum:"true"`
+	Data    *LightClientOptimisticUpdateJson `json:"data"`
+}
+
+type LightClientOptimisticUpdateJson struct {
+	AttestedHeader *BeaconBlockHeaderJson `json:"attested_header"`
+	SyncAggregate  *SyncAggregateJson     `json:"sync_aggregate"`
+	SignatureSlot  string                 `json:"signature_slot"`
+}
+
 // ---------------
 // Error handling.
 // ---------------
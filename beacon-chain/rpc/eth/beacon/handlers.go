@@ -19,6 +19,7 @@ import (
 	"github.com/theQRL/qrysm/v4/beacon-chain/db/filters"
 	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/helpers"
 	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/theQRL/qrysm/v4/config/features"
 	fieldparams "github.com/theQRL/qrysm/v4/config/fieldparams"
 	"github.com/theQRL/qrysm/v4/config/params"
 	"github.com/theQRL/qrysm/v4/consensus-types/blocks"
@@ -1027,3 +1028,46 @@ func (s *Server) GetGenesis(w http.ResponseWriter, r *http.Request) {
 	}
 	http2.WriteJson(w, resp)
 }
+
+// GetDepositSnapshot retrieves the EIP-4881 deposit tree snapshot of the finalized deposits.
+func (s *Server) GetDepositSnapshot(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetDepositSnapshot")
+	defer span.End()
+
+	if !features.Get().EnableEIP4881 {
+		http2.HandleError(w, "Deposit snapshots are not supported without EIP-4881 deposit trees", http.StatusNotFound)
+		return
+	}
+	eth1Data, err := s.BeaconDB.ExecutionChainData(ctx)
+	if err != nil {
+		http2.HandleError(w, "Could not retrieve execution chain data: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if eth1Data == nil || eth1Data.DepositSnapshot == nil || eth1Data.DepositSnapshot.DepositCount == 0 {
+		http2.HandleError(w, "No finalized deposit snapshot available", http.StatusNotFound)
+		return
+	}
+	snapshot := eth1Data.DepositSnapshot
+	if http2.SszRequested(r) {
+		sszResp, err := snapshot.MarshalSSZ()
+		if err != nil {
+			http2.HandleError(w, "Could not marshal deposit snapshot into SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http2.WriteSsz(w, sszResp, "deposit_snapshot.ssz")
+		return
+	}
+	finalized := make([]string, len(snapshot.Finalized))
+	for i, f := range snapshot.Finalized {
+		finalized[i] = hexutil.Encode(f)
+	}
+	http2.WriteJson(w, &GetDepositSnapshotResponse{
+		Data: &DepositSnapshot{
+			Finalized:            finalized,
+			DepositRoot:          hexutil.Encode(snapshot.DepositRoot),
+			DepositCount:         strconv.FormatUint(snapshot.DepositCount, 10),
+			ExecutionBlockHash:   hexutil.Encode(snapshot.ExecutionHash),
+			ExecutionBlockHeight: strconv.FormatUint(snapshot.ExecutionDepth, 10),
+		},
+	})
+}
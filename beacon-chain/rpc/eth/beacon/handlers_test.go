@@ -19,6 +19,7 @@ import (
 	"github.com/theQRL/go-zond/common/hexutil"
 	"github.com/theQRL/qrysm/v4/api"
 	chainMock "github.com/theQRL/qrysm/v4/beacon-chain/blockchain/testing"
+	"github.com/theQRL/qrysm/v4/beacon-chain/cache/depositsnapshot"
 	"github.com/theQRL/qrysm/v4/beacon-chain/core/transition"
 	dbTest "github.com/theQRL/qrysm/v4/beacon-chain/db/testing"
 	doublylinkedtree "github.com/theQRL/qrysm/v4/beacon-chain/forkchoice/doubly-linked-tree"
@@ -27,6 +28,7 @@ import (
 	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/testutil"
 	"github.com/theQRL/qrysm/v4/beacon-chain/state"
 	mockSync "github.com/theQRL/qrysm/v4/beacon-chain/sync/initial-sync/testing"
+	"github.com/theQRL/qrysm/v4/config/features"
 	"github.com/theQRL/qrysm/v4/config/params"
 	"github.com/theQRL/qrysm/v4/consensus-types/blocks"
 	"github.com/theQRL/qrysm/v4/consensus-types/interfaces"
@@ -2186,3 +2188,73 @@ func TestGetDepositContract(t *testing.T) {
 	assert.Equal(t, "10", response.Data.ChainId)
 	assert.Equal(t, "0x4242424242424242424242424242424242424242", response.Data.Address)
 }
+
+func TestGetDepositSnapshot(t *testing.T) {
+	resetFn := features.InitWithReset(&features.Flags{
+		EnableEIP4881: true,
+	})
+	defer resetFn()
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	s := Server{BeaconDB: beaconDB}
+
+	t.Run("no snapshot", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "/zond/v1/beacon/deposit_snapshot", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetDepositSnapshot(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+
+	tree := depositsnapshot.NewDepositTree()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, tree.Insert(bytesutil.PadTo([]byte{byte(i + 1)}, 32), i))
+	}
+	require.NoError(t, tree.Finalize(2, [32]byte{'a'}, 10))
+	snapshot, err := tree.ToProto()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveExecutionChainData(ctx, &zond.ETH1ChainData{DepositSnapshot: snapshot}))
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "/zond/v1/beacon/deposit_snapshot", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetDepositSnapshot(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &GetDepositSnapshotResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.NotNil(t, resp.Data)
+		require.Equal(t, len(snapshot.Finalized), len(resp.Data.Finalized))
+		for i, f := range snapshot.Finalized {
+			assert.Equal(t, hexutil.Encode(f), resp.Data.Finalized[i])
+		}
+		assert.Equal(t, hexutil.Encode(snapshot.DepositRoot), resp.Data.DepositRoot)
+		assert.Equal(t, "3", resp.Data.DepositCount)
+		assert.Equal(t, hexutil.Encode(snapshot.ExecutionHash), resp.Data.ExecutionBlockHash)
+		assert.Equal(t, "10", resp.Data.ExecutionBlockHeight)
+	})
+	t.Run("ssz", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "/zond/v1/beacon/deposit_snapshot", nil)
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetDepositSnapshot(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		got := &zond.DepositSnapshot{}
+		require.NoError(t, got.UnmarshalSSZ(writer.Body.Bytes()))
+		assert.DeepEqual(t, snapshot, got)
+	})
+	t.Run("EIP-4881 disabled", func(t *testing.T) {
+		resetFn := features.InitWithReset(&features.Flags{})
+		defer resetFn()
+		request := httptest.NewRequest(http.MethodGet, "/zond/v1/beacon/deposit_snapshot", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetDepositSnapshot(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+}
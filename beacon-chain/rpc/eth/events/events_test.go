@@ -25,6 +25,7 @@ import (
 	"github.com/theQRL/qrysm/v4/proto/migration"
 	zond "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
 	zondpb "github.com/theQRL/qrysm/v4/proto/zond/v1"
+	zondpbv2 "github.com/theQRL/qrysm/v4/proto/zond/v2"
 	"github.com/theQRL/qrysm/v4/runtime/version"
 	"github.com/theQRL/qrysm/v4/testing/assert"
 	"github.com/theQRL/qrysm/v4/testing/mock"
@@ -633,6 +634,71 @@ func TestStreamEvents_StateEvents(t *testing.T) {
 			feed: srv.StateNotifier.StateFeed(),
 		})
 	})
+	t.Run(LightClientFinalityUpdateTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedUpdate := &zondpbv2.LightClientFinalityUpdateWithVersion{
+			Version: zondpbv2.Version_CAPELLA,
+			Data: &zondpbv2.LightClientFinalityUpdate{
+				AttestedHeader:  &zondpb.BeaconBlockHeader{Slot: 8},
+				FinalizedHeader: &zondpb.BeaconBlockHeader{Slot: 1},
+				SignatureSlot:   9,
+			},
+		}
+		genericResponse, err := anypb.New(wantedUpdate)
+		require.NoError(t, err)
+		wantedMessage := &gateway.EventSource{
+			Event: LightClientFinalityUpdateTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{LightClientFinalityUpdateTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: statefeed.LightClientFinalityUpdate,
+				Data: wantedUpdate,
+			},
+			feed: srv.StateNotifier.StateFeed(),
+		})
+	})
+	t.Run(LightClientOptimisticUpdateTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedUpdate := &zondpbv2.LightClientOptimisticUpdateWithVersion{
+			Version: zondpbv2.Version_CAPELLA,
+			Data: &zondpbv2.LightClientOptimisticUpdate{
+				AttestedHeader: &zondpb.BeaconBlockHeader{Slot: 8},
+				SignatureSlot:  9,
+			},
+		}
+		genericResponse, err := anypb.New(wantedUpdate)
+		require.NoError(t, err)
+		wantedMessage := &gateway.EventSource{
+			Event: LightClientOptimisticUpdateTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{LightClientOptimisticUpdateTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: statefeed.LightClientOptimisticUpdate,
+				Data: wantedUpdate,
+			},
+			feed: srv.StateNotifier.StateFeed(),
+		})
+	})
 }
 
 func TestStreamEvents_CommaSeparatedTopics(t *testing.T) {
@@ -0,0 +1,248 @@
+package lightclient
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/theQRL/go-zond/common/hexutil"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/theQRL/qrysm/v4/config/params"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	http2 "github.com/theQRL/qrysm/v4/network/http"
+	"github.com/theQRL/qrysm/v4/proto/migration"
+	zondpbv1 "github.com/theQRL/qrysm/v4/proto/zond/v1"
+	zondpbv2 "github.com/theQRL/qrysm/v4/proto/zond/v2"
+	"github.com/theQRL/qrysm/v4/runtime/version"
+	"github.com/theQRL/qrysm/v4/time/slots"
+	"go.opencensus.io/trace"
+)
+
+// MaxRequestLightClientUpdates is the maximum number of light client updates which can be requested at once.
+const MaxRequestLightClientUpdates = 128
+
+// GetLightClientBootstrap is an HTTP handler for Beacon API getLightClientBootstrap.
+func (s *Server) GetLightClientBootstrap(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "lightclient.GetLightClientBootstrap")
+	defer span.End()
+
+	rawRoot := mux.Vars(r)["block_root"]
+	if _, valid := shared.ValidateHex(w, "block_root", rawRoot, 32); !valid {
+		return
+	}
+	blk, err := s.Blocker.Block(ctx, []byte(rawRoot))
+	if !shared.WriteBlockFetchError(w, blk, err) {
+		return
+	}
+	if blk.Version() < version.Altair {
+		http2.HandleError(w, "light client bootstrap is not supported before Altair fork", http.StatusBadRequest)
+		return
+	}
+	header, err := blk.Header()
+	if err != nil {
+		http2.HandleError(w, errors.Wrap(err, "could not get block header").Error(), http.StatusInternalServerError)
+		return
+	}
+	stateRoot := blk.Block().StateRoot()
+	st, err := s.Stater.State(ctx, []byte(hexutil.Encode(stateRoot[:])))
+	if err != nil {
+		http2.HandleError(w, errors.Wrap(err, "could not get state").Error(), http.StatusInternalServerError)
+		return
+	}
+	if st == nil || st.IsNil() {
+		http2.HandleError(w, "could not find state for block", http.StatusNotFound)
+		return
+	}
+	committee, err := st.CurrentSyncCommittee()
+	if err != nil {
+		http2.HandleError(w, errors.Wrap(err, "could not get current sync committee").Error(), http.StatusInternalServerError)
+		return
+	}
+	branch, err := st.CurrentSyncCommitteeProof(ctx)
+	if err != nil {
+		http2.HandleError(w, errors.Wrap(err, "could not get current sync committee proof").Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http2.WriteJson(w, &LightClientBootstrapResponse{
+		Version: version.String(blk.Version()),
+		Data: &LightClientBootstrap{
+			Header: headerFromConsensus(migration.V1Alpha1SignedHeaderToV1(header).Message),
+			CurrentSyncCommittee: &SyncCommittee{
+				Pubkeys:         encodeBytes(committee.Pubkeys),
+				AggregatePubkey: hexutil.Encode(committee.AggregatePubkey),
+			},
+			CurrentSyncCommitteeBranch: encodeBytes(branch),
+		},
+	})
+}
+
+// GetLightClientUpdatesByRange is an HTTP handler for Beacon API getLightClientUpdatesByRange.
+func (s *Server) GetLightClientUpdatesByRange(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "lightclient.GetLightClientUpdatesByRange")
+	defer span.End()
+
+	startPeriod, valid := shared.ValidateUint(w, "start_period", r.URL.Query().Get("start_period"))
+	if !valid {
+		return
+	}
+	count, valid := shared.ValidateUint(w, "count", r.URL.Query().Get("count"))
+	if !valid {
+		return
+	}
+	if count == 0 {
+		http2.HandleError(w, "count must be greater than 0", http.StatusBadRequest)
+		return
+	}
+	if count > MaxRequestLightClientUpdates {
+		count = MaxRequestLightClientUpdates
+	}
+	endPeriod := startPeriod + count - 1
+	if endPeriod < startPeriod {
+		http2.HandleError(w, fmt.Sprintf("start_period %d and count %d overflow", startPeriod, count), http.StatusBadRequest)
+		return
+	}
+
+	updates, err := s.BeaconDB.LightClientUpdates(ctx, startPeriod, endPeriod)
+	if err != nil {
+		http2.HandleError(w, errors.Wrap(err, "could not get light client updates").Error(), http.StatusInternalServerError)
+		return
+	}
+	resp := make([]*LightClientUpdateWithVersion, len(updates))
+	for i, u := range updates {
+		resp[i] = &LightClientUpdateWithVersion{
+			Version: version.String(versionAtSlot(u.AttestedHeader.Slot)),
+			Data:    updateFromConsensus(u),
+		}
+	}
+	http2.WriteJson(w, resp)
+}
+
+// GetLightClientFinalityUpdate is an HTTP handler for Beacon API getLightClientFinalityUpdate.
+func (s *Server) GetLightClientFinalityUpdate(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "lightclient.GetLightClientFinalityUpdate")
+	defer span.End()
+
+	update := s.LightClientFetcher.LightClientFinalityUpdate()
+	if update == nil {
+		http2.HandleError(w, "no light client finality update is available", http.StatusNotFound)
+		return
+	}
+	if http2.SszRequested(r) {
+		sszResp, err := update.MarshalSSZ()
+		if err != nil {
+			http2.HandleError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http2.WriteSsz(w, sszResp, "light_client_finality_update.ssz")
+		return
+	}
+	http2.WriteJson(w, &LightClientFinalityUpdateResponse{
+		Version: version.String(versionAtSlot(update.AttestedHeader.Slot)),
+		Data: &LightClientFinalityUpdate{
+			AttestedHeader:  headerFromConsensus(update.AttestedHeader),
+			FinalizedHeader: headerFromConsensus(update.FinalizedHeader),
+			FinalityBranch:  encodeBytes(update.FinalityBranch),
+			SyncAggregate:   syncAggregateFromConsensus(update.SyncAggregate),
+			SignatureSlot:   strconv.FormatUint(uint64(update.SignatureSlot), 10),
+		},
+	})
+}
+
+// GetLightClientOptimisticUpdate is an HTTP handler for Beacon API getLightClientOptimisticUpdate.
+func (s *Server) GetLightClientOptimisticUpdate(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "lightclient.GetLightClientOptimisticUpdate")
+	defer span.End()
+
+	update := s.LightClientFetcher.LightClientOptimisticUpdate()
+	if update == nil {
+		http2.HandleError(w, "no light client optimistic update is available", http.StatusNotFound)
+		return
+	}
+	if http2.SszRequested(r) {
+		sszResp, err := update.MarshalSSZ()
+		if err != nil {
+			http2.HandleError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http2.WriteSsz(w, sszResp, "light_client_optimistic_update.ssz")
+		return
+	}
+	http2.WriteJson(w, &LightClientOptimisticUpdateResponse{
+		Version: version.String(versionAtSlot(update.AttestedHeader.Slot)),
+		Data: &LightClientOptimisticUpdate{
+			AttestedHeader: headerFromConsensus(update.AttestedHeader),
+			SyncAggregate:  syncAggregateFromConsensus(update.SyncAggregate),
+			SignatureSlot:  strconv.FormatUint(uint64(update.SignatureSlot), 10),
+		},
+	})
+}
+
+// versionAtSlot returns the fork version which is active at the given slot.
+func versionAtSlot(slot primitives.Slot) int {
+	epoch := slots.ToEpoch(slot)
+	cfg := params.BeaconConfig()
+	switch {
+	case epoch >= cfg.DenebForkEpoch:
+		return version.Deneb
+	case epoch >= cfg.CapellaForkEpoch:
+		return version.Capella
+	case epoch >= cfg.BellatrixForkEpoch:
+		return version.Bellatrix
+	case epoch >= cfg.AltairForkEpoch:
+		return version.Altair
+	default:
+		return version.Phase0
+	}
+}
+
+func updateFromConsensus(u *zondpbv2.LightClientUpdate) *LightClientUpdate {
+	update := &LightClientUpdate{
+		AttestedHeader:          headerFromConsensus(u.AttestedHeader),
+		NextSyncCommitteeBranch: encodeBytes(u.NextSyncCommitteeBranch),
+		FinalizedHeader:         headerFromConsensus(u.FinalizedHeader),
+		FinalityBranch:          encodeBytes(u.FinalityBranch),
+		SyncAggregate:           syncAggregateFromConsensus(u.SyncAggregate),
+		SignatureSlot:           strconv.FormatUint(uint64(u.SignatureSlot), 10),
+	}
+	if u.NextSyncCommittee != nil {
+		update.NextSyncCommittee = &SyncCommittee{
+			Pubkeys:         encodeBytes(u.NextSyncCommittee.Pubkeys),
+			AggregatePubkey: hexutil.Encode(u.NextSyncCommittee.AggregatePubkey),
+		}
+	}
+	return update
+}
+
+func headerFromConsensus(h *zondpbv1.BeaconBlockHeader) *shared.BeaconBlockHeader {
+	if h == nil {
+		return nil
+	}
+	return &shared.BeaconBlockHeader{
+		Slot:          strconv.FormatUint(uint64(h.Slot), 10),
+		ProposerIndex: strconv.FormatUint(uint64(h.ProposerIndex), 10),
+		ParentRoot:    hexutil.Encode(h.ParentRoot),
+		StateRoot:     hexutil.Encode(h.StateRoot),
+		BodyRoot:      hexutil.Encode(h.BodyRoot),
+	}
+}
+
+func syncAggregateFromConsensus(a *zondpbv1.SyncAggregate) *shared.SyncAggregate {
+	if a == nil {
+		return nil
+	}
+	return &shared.SyncAggregate{
+		SyncCommitteeBits:      hexutil.Encode(a.SyncCommitteeBits),
+		SyncCommitteeSignature: hexutil.Encode(a.SyncCommitteeSignature),
+	}
+}
+
+func encodeBytes(b [][]byte) []string {
+	encoded := make([]string, len(b))
+	for i := range b {
+		encoded[i] = hexutil.Encode(b[i])
+	}
+	return encoded
+}
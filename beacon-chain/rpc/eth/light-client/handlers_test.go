@@ -0,0 +1,215 @@
+package lightclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/theQRL/go-zond/common/hexutil"
+	mock "github.com/theQRL/qrysm/v4/beacon-chain/blockchain/testing"
+	testDB "github.com/theQRL/qrysm/v4/beacon-chain/db/testing"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/testutil"
+	"github.com/theQRL/qrysm/v4/consensus-types/blocks"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	http2 "github.com/theQRL/qrysm/v4/network/http"
+	zondpbv1 "github.com/theQRL/qrysm/v4/proto/zond/v1"
+	zondpbv2 "github.com/theQRL/qrysm/v4/proto/zond/v2"
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+	"github.com/theQRL/qrysm/v4/testing/util"
+)
+
+func testHeader(slot primitives.Slot) *zondpbv1.BeaconBlockHeader {
+	return &zondpbv1.BeaconBlockHeader{
+		Slot:       slot,
+		ParentRoot: make([]byte, 32),
+		StateRoot:  make([]byte, 32),
+		BodyRoot:   make([]byte, 32),
+	}
+}
+
+func testBranch(depth int) [][]byte {
+	branch := make([][]byte, depth)
+	for i := range branch {
+		branch[i] = make([]byte, 32)
+	}
+	return branch
+}
+
+func testSyncAggregate() *zondpbv1.SyncAggregate {
+	return &zondpbv1.SyncAggregate{
+		SyncCommitteeBits:      []byte{0xff, 0xff},
+		SyncCommitteeSignature: make([]byte, 73520),
+	}
+}
+
+func testUpdate(attestedSlot primitives.Slot) *zondpbv2.LightClientUpdate {
+	return &zondpbv2.LightClientUpdate{
+		AttestedHeader:          testHeader(attestedSlot),
+		NextSyncCommitteeBranch: testBranch(5),
+		FinalizedHeader:         testHeader(attestedSlot - 1),
+		FinalityBranch:          testBranch(6),
+		SyncAggregate:           testSyncAggregate(),
+		SignatureSlot:           attestedSlot + 1,
+	}
+}
+
+func TestGetLightClientBootstrap(t *testing.T) {
+	st, err := util.NewBeaconStateCapella()
+	require.NoError(t, err)
+	b := util.NewBeaconBlockCapella()
+	b.Block.Slot = 10
+	blk, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	s := &Server{
+		Blocker: &testutil.MockBlocker{BlockToReturn: blk},
+		Stater:  &testutil.MockStater{BeaconState: st},
+	}
+
+	request := httptest.NewRequest("GET", "http://foo.com/zond/v1/beacon/light_client/bootstrap/{block_root}", nil)
+	request = mux.SetURLVars(request, map[string]string{"block_root": hexutil.Encode(make([]byte, 32))})
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetLightClientBootstrap(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &LightClientBootstrapResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, "capella", resp.Version)
+	assert.Equal(t, "10", resp.Data.Header.Slot)
+	committee, err := st.CurrentSyncCommittee()
+	require.NoError(t, err)
+	assert.Equal(t, len(committee.Pubkeys), len(resp.Data.CurrentSyncCommittee.Pubkeys))
+	assert.Equal(t, hexutil.Encode(committee.AggregatePubkey), resp.Data.CurrentSyncCommittee.AggregatePubkey)
+	assert.Equal(t, 5, len(resp.Data.CurrentSyncCommitteeBranch))
+}
+
+func TestGetLightClientUpdatesByRange(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	for period := uint64(1); period <= 3; period++ {
+		require.NoError(t, db.SaveLightClientUpdate(ctx, period, testUpdate(primitives.Slot(period*100))))
+	}
+	s := &Server{BeaconDB: db}
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest("GET", "http://foo.com/zond/v1/beacon/light_client/updates?start_period=2&count=5", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientUpdatesByRange(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		var resp []*LightClientUpdateWithVersion
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &resp))
+		require.Equal(t, 2, len(resp))
+		assert.Equal(t, "200", resp[0].Data.AttestedHeader.Slot)
+		assert.Equal(t, "300", resp[1].Data.AttestedHeader.Slot)
+		assert.Equal(t, "301", resp[1].Data.SignatureSlot)
+	})
+	t.Run("no count", func(t *testing.T) {
+		request := httptest.NewRequest("GET", "http://foo.com/zond/v1/beacon/light_client/updates?start_period=2", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientUpdatesByRange(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "count is required", e.Message)
+	})
+	t.Run("zero count", func(t *testing.T) {
+		request := httptest.NewRequest("GET", "http://foo.com/zond/v1/beacon/light_client/updates?start_period=2&count=0", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientUpdatesByRange(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "count must be greater than 0", e.Message)
+	})
+}
+
+func TestGetLightClientFinalityUpdate(t *testing.T) {
+	update := &zondpbv2.LightClientFinalityUpdate{
+		AttestedHeader:  testHeader(10),
+		FinalizedHeader: testHeader(8),
+		FinalityBranch:  testBranch(6),
+		SyncAggregate:   testSyncAggregate(),
+		SignatureSlot:   11,
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		s := &Server{LightClientFetcher: &mock.ChainService{FinalityUpdate: update}}
+		request := httptest.NewRequest("GET", "http://foo.com/zond/v1/beacon/light_client/finality_update", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientFinalityUpdate(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &LightClientFinalityUpdateResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "10", resp.Data.AttestedHeader.Slot)
+		assert.Equal(t, "8", resp.Data.FinalizedHeader.Slot)
+		assert.Equal(t, 6, len(resp.Data.FinalityBranch))
+		assert.Equal(t, "11", resp.Data.SignatureSlot)
+	})
+	t.Run("ssz", func(t *testing.T) {
+		s := &Server{LightClientFetcher: &mock.ChainService{FinalityUpdate: update}}
+		request := httptest.NewRequest("GET", "http://foo.com/zond/v1/beacon/light_client/finality_update", nil)
+		request.Header.Set("Accept", "application/octet-stream")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientFinalityUpdate(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		expected, err := update.MarshalSSZ()
+		require.NoError(t, err)
+		assert.DeepEqual(t, expected, writer.Body.Bytes())
+	})
+	t.Run("not available", func(t *testing.T) {
+		s := &Server{LightClientFetcher: &mock.ChainService{}}
+		request := httptest.NewRequest("GET", "http://foo.com/zond/v1/beacon/light_client/finality_update", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientFinalityUpdate(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+}
+
+func TestGetLightClientOptimisticUpdate(t *testing.T) {
+	update := &zondpbv2.LightClientOptimisticUpdate{
+		AttestedHeader: testHeader(10),
+		SyncAggregate:  testSyncAggregate(),
+		SignatureSlot:  11,
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		s := &Server{LightClientFetcher: &mock.ChainService{OptimisticUpdate: update}}
+		request := httptest.NewRequest("GET", "http://foo.com/zond/v1/beacon/light_client/optimistic_update", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientOptimisticUpdate(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &LightClientOptimisticUpdateResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "10", resp.Data.AttestedHeader.Slot)
+		assert.Equal(t, hexutil.Encode(update.SyncAggregate.SyncCommitteeBits), resp.Data.SyncAggregate.SyncCommitteeBits)
+		assert.Equal(t, "11", resp.Data.SignatureSlot)
+	})
+	t.Run("not available", func(t *testing.T) {
+		s := &Server{LightClientFetcher: &mock.ChainService{}}
+		request := httptest.NewRequest("GET", "http://foo.com/zond/v1/beacon/light_client/optimistic_update", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientOptimisticUpdate(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+}
@@ -0,0 +1,14 @@
+package lightclient
+
+import (
+	"github.com/theQRL/qrysm/v4/beacon-chain/blockchain"
+	"github.com/theQRL/qrysm/v4/beacon-chain/db"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/lookup"
+)
+
+type Server struct {
+	Blocker            lookup.Blocker
+	Stater             lookup.Stater
+	BeaconDB           db.ReadOnlyDatabase
+	LightClientFetcher blockchain.LightClientFetcher
+}
@@ -0,0 +1,133 @@
+package debug
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/emicklei/dot"
+	"github.com/theQRL/go-zond/common/hexutil"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/shared"
+	zondpbv1 "github.com/theQRL/qrysm/v4/proto/zond/v1"
+)
+
+// Node fill colors by execution validity, as shown in the DOT rendering of the graph.
+var validityColors = map[string]string{
+	zondpbv1.ForkChoiceNodeValidity_VALID.String():      "palegreen",
+	zondpbv1.ForkChoiceNodeValidity_OPTIMISTIC.String(): "gold",
+	zondpbv1.ForkChoiceNodeValidity_INVALID.String():    "tomato",
+}
+
+func forkChoiceGraphFromDump(dump *zondpbv1.ForkChoiceDump) *ForkChoiceGraph {
+	headRoot := hexutil.Encode(dump.HeadRoot)
+	boostRoot := hexutil.Encode(dump.ProposerBoostRoot)
+	previousBoostRoot := hexutil.Encode(dump.PreviousProposerBoostRoot)
+	nodes := make([]*ForkChoiceGraphNode, len(dump.ForkChoiceNodes))
+	for i, n := range dump.ForkChoiceNodes {
+		root := hexutil.Encode(n.BlockRoot)
+		nodes[i] = &ForkChoiceGraphNode{
+			Slot:                     fmt.Sprintf("%d", n.Slot),
+			BlockRoot:                root,
+			ParentRoot:               hexutil.Encode(n.ParentRoot),
+			JustifiedEpoch:           fmt.Sprintf("%d", n.JustifiedEpoch),
+			FinalizedEpoch:           fmt.Sprintf("%d", n.FinalizedEpoch),
+			UnrealizedJustifiedEpoch: fmt.Sprintf("%d", n.UnrealizedJustifiedEpoch),
+			UnrealizedFinalizedEpoch: fmt.Sprintf("%d", n.UnrealizedFinalizedEpoch),
+			Balance:                  fmt.Sprintf("%d", n.Balance),
+			Weight:                   fmt.Sprintf("%d", n.Weight),
+			ExecutionOptimistic:      n.ExecutionOptimistic,
+			ExecutionBlockHash:       hexutil.Encode(n.ExecutionBlockHash),
+			Timestamp:                fmt.Sprintf("%d", n.Timestamp),
+			Validity:                 n.Validity.String(),
+			Head:                     root == headRoot,
+			ProposerBoost:            root == boostRoot,
+			PreviousProposerBoost:    root == previousBoostRoot,
+		}
+	}
+	return &ForkChoiceGraph{
+		JustifiedCheckpoint:           checkpointFromDump(dump.JustifiedCheckpoint),
+		FinalizedCheckpoint:           checkpointFromDump(dump.FinalizedCheckpoint),
+		UnrealizedJustifiedCheckpoint: checkpointFromDump(dump.UnrealizedJustifiedCheckpoint),
+		UnrealizedFinalizedCheckpoint: checkpointFromDump(dump.UnrealizedFinalizedCheckpoint),
+		ProposerBoostRoot:             boostRoot,
+		PreviousProposerBoostRoot:     previousBoostRoot,
+		HeadRoot:                      headRoot,
+		Nodes:                         nodes,
+	}
+}
+
+func checkpointFromDump(cp *zondpbv1.Checkpoint) *shared.Checkpoint {
+	if cp == nil {
+		return nil
+	}
+	return &shared.Checkpoint{
+		Epoch: fmt.Sprintf("%d", cp.Epoch),
+		Root:  hexutil.Encode(cp.Root),
+	}
+}
+
+// DOT renders the fork choice graph in the Graphviz DOT language. Every block is a box labeled with its slot,
+// root, weight and justification, filled according to its execution validity, with an edge to its parent.
+// The head is outlined in bold and the proposer boost is shown in the label of the boosted block.
+func (g *ForkChoiceGraph) DOT() string {
+	graph := dot.NewGraph(dot.Directed)
+	graph.Attr("rankdir", "RL")
+	graph.Attr("labelloc", "t")
+	graph.Label(fmt.Sprintf("justified: %s, finalized: %s, unrealized justified: %s, unrealized finalized: %s",
+		checkpointString(g.JustifiedCheckpoint),
+		checkpointString(g.FinalizedCheckpoint),
+		checkpointString(g.UnrealizedJustifiedCheckpoint),
+		checkpointString(g.UnrealizedFinalizedCheckpoint),
+	))
+
+	dotNodes := make(map[string]dot.Node, len(g.Nodes))
+	for _, n := range g.Nodes {
+		lines := []string{
+			"slot: " + n.Slot,
+			"root: " + ShortRoot(n.BlockRoot),
+			"weight: " + n.Weight,
+			fmt.Sprintf("justified: %s (unrealized %s)", n.JustifiedEpoch, n.UnrealizedJustifiedEpoch),
+			fmt.Sprintf("finalized: %s (unrealized %s)", n.FinalizedEpoch, n.UnrealizedFinalizedEpoch),
+			"validity: " + n.Validity,
+		}
+		if n.ProposerBoost {
+			lines = append(lines, "proposer boost")
+		}
+		if n.PreviousProposerBoost {
+			lines = append(lines, "previous proposer boost")
+		}
+		style := "filled"
+		if n.Head {
+			lines = append(lines, "head")
+			style = "filled,bold"
+		}
+		dn := graph.Node(n.BlockRoot).Box().
+			Label(strings.Join(lines, "\n")).
+			Attr("style", style).
+			Attr("fillcolor", validityColors[n.Validity])
+		if n.Head {
+			dn.Attr("penwidth", "3")
+		}
+		dotNodes[n.BlockRoot] = dn
+	}
+	for _, n := range g.Nodes {
+		if parent, ok := dotNodes[n.ParentRoot]; ok {
+			graph.Edge(dotNodes[n.BlockRoot], parent)
+		}
+	}
+	return graph.String()
+}
+
+// ShortRoot truncates a hex encoded root to its first bytes for display.
+func ShortRoot(root string) string {
+	if len(root) <= 10 {
+		return root
+	}
+	return root[:10]
+}
+
+func checkpointString(cp *shared.Checkpoint) string {
+	if cp == nil {
+		return "none"
+	}
+	return fmt.Sprintf("%s/%s", cp.Epoch, ShortRoot(cp.Root))
+}
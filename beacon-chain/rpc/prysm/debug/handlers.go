@@ -0,0 +1,42 @@
+package debug
+
+import (
+	"net/http"
+
+	http2 "github.com/theQRL/qrysm/v4/network/http"
+	"go.opencensus.io/trace"
+)
+
+// GetForkChoiceGraph is a HTTP handler that serves the GET /qrysm/v1/debug/fork_choice/graph endpoint.
+// It returns the tree of blocks in the live fork choice store, annotated with their weights, execution
+// validity, justification and the proposer boost. The tree is returned as JSON, or in the Graphviz DOT
+// language if the format query parameter is dot.
+func (s *Server) GetForkChoiceGraph(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "debug.GetForkChoiceGraph")
+	defer span.End()
+
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "json" && format != "dot" {
+		http2.HandleError(w, "Invalid format "+format+", must be json or dot", http.StatusBadRequest)
+		return
+	}
+	dump, err := s.ForkchoiceFetcher.ForkChoiceDump(ctx)
+	if err != nil {
+		http2.HandleError(w, "Could not get fork choice dump: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if dump == nil {
+		http2.HandleError(w, "Fork choice store is not initialized", http.StatusServiceUnavailable)
+		return
+	}
+	graph := forkChoiceGraphFromDump(dump)
+	if format == "dot" {
+		w.Header().Set("Content-Type", "text/vnd.graphviz")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(graph.DOT())); err != nil {
+			log.WithError(err).Error("Could not write fork choice graph")
+		}
+		return
+	}
+	http2.WriteJson(w, &ForkChoiceGraphResponse{Data: graph})
+}
@@ -0,0 +1,114 @@
+package debug
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/theQRL/qrysm/v4/beacon-chain/blockchain"
+	http2 "github.com/theQRL/qrysm/v4/network/http"
+	zondpbv1 "github.com/theQRL/qrysm/v4/proto/zond/v1"
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+)
+
+type mockForkchoiceFetcher struct {
+	blockchain.ForkchoiceFetcher
+	dump *zondpbv1.ForkChoiceDump
+}
+
+func (m *mockForkchoiceFetcher) ForkChoiceDump(_ context.Context) (*zondpbv1.ForkChoiceDump, error) {
+	return m.dump, nil
+}
+
+func testForkChoiceDump() *zondpbv1.ForkChoiceDump {
+	root := func(b byte) []byte {
+		r := make([]byte, 32)
+		r[0] = b
+		return r
+	}
+	return &zondpbv1.ForkChoiceDump{
+		JustifiedCheckpoint:           &zondpbv1.Checkpoint{Epoch: 1, Root: root('a')},
+		FinalizedCheckpoint:           &zondpbv1.Checkpoint{Epoch: 0, Root: root('a')},
+		UnrealizedJustifiedCheckpoint: &zondpbv1.Checkpoint{Epoch: 2, Root: root('b')},
+		UnrealizedFinalizedCheckpoint: &zondpbv1.Checkpoint{Epoch: 1, Root: root('a')},
+		ProposerBoostRoot:             root('c'),
+		PreviousProposerBoostRoot:     root('b'),
+		HeadRoot:                      root('c'),
+		ForkChoiceNodes: []*zondpbv1.ForkChoiceNode{
+			{Slot: 32, BlockRoot: root('a'), ParentRoot: make([]byte, 32), Weight: 30, Validity: zondpbv1.ForkChoiceNodeValidity_VALID},
+			{Slot: 64, BlockRoot: root('b'), ParentRoot: root('a'), JustifiedEpoch: 1, Weight: 20, Validity: zondpbv1.ForkChoiceNodeValidity_VALID},
+			{Slot: 65, BlockRoot: root('c'), ParentRoot: root('b'), JustifiedEpoch: 1, Weight: 15, ExecutionOptimistic: true, Validity: zondpbv1.ForkChoiceNodeValidity_OPTIMISTIC},
+			{Slot: 65, BlockRoot: root('d'), ParentRoot: root('b'), JustifiedEpoch: 1, Weight: 5, Validity: zondpbv1.ForkChoiceNodeValidity_INVALID},
+		},
+	}
+}
+
+func TestGetForkChoiceGraph(t *testing.T) {
+	s := &Server{ForkchoiceFetcher: &mockForkchoiceFetcher{dump: testForkChoiceDump()}}
+
+	t.Run("json", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/qrysm/v1/debug/fork_choice/graph", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetForkChoiceGraph(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &ForkChoiceGraphResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.NotNil(t, resp.Data)
+		assert.Equal(t, "1", resp.Data.JustifiedCheckpoint.Epoch)
+		assert.Equal(t, "2", resp.Data.UnrealizedJustifiedCheckpoint.Epoch)
+		require.Equal(t, 4, len(resp.Data.Nodes))
+		head := resp.Data.Nodes[2]
+		assert.Equal(t, "65", head.Slot)
+		assert.Equal(t, "15", head.Weight)
+		assert.Equal(t, "OPTIMISTIC", head.Validity)
+		assert.Equal(t, true, head.ExecutionOptimistic)
+		assert.Equal(t, true, head.Head)
+		assert.Equal(t, true, head.ProposerBoost)
+		assert.Equal(t, false, head.PreviousProposerBoost)
+		assert.Equal(t, true, resp.Data.Nodes[1].PreviousProposerBoost)
+		assert.Equal(t, false, resp.Data.Nodes[3].Head)
+		assert.Equal(t, "INVALID", resp.Data.Nodes[3].Validity)
+	})
+	t.Run("dot", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/qrysm/v1/debug/fork_choice/graph?format=dot", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetForkChoiceGraph(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, "text/vnd.graphviz", writer.Header().Get("Content-Type"))
+		body := writer.Body.String()
+		assert.Equal(t, true, strings.HasPrefix(body, "digraph"))
+		assert.Equal(t, 3, strings.Count(body, "->"))
+		assert.StringContains(t, "proposer boost", body)
+		assert.StringContains(t, "head", body)
+		assert.StringContains(t, "tomato", body)
+	})
+	t.Run("invalid format", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/qrysm/v1/debug/fork_choice/graph?format=svg", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetForkChoiceGraph(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Invalid format", e.Message)
+	})
+	t.Run("not initialized", func(t *testing.T) {
+		s := &Server{ForkchoiceFetcher: &mockForkchoiceFetcher{}}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/qrysm/v1/debug/fork_choice/graph", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetForkChoiceGraph(writer, request)
+		require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+}
@@ -0,0 +1,10 @@
+package debug
+
+import (
+	"github.com/theQRL/qrysm/v4/beacon-chain/blockchain"
+)
+
+// Server defines a server implementation for the HTTP debug endpoints exposing the live fork choice store.
+type Server struct {
+	ForkchoiceFetcher blockchain.ForkchoiceFetcher
+}
@@ -0,0 +1,37 @@
+package debug
+
+import "github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/shared"
+
+type ForkChoiceGraphResponse struct {
+	Data *ForkChoiceGraph `json:"data"`
+}
+
+type ForkChoiceGraph struct {
+	JustifiedCheckpoint           *shared.Checkpoint     `json:"justified_checkpoint"`
+	FinalizedCheckpoint           *shared.Checkpoint     `json:"finalized_checkpoint"`
+	UnrealizedJustifiedCheckpoint *shared.Checkpoint     `json:"unrealized_justified_checkpoint"`
+	UnrealizedFinalizedCheckpoint *shared.Checkpoint     `json:"unrealized_finalized_checkpoint"`
+	ProposerBoostRoot             string                 `json:"proposer_boost_root"`
+	PreviousProposerBoostRoot     string                 `json:"previous_proposer_boost_root"`
+	HeadRoot                      string                 `json:"head_root"`
+	Nodes                         []*ForkChoiceGraphNode `json:"nodes"`
+}
+
+type ForkChoiceGraphNode struct {
+	Slot                     string `json:"slot"`
+	BlockRoot                string `json:"block_root"`
+	ParentRoot               string `json:"parent_root"`
+	JustifiedEpoch           string `json:"justified_epoch"`
+	FinalizedEpoch           string `json:"finalized_epoch"`
+	UnrealizedJustifiedEpoch string `json:"unrealized_justified_epoch"`
+	UnrealizedFinalizedEpoch string `json:"unrealized_finalized_epoch"`
+	Balance                  string `json:"balance"`
+	Weight                   string `json:"weight"`
+	ExecutionOptimistic      bool   `json:"execution_optimistic"`
+	ExecutionBlockHash       string `json:"execution_block_hash"`
+	Timestamp                string `json:"timestamp"`
+	Validity                 string `json:"validity"`
+	Head                     bool   `json:"head"`
+	ProposerBoost            bool   `json:"proposer_boost"`
+	PreviousProposerBoost    bool   `json:"previous_proposer_boost"`
+}
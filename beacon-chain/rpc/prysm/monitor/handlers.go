@@ -0,0 +1,145 @@
+package monitor
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/v4/beacon-chain/monitor"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	http2 "github.com/theQRL/qrysm/v4/network/http"
+	"go.opencensus.io/trace"
+)
+
+// GetTrackedValidators is a HTTP handler that serves the GET /qrysm/v1/monitor/validators endpoint.
+// It returns the indices of the validators tracked by the validator monitor.
+func (s *Server) GetTrackedValidators(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "monitor.GetTrackedValidators")
+	defer span.End()
+
+	http2.WriteJson(w, &TrackedValidatorsResponse{Data: indicesToStrings(s.ValidatorTracker.TrackedValidatorIndices())})
+}
+
+// TrackValidators is a HTTP handler that serves the POST /qrysm/v1/monitor/validators endpoint.
+// The request body is a list of validator indices to start tracking. It returns the indices of the
+// validators tracked by the validator monitor.
+func (s *Server) TrackValidators(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "monitor.TrackValidators")
+	defer span.End()
+
+	var rawIndices []string
+	err := json.NewDecoder(r.Body).Decode(&rawIndices)
+	switch {
+	case err == io.EOF:
+		http2.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		http2.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(rawIndices) == 0 {
+		http2.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	}
+	indices := make([]primitives.ValidatorIndex, len(rawIndices))
+	for i, raw := range rawIndices {
+		idx, ok := shared.ValidateUint(w, fmt.Sprintf("Index %d", i), raw)
+		if !ok {
+			return
+		}
+		indices[i] = primitives.ValidatorIndex(idx)
+	}
+	if err := s.ValidatorTracker.TrackValidators(ctx, indices); err != nil {
+		if errors.Is(err, monitor.ErrUnknownValidator) {
+			http2.HandleError(w, "Could not track validators: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		http2.HandleError(w, "Could not track validators: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	http2.WriteJson(w, &TrackedValidatorsResponse{Data: indicesToStrings(s.ValidatorTracker.TrackedValidatorIndices())})
+}
+
+// UntrackValidator is a HTTP handler that serves the DELETE /qrysm/v1/monitor/validators/{index} endpoint.
+// The validator stops being tracked by the validator monitor, its performance history is kept.
+func (s *Server) UntrackValidator(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "monitor.UntrackValidator")
+	defer span.End()
+
+	idx, ok := shared.ValidateUint(w, "index", mux.Vars(r)["index"])
+	if !ok {
+		return
+	}
+	if err := s.ValidatorTracker.UntrackValidators(ctx, []primitives.ValidatorIndex{primitives.ValidatorIndex(idx)}); err != nil {
+		http2.HandleError(w, "Could not untrack validator: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	http2.WriteJson(w, &TrackedValidatorsResponse{Data: indicesToStrings(s.ValidatorTracker.TrackedValidatorIndices())})
+}
+
+// GetPerformanceHistory is a HTTP handler that serves the GET /qrysm/v1/monitor/validators/{index}/history
+// endpoint. It returns the epoch performance of the validator recorded by the validator monitor, optionally
+// restricted to the epochs between the from_epoch and to_epoch query parameters, inclusive.
+func (s *Server) GetPerformanceHistory(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "monitor.GetPerformanceHistory")
+	defer span.End()
+
+	idx, ok := shared.ValidateUint(w, "index", mux.Vars(r)["index"])
+	if !ok {
+		return
+	}
+	ok, _, from := shared.UintFromQuery(w, r, "from_epoch")
+	if !ok {
+		return
+	}
+	ok, rawTo, to := shared.UintFromQuery(w, r, "to_epoch")
+	if !ok {
+		return
+	}
+	if rawTo == "" {
+		to = math.MaxUint64
+	}
+	if from > to {
+		http2.HandleError(w, fmt.Sprintf("from_epoch %d is after to_epoch %d", from, to), http.StatusBadRequest)
+		return
+	}
+	history, err := s.ValidatorTracker.PerformanceHistory(ctx, primitives.ValidatorIndex(idx), primitives.Epoch(from), primitives.Epoch(to))
+	if err != nil {
+		http2.HandleError(w, "Could not get performance history: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	data := make([]*ValidatorEpochPerformance, len(history))
+	for i, p := range history {
+		data[i] = &ValidatorEpochPerformance{
+			ValidatorIndex:    strconv.FormatUint(uint64(p.ValidatorIndex), 10),
+			Epoch:             strconv.FormatUint(uint64(p.Epoch), 10),
+			Attested:          p.Attested,
+			InclusionDistance: strconv.FormatUint(uint64(p.InclusionDistance), 10),
+			CorrectSource:     p.CorrectSource,
+			CorrectTarget:     p.CorrectTarget,
+			CorrectHead:       p.CorrectHead,
+			ProposedBlocks:    strconv.FormatUint(p.ProposedBlocks, 10),
+			SyncContributions: strconv.FormatUint(p.SyncContributions, 10),
+			SyncExpected:      strconv.FormatUint(p.SyncExpected, 10),
+			Aggregations:      strconv.FormatUint(p.Aggregations, 10),
+			StartBalance:      strconv.FormatUint(p.StartBalance, 10),
+			EndBalance:        strconv.FormatUint(p.EndBalance, 10),
+			BalanceChange:     strconv.FormatInt(p.BalanceChange(), 10),
+		}
+	}
+	http2.WriteJson(w, &PerformanceHistoryResponse{Data: data})
+}
+
+func indicesToStrings(indices []primitives.ValidatorIndex) []string {
+	s := make([]string, len(indices))
+	for i, idx := range indices {
+		s[i] = strconv.FormatUint(uint64(idx), 10)
+	}
+	return s
+}
@@ -0,0 +1,199 @@
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/v4/beacon-chain/monitor"
+	monitortypes "github.com/theQRL/qrysm/v4/beacon-chain/monitor/types"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	http2 "github.com/theQRL/qrysm/v4/network/http"
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+)
+
+type mockValidatorTracker struct {
+	tracked    map[primitives.ValidatorIndex]bool
+	history    []*monitortypes.ValidatorEpochPerformance
+	start, end primitives.Epoch
+}
+
+func (m *mockValidatorTracker) TrackedValidatorIndices() []primitives.ValidatorIndex {
+	indices := make([]primitives.ValidatorIndex, 0, len(m.tracked))
+	for idx := range m.tracked {
+		indices = append(indices, idx)
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+	return indices
+}
+
+func (m *mockValidatorTracker) TrackValidators(_ context.Context, indices []primitives.ValidatorIndex) error {
+	for _, idx := range indices {
+		if idx >= 1000 {
+			return errors.Wrapf(monitor.ErrUnknownValidator, "validator index %d", idx)
+		}
+	}
+	for _, idx := range indices {
+		m.tracked[idx] = true
+	}
+	return nil
+}
+
+func (m *mockValidatorTracker) UntrackValidators(_ context.Context, indices []primitives.ValidatorIndex) error {
+	for _, idx := range indices {
+		delete(m.tracked, idx)
+	}
+	return nil
+}
+
+func (m *mockValidatorTracker) PerformanceHistory(
+	_ context.Context, _ primitives.ValidatorIndex, start, end primitives.Epoch,
+) ([]*monitortypes.ValidatorEpochPerformance, error) {
+	m.start, m.end = start, end
+	return m.history, nil
+}
+
+func TestGetTrackedValidators(t *testing.T) {
+	s := &Server{ValidatorTracker: &mockValidatorTracker{tracked: map[primitives.ValidatorIndex]bool{5: true, 2: true}}}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/qrysm/v1/monitor/validators", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetTrackedValidators(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &TrackedValidatorsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.DeepEqual(t, []string{"2", "5"}, resp.Data)
+}
+
+func TestTrackValidators(t *testing.T) {
+	s := &Server{ValidatorTracker: &mockValidatorTracker{tracked: map[primitives.ValidatorIndex]bool{5: true}}}
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/qrysm/v1/monitor/validators", strings.NewReader(`["3","7"]`))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.TrackValidators(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &TrackedValidatorsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.DeepEqual(t, []string{"3", "5", "7"}, resp.Data)
+	})
+	t.Run("no data", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/qrysm/v1/monitor/validators", strings.NewReader(`[]`))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.TrackValidators(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "No data submitted", e.Message)
+	})
+	t.Run("invalid index", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/qrysm/v1/monitor/validators", strings.NewReader(`["foo"]`))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.TrackValidators(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("unknown validator", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/qrysm/v1/monitor/validators", strings.NewReader(`["1000"]`))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.TrackValidators(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "unknown validator", e.Message)
+	})
+}
+
+func TestUntrackValidator(t *testing.T) {
+	s := &Server{ValidatorTracker: &mockValidatorTracker{tracked: map[primitives.ValidatorIndex]bool{3: true, 5: true}}}
+
+	request := httptest.NewRequest(http.MethodDelete, "http://example.com/qrysm/v1/monitor/validators/3", nil)
+	request = mux.SetURLVars(request, map[string]string{"index": "3"})
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.UntrackValidator(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &TrackedValidatorsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.DeepEqual(t, []string{"5"}, resp.Data)
+}
+
+func TestGetPerformanceHistory(t *testing.T) {
+	tracker := &mockValidatorTracker{
+		history: []*monitortypes.ValidatorEpochPerformance{{
+			ValidatorIndex:    3,
+			Epoch:             4,
+			Attested:          true,
+			InclusionDistance: 1,
+			CorrectSource:     true,
+			CorrectTarget:     true,
+			ProposedBlocks:    1,
+			SyncContributions: 30,
+			SyncExpected:      32,
+			StartBalance:      32000000000,
+			EndBalance:        31999000000,
+		}},
+	}
+	s := &Server{ValidatorTracker: tracker}
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/qrysm/v1/monitor/validators/3/history?from_epoch=2", nil)
+		request = mux.SetURLVars(request, map[string]string{"index": "3"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPerformanceHistory(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &PerformanceHistoryResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.DeepEqual(t, []*ValidatorEpochPerformance{{
+			ValidatorIndex:    "3",
+			Epoch:             "4",
+			Attested:          true,
+			InclusionDistance: "1",
+			CorrectSource:     true,
+			CorrectTarget:     true,
+			CorrectHead:       false,
+			ProposedBlocks:    "1",
+			SyncContributions: "30",
+			SyncExpected:      "32",
+			Aggregations:      "0",
+			StartBalance:      "32000000000",
+			EndBalance:        "31999000000",
+			BalanceChange:     "-1000000",
+		}}, resp.Data)
+		assert.Equal(t, primitives.Epoch(2), tracker.start)
+		assert.Equal(t, primitives.Epoch(math.MaxUint64), tracker.end)
+	})
+	t.Run("from after to", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/qrysm/v1/monitor/validators/3/history?from_epoch=5&to_epoch=4", nil)
+		request = mux.SetURLVars(request, map[string]string{"index": "3"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPerformanceHistory(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "from_epoch 5 is after to_epoch 4", e.Message)
+	})
+}
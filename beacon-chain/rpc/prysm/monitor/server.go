@@ -0,0 +1,11 @@
+package monitor
+
+import (
+	"github.com/theQRL/qrysm/v4/beacon-chain/monitor"
+)
+
+// Server defines a server implementation for the HTTP endpoints managing the validators
+// tracked by the validator monitor and exposing their performance.
+type Server struct {
+	ValidatorTracker monitor.ValidatorTracker
+}
@@ -0,0 +1,26 @@
+package monitor
+
+type TrackedValidatorsResponse struct {
+	Data []string `json:"data"`
+}
+
+type PerformanceHistoryResponse struct {
+	Data []*ValidatorEpochPerformance `json:"data"`
+}
+
+type ValidatorEpochPerformance struct {
+	ValidatorIndex    string `json:"validator_index"`
+	Epoch             string `json:"epoch"`
+	Attested          bool   `json:"attested"`
+	InclusionDistance string `json:"inclusion_distance"`
+	CorrectSource     bool   `json:"correct_source"`
+	CorrectTarget     bool   `json:"correct_target"`
+	CorrectHead       bool   `json:"correct_head"`
+	ProposedBlocks    string `json:"proposed_blocks"`
+	SyncContributions string `json:"sync_contributions"`
+	SyncExpected      string `json:"sync_expected"`
+	Aggregations      string `json:"aggregations"`
+	StartBalance      string `json:"start_balance"`
+	EndBalance        string `json:"end_balance"`
+	BalanceChange     string `json:"balance_change"`
+}
@@ -0,0 +1,213 @@
+package slasher
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/theQRL/go-zond/common/hexutil"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/shared"
+	slashertypes "github.com/theQRL/qrysm/v4/beacon-chain/slasher/types"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	http2 "github.com/theQRL/qrysm/v4/network/http"
+	zond "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+const (
+	// AttesterSlashingTopic is the topic of the events streamed for detected attester slashings.
+	AttesterSlashingTopic = "attester_slashing"
+	// ProposerSlashingTopic is the topic of the events streamed for detected proposer slashings.
+	ProposerSlashingTopic = "proposer_slashing"
+)
+
+// GetSlashings is a HTTP handler that serves the GET /qrysm/slasher/slashings/{validator_index} endpoint.
+// It returns the attester and proposer slashings detected by the slasher against the validator, optionally
+// restricted to the epochs between the start_epoch and end_epoch query parameters, inclusive. The epoch of
+// an attester slashing is the highest target epoch of its attestations.
+func (s *Server) GetSlashings(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.GetSlashings")
+	defer span.End()
+
+	validatorIdx, start, end, ok := validatorEpochRange(w, r)
+	if !ok {
+		return
+	}
+	attesterSlashings, proposerSlashings, err := s.Slasher.Slashings(ctx, validatorIdx, start, end)
+	if err != nil {
+		http2.HandleError(w, "Could not get slashings: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	attesterSlashingsJson, err := shared.AttesterSlashingsFromConsensus(attesterSlashings)
+	if err != nil {
+		http2.HandleError(w, "Could not convert attester slashings: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	proposerSlashingsJson, err := shared.ProposerSlashingsFromConsensus(proposerSlashings)
+	if err != nil {
+		http2.HandleError(w, "Could not convert proposer slashings: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	http2.WriteJson(w, &SlashingsResponse{
+		Data: &Slashings{
+			AttesterSlashings: attesterSlashingsJson,
+			ProposerSlashings: proposerSlashingsJson,
+		},
+	})
+}
+
+// GetAttestationRecords is a HTTP handler that serves the GET /qrysm/slasher/attestations/{validator_index}
+// endpoint. It returns the attestations of the validator stored by the slasher, optionally restricted to the
+// target epochs between the start_epoch and end_epoch query parameters, inclusive. Only the attestations
+// within the history kept by the slasher are stored.
+func (s *Server) GetAttestationRecords(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.GetAttestationRecords")
+	defer span.End()
+
+	validatorIdx, start, end, ok := validatorEpochRange(w, r)
+	if !ok {
+		return
+	}
+	atts, err := s.Slasher.AttestationRecords(ctx, validatorIdx, start, end)
+	if err != nil {
+		http2.HandleError(w, "Could not get attestation records: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	data := make([]*IndexedAttestation, len(atts))
+	for i, att := range atts {
+		data[i] = indexedAttestationFromConsensus(att)
+	}
+	http2.WriteJson(w, &AttestationRecordsResponse{Data: data})
+}
+
+// GetSpans is a HTTP handler that serves the GET /qrysm/slasher/spans/{validator_index} endpoint.
+// It returns the min and max spans of the validator at each epoch of the history kept by the slasher,
+// optionally restricted to the epochs between the start_epoch and end_epoch query parameters, inclusive.
+// A min span of 65535 and a max span of 0 mean no attestation constrains the validator at the epoch.
+func (s *Server) GetSpans(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.GetSpans")
+	defer span.End()
+
+	validatorIdx, start, end, ok := validatorEpochRange(w, r)
+	if !ok {
+		return
+	}
+	spans, err := s.Slasher.Spans(ctx, validatorIdx, start, end)
+	if err != nil {
+		http2.HandleError(w, "Could not get spans: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	data := make([]*Spans, len(spans))
+	for i, sp := range spans {
+		data[i] = &Spans{
+			Epoch:   strconv.FormatUint(uint64(sp.Epoch), 10),
+			MinSpan: strconv.FormatUint(uint64(sp.MinSpan), 10),
+			MaxSpan: strconv.FormatUint(uint64(sp.MaxSpan), 10),
+		}
+	}
+	http2.WriteJson(w, &SpansResponse{Data: data})
+}
+
+// StreamSlashings is a HTTP handler that serves the GET /qrysm/slasher/events endpoint. It streams the
+// slashings detected by the slasher as server-sent events, with the attester_slashing and
+// proposer_slashing topics.
+func (s *Server) StreamSlashings(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http2.HandleError(w, "Streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	ch := make(chan *slashertypes.DetectedSlashing, 16)
+	sub := s.Slasher.SubscribeSlashings(ch)
+	defer sub.Unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case sl := <-ch:
+			topic, data, err := slashingEvent(sl)
+			if err != nil {
+				log.WithError(err).Error("Could not encode slashing event")
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", topic, data); err != nil {
+				return
+			}
+			flusher.Flush()
+		case err := <-sub.Err():
+			if err != nil {
+				log.WithError(err).Warn("Closing slashing events stream")
+			}
+			return
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+func slashingEvent(sl *slashertypes.DetectedSlashing) (string, []byte, error) {
+	if sl.AttesterSlashing != nil {
+		slashings, err := shared.AttesterSlashingsFromConsensus([]*zond.AttesterSlashing{sl.AttesterSlashing})
+		if err != nil {
+			return "", nil, err
+		}
+		data, err := json.Marshal(slashings[0])
+		return AttesterSlashingTopic, data, err
+	}
+	slashings, err := shared.ProposerSlashingsFromConsensus([]*zond.ProposerSlashing{sl.ProposerSlashing})
+	if err != nil {
+		return "", nil, err
+	}
+	data, err := json.Marshal(slashings[0])
+	return ProposerSlashingTopic, data, err
+}
+
+// validatorEpochRange reads the validator index path parameter and the optional start_epoch and end_epoch
+// query parameters, writing an error response if they are invalid.
+func validatorEpochRange(w http.ResponseWriter, r *http.Request) (primitives.ValidatorIndex, primitives.Epoch, primitives.Epoch, bool) {
+	validatorIdx, ok := shared.ValidateUint(w, "validator_index", mux.Vars(r)["validator_index"])
+	if !ok {
+		return 0, 0, 0, false
+	}
+	ok, _, start := shared.UintFromQuery(w, r, "start_epoch")
+	if !ok {
+		return 0, 0, 0, false
+	}
+	ok, rawEnd, end := shared.UintFromQuery(w, r, "end_epoch")
+	if !ok {
+		return 0, 0, 0, false
+	}
+	if rawEnd == "" {
+		end = math.MaxUint64
+	}
+	if start > end {
+		http2.HandleError(w, fmt.Sprintf("start_epoch %d is after end_epoch %d", start, end), http.StatusBadRequest)
+		return 0, 0, 0, false
+	}
+	return primitives.ValidatorIndex(validatorIdx), primitives.Epoch(start), primitives.Epoch(end), true
+}
+
+func indexedAttestationFromConsensus(att *zond.IndexedAttestation) *IndexedAttestation {
+	return &IndexedAttestation{
+		AttestingIndices:        uint64sToStrings(att.AttestingIndices),
+		Data:                    shared.AttestationDataFromConsensus(att.Data),
+		Signature:               hexutil.Encode(att.Signature),
+		SignatureValidatorIndex: uint64sToStrings(att.SignatureValidatorIndex),
+	}
+}
+
+func uint64sToStrings(values []uint64) []string {
+	s := make([]string, len(values))
+	for i, v := range values {
+		s[i] = strconv.FormatUint(v, 10)
+	}
+	return s
+}
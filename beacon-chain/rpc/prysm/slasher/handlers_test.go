@@ -0,0 +1,199 @@
+package slasher
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/theQRL/qrysm/v4/async/event"
+	slashertypes "github.com/theQRL/qrysm/v4/beacon-chain/slasher/types"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	http2 "github.com/theQRL/qrysm/v4/network/http"
+	zond "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+	"github.com/theQRL/qrysm/v4/testing/util"
+)
+
+type mockEvidenceFetcher struct {
+	validatorIdx      primitives.ValidatorIndex
+	start, end        primitives.Epoch
+	attesterSlashings []*zond.AttesterSlashing
+	proposerSlashings []*zond.ProposerSlashing
+	atts              []*zond.IndexedAttestation
+	spans             []*slashertypes.EpochSpans
+	feed              event.Feed
+}
+
+func (m *mockEvidenceFetcher) Slashings(
+	_ context.Context, validatorIdx primitives.ValidatorIndex, start, end primitives.Epoch,
+) ([]*zond.AttesterSlashing, []*zond.ProposerSlashing, error) {
+	m.validatorIdx, m.start, m.end = validatorIdx, start, end
+	return m.attesterSlashings, m.proposerSlashings, nil
+}
+
+func (m *mockEvidenceFetcher) AttestationRecords(
+	_ context.Context, validatorIdx primitives.ValidatorIndex, start, end primitives.Epoch,
+) ([]*zond.IndexedAttestation, error) {
+	m.validatorIdx, m.start, m.end = validatorIdx, start, end
+	return m.atts, nil
+}
+
+func (m *mockEvidenceFetcher) Spans(
+	_ context.Context, validatorIdx primitives.ValidatorIndex, start, end primitives.Epoch,
+) ([]*slashertypes.EpochSpans, error) {
+	m.validatorIdx, m.start, m.end = validatorIdx, start, end
+	return m.spans, nil
+}
+
+func (m *mockEvidenceFetcher) SubscribeSlashings(ch chan<- *slashertypes.DetectedSlashing) event.Subscription {
+	return m.feed.Subscribe(ch)
+}
+
+func TestGetSlashings(t *testing.T) {
+	fetcher := &mockEvidenceFetcher{
+		attesterSlashings: []*zond.AttesterSlashing{{
+			Attestation_1: util.HydrateIndexedAttestation(&zond.IndexedAttestation{AttestingIndices: []uint64{3}}),
+			Attestation_2: util.HydrateIndexedAttestation(&zond.IndexedAttestation{AttestingIndices: []uint64{3}}),
+		}},
+		proposerSlashings: []*zond.ProposerSlashing{{
+			Header_1: util.HydrateSignedBeaconHeader(&zond.SignedBeaconBlockHeader{}),
+			Header_2: util.HydrateSignedBeaconHeader(&zond.SignedBeaconBlockHeader{}),
+		}},
+	}
+	s := &Server{Slasher: fetcher}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/qrysm/slasher/slashings/3?start_epoch=2&end_epoch=5", nil)
+	request = mux.SetURLVars(request, map[string]string{"validator_index": "3"})
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetSlashings(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &SlashingsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.Equal(t, 1, len(resp.Data.AttesterSlashings))
+	require.Equal(t, 1, len(resp.Data.ProposerSlashings))
+	assert.DeepEqual(t, []string{"3"}, resp.Data.AttesterSlashings[0].Attestation1.AttestingIndices)
+	assert.Equal(t, primitives.ValidatorIndex(3), fetcher.validatorIdx)
+	assert.Equal(t, primitives.Epoch(2), fetcher.start)
+	assert.Equal(t, primitives.Epoch(5), fetcher.end)
+}
+
+func TestGetAttestationRecords(t *testing.T) {
+	fetcher := &mockEvidenceFetcher{
+		atts: []*zond.IndexedAttestation{util.HydrateIndexedAttestation(&zond.IndexedAttestation{
+			AttestingIndices:        []uint64{3, 7},
+			SignatureValidatorIndex: []uint64{1},
+		})},
+	}
+	s := &Server{Slasher: fetcher}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/qrysm/slasher/attestations/3?start_epoch=2", nil)
+	request = mux.SetURLVars(request, map[string]string{"validator_index": "3"})
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetAttestationRecords(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &AttestationRecordsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.Equal(t, 1, len(resp.Data))
+	assert.DeepEqual(t, []string{"3", "7"}, resp.Data[0].AttestingIndices)
+	assert.DeepEqual(t, []string{"1"}, resp.Data[0].SignatureValidatorIndex)
+	assert.Equal(t, primitives.Epoch(2), fetcher.start)
+	assert.Equal(t, primitives.Epoch(math.MaxUint64), fetcher.end)
+}
+
+func TestGetSpans(t *testing.T) {
+	fetcher := &mockEvidenceFetcher{
+		spans: []*slashertypes.EpochSpans{
+			{Epoch: 7, MinSpan: 2, MaxSpan: 0},
+			{Epoch: 8, MinSpan: 65535, MaxSpan: 1},
+		},
+	}
+	s := &Server{Slasher: fetcher}
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/qrysm/slasher/spans/1?start_epoch=7", nil)
+		request = mux.SetURLVars(request, map[string]string{"validator_index": "1"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetSpans(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &SpansResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.DeepEqual(t, []*Spans{
+			{Epoch: "7", MinSpan: "2", MaxSpan: "0"},
+			{Epoch: "8", MinSpan: "65535", MaxSpan: "1"},
+		}, resp.Data)
+		assert.Equal(t, primitives.Epoch(7), fetcher.start)
+		assert.Equal(t, primitives.Epoch(math.MaxUint64), fetcher.end)
+	})
+	t.Run("invalid validator index", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/qrysm/slasher/spans/foo", nil)
+		request = mux.SetURLVars(request, map[string]string{"validator_index": "foo"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetSpans(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "validator_index is invalid", e.Message)
+	})
+	t.Run("start after end", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/qrysm/slasher/spans/1?start_epoch=5&end_epoch=4", nil)
+		request = mux.SetURLVars(request, map[string]string{"validator_index": "1"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetSpans(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "start_epoch 5 is after end_epoch 4", e.Message)
+	})
+}
+
+func TestStreamSlashings(t *testing.T) {
+	fetcher := &mockEvidenceFetcher{}
+	s := &Server{Slasher: fetcher}
+	srv := httptest.NewServer(http.HandlerFunc(s.StreamSlashings))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, resp.Body.Close())
+	}()
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	// Wait for the handler to subscribe before sending the slashing.
+	deadline := time.Now().Add(5 * time.Second)
+	for fetcher.feed.Send(&slashertypes.DetectedSlashing{
+		ProposerSlashing: &zond.ProposerSlashing{
+			Header_1: util.HydrateSignedBeaconHeader(&zond.SignedBeaconBlockHeader{}),
+			Header_2: util.HydrateSignedBeaconHeader(&zond.SignedBeaconBlockHeader{}),
+		},
+	}) == 0 {
+		require.Equal(t, true, time.Now().Before(deadline), "handler did not subscribe to slashings")
+		time.Sleep(10 * time.Millisecond)
+	}
+	reader := bufio.NewReader(resp.Body)
+	line, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "event: "+ProposerSlashingTopic, strings.TrimSpace(line))
+	line, err = reader.ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, true, strings.HasPrefix(line, "data: {\"signed_header_1\""), line)
+}
@@ -0,0 +1,11 @@
+package slasher
+
+import (
+	"github.com/theQRL/qrysm/v4/beacon-chain/slasher"
+)
+
+// Server defines a server implementation for the HTTP endpoints exposing the evidence
+// held by the slasher about validators.
+type Server struct {
+	Slasher slasher.EvidenceFetcher
+}
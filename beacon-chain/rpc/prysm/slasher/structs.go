@@ -0,0 +1,35 @@
+package slasher
+
+import "github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/shared"
+
+type SlashingsResponse struct {
+	Data *Slashings `json:"data"`
+}
+
+type Slashings struct {
+	AttesterSlashings []*shared.AttesterSlashing `json:"attester_slashings"`
+	ProposerSlashings []*shared.ProposerSlashing `json:"proposer_slashings"`
+}
+
+type AttestationRecordsResponse struct {
+	Data []*IndexedAttestation `json:"data"`
+}
+
+// IndexedAttestation is an attestation stored by the slasher. SignatureValidatorIndex holds the validator index
+// of each signature of the attestation, which is needed to verify them.
+type IndexedAttestation struct {
+	AttestingIndices        []string                `json:"attesting_indices"`
+	Data                    *shared.AttestationData `json:"data"`
+	Signature               string                  `json:"signature"`
+	SignatureValidatorIndex []string                `json:"signature_validator_index"`
+}
+
+type SpansResponse struct {
+	Data []*Spans `json:"data"`
+}
+
+type Spans struct {
+	Epoch   string `json:"epoch"`
+	MinSpan string `json:"min_span"`
+	MaxSpan string `json:"max_span"`
+}
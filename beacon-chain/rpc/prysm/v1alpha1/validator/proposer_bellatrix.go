@@ -11,8 +11,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/sirupsen/logrus"
-	"github.com/theQRL/qrysm/v4/api/client/builder"
-	"github.com/theQRL/qrysm/v4/beacon-chain/core/signing"
+	"github.com/theQRL/qrysm/v4/beacon-chain/builder"
 	fieldparams "github.com/theQRL/qrysm/v4/config/fieldparams"
 	"github.com/theQRL/qrysm/v4/config/params"
 	"github.com/theQRL/qrysm/v4/consensus-types/interfaces"
@@ -205,7 +204,7 @@ func (vs *Server) getPayloadHeaderFromBuilder(ctx context.Context, slot primitiv
 		return nil, nil, fmt.Errorf("incorrect timestamp %d != %d", header.Timestamp(), uint64(t.Unix()))
 	}
 
-	if err := validateBuilderSignature(signedBid); err != nil {
+	if err := builder.ValidateBuilderSignature(signedBid); err != nil {
 		return nil, nil, errors.Wrap(err, "could not validate builder signature")
 	}
 
@@ -238,27 +237,6 @@ func (vs *Server) getPayloadHeaderFromBuilder(ctx context.Context, slot primitiv
 	return header, bundle, nil
 }
 
-// Validates builder signature and returns an error if the signature is invalid.
-func validateBuilderSignature(signedBid builder.SignedBid) error {
-	d, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder,
-		nil, /* fork version */
-		nil /* genesis val root */)
-	if err != nil {
-		return err
-	}
-	if signedBid.IsNil() {
-		return errors.New("nil builder bid")
-	}
-	bid, err := signedBid.Message()
-	if err != nil {
-		return errors.Wrap(err, "could not get bid")
-	}
-	if bid.IsNil() {
-		return errors.New("builder returned nil bid")
-	}
-	return signing.VerifySigningRoot(bid, bid.Pubkey(), signedBid.Signature(), d)
-}
-
 func matchingWithdrawalsRoot(local, builder interfaces.ExecutionData) (bool, error) {
 	wds, err := local.Withdrawals()
 	if err != nil {
@@ -10,7 +10,6 @@ import (
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
 	"github.com/theQRL/go-zond/common"
-	"github.com/theQRL/qrysm/v4/api/client/builder"
 	blockchainTest "github.com/theQRL/qrysm/v4/beacon-chain/blockchain/testing"
 	builderTest "github.com/theQRL/qrysm/v4/beacon-chain/builder/testing"
 	"github.com/theQRL/qrysm/v4/beacon-chain/cache"
@@ -601,44 +600,6 @@ func TestServer_getPayloadHeader(t *testing.T) {
 	}
 }
 
-func TestServer_validateBuilderSignature(t *testing.T) {
-	sk, err := dilithium.RandKey()
-	require.NoError(t, err)
-	bid := &zondpb.BuilderBid{
-		Header: &v1.ExecutionPayloadHeader{
-			ParentHash:       make([]byte, fieldparams.RootLength),
-			FeeRecipient:     make([]byte, fieldparams.FeeRecipientLength),
-			StateRoot:        make([]byte, fieldparams.RootLength),
-			ReceiptsRoot:     make([]byte, fieldparams.RootLength),
-			LogsBloom:        make([]byte, fieldparams.LogsBloomLength),
-			PrevRandao:       make([]byte, fieldparams.RootLength),
-			BaseFeePerGas:    make([]byte, fieldparams.RootLength),
-			BlockHash:        make([]byte, fieldparams.RootLength),
-			TransactionsRoot: make([]byte, fieldparams.RootLength),
-			BlockNumber:      1,
-		},
-		Pubkey: sk.PublicKey().Marshal(),
-		Value:  bytesutil.PadTo([]byte{1, 2, 3}, 32),
-	}
-	d := params.BeaconConfig().DomainApplicationBuilder
-	domain, err := signing.ComputeDomain(d, nil, nil)
-	require.NoError(t, err)
-	sr, err := signing.ComputeSigningRoot(bid, domain)
-	require.NoError(t, err)
-	pbBid := &zondpb.SignedBuilderBid{
-		Message:   bid,
-		Signature: sk.Sign(sr[:]).Marshal(),
-	}
-	sBid, err := builder.WrappedSignedBuilderBid(pbBid)
-	require.NoError(t, err)
-	require.NoError(t, validateBuilderSignature(sBid))
-
-	pbBid.Message.Value = make([]byte, 32)
-	sBid, err = builder.WrappedSignedBuilderBid(pbBid)
-	require.NoError(t, err)
-	require.ErrorIs(t, validateBuilderSignature(sBid), signing.ErrSigFailedToVerify)
-}
-
 func Test_matchingWithdrawalsRoot(t *testing.T) {
 	t.Run("could not get local withdrawals", func(t *testing.T) {
 		local := &v1.ExecutionPayload{}
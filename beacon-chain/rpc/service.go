@@ -25,6 +25,7 @@ import (
 	statefeed "github.com/theQRL/qrysm/v4/beacon-chain/core/feed/state"
 	"github.com/theQRL/qrysm/v4/beacon-chain/db"
 	"github.com/theQRL/qrysm/v4/beacon-chain/execution"
+	"github.com/theQRL/qrysm/v4/beacon-chain/monitor"
 	"github.com/theQRL/qrysm/v4/beacon-chain/operations/attestations"
 	"github.com/theQRL/qrysm/v4/beacon-chain/operations/blstoexec"
 	"github.com/theQRL/qrysm/v4/beacon-chain/operations/slashings"
@@ -37,11 +38,15 @@ import (
 	rpcBuilder "github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/builder"
 	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/debug"
 	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/events"
+	lightclient "github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/light-client"
 	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/node"
 	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/rewards"
 	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/validator"
 	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/lookup"
+	debugprysm "github.com/theQRL/qrysm/v4/beacon-chain/rpc/prysm/debug"
+	monitorprysm "github.com/theQRL/qrysm/v4/beacon-chain/rpc/prysm/monitor"
 	nodeprysm "github.com/theQRL/qrysm/v4/beacon-chain/rpc/prysm/node"
+	slasherprysm "github.com/theQRL/qrysm/v4/beacon-chain/rpc/prysm/slasher"
 	beaconv1alpha1 "github.com/theQRL/qrysm/v4/beacon-chain/rpc/prysm/v1alpha1/beacon"
 	debugv1alpha1 "github.com/theQRL/qrysm/v4/beacon-chain/rpc/prysm/v1alpha1/debug"
 	nodev1alpha1 "github.com/theQRL/qrysm/v4/beacon-chain/rpc/prysm/v1alpha1/node"
@@ -108,6 +113,8 @@ type Config struct {
 	ExitPool                      voluntaryexits.PoolManager
 	SlashingsPool                 slashings.PoolManager
 	SlashingChecker               slasherservice.SlashingChecker
+	SlasherEvidenceFetcher        slasherservice.EvidenceFetcher
+	ValidatorMonitor              monitor.ValidatorTracker
 	SyncCommitteeObjectPool       synccommittee.Pool
 	DilithiumChangesPool          blstoexec.PoolManager
 	SyncService                   chainSync.Checker
@@ -125,6 +132,7 @@ type Config struct {
 	ExecutionEngineCaller         execution.EngineCaller
 	ProposerIdsCache              *cache.ProposerPayloadIDsCache
 	OptimisticModeFetcher         blockchain.OptimisticModeFetcher
+	LightClientFetcher            blockchain.LightClientFetcher
 	BlockBuilder                  builder.BlockBuilder
 	Router                        *mux.Router
 	ClockWaiter                   startup.ClockWaiter
@@ -239,6 +247,19 @@ func (s *Service) Start() {
 	}
 	s.cfg.Router.HandleFunc("/zond/v1/beacon/blob_sidecars/{block_id}", blobServer.Blobs).Methods(http.MethodGet)
 
+	if features.Get().EnableLightClient {
+		lightClientServer := &lightclient.Server{
+			Blocker:            blocker,
+			Stater:             stater,
+			BeaconDB:           s.cfg.BeaconDB,
+			LightClientFetcher: s.cfg.LightClientFetcher,
+		}
+		s.cfg.Router.HandleFunc("/zond/v1/beacon/light_client/bootstrap/{block_root}", lightClientServer.GetLightClientBootstrap).Methods(http.MethodGet)
+		s.cfg.Router.HandleFunc("/zond/v1/beacon/light_client/updates", lightClientServer.GetLightClientUpdatesByRange).Methods(http.MethodGet)
+		s.cfg.Router.HandleFunc("/zond/v1/beacon/light_client/finality_update", lightClientServer.GetLightClientFinalityUpdate).Methods(http.MethodGet)
+		s.cfg.Router.HandleFunc("/zond/v1/beacon/light_client/optimistic_update", lightClientServer.GetLightClientOptimisticUpdate).Methods(http.MethodGet)
+	}
+
 	coreService := &core.Service{
 		HeadFetcher:        s.cfg.HeadFetcher,
 		GenesisTimeFetcher: s.cfg.GenesisTimeFetcher,
@@ -367,6 +388,26 @@ func (s *Service) Start() {
 	s.cfg.Router.HandleFunc("/qrysm/node/trusted_peers", nodeServerPrysm.AddTrustedPeer).Methods(http.MethodPost)
 	s.cfg.Router.HandleFunc("/qrysm/node/trusted_peers/{peer_id}", nodeServerPrysm.RemoveTrustedPeer).Methods(http.MethodDelete)
 
+	if s.cfg.SlasherEvidenceFetcher != nil {
+		slasherServerPrysm := &slasherprysm.Server{
+			Slasher: s.cfg.SlasherEvidenceFetcher,
+		}
+		s.cfg.Router.HandleFunc("/qrysm/slasher/slashings/{validator_index}", slasherServerPrysm.GetSlashings).Methods(http.MethodGet)
+		s.cfg.Router.HandleFunc("/qrysm/slasher/attestations/{validator_index}", slasherServerPrysm.GetAttestationRecords).Methods(http.MethodGet)
+		s.cfg.Router.HandleFunc("/qrysm/slasher/spans/{validator_index}", slasherServerPrysm.GetSpans).Methods(http.MethodGet)
+		s.cfg.Router.HandleFunc("/qrysm/slasher/events", slasherServerPrysm.StreamSlashings).Methods(http.MethodGet)
+	}
+
+	if s.cfg.ValidatorMonitor != nil {
+		monitorServerPrysm := &monitorprysm.Server{
+			ValidatorTracker: s.cfg.ValidatorMonitor,
+		}
+		s.cfg.Router.HandleFunc("/qrysm/v1/monitor/validators", monitorServerPrysm.GetTrackedValidators).Methods(http.MethodGet)
+		s.cfg.Router.HandleFunc("/qrysm/v1/monitor/validators", monitorServerPrysm.TrackValidators).Methods(http.MethodPost)
+		s.cfg.Router.HandleFunc("/qrysm/v1/monitor/validators/{index}", monitorServerPrysm.UntrackValidator).Methods(http.MethodDelete)
+		s.cfg.Router.HandleFunc("/qrysm/v1/monitor/validators/{index}/history", monitorServerPrysm.GetPerformanceHistory).Methods(http.MethodGet)
+	}
+
 	beaconChainServer := &beaconv1alpha1.Server{
 		Ctx:                         s.ctx,
 		BeaconDB:                    s.cfg.BeaconDB,
@@ -446,6 +487,7 @@ func (s *Service) Start() {
 	s.cfg.Router.HandleFunc("/zond/v1/beacon/headers/{block_id}", beaconChainServerV1.GetBlockHeader).Methods(http.MethodGet)
 	s.cfg.Router.HandleFunc("/zond/v1/config/deposit_contract", beaconChainServerV1.GetDepositContract).Methods(http.MethodGet)
 	s.cfg.Router.HandleFunc("/zond/v1/beacon/genesis", beaconChainServerV1.GetGenesis).Methods(http.MethodGet)
+	s.cfg.Router.HandleFunc("/zond/v1/beacon/deposit_snapshot", beaconChainServerV1.GetDepositSnapshot).Methods(http.MethodGet)
 	s.cfg.Router.HandleFunc("/zond/v1/beacon/states/{state_id}/finality_checkpoints", beaconChainServerV1.GetFinalityCheckpoints).Methods(http.MethodGet)
 	s.cfg.Router.HandleFunc("/zond/v1/beacon/states/{state_id}/validators", beaconChainServerV1.GetValidators).Methods(http.MethodGet)
 	s.cfg.Router.HandleFunc("/zond/v1/beacon/states/{state_id}/validators/{validator_id}", beaconChainServerV1.GetValidator).Methods(http.MethodGet)
@@ -486,6 +528,11 @@ func (s *Service) Start() {
 		}
 		zondpbv1alpha1.RegisterDebugServer(s.grpcServer, debugServer)
 		zondpbservice.RegisterBeaconDebugServer(s.grpcServer, debugServerV1)
+
+		debugServerPrysm := &debugprysm.Server{
+			ForkchoiceFetcher: s.cfg.ForkchoiceFetcher,
+		}
+		s.cfg.Router.HandleFunc("/qrysm/v1/debug/fork_choice/graph", debugServerPrysm.GetForkChoiceGraph).Methods(http.MethodGet)
 	}
 	zondpbv1alpha1.RegisterBeaconNodeValidatorServer(s.grpcServer, validatorServer)
 	zondpbservice.RegisterBeaconValidatorServer(s.grpcServer, validatorServerV1)
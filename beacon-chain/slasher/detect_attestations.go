@@ -84,54 +84,45 @@ func (s *Service) detectAllAttesterSlashings(
 	args *chunkUpdateArgs,
 	attestations []*slashertypes.IndexedAttestationWrapper,
 ) ([]*zondpb.AttesterSlashing, error) {
-	// Map of updated chunks by chunk index, which will be saved at the end.
-	updatedChunks := make(map[uint64]Chunker)
 	groupedAtts := s.groupByChunkIndex(attestations)
 	validatorIndices := s.params.validatorIndicesInChunk(args.validatorChunkIndex)
 
-	// Update the min/max span chunks for the change of current epoch.
-	for _, validatorIndex := range validatorIndices {
-		if err := s.epochUpdateForValidator(ctx, args, updatedChunks, validatorIndex); err != nil {
+	// Min and max spans are kept in separate chunks, so each kind has its own map of updated chunks by
+	// chunk index, which will be saved at the end.
+	slashings := make([]*zondpb.AttesterSlashing, 0)
+	for _, kind := range []slashertypes.ChunkKind{slashertypes.MinSpan, slashertypes.MaxSpan} {
+		kindArgs := &chunkUpdateArgs{
+			kind:                kind,
+			validatorChunkIndex: args.validatorChunkIndex,
+			currentEpoch:        args.currentEpoch,
+		}
+		updatedChunks := make(map[uint64]Chunker)
+
+		// Update the span chunks for the change of current epoch.
+		for _, validatorIndex := range validatorIndices {
+			if err := s.epochUpdateForValidator(ctx, kindArgs, updatedChunks, validatorIndex); err != nil {
+				return nil, errors.Wrapf(
+					err,
+					"could not update validator index chunks %d",
+					validatorIndex,
+				)
+			}
+		}
+
+		// Update the spans and retrieve any detected slashable offenses.
+		kindSlashings, err := s.updateSpans(ctx, updatedChunks, kindArgs, groupedAtts)
+		if err != nil {
 			return nil, errors.Wrapf(
 				err,
-				"could not update validator index chunks %d",
-				validatorIndex,
+				"could not update attestation spans of chunk kind %d for validator chunk index %d",
+				kind,
+				args.validatorChunkIndex,
 			)
 		}
-	}
-
-	// Update min and max spans and retrieve any detected slashable offenses.
-	surroundingSlashings, err := s.updateSpans(ctx, updatedChunks, &chunkUpdateArgs{
-		kind:                slashertypes.MinSpan,
-		validatorChunkIndex: args.validatorChunkIndex,
-		currentEpoch:        args.currentEpoch,
-	}, groupedAtts)
-	if err != nil {
-		return nil, errors.Wrapf(
-			err,
-			"could not update min attestation spans for validator chunk index %d",
-			args.validatorChunkIndex,
-		)
-	}
-
-	surroundedSlashings, err := s.updateSpans(ctx, updatedChunks, &chunkUpdateArgs{
-		kind:                slashertypes.MaxSpan,
-		validatorChunkIndex: args.validatorChunkIndex,
-		currentEpoch:        args.currentEpoch,
-	}, groupedAtts)
-	if err != nil {
-		return nil, errors.Wrapf(
-			err,
-			"could not update max attestation spans for validator chunk index %d",
-			args.validatorChunkIndex,
-		)
-	}
-
-	slashings := make([]*zondpb.AttesterSlashing, 0, len(surroundingSlashings)+len(surroundedSlashings))
-	slashings = append(slashings, surroundingSlashings...)
-	slashings = append(slashings, surroundedSlashings...)
-	if err := s.saveUpdatedChunks(ctx, args, updatedChunks); err != nil {
-		return nil, err
+		slashings = append(slashings, kindSlashings...)
+		if err := s.saveUpdatedChunks(ctx, kindArgs, updatedChunks); err != nil {
+			return nil, err
+		}
 	}
 	return slashings, nil
 }
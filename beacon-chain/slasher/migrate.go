@@ -0,0 +1,226 @@
+package slasher
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/sirupsen/logrus"
+	"github.com/theQRL/qrysm/v4/beacon-chain/db"
+	slashertypes "github.com/theQRL/qrysm/v4/beacon-chain/slasher/types"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+)
+
+// ChunksMigrationDatabase defines the slasher database methods needed to migrate chunks to new parameters.
+type ChunksMigrationDatabase interface {
+	ChunkParameters(ctx context.Context) (*slashertypes.ChunkParameters, error)
+	LoadSlasherChunks(
+		ctx context.Context, kind slashertypes.ChunkKind, diskKeys [][]byte,
+	) ([][]uint16, []bool, error)
+	SlasherChunkKeys(ctx context.Context, kind slashertypes.ChunkKind) ([][]byte, error)
+	HighestEpochWritten(ctx context.Context) (primitives.Epoch, error)
+	StartSlasherChunksMigration(ctx context.Context) error
+	SaveMigratedSlasherChunks(
+		ctx context.Context, kind slashertypes.ChunkKind, chunkKeys [][]byte, chunks [][]uint16,
+	) error
+	CommitSlasherChunksMigration(ctx context.Context, p *slashertypes.ChunkParameters) error
+}
+
+// checkChunkParameters ensures the slasher chunks on disk were written with the given parameters, recording them
+// if the database holds no chunk yet.
+func checkChunkParameters(ctx context.Context, slasherDB db.SlasherDatabase, p *Parameters) error {
+	stored, err := slasherDB.ChunkParameters(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not read slasher chunk parameters")
+	}
+	if stored == nil {
+		return slasherDB.SaveChunkParameters(ctx, p.ChunkParameters())
+	}
+	storedParams, err := ParamsFromChunkParameters(stored)
+	if err != nil {
+		return errors.Wrap(err, "invalid slasher chunk parameters in database")
+	}
+	if !storedParams.Equal(p) {
+		return errors.Errorf(
+			"slasher database was written with %s but the slasher is configured with %s, "+
+				"migrate the database with `qrysmctl db migrate-slasher` or run the slasher with the same parameters",
+			storedParams, p,
+		)
+	}
+	return nil
+}
+
+// MigrateChunks rewrites the min and max span chunks of the slasher database, which were written with the
+// parameters recorded in it, so that they are split according to the given parameters. The distances of the
+// epochs kept by both histories, counting back from the highest epoch written for any validator, are carried
+// over, while the distances of older epochs are dropped.
+//
+// The migrated chunks are staged next to the current ones and only replace them once every chunk was written,
+// so an interrupted migration leaves the database untouched.
+func MigrateChunks(ctx context.Context, slasherDB ChunksMigrationDatabase, to *Parameters) error {
+	stored, err := slasherDB.ChunkParameters(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not read slasher chunk parameters")
+	}
+	from := DefaultParams()
+	if stored != nil {
+		if from, err = ParamsFromChunkParameters(stored); err != nil {
+			return errors.Wrap(err, "invalid slasher chunk parameters in database")
+		}
+	}
+	if from.Equal(to) {
+		log.WithField("params", to).Info("Slasher chunks already use the requested parameters")
+		return nil
+	}
+	current, err := slasherDB.HighestEpochWritten(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not determine highest epoch written")
+	}
+	log.WithFields(logrus.Fields{
+		"from":         from,
+		"to":           to,
+		"currentEpoch": current,
+	}).Info("Migrating slasher chunks")
+	if err := slasherDB.StartSlasherChunksMigration(ctx); err != nil {
+		return err
+	}
+	for _, kind := range []slashertypes.ChunkKind{slashertypes.MinSpan, slashertypes.MaxSpan} {
+		if err := migrateChunks(ctx, slasherDB, kind, from, to, current); err != nil {
+			return errors.Wrapf(err, "could not migrate chunks of kind %d", kind)
+		}
+	}
+	return slasherDB.CommitSlasherChunksMigration(ctx, to.ChunkParameters())
+}
+
+func migrateChunks(
+	ctx context.Context,
+	slasherDB ChunksMigrationDatabase,
+	kind slashertypes.ChunkKind,
+	from, to *Parameters,
+	current primitives.Epoch,
+) error {
+	keys, err := slasherDB.SlasherChunkKeys(ctx, kind)
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	width := uint64(from.historyLength.Div(from.chunkSize))
+	var highestValidatorChunk uint64
+	for _, k := range keys {
+		if idx := ssz.UnmarshallUint64(k) / width; idx > highestValidatorChunk {
+			highestValidatorChunk = idx
+		}
+	}
+	numValidators := (highestValidatorChunk + 1) * from.validatorChunkSize
+
+	// Only the epochs kept by both the old and the new history are carried over.
+	window := from.historyLength
+	if to.historyLength < window {
+		window = to.historyLength
+	}
+	var lowest primitives.Epoch
+	if current+1 > window {
+		lowest = current + 1 - window
+	}
+	neutral := neutralElement(kind)
+	old := &oldChunks{ctx: ctx, db: slasherDB, kind: kind, params: from, chunks: make(map[string][]uint16)}
+
+	for validatorChunk := uint64(0); validatorChunk*to.validatorChunkSize < numValidators; validatorChunk++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		newChunks := make(map[uint64][]uint16)
+		for _, v := range to.validatorIndicesInChunk(validatorChunk) {
+			if uint64(v) >= numValidators {
+				break
+			}
+			for e := lowest; e <= current; e++ {
+				chunk, err := old.get(v, e)
+				if err != nil {
+					return err
+				}
+				if chunk == nil || chunk[from.cellIndex(v, e)] == neutral {
+					continue
+				}
+				chunkIdx := to.chunkIndex(e)
+				newChunk, ok := newChunks[chunkIdx]
+				if !ok {
+					newChunk = make([]uint16, to.chunkSize*to.validatorChunkSize)
+					for i := range newChunk {
+						newChunk[i] = neutral
+					}
+					newChunks[chunkIdx] = newChunk
+				}
+				newChunk[to.cellIndex(v, e)] = chunk[from.cellIndex(v, e)]
+			}
+		}
+		old.evictBelow(from.validatorChunkIndex(primitives.ValidatorIndex((validatorChunk + 1) * to.validatorChunkSize)))
+
+		chunkIndices := make([]uint64, 0, len(newChunks))
+		for idx := range newChunks {
+			chunkIndices = append(chunkIndices, idx)
+		}
+		sort.Slice(chunkIndices, func(i, j int) bool { return chunkIndices[i] < chunkIndices[j] })
+		chunkKeys := make([][]byte, len(chunkIndices))
+		chunks := make([][]uint16, len(chunkIndices))
+		for i, idx := range chunkIndices {
+			chunkKeys[i] = to.flatSliceID(validatorChunk, idx)
+			chunks[i] = newChunks[idx]
+		}
+		if err := slasherDB.SaveMigratedSlasherChunks(ctx, kind, chunkKeys, chunks); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// oldChunks loads and caches the chunks being migrated.
+type oldChunks struct {
+	ctx    context.Context
+	db     ChunksMigrationDatabase
+	kind   slashertypes.ChunkKind
+	params *Parameters
+	chunks map[string][]uint16
+}
+
+// get returns the chunk holding the distance of the validator at the epoch, nil if it was never written.
+func (o *oldChunks) get(v primitives.ValidatorIndex, e primitives.Epoch) ([]uint16, error) {
+	key := o.params.flatSliceID(o.params.validatorChunkIndex(v), o.params.chunkIndex(e))
+	if chunk, ok := o.chunks[string(key)]; ok {
+		return chunk, nil
+	}
+	chunks, exists, err := o.db.LoadSlasherChunks(o.ctx, o.kind, [][]byte{key})
+	if err != nil {
+		return nil, err
+	}
+	var chunk []uint16
+	if exists[0] {
+		chunk = chunks[0]
+		if uint64(len(chunk)) != o.params.chunkSize*o.params.validatorChunkSize {
+			return nil, errors.Errorf("chunk has length %d, expected %d", len(chunk), o.params.chunkSize*o.params.validatorChunkSize)
+		}
+	}
+	o.chunks[string(key)] = chunk
+	return chunk, nil
+}
+
+// evictBelow drops the cached chunks of the validator chunks below the given one, which are not needed anymore.
+func (o *oldChunks) evictBelow(validatorChunk uint64) {
+	width := uint64(o.params.historyLength.Div(o.params.chunkSize))
+	for k := range o.chunks {
+		if ssz.UnmarshallUint64([]byte(k))/width < validatorChunk {
+			delete(o.chunks, k)
+		}
+	}
+}
+
+func neutralElement(kind slashertypes.ChunkKind) uint16 {
+	if kind == slashertypes.MinSpan {
+		return math.MaxUint16
+	}
+	return 0
+}
@@ -0,0 +1,133 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/theQRL/qrysm/v4/beacon-chain/db/slasherkv"
+	dbtest "github.com/theQRL/qrysm/v4/beacon-chain/db/testing"
+	slashertypes "github.com/theQRL/qrysm/v4/beacon-chain/slasher/types"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/testing/require"
+)
+
+func TestNewParams(t *testing.T) {
+	p, err := NewParams(4, 128, 1024)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), p.chunkSize)
+	require.Equal(t, uint64(128), p.validatorChunkSize)
+	require.Equal(t, primitives.Epoch(1024), p.historyLength)
+	require.Equal(t, true, p.Equal(&Parameters{chunkSize: 4, validatorChunkSize: 128, historyLength: 1024}))
+	require.Equal(t, false, p.Equal(DefaultParams()))
+
+	fromDisk, err := ParamsFromChunkParameters(p.ChunkParameters())
+	require.NoError(t, err)
+	require.Equal(t, true, p.Equal(fromDisk))
+
+	_, err = NewParams(0, 128, 1024)
+	require.ErrorContains(t, "must be greater than 0", err)
+	_, err = NewParams(3, 128, 1024)
+	require.ErrorContains(t, "must be a multiple of the chunk size", err)
+	_, err = NewParams(2048, 128, 1024)
+	require.ErrorContains(t, "must be a multiple of the chunk size", err)
+}
+
+func TestCheckChunkParameters(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+
+	// The parameters are recorded in a database without any chunk.
+	require.NoError(t, checkChunkParameters(ctx, slasherDB, DefaultParams()))
+	stored, err := slasherDB.ChunkParameters(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, DefaultParams().ChunkParameters(), stored)
+
+	require.NoError(t, checkChunkParameters(ctx, slasherDB, DefaultParams()))
+	p, err := NewParams(8, 256, 4096)
+	require.NoError(t, err)
+	require.ErrorContains(t, "qrysmctl db migrate-slasher", checkChunkParameters(ctx, slasherDB, p))
+}
+
+func TestMigrateChunks(t *testing.T) {
+	from := &Parameters{chunkSize: 2, validatorChunkSize: 2, historyLength: 8}
+	tests := []struct {
+		name string
+		to   *Parameters
+	}{
+		{name: "shorter history", to: &Parameters{chunkSize: 4, validatorChunkSize: 3, historyLength: 4}},
+		{name: "longer history", to: &Parameters{chunkSize: 4, validatorChunkSize: 1, historyLength: 16}},
+		{name: "same history", to: &Parameters{chunkSize: 8, validatorChunkSize: 4, historyLength: 8}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			slasherDB, err := slasherkv.NewKVStore(ctx, t.TempDir())
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				require.NoError(t, slasherDB.Close())
+			})
+			require.NoError(t, slasherDB.SaveChunkParameters(ctx, from.ChunkParameters()))
+
+			const numValidators = 4
+			current := primitives.Epoch(9)
+			require.NoError(t, slasherDB.SaveLastEpochsWrittenForValidators(ctx, map[primitives.ValidatorIndex]primitives.Epoch{
+				0: current,
+			}))
+			distance := func(v primitives.ValidatorIndex, e primitives.Epoch) uint16 {
+				return uint16(v)*100 + uint16(e)
+			}
+			lowestFrom := current + 1 - from.historyLength
+			for _, kind := range []slashertypes.ChunkKind{slashertypes.MinSpan, slashertypes.MaxSpan} {
+				chunks := make(map[string][]uint16)
+				for v := primitives.ValidatorIndex(0); v < numValidators; v++ {
+					for e := lowestFrom; e <= current; e++ {
+						key := string(from.flatSliceID(from.validatorChunkIndex(v), from.chunkIndex(e)))
+						if _, ok := chunks[key]; !ok {
+							chunks[key] = emptyChunk(from, kind)
+						}
+						chunks[key][from.cellIndex(v, e)] = distance(v, e)
+					}
+				}
+				keys := make([][]byte, 0, len(chunks))
+				values := make([][]uint16, 0, len(chunks))
+				for k, c := range chunks {
+					keys = append(keys, []byte(k))
+					values = append(values, c)
+				}
+				require.NoError(t, slasherDB.SaveSlasherChunks(ctx, kind, keys, values))
+			}
+
+			require.NoError(t, MigrateChunks(ctx, slasherDB, tt.to))
+			stored, err := slasherDB.ChunkParameters(ctx)
+			require.NoError(t, err)
+			require.DeepEqual(t, tt.to.ChunkParameters(), stored)
+
+			// Only the epochs kept by both histories are carried over.
+			lowest := lowestFrom
+			if tt.to.historyLength < from.historyLength {
+				lowest = current + 1 - tt.to.historyLength
+			}
+			for _, kind := range []slashertypes.ChunkKind{slashertypes.MinSpan, slashertypes.MaxSpan} {
+				for v := primitives.ValidatorIndex(0); v < numValidators; v++ {
+					for e := lowest; e <= current; e++ {
+						key := tt.to.flatSliceID(tt.to.validatorChunkIndex(v), tt.to.chunkIndex(e))
+						chunks, exist, err := slasherDB.LoadSlasherChunks(ctx, kind, [][]byte{key})
+						require.NoError(t, err)
+						require.Equal(t, true, exist[0])
+						require.Equal(t, distance(v, e), chunks[0][tt.to.cellIndex(v, e)])
+					}
+				}
+			}
+			// The migration is a no-op once the chunks use the requested parameters.
+			require.NoError(t, MigrateChunks(ctx, slasherDB, tt.to))
+		})
+	}
+}
+
+func emptyChunk(p *Parameters, kind slashertypes.ChunkKind) []uint16 {
+	chunk := make([]uint16, p.chunkSize*p.validatorChunkSize)
+	for i := range chunk {
+		chunk[i] = neutralElement(kind)
+	}
+	return chunk
+}
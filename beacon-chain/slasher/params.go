@@ -1,7 +1,11 @@
 package slasher
 
 import (
+	"fmt"
+
+	"github.com/pkg/errors"
 	ssz "github.com/prysmaticlabs/fastssz"
+	slashertypes "github.com/theQRL/qrysm/v4/beacon-chain/slasher/types"
 	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
 )
 
@@ -38,6 +42,49 @@ func DefaultParams() *Parameters {
 	}
 }
 
+// NewParams returns slasher parameters with the given chunk size, validator chunk size and history length.
+// The history length must be a multiple of the chunk size, so that the min and max spans of a validator are
+// split into whole chunks.
+func NewParams(chunkSize, validatorChunkSize uint64, historyLength primitives.Epoch) (*Parameters, error) {
+	if chunkSize == 0 || validatorChunkSize == 0 || historyLength == 0 {
+		return nil, errors.New("slasher chunk size, validator chunk size and history length must be greater than 0")
+	}
+	if uint64(historyLength)%chunkSize != 0 {
+		return nil, errors.Errorf("slasher history length %d must be a multiple of the chunk size %d", historyLength, chunkSize)
+	}
+	return &Parameters{
+		chunkSize:          chunkSize,
+		validatorChunkSize: validatorChunkSize,
+		historyLength:      historyLength,
+	}, nil
+}
+
+// ParamsFromChunkParameters returns the slasher parameters matching chunk parameters recorded on disk.
+func ParamsFromChunkParameters(p *slashertypes.ChunkParameters) (*Parameters, error) {
+	return NewParams(p.ChunkSize, p.ValidatorChunkSize, p.HistoryLength)
+}
+
+// ChunkParameters returns the chunk parameters to record on disk along with the chunks.
+func (p *Parameters) ChunkParameters() *slashertypes.ChunkParameters {
+	return &slashertypes.ChunkParameters{
+		ChunkSize:          p.chunkSize,
+		ValidatorChunkSize: p.validatorChunkSize,
+		HistoryLength:      p.historyLength,
+	}
+}
+
+// Equal returns true if both parameters split the min and max spans the same way.
+func (p *Parameters) Equal(other *Parameters) bool {
+	return p.chunkSize == other.chunkSize &&
+		p.validatorChunkSize == other.validatorChunkSize &&
+		p.historyLength == other.historyLength
+}
+
+// String --
+func (p *Parameters) String() string {
+	return fmt.Sprintf("chunkSize=%d validatorChunkSize=%d historyLength=%d", p.chunkSize, p.validatorChunkSize, p.historyLength)
+}
+
 // Validator min and max spans are split into chunks of length C = chunkSize.
 // That is, if we are keeping N epochs worth of attesting history, finding what
 // chunk a certain epoch, e, falls into can be computed as (e % N) / C. For example,
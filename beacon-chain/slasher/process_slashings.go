@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/theQRL/qrysm/v4/beacon-chain/core/blocks"
+	slashertypes "github.com/theQRL/qrysm/v4/beacon-chain/slasher/types"
 	"github.com/theQRL/qrysm/v4/beacon-chain/state"
 	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
 	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
@@ -12,6 +13,10 @@ import (
 // Verifies attester slashings, logs them, and submits them to the slashing operations pool
 // in the beacon node if they pass validation.
 func (s *Service) processAttesterSlashings(ctx context.Context, slashings []*zondpb.AttesterSlashing) error {
+	if s.serviceCfg.RemoteBeaconNode != nil {
+		s.submitAttesterSlashings(ctx, slashings)
+		return nil
+	}
 	var beaconState state.BeaconState
 	var err error
 	if len(slashings) > 0 {
@@ -34,8 +39,9 @@ func (s *Service) processAttesterSlashings(ctx context.Context, slashings []*zon
 			continue
 		}
 
-		// Log the slashing event and insert into the beacon node's operations pool.
+		// Log and record the slashing event and insert into the beacon node's operations pool.
 		logAttesterSlashing(sl)
+		s.recordAttesterSlashing(ctx, sl)
 		if err := s.serviceCfg.SlashingPoolInserter.InsertAttesterSlashing(
 			ctx, beaconState, sl,
 		); err != nil {
@@ -48,6 +54,10 @@ func (s *Service) processAttesterSlashings(ctx context.Context, slashings []*zon
 // Verifies proposer slashings, logs them, and submits them to the slashing operations pool
 // in the beacon node if they pass validation.
 func (s *Service) processProposerSlashings(ctx context.Context, slashings []*zondpb.ProposerSlashing) error {
+	if s.serviceCfg.RemoteBeaconNode != nil {
+		s.submitProposerSlashings(ctx, slashings)
+		return nil
+	}
 	var beaconState state.BeaconState
 	var err error
 	if len(slashings) > 0 {
@@ -69,8 +79,9 @@ func (s *Service) processProposerSlashings(ctx context.Context, slashings []*zon
 			)
 			continue
 		}
-		// Log the slashing event and insert into the beacon node's operations pool.
+		// Log and record the slashing event and insert into the beacon node's operations pool.
 		logProposerSlashing(sl)
+		s.recordProposerSlashing(ctx, sl)
 		if err := s.serviceCfg.SlashingPoolInserter.InsertProposerSlashing(ctx, beaconState, sl); err != nil {
 			log.WithError(err).Error("Could not insert proposer slashing into operations pool")
 		}
@@ -78,6 +89,48 @@ func (s *Service) processProposerSlashings(ctx context.Context, slashings []*zon
 	return nil
 }
 
+// Logs attester slashings and submits them to the beacon node watched by a slasher running in its own process,
+// which verifies them before inserting them into its operations pool.
+func (s *Service) submitAttesterSlashings(ctx context.Context, slashings []*zondpb.AttesterSlashing) {
+	for _, sl := range slashings {
+		logAttesterSlashing(sl)
+		s.recordAttesterSlashing(ctx, sl)
+		if err := s.serviceCfg.RemoteBeaconNode.SubmitAttesterSlashing(ctx, sl); err != nil {
+			log.WithError(err).Error("Could not submit attester slashing to beacon node")
+		}
+	}
+}
+
+// Logs proposer slashings and submits them to the beacon node watched by a slasher running in its own process,
+// which verifies them before inserting them into its operations pool.
+func (s *Service) submitProposerSlashings(ctx context.Context, slashings []*zondpb.ProposerSlashing) {
+	for _, sl := range slashings {
+		logProposerSlashing(sl)
+		s.recordProposerSlashing(ctx, sl)
+		if err := s.serviceCfg.RemoteBeaconNode.SubmitProposerSlashing(ctx, sl); err != nil {
+			log.WithError(err).Error("Could not submit proposer slashing to beacon node")
+		}
+	}
+}
+
+// Saves an attester slashing as evidence against the slashed validators and notifies the
+// subscribers to detected slashings.
+func (s *Service) recordAttesterSlashing(ctx context.Context, sl *zondpb.AttesterSlashing) {
+	if err := s.serviceCfg.Database.SaveAttesterSlashings(ctx, []*zondpb.AttesterSlashing{sl}); err != nil {
+		log.WithError(err).Error("Could not save attester slashing")
+	}
+	s.slashingsFeed.Send(&slashertypes.DetectedSlashing{AttesterSlashing: sl})
+}
+
+// Saves a proposer slashing as evidence against the slashed validator and notifies the
+// subscribers to detected slashings.
+func (s *Service) recordProposerSlashing(ctx context.Context, sl *zondpb.ProposerSlashing) {
+	if err := s.serviceCfg.Database.SaveProposerSlashings(ctx, []*zondpb.ProposerSlashing{sl}); err != nil {
+		log.WithError(err).Error("Could not save proposer slashing")
+	}
+	s.slashingsFeed.Send(&slashertypes.DetectedSlashing{ProposerSlashing: sl})
+}
+
 func (s *Service) verifyBlockSignature(ctx context.Context, header *zondpb.SignedBeaconBlockHeader) error {
 	parentState, err := s.serviceCfg.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(header.Header.ParentRoot))
 	if err != nil {
@@ -175,7 +175,7 @@ func (s *Service) pruneSlasherData(ctx context.Context, slotTicker <-chan primit
 	for {
 		select {
 		case <-slotTicker:
-			headEpoch := slots.ToEpoch(s.serviceCfg.HeadStateFetcher.HeadSlot())
+			headEpoch := slots.ToEpoch(s.headSlot())
 			if err := s.pruneSlasherDataWithinSlidingWindow(ctx, headEpoch); err != nil {
 				log.WithError(err).Error("Could not prune slasher data")
 				continue
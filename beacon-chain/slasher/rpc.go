@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/v4/async/event"
 	slashertypes "github.com/theQRL/qrysm/v4/beacon-chain/slasher/types"
 	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
 	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
@@ -77,3 +78,155 @@ func (s *Service) IsSlashableAttestation(
 	}
 	return attesterSlashings, nil
 }
+
+// Slashings retrieves the attester and proposer slashings detected against the validator whose
+// epoch lies between the start and end epochs, inclusive.
+func (s *Service) Slashings(
+	ctx context.Context, validatorIdx primitives.ValidatorIndex, start, end primitives.Epoch,
+) ([]*zondpb.AttesterSlashing, []*zondpb.ProposerSlashing, error) {
+	attesterSlashings, proposerSlashings, err := s.serviceCfg.Database.SlashingsForValidator(ctx, validatorIdx, start, end)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get slashings from database")
+	}
+	return attesterSlashings, proposerSlashings, nil
+}
+
+// AttestationRecords retrieves the attestations of the validator stored by the slasher with a target
+// epoch between the start and end epochs, inclusive, which lies within the history kept by the slasher.
+func (s *Service) AttestationRecords(
+	ctx context.Context, validatorIdx primitives.ValidatorIndex, start, end primitives.Epoch,
+) ([]*zondpb.IndexedAttestation, error) {
+	start, end, err := s.historyWindow(start, end)
+	if err != nil {
+		return nil, err
+	}
+	atts := make([]*zondpb.IndexedAttestation, 0)
+	if start > end {
+		return atts, nil
+	}
+	records, err := s.serviceCfg.Database.AttestationRecordsForValidator(ctx, validatorIdx, start, end)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attestation records from database")
+	}
+	for _, r := range records {
+		atts = append(atts, r.IndexedAttestation)
+	}
+	return atts, nil
+}
+
+// Spans retrieves the min and max spans of the validator at the epochs between the start and end
+// epochs, inclusive, which lie within the history kept by the slasher.
+func (s *Service) Spans(
+	ctx context.Context, validatorIdx primitives.ValidatorIndex, start, end primitives.Epoch,
+) ([]*slashertypes.EpochSpans, error) {
+	start, end, err := s.historyWindow(start, end)
+	if err != nil {
+		return nil, err
+	}
+	spans := make([]*slashertypes.EpochSpans, 0)
+	if start > end {
+		return spans, nil
+	}
+	minChunks := make(map[uint64][]uint16)
+	maxChunks := make(map[uint64][]uint16)
+	for epoch := start; epoch <= end; epoch++ {
+		minSpan, err := s.spanAt(ctx, slashertypes.MinSpan, minChunks, validatorIdx, epoch)
+		if err != nil {
+			return nil, err
+		}
+		maxSpan, err := s.spanAt(ctx, slashertypes.MaxSpan, maxChunks, validatorIdx, epoch)
+		if err != nil {
+			return nil, err
+		}
+		spans = append(spans, &slashertypes.EpochSpans{Epoch: epoch, MinSpan: minSpan, MaxSpan: maxSpan})
+	}
+	return spans, nil
+}
+
+// slashingsSubscriberBufferSize is the number of detected slashings buffered for a subscriber which does not
+// receive them fast enough.
+const slashingsSubscriberBufferSize = 256
+
+// errSlowSlashingsSubscriber is returned on the subscription of a subscriber whose buffer of detected slashings is full.
+var errSlowSlashingsSubscriber = errors.New("slashings subscriber is too slow, buffer of detected slashings is full")
+
+// SubscribeSlashings subscribes to the slashings detected by the slasher. The slashings are relayed to the channel
+// through a buffer of the subscriber, so that a slow subscriber never blocks the detection of slashings. If the
+// buffer fills up, the subscription ends with an error and the subscriber has to subscribe again.
+func (s *Service) SubscribeSlashings(ch chan<- *slashertypes.DetectedSlashing) event.Subscription {
+	detected := make(chan *slashertypes.DetectedSlashing, 1)
+	sub := s.slashingsFeed.Subscribe(detected)
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		buffer := make([]*slashertypes.DetectedSlashing, 0, slashingsSubscriberBufferSize)
+		for {
+			// Only try to relay the next slashing when there is one, a nil channel is never ready.
+			var out chan<- *slashertypes.DetectedSlashing
+			var next *slashertypes.DetectedSlashing
+			if len(buffer) > 0 {
+				out, next = ch, buffer[0]
+			}
+			select {
+			case sl := <-detected:
+				if len(buffer) == slashingsSubscriberBufferSize {
+					return errSlowSlashingsSubscriber
+				}
+				buffer = append(buffer, sl)
+			case out <- next:
+				buffer = buffer[1:]
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	})
+}
+
+// historyWindow restricts the epoch range to the epochs whose history is kept by the slasher.
+func (s *Service) historyWindow(start, end primitives.Epoch) (primitives.Epoch, primitives.Epoch, error) {
+	if s.genesisTime.IsZero() {
+		return 0, 0, errors.New("slasher is not initialized yet")
+	}
+	current := slots.EpochsSinceGenesis(s.genesisTime)
+	var lowest primitives.Epoch
+	if current+1 > s.params.historyLength {
+		lowest = current + 1 - s.params.historyLength
+	}
+	if start < lowest {
+		start = lowest
+	}
+	if end > current {
+		end = current
+	}
+	return start, end, nil
+}
+
+// spanAt reads the min or max span of the validator at the epoch from its chunk, loading the chunk
+// from the database unless it is cached by chunk index.
+func (s *Service) spanAt(
+	ctx context.Context,
+	kind slashertypes.ChunkKind,
+	cache map[uint64][]uint16,
+	validatorIdx primitives.ValidatorIndex,
+	epoch primitives.Epoch,
+) (uint16, error) {
+	chunkIdx := s.params.chunkIndex(epoch)
+	chunk, ok := cache[chunkIdx]
+	if !ok {
+		key := s.params.flatSliceID(s.params.validatorChunkIndex(validatorIdx), chunkIdx)
+		chunks, exist, err := s.serviceCfg.Database.LoadSlasherChunks(ctx, kind, [][]byte{key})
+		if err != nil {
+			return 0, errors.Wrap(err, "could not load slasher chunk")
+		}
+		if exist[0] {
+			chunk = chunks[0]
+		}
+		cache[chunkIdx] = chunk
+	}
+	cell := s.params.cellIndex(validatorIdx, epoch)
+	if cell >= uint64(len(chunk)) {
+		return neutralElement(kind), nil
+	}
+	return chunk[cell], nil
+}
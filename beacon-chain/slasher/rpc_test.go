@@ -198,3 +198,87 @@ func TestService_HighestAttestations(t *testing.T) {
 		require.DeepEqual(t, &zondpb.HighestAttestation{ValidatorIndex: 1, HighestSourceEpoch: 0, HighestTargetEpoch: 1}, atts[0])
 	})
 }
+
+func TestService_AttestationRecordsAndSpans(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+
+	currentEpoch := primitives.Epoch(3)
+	currentTime := time.Now()
+	totalSlots := uint64(currentEpoch) * uint64(params.BeaconConfig().SlotsPerEpoch)
+	secondsSinceGenesis := time.Duration(totalSlots * params.BeaconConfig().SecondsPerSlot)
+	genesisTime := currentTime.Add(-secondsSinceGenesis * time.Second)
+
+	s := &Service{
+		serviceCfg: &ServiceConfig{
+			Database: slasherDB,
+		},
+		params:                         DefaultParams(),
+		blksQueue:                      newBlocksQueue(),
+		latestEpochWrittenForValidator: map[primitives.ValidatorIndex]primitives.Epoch{},
+	}
+	_, err := s.Spans(ctx, 5, 0, 3)
+	require.ErrorContains(t, "slasher is not initialized yet", err)
+
+	s.genesisTime = genesisTime
+	att := createAttestationWrapper(t, 0, 3, []uint64{5}, []byte{1})
+	attesterSlashings, err := s.IsSlashableAttestation(ctx, att.IndexedAttestation)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(attesterSlashings))
+
+	atts, err := s.AttestationRecords(ctx, 5, 0, 100)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(atts))
+	require.DeepEqual(t, att.IndexedAttestation, atts[0])
+
+	atts, err = s.AttestationRecords(ctx, 6, 0, 100)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(atts))
+
+	// The end epoch is capped to the current epoch.
+	spans, err := s.Spans(ctx, 5, 0, 100)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(spans))
+	for i, wantMaxSpan := range []uint16{0, 2, 1, 0} {
+		assert.Equal(t, primitives.Epoch(i), spans[i].Epoch)
+		assert.Equal(t, wantMaxSpan, spans[i].MaxSpan)
+	}
+}
+
+func TestService_SubscribeSlashings(t *testing.T) {
+	s := &Service{}
+	ch := make(chan *slashertypes.DetectedSlashing, 1)
+	sub := s.SubscribeSlashings(ch)
+	sl := &slashertypes.DetectedSlashing{ProposerSlashing: &zondpb.ProposerSlashing{}}
+	s.slashingsFeed.Send(sl)
+	select {
+	case got := <-ch:
+		assert.Equal(t, sl, got)
+	case <-time.After(5 * time.Second):
+		t.Fatal("slashing was not received")
+	}
+	sub.Unsubscribe()
+
+	// A subscriber which does not receive the slashings does not block their detection.
+	slow := make(chan *slashertypes.DetectedSlashing)
+	sub = s.SubscribeSlashings(slow)
+	defer sub.Unsubscribe()
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < slashingsSubscriberBufferSize+2; i++ {
+			s.slashingsFeed.Send(sl)
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("detected slashings were blocked by a slow subscriber")
+	}
+	select {
+	case err := <-sub.Err():
+		require.ErrorIs(t, err, errSlowSlashingsSubscriber)
+	case <-time.After(5 * time.Second):
+		t.Fatal("slow subscriber was not disconnected")
+	}
+}
@@ -8,11 +8,13 @@ import (
 	"context"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/theQRL/qrysm/v4/async/event"
 	"github.com/theQRL/qrysm/v4/beacon-chain/blockchain"
 	statefeed "github.com/theQRL/qrysm/v4/beacon-chain/core/feed/state"
 	"github.com/theQRL/qrysm/v4/beacon-chain/db"
 	"github.com/theQRL/qrysm/v4/beacon-chain/operations/slashings"
+	slashertypes "github.com/theQRL/qrysm/v4/beacon-chain/slasher/types"
 	"github.com/theQRL/qrysm/v4/beacon-chain/startup"
 	"github.com/theQRL/qrysm/v4/beacon-chain/state/stategen"
 	"github.com/theQRL/qrysm/v4/beacon-chain/sync"
@@ -40,6 +42,20 @@ type ServiceConfig struct {
 	HeadStateFetcher        blockchain.HeadFetcher
 	SyncChecker             sync.Checker
 	ClockWaiter             startup.ClockWaiter
+	// Params are the slasher parameters, the default parameters are used when nil.
+	Params *Parameters
+	// RemoteBeaconNode is set when the slasher runs outside of a beacon node. The head state
+	// and operations pool dependencies are not used then.
+	RemoteBeaconNode RemoteBeaconNode
+}
+
+// RemoteBeaconNode defines the beacon node a slasher running in its own process watches, and submits the
+// slashings it detects to. The beacon node verifies the slashings before adding them to its operations pool.
+type RemoteBeaconNode interface {
+	HeadSlot() primitives.Slot
+	NumValidators(ctx context.Context) (int, error)
+	SubmitAttesterSlashing(ctx context.Context, slashing *zondpb.AttesterSlashing) error
+	SubmitProposerSlashing(ctx context.Context, slashing *zondpb.ProposerSlashing) error
 }
 
 // SlashingChecker is an interface for defining services that the beacon node may interact with to provide slashing data.
@@ -51,6 +67,21 @@ type SlashingChecker interface {
 	) ([]*zondpb.HighestAttestation, error)
 }
 
+// EvidenceFetcher defines the queries on the evidence the slasher holds about validators: the slashings it
+// detected, the attestation records and the min and max spans it checks new attestations against.
+type EvidenceFetcher interface {
+	Slashings(
+		ctx context.Context, validatorIdx primitives.ValidatorIndex, start, end primitives.Epoch,
+	) ([]*zondpb.AttesterSlashing, []*zondpb.ProposerSlashing, error)
+	AttestationRecords(
+		ctx context.Context, validatorIdx primitives.ValidatorIndex, start, end primitives.Epoch,
+	) ([]*zondpb.IndexedAttestation, error)
+	Spans(
+		ctx context.Context, validatorIdx primitives.ValidatorIndex, start, end primitives.Epoch,
+	) ([]*slashertypes.EpochSpans, error)
+	SubscribeSlashings(ch chan<- *slashertypes.DetectedSlashing) event.Subscription
+}
+
 // Service defining a slasher implementation as part of
 // the beacon node, able to detect eth2 slashable offenses.
 type Service struct {
@@ -67,13 +98,23 @@ type Service struct {
 	blocksSlotTicker               *slots.SlotTicker
 	pruningSlotTicker              *slots.SlotTicker
 	latestEpochWrittenForValidator map[primitives.ValidatorIndex]primitives.Epoch
+	slashingsFeed                  event.Feed
 }
 
 // New instantiates a new slasher from configuration values.
 func New(ctx context.Context, srvCfg *ServiceConfig) (*Service, error) {
+	p := srvCfg.Params
+	if p == nil {
+		p = DefaultParams()
+	}
+	if srvCfg.Database != nil {
+		if err := checkChunkParameters(ctx, srvCfg.Database, p); err != nil {
+			return nil, err
+		}
+	}
 	ctx, cancel := context.WithCancel(ctx)
 	return &Service{
-		params:                         DefaultParams(),
+		params:                         p,
 		serviceCfg:                     srvCfg,
 		indexedAttsChan:                make(chan *zondpb.IndexedAttestation, 1),
 		beaconBlockHeadersChan:         make(chan *zondpb.SignedBeaconBlockHeader, 1),
@@ -98,12 +139,11 @@ func (s *Service) run() {
 	log.Info("Completed chain sync, starting slashing detection")
 
 	// Get the latest epoch written for each validator from disk on startup.
-	headState, err := s.serviceCfg.HeadStateFetcher.HeadState(s.ctx)
+	numVals, err := s.numValidators(s.ctx)
 	if err != nil {
-		log.WithError(err).Error("Failed to fetch head state")
+		log.WithError(err).Error("Failed to fetch the number of validators")
 		return
 	}
-	numVals := headState.NumValidators()
 	validatorIndices := make([]primitives.ValidatorIndex, numVals)
 	for i := 0; i < numVals; i++ {
 		validatorIndices[i] = primitives.ValidatorIndex(i)
@@ -172,6 +212,24 @@ func (*Service) Status() error {
 	return nil
 }
 
+func (s *Service) numValidators(ctx context.Context) (int, error) {
+	if s.serviceCfg.RemoteBeaconNode != nil {
+		return s.serviceCfg.RemoteBeaconNode.NumValidators(ctx)
+	}
+	headState, err := s.serviceCfg.HeadStateFetcher.HeadState(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not fetch head state")
+	}
+	return headState.NumValidators(), nil
+}
+
+func (s *Service) headSlot() primitives.Slot {
+	if s.serviceCfg.RemoteBeaconNode != nil {
+		return s.serviceCfg.RemoteBeaconNode.HeadSlot()
+	}
+	return s.serviceCfg.HeadStateFetcher.HeadSlot()
+}
+
 func (s *Service) waitForChainInitialization() {
 	clock, err := s.serviceCfg.ClockWaiter.WaitForClock(s.ctx)
 	if err != nil {
@@ -0,0 +1,171 @@
+package standalone
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/r3labs/sse/v2"
+	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/qrysm/v4/api/client/beacon"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/apimiddleware"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/events"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/shared"
+	fieldparams "github.com/theQRL/qrysm/v4/config/fieldparams"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	"github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1/attestation"
+	"github.com/theQRL/qrysm/v4/time/slots"
+)
+
+const eventsPath = "/zond/v1/events"
+
+// streamEvents subscribes to the head, block and attestation events of the beacon node, resubscribing
+// whenever the stream ends until the service is stopped.
+func (s *Service) streamEvents(n *beacon.Client) {
+	topics := strings.Join([]string{events.HeadTopic, events.BlockTopic, events.AttestationTopic}, ",")
+	url := fmt.Sprintf("%s%s?topics=%s", strings.TrimSuffix(n.NodeURL(), "/"), eventsPath, topics)
+	logger := log.WithField("endpoint", n.NodeURL())
+	for {
+		c := sse.NewClient(url)
+		logger.Info("Subscribing to beacon node events")
+		err := c.SubscribeRawWithContext(s.ctx, func(msg *sse.Event) {
+			topic := string(msg.Event)
+			receivedEventsTotal.WithLabelValues(topic).Inc()
+			if err := s.handleEvent(s.ctx, n, topic, msg.Data); err != nil {
+				failedEventsTotal.WithLabelValues(topic).Inc()
+				logger.WithError(err).WithField("topic", topic).Debug("Could not handle beacon node event")
+			}
+		})
+		if s.ctx.Err() != nil {
+			return
+		}
+		logger.WithError(err).Warn("Beacon node event stream ended, resubscribing")
+		select {
+		case <-time.After(retryInterval):
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Service) handleEvent(ctx context.Context, n *beacon.Client, topic string, data []byte) error {
+	switch topic {
+	case events.HeadTopic:
+		head := &apimiddleware.EventHeadJson{}
+		if err := json.Unmarshal(data, head); err != nil {
+			return errors.Wrap(err, "could not decode head event")
+		}
+		slot, err := strconv.ParseUint(head.Slot, 10, 64)
+		if err != nil {
+			return errors.Wrapf(err, "invalid head slot %s", head.Slot)
+		}
+		s.updateHeadSlot(primitives.Slot(slot))
+	case events.BlockTopic:
+		blk := &apimiddleware.ReceivedBlockDataJson{}
+		if err := json.Unmarshal(data, blk); err != nil {
+			return errors.Wrap(err, "could not decode block event")
+		}
+		root, err := shared.DecodeHexWithLength(blk.Block, fieldparams.RootLength)
+		if err != nil {
+			return errors.Wrap(err, "invalid block root")
+		}
+		if s.seenBefore(bytesutil.ToBytes32(root)) {
+			return nil
+		}
+		header, err := n.GetBlockHeader(ctx, beacon.IdFromRoot(bytesutil.ToBytes32(root)))
+		if err != nil {
+			return err
+		}
+		s.blockHeadersFeed.Send(header)
+	case events.AttestationTopic:
+		a := &shared.Attestation{}
+		if err := json.Unmarshal(data, a); err != nil {
+			return errors.Wrap(err, "could not decode attestation event")
+		}
+		att, err := a.ToConsensus()
+		if err != nil {
+			return err
+		}
+		root, err := att.HashTreeRoot()
+		if err != nil {
+			return errors.Wrap(err, "could not hash attestation")
+		}
+		if s.seenBefore(root) {
+			return nil
+		}
+		indexed, err := s.indexedAttestation(ctx, n, att)
+		if err != nil {
+			return err
+		}
+		s.indexedAttsFeed.Send(indexed)
+	}
+	return nil
+}
+
+// seenBefore records the root, returning true if it was already recorded.
+func (s *Service) seenBefore(root [32]byte) bool {
+	ok, _ := s.seen.ContainsOrAdd(root, true)
+	return ok
+}
+
+// indexedAttestation converts the attestation to an indexed attestation. The event stream omits which
+// validator made each of the aggregated signatures, which are ordered as the committee members whose
+// aggregation bits are set.
+func (s *Service) indexedAttestation(
+	ctx context.Context, n *beacon.Client, att *zondpb.Attestation,
+) (*zondpb.IndexedAttestation, error) {
+	committee, err := s.committee(ctx, n, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return nil, err
+	}
+	bits := att.AggregationBits.BitIndices()
+	if len(att.Signature) != len(bits)*dilithium2.CryptoBytes {
+		return nil, errors.Errorf("attestation has %d signature bytes for %d aggregation bits set", len(att.Signature), len(bits))
+	}
+	att.SignatureValidatorIndex = make([]uint64, len(bits))
+	for i, b := range bits {
+		if b >= len(committee) {
+			return nil, errors.Errorf("aggregation bit %d out of committee of size %d", b, len(committee))
+		}
+		att.SignatureValidatorIndex[i] = uint64(committee[b])
+	}
+	return attestation.ConvertToIndexed(ctx, att, committee)
+}
+
+// committee returns the committee at the slot and index, retrieving the committees of its epoch from the beacon
+// node if they were not retrieved yet.
+func (s *Service) committee(
+	ctx context.Context, n *beacon.Client, slot primitives.Slot, index primitives.CommitteeIndex,
+) ([]primitives.ValidatorIndex, error) {
+	epoch := slots.ToEpoch(slot)
+	s.committeesLock.Lock()
+	defer s.committeesLock.Unlock()
+	byKey, ok := s.committees[epoch]
+	if !ok {
+		committees, err := n.GetCommittees(ctx, beacon.IdHead, epoch)
+		if err != nil {
+			return nil, err
+		}
+		byKey = make(map[committeeKey][]primitives.ValidatorIndex, len(committees))
+		for _, c := range committees {
+			byKey[committeeKey{slot: c.Slot, index: c.Index}] = c.Validators
+		}
+		s.committees[epoch] = byKey
+		for e := range s.committees {
+			if e+committeeCacheEpochs <= epoch {
+				delete(s.committees, e)
+			}
+		}
+	}
+	committee, ok := byKey[committeeKey{slot: slot, index: index}]
+	if !ok {
+		return nil, errors.Errorf("no committee %d at slot %d", index, slot)
+	}
+	return committee, nil
+}
@@ -0,0 +1,5 @@
+package standalone
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "standalone-slasher")
@@ -0,0 +1,21 @@
+package standalone
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	receivedEventsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "standalone_slasher_events_received_total",
+		Help: "Total number of events received from the beacon nodes, by topic",
+	}, []string{"topic"})
+	failedEventsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "standalone_slasher_events_failed_total",
+		Help: "Total number of events received from the beacon nodes that could not be handed to the slasher, by topic",
+	}, []string{"topic"})
+	submittedSlashingsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "standalone_slasher_slashings_submitted_total",
+		Help: "Total number of slashings accepted by at least one beacon node, by kind",
+	}, []string{"kind"})
+)
@@ -0,0 +1,258 @@
+// Package standalone feeds a slasher running in its own process with the blocks and attestations streamed by the
+// event APIs of one or more beacon nodes, and submits the slashings the slasher detects back to their operations
+// pools.
+package standalone
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/theQRL/qrysm/v4/api/client"
+	"github.com/theQRL/qrysm/v4/api/client/beacon"
+	"github.com/theQRL/qrysm/v4/async/event"
+	"github.com/theQRL/qrysm/v4/beacon-chain/slasher"
+	"github.com/theQRL/qrysm/v4/beacon-chain/startup"
+	beaconsync "github.com/theQRL/qrysm/v4/beacon-chain/sync"
+	lruwrpr "github.com/theQRL/qrysm/v4/cache/lru"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+)
+
+const (
+	// seenCacheSize is the number of block and attestation roots remembered to skip the events
+	// several beacon nodes stream for the same object.
+	seenCacheSize = 1 << 16
+	// committeeCacheEpochs is the number of epochs whose committees are kept in memory.
+	committeeCacheEpochs = 3
+)
+
+var (
+	_ beaconsync.Checker       = (*Service)(nil)
+	_ slasher.RemoteBeaconNode = (*Service)(nil)
+)
+
+// retryInterval is the time waited before retrying to reach the beacon nodes.
+var retryInterval = 5 * time.Second
+
+// Config for the service feeding a standalone slasher.
+type Config struct {
+	// Endpoints are the REST API endpoints of the beacon nodes watched by the slasher.
+	Endpoints []string
+	// Timeout is the timeout of the requests to the beacon nodes, the event streams excepted.
+	Timeout time.Duration
+}
+
+type committeeKey struct {
+	slot  primitives.Slot
+	index primitives.CommitteeIndex
+}
+
+// Service streams the blocks and attestations of beacon nodes into the feeds of a slasher, and acts as
+// the beacon node the slasher submits its slashings to.
+type Service struct {
+	ctx              context.Context
+	cancel           context.CancelFunc
+	nodes            []*beacon.Client
+	clock            *startup.ClockSynchronizer
+	initialized      atomic.Bool
+	headSlot         atomic.Uint64
+	indexedAttsFeed  *event.Feed
+	blockHeadersFeed *event.Feed
+	seen             *lru.Cache
+	committeesLock   sync.Mutex
+	committees       map[primitives.Epoch]map[committeeKey][]primitives.ValidatorIndex
+}
+
+// New creates a service watching the beacon nodes of the configuration.
+func New(ctx context.Context, cfg *Config) (*Service, error) {
+	if len(cfg.Endpoints) == 0 {
+		return nil, errors.New("at least one beacon node endpoint is required")
+	}
+	var opts []client.ClientOpt
+	if cfg.Timeout > 0 {
+		opts = append(opts, client.WithTimeout(cfg.Timeout))
+	}
+	nodes := make([]*beacon.Client, len(cfg.Endpoints))
+	for i, endpoint := range cfg.Endpoints {
+		c, err := beacon.NewClient(strings.TrimSpace(endpoint), opts...)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid beacon node endpoint %s", endpoint)
+		}
+		nodes[i] = c
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:              ctx,
+		cancel:           cancel,
+		nodes:            nodes,
+		clock:            startup.NewClockSynchronizer(),
+		indexedAttsFeed:  new(event.Feed),
+		blockHeadersFeed: new(event.Feed),
+		seen:             lruwrpr.New(seenCacheSize),
+		committees:       make(map[primitives.Epoch]map[committeeKey][]primitives.ValidatorIndex),
+	}, nil
+}
+
+// ClockWaiter returns the clock the slasher waits for, set once the genesis of the chain is known.
+func (s *Service) ClockWaiter() startup.ClockWaiter {
+	return s.clock
+}
+
+// IndexedAttestationsFeed returns the feed of the attestations received from the beacon nodes.
+func (s *Service) IndexedAttestationsFeed() *event.Feed {
+	return s.indexedAttsFeed
+}
+
+// BeaconBlockHeadersFeed returns the feed of the headers of the blocks received from the beacon nodes.
+func (s *Service) BeaconBlockHeadersFeed() *event.Feed {
+	return s.blockHeadersFeed
+}
+
+// Start retrieving the genesis of the chain and streaming the events of the beacon nodes.
+func (s *Service) Start() {
+	go s.initialize()
+	for _, n := range s.nodes {
+		go s.streamEvents(n)
+	}
+}
+
+// Stop streaming events.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status returns an error until the genesis of the chain was retrieved from a beacon node.
+func (s *Service) Status() error {
+	if !s.Initialized() {
+		return errors.New("waiting to reach a beacon node")
+	}
+	return nil
+}
+
+// initialize sets the clock of the slasher from the genesis of the first beacon node reached.
+func (s *Service) initialize() {
+	for {
+		for _, n := range s.nodes {
+			genesis, err := n.GetGenesis(s.ctx)
+			if err != nil {
+				log.WithError(err).WithField("endpoint", n.NodeURL()).Warn("Could not retrieve genesis")
+				continue
+			}
+			if header, err := n.GetBlockHeader(s.ctx, beacon.IdHead); err == nil {
+				s.updateHeadSlot(header.Header.Slot)
+			}
+			if err := s.clock.SetClock(startup.NewClock(genesis.Time, genesis.ValidatorsRoot)); err != nil {
+				log.WithError(err).Error("Could not set clock")
+				return
+			}
+			s.initialized.Store(true)
+			log.WithFields(logrus.Fields{
+				"endpoint":    n.NodeURL(),
+				"genesisTime": genesis.Time,
+			}).Info("Retrieved genesis from beacon node")
+			return
+		}
+		select {
+		case <-time.After(retryInterval):
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// Initialized returns true once the genesis of the chain was retrieved from a beacon node.
+func (s *Service) Initialized() bool {
+	return s.initialized.Load()
+}
+
+// Syncing returns true unless one of the beacon nodes is synced.
+func (s *Service) Syncing() bool {
+	for _, n := range s.nodes {
+		status, err := n.GetSyncStatus(s.ctx)
+		if err != nil {
+			log.WithError(err).WithField("endpoint", n.NodeURL()).Debug("Could not retrieve sync status")
+			continue
+		}
+		if !status.IsSyncing {
+			return false
+		}
+	}
+	return true
+}
+
+// Synced returns true once the genesis of the chain is known and one of the beacon nodes is synced.
+func (s *Service) Synced() bool {
+	return s.Initialized() && !s.Syncing()
+}
+
+// Resync is not supported, the beacon nodes sync on their own.
+func (*Service) Resync() error {
+	return errors.New("cannot resync beacon nodes watched over their REST API")
+}
+
+// HeadSlot returns the highest head slot streamed by the beacon nodes.
+func (s *Service) HeadSlot() primitives.Slot {
+	return primitives.Slot(s.headSlot.Load())
+}
+
+func (s *Service) updateHeadSlot(slot primitives.Slot) {
+	for {
+		current := s.headSlot.Load()
+		if uint64(slot) <= current || s.headSlot.CompareAndSwap(current, uint64(slot)) {
+			return
+		}
+	}
+}
+
+// NumValidators returns the number of validators in the head state of the first beacon node reached.
+func (s *Service) NumValidators(ctx context.Context) (int, error) {
+	var err error
+	for _, n := range s.nodes {
+		var count int
+		count, err = n.GetValidatorCount(ctx, beacon.IdHead)
+		if err == nil {
+			return count, nil
+		}
+		log.WithError(err).WithField("endpoint", n.NodeURL()).Debug("Could not retrieve validator count")
+	}
+	return 0, errors.Wrap(err, "could not retrieve validator count from any beacon node")
+}
+
+// SubmitAttesterSlashing submits the slashing to every beacon node, succeeding if one of them accepts it.
+func (s *Service) SubmitAttesterSlashing(ctx context.Context, slashing *zondpb.AttesterSlashing) error {
+	return s.submit("attester", func(n *beacon.Client) error {
+		return n.SubmitAttesterSlashing(ctx, slashing)
+	})
+}
+
+// SubmitProposerSlashing submits the slashing to every beacon node, succeeding if one of them accepts it.
+func (s *Service) SubmitProposerSlashing(ctx context.Context, slashing *zondpb.ProposerSlashing) error {
+	return s.submit("proposer", func(n *beacon.Client) error {
+		return n.SubmitProposerSlashing(ctx, slashing)
+	})
+}
+
+func (s *Service) submit(kind string, submit func(n *beacon.Client) error) error {
+	var lastErr error
+	accepted := false
+	for _, n := range s.nodes {
+		if err := submit(n); err != nil {
+			log.WithError(err).WithField("endpoint", n.NodeURL()).Debugf("Beacon node rejected %s slashing", kind)
+			lastErr = err
+			continue
+		}
+		accepted = true
+	}
+	if !accepted {
+		return errors.Wrapf(lastErr, "no beacon node accepted the %s slashing", kind)
+	}
+	submittedSlashingsTotal.WithLabelValues(kind).Inc()
+	return nil
+}
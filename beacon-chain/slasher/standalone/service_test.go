@@ -0,0 +1,153 @@
+package standalone
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/go-zond/common/hexutil"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/events"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	"github.com/theQRL/qrysm/v4/testing/require"
+	"github.com/theQRL/qrysm/v4/testing/util"
+)
+
+func newTestService(t *testing.T, endpoints ...string) *Service {
+	s, err := New(context.Background(), &Config{Endpoints: endpoints})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, s.Stop())
+	})
+	return s
+}
+
+func TestNew_NoEndpoint(t *testing.T) {
+	_, err := New(context.Background(), &Config{})
+	require.ErrorContains(t, "at least one beacon node endpoint is required", err)
+}
+
+func TestService_HandleAttestationEvent(t *testing.T) {
+	var committeeRequests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/zond/v1/beacon/states/head/committees", r.URL.Path)
+		require.Equal(t, "0", r.URL.Query().Get("epoch"))
+		committeeRequests++
+		resp := map[string]interface{}{
+			"data": []*shared.Committee{
+				{Index: "0", Slot: "1", Validators: []string{"10", "11", "12", "13"}},
+			},
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer srv.Close()
+	s := newTestService(t, srv.URL)
+
+	ch := make(chan *zondpb.IndexedAttestation, 2)
+	sub := s.IndexedAttestationsFeed().Subscribe(ch)
+	defer sub.Unsubscribe()
+
+	sigs := append(bytesutil.PadTo([]byte{1}, dilithium2.CryptoBytes), bytesutil.PadTo([]byte{3}, dilithium2.CryptoBytes)...)
+	root := hexutil.Encode(make([]byte, 32))
+	event, err := json.Marshal(&shared.Attestation{
+		// Bits 1 and 3 of a committee of 4.
+		AggregationBits: "0x1a",
+		Data: &shared.AttestationData{
+			Slot:            "1",
+			CommitteeIndex:  "0",
+			BeaconBlockRoot: root,
+			Source:          &shared.Checkpoint{Epoch: "0", Root: root},
+			Target:          &shared.Checkpoint{Epoch: "0", Root: root},
+		},
+		Signature: hexutil.Encode(sigs),
+	})
+	require.NoError(t, err)
+
+	n := s.nodes[0]
+	require.NoError(t, s.handleEvent(context.Background(), n, events.AttestationTopic, event))
+	indexed := <-ch
+	require.DeepEqual(t, []uint64{11, 13}, indexed.AttestingIndices)
+	require.DeepEqual(t, sigs, indexed.Signature)
+
+	// The same attestation streamed again is skipped.
+	require.NoError(t, s.handleEvent(context.Background(), n, events.AttestationTopic, event))
+	require.Equal(t, 0, len(ch))
+	require.Equal(t, 1, committeeRequests)
+}
+
+func TestService_HandleBlockEvent(t *testing.T) {
+	root := [32]byte{'a'}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, fmt.Sprintf("/zond/v1/beacon/headers/%#x", root), r.URL.Path)
+		resp := map[string]interface{}{
+			"data": &shared.SignedBeaconBlockHeaderContainer{
+				Root: hexutil.Encode(root[:]),
+				Header: &shared.SignedBeaconBlockHeader{
+					Message: &shared.BeaconBlockHeader{
+						Slot:          "5",
+						ProposerIndex: "7",
+						ParentRoot:    hexutil.Encode(make([]byte, 32)),
+						StateRoot:     hexutil.Encode(make([]byte, 32)),
+						BodyRoot:      hexutil.Encode(make([]byte, 32)),
+					},
+					Signature: hexutil.Encode(make([]byte, dilithium2.CryptoBytes)),
+				},
+			},
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer srv.Close()
+	s := newTestService(t, srv.URL)
+
+	ch := make(chan *zondpb.SignedBeaconBlockHeader, 2)
+	sub := s.BeaconBlockHeadersFeed().Subscribe(ch)
+	defer sub.Unsubscribe()
+
+	event := []byte(fmt.Sprintf(`{"slot":"5","block":"%#x","execution_optimistic":false}`, root))
+	require.NoError(t, s.handleEvent(context.Background(), s.nodes[0], events.BlockTopic, event))
+	header := <-ch
+	require.Equal(t, primitives.Slot(5), header.Header.Slot)
+	require.Equal(t, primitives.ValidatorIndex(7), header.Header.ProposerIndex)
+
+	require.NoError(t, s.handleEvent(context.Background(), s.nodes[0], events.BlockTopic, event))
+	require.Equal(t, 0, len(ch))
+}
+
+func TestService_HandleHeadEvent(t *testing.T) {
+	s := newTestService(t, "http://localhost:3500")
+	require.NoError(t, s.handleEvent(context.Background(), s.nodes[0], events.HeadTopic, []byte(`{"slot":"12"}`)))
+	require.Equal(t, primitives.Slot(12), s.HeadSlot())
+	// A node behind the others does not move the head slot back.
+	require.NoError(t, s.handleEvent(context.Background(), s.nodes[0], events.HeadTopic, []byte(`{"slot":"10"}`)))
+	require.Equal(t, primitives.Slot(12), s.HeadSlot())
+}
+
+func TestService_SubmitProposerSlashing(t *testing.T) {
+	var accepted int
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/zond/v1/beacon/pool/proposer_slashings", r.URL.Path)
+		accepted++
+	}))
+	defer ok.Close()
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer failing.Close()
+
+	slashing := &zondpb.ProposerSlashing{
+		Header_1: util.HydrateSignedBeaconHeader(&zondpb.SignedBeaconBlockHeader{}),
+		Header_2: util.HydrateSignedBeaconHeader(&zondpb.SignedBeaconBlockHeader{}),
+	}
+	s := newTestService(t, failing.URL, ok.URL)
+	require.NoError(t, s.SubmitProposerSlashing(context.Background(), slashing))
+	require.Equal(t, 1, accepted)
+
+	s = newTestService(t, failing.URL)
+	require.ErrorContains(t, "no beacon node accepted the proposer slashing", s.SubmitProposerSlashing(context.Background(), slashing))
+}
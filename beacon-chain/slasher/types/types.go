@@ -51,3 +51,26 @@ type AttestedEpochForValidator struct {
 	ValidatorIndex primitives.ValidatorIndex
 	Epoch          primitives.Epoch
 }
+
+// ChunkParameters describe how the min and max spans of validators are split into the chunks
+// stored on disk. See the slasher package for the meaning of each parameter.
+type ChunkParameters struct {
+	ChunkSize          uint64
+	ValidatorChunkSize uint64
+	HistoryLength      primitives.Epoch
+}
+
+// EpochSpans holds the min and max spans of a validator at an epoch. The min span is the shortest distance
+// from the epoch to the target of an attestation with a later source, the max span the longest distance
+// from the epoch to the target of an attestation with an earlier source.
+type EpochSpans struct {
+	Epoch   primitives.Epoch
+	MinSpan uint16
+	MaxSpan uint16
+}
+
+// DetectedSlashing is a slashing detected by the slasher, either an attester or a proposer slashing.
+type DetectedSlashing struct {
+	AttesterSlashing *zondpb.AttesterSlashing
+	ProposerSlashing *zondpb.ProposerSlashing
+}
@@ -0,0 +1,199 @@
+// Package statediff computes and applies field-level differences between two beacon states of the same fork,
+// so that historical states can be stored as a diff against a full snapshot rather than in full.
+//
+// A diff holds the SSZ encoding of every top-level state field whose value changed. List fields, such as the
+// validator registry and the balances, are patched element by element: only the new list length and the elements
+// that changed or were appended are stored.
+package statediff
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ErrTypeMismatch is returned when the states of a diff are not of the same type, e.g. belong to different forks.
+var ErrTypeMismatch = errors.New("states are not of the same type")
+
+// Diff holds the fields of a state which differ from a base state.
+type Diff struct {
+	fields []*fieldDiff
+}
+
+type fieldDiff struct {
+	number protoreflect.FieldNumber
+	// value is the SSZ encoding of the new value of a singular field.
+	value []byte
+	// list is set for list fields, whose new length and changed elements are stored instead of the value.
+	list     bool
+	length   uint64
+	elements []*elementDiff
+}
+
+type elementDiff struct {
+	index uint64
+	value []byte
+}
+
+// Compute returns the diff turning the base state into the target state. Both states are protobuf beacon
+// states of the same type.
+func Compute(base, target proto.Message) (*Diff, error) {
+	baseMsg, targetMsg := base.ProtoReflect(), target.ProtoReflect()
+	if baseMsg.Descriptor().FullName() != targetMsg.Descriptor().FullName() {
+		return nil, errors.Wrapf(ErrTypeMismatch, "base is %s, target is %s", baseMsg.Descriptor().FullName(), targetMsg.Descriptor().FullName())
+	}
+	d := &Diff{}
+	fields := targetMsg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.IsList() {
+			fDiff, err := computeListDiff(fd, baseMsg.Get(fd).List(), targetMsg.Get(fd).List())
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not diff field %s", fd.Name())
+			}
+			if fDiff != nil {
+				d.fields = append(d.fields, fDiff)
+			}
+			continue
+		}
+		baseValue, err := encodeValue(fd, baseMsg.Get(fd))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not encode field %s", fd.Name())
+		}
+		targetValue, err := encodeValue(fd, targetMsg.Get(fd))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not encode field %s", fd.Name())
+		}
+		if !bytes.Equal(baseValue, targetValue) {
+			d.fields = append(d.fields, &fieldDiff{number: fd.Number(), value: targetValue})
+		}
+	}
+	return d, nil
+}
+
+func computeListDiff(fd protoreflect.FieldDescriptor, base, target protoreflect.List) (*fieldDiff, error) {
+	fDiff := &fieldDiff{number: fd.Number(), list: true, length: uint64(target.Len())}
+	for i := 0; i < target.Len(); i++ {
+		targetValue, err := encodeValue(fd, target.Get(i))
+		if err != nil {
+			return nil, err
+		}
+		if i < base.Len() {
+			baseValue, err := encodeValue(fd, base.Get(i))
+			if err != nil {
+				return nil, err
+			}
+			if bytes.Equal(baseValue, targetValue) {
+				continue
+			}
+		}
+		fDiff.elements = append(fDiff.elements, &elementDiff{index: uint64(i), value: targetValue})
+	}
+	if len(fDiff.elements) == 0 && base.Len() == target.Len() {
+		return nil, nil
+	}
+	return fDiff, nil
+}
+
+// Apply applies the diff to the base state, which is modified in place.
+func (d *Diff) Apply(base proto.Message) error {
+	msg := base.ProtoReflect()
+	fields := msg.Descriptor().Fields()
+	for _, fDiff := range d.fields {
+		fd := fields.ByNumber(fDiff.number)
+		if fd == nil {
+			return errors.Errorf("%s has no field number %d", msg.Descriptor().FullName(), fDiff.number)
+		}
+		if fDiff.list != fd.IsList() {
+			return errors.Errorf("field %s is patched as the wrong kind", fd.Name())
+		}
+		if !fd.IsList() {
+			if fd.Kind() == protoreflect.MessageKind && len(fDiff.value) == 0 {
+				msg.Clear(fd)
+				continue
+			}
+			v, err := decodeValue(fd, fDiff.value, msg.NewField(fd))
+			if err != nil {
+				return errors.Wrapf(err, "could not decode field %s", fd.Name())
+			}
+			msg.Set(fd, v)
+			continue
+		}
+		list := msg.Mutable(fd).List()
+		if fDiff.length < uint64(list.Len()) {
+			list.Truncate(int(fDiff.length))
+		}
+		for _, e := range fDiff.elements {
+			if e.index >= fDiff.length || e.index > uint64(list.Len()) {
+				return errors.Errorf("element %d of field %s is out of range", e.index, fd.Name())
+			}
+			v, err := decodeValue(fd, e.value, list.NewElement())
+			if err != nil {
+				return errors.Wrapf(err, "could not decode element %d of field %s", e.index, fd.Name())
+			}
+			if e.index == uint64(list.Len()) {
+				list.Append(v)
+			} else {
+				list.Set(int(e.index), v)
+			}
+		}
+		if uint64(list.Len()) != fDiff.length {
+			return errors.Errorf("field %s has %d elements after the patch, expected %d", fd.Name(), list.Len(), fDiff.length)
+		}
+	}
+	return nil
+}
+
+// NumFields returns the number of state fields which differ from the base state.
+func (d *Diff) NumFields() int {
+	return len(d.fields)
+}
+
+// encodeValue returns the SSZ encoding of a singular value or a list element of the field.
+func encodeValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) ([]byte, error) {
+	switch fd.Kind() {
+	case protoreflect.Uint64Kind:
+		return binary.LittleEndian.AppendUint64(nil, v.Uint()), nil
+	case protoreflect.BytesKind:
+		return v.Bytes(), nil
+	case protoreflect.MessageKind:
+		if !v.Message().IsValid() {
+			return nil, nil
+		}
+		m, ok := v.Message().Interface().(ssz.Marshaler)
+		if !ok {
+			return nil, errors.Errorf("%s cannot be SSZ encoded", v.Message().Descriptor().FullName())
+		}
+		return m.MarshalSSZ()
+	default:
+		return nil, errors.Errorf("unsupported field kind %s", fd.Kind())
+	}
+}
+
+// decodeValue decodes a value encoded by encodeValue, using the empty value of the field for messages.
+func decodeValue(fd protoreflect.FieldDescriptor, enc []byte, empty protoreflect.Value) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.Uint64Kind:
+		if len(enc) != 8 {
+			return protoreflect.Value{}, errors.Errorf("invalid uint64 encoding length %d", len(enc))
+		}
+		return protoreflect.ValueOfUint64(binary.LittleEndian.Uint64(enc)), nil
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes(bytes.Clone(enc)), nil
+	case protoreflect.MessageKind:
+		m, ok := empty.Message().Interface().(ssz.Unmarshaler)
+		if !ok {
+			return protoreflect.Value{}, errors.Errorf("%s cannot be SSZ decoded", empty.Message().Descriptor().FullName())
+		}
+		if err := m.UnmarshalSSZ(enc); err != nil {
+			return protoreflect.Value{}, err
+		}
+		return empty, nil
+	default:
+		return protoreflect.Value{}, errors.Errorf("unsupported field kind %s", fd.Kind())
+	}
+}
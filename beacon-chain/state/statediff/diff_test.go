@@ -0,0 +1,98 @@
+package statediff
+
+import (
+	"testing"
+
+	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func testValidator(pubkey byte, balance uint64) *zondpb.Validator {
+	return &zondpb.Validator{
+		PublicKey:             bytesutil.PadTo([]byte{pubkey}, 2592),
+		WithdrawalCredentials: make([]byte, 32),
+		EffectiveBalance:      balance,
+	}
+}
+
+func testState() *zondpb.BeaconStateCapella {
+	return &zondpb.BeaconStateCapella{
+		GenesisTime: 10,
+		Slot:        64,
+		Fork: &zondpb.Fork{
+			PreviousVersion: []byte{0, 0, 0, 0},
+			CurrentVersion:  []byte{0, 0, 0, 1},
+		},
+		BlockRoots:                [][]byte{bytesutil.PadTo([]byte{1}, 32), bytesutil.PadTo([]byte{2}, 32)},
+		Validators:                []*zondpb.Validator{testValidator(1, 32), testValidator(2, 32), testValidator(3, 32)},
+		Balances:                  []uint64{32, 32, 32},
+		CurrentEpochParticipation: []byte{1, 1, 1},
+		FinalizedCheckpoint:       &zondpb.Checkpoint{Epoch: 1, Root: make([]byte, 32)},
+	}
+}
+
+func TestDiff_RoundTrip(t *testing.T) {
+	base := testState()
+	target := proto.Clone(base).(*zondpb.BeaconStateCapella)
+	target.Slot = 128
+	target.Fork.CurrentVersion = []byte{0, 0, 0, 2}
+	target.BlockRoots[1] = bytesutil.PadTo([]byte{3}, 32)
+	target.Validators[1] = testValidator(2, 31)
+	target.Validators = append(target.Validators, testValidator(4, 32))
+	target.Balances = []uint64{32, 31, 33, 32}
+	target.FinalizedCheckpoint = nil
+
+	d, err := Compute(base, target)
+	require.NoError(t, err)
+	// Slot, fork, block roots, validators, balances and finalized checkpoint.
+	assert.Equal(t, 6, d.NumFields())
+
+	decoded, err := Unmarshal(d.Marshal())
+	require.NoError(t, err)
+	require.NoError(t, decoded.Apply(base))
+	require.Equal(t, true, proto.Equal(target, base))
+}
+
+func TestDiff_TruncatedList(t *testing.T) {
+	base := testState()
+	target := proto.Clone(base).(*zondpb.BeaconStateCapella)
+	target.Validators = target.Validators[:1]
+	target.Balances = nil
+
+	d, err := Compute(base, target)
+	require.NoError(t, err)
+	assert.Equal(t, 2, d.NumFields())
+	require.NoError(t, d.Apply(base))
+	require.Equal(t, true, proto.Equal(target, base))
+}
+
+func TestDiff_NoChange(t *testing.T) {
+	d, err := Compute(testState(), testState())
+	require.NoError(t, err)
+	assert.Equal(t, 0, d.NumFields())
+	decoded, err := Unmarshal(d.Marshal())
+	require.NoError(t, err)
+	assert.Equal(t, 0, decoded.NumFields())
+}
+
+func TestCompute_TypeMismatch(t *testing.T) {
+	_, err := Compute(testState(), &zondpb.BeaconStateDeneb{})
+	require.ErrorIs(t, err, ErrTypeMismatch)
+}
+
+func TestUnmarshal_Invalid(t *testing.T) {
+	base := testState()
+	target := proto.Clone(base).(*zondpb.BeaconStateCapella)
+	target.Balances = []uint64{1, 2, 3}
+	d, err := Compute(base, target)
+	require.NoError(t, err)
+	enc := d.Marshal()
+
+	_, err = Unmarshal(enc[:len(enc)-1])
+	require.ErrorContains(t, "unexpected end of data", err)
+	_, err = Unmarshal(append(enc, 0))
+	require.ErrorContains(t, "1 trailing bytes", err)
+}
@@ -0,0 +1,122 @@
+package statediff
+
+import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	valueField byte = iota
+	listField
+)
+
+// Marshal encodes the diff. Every field is encoded as its protobuf field number and kind, followed by either its
+// length-prefixed SSZ value, or by the new list length and the index and length-prefixed SSZ value of every
+// changed element. Integers are little-endian.
+func (d *Diff) Marshal() []byte {
+	enc := binary.LittleEndian.AppendUint32(nil, uint32(len(d.fields)))
+	for _, f := range d.fields {
+		enc = binary.LittleEndian.AppendUint32(enc, uint32(f.number))
+		if !f.list {
+			enc = append(enc, valueField)
+			enc = appendBytes(enc, f.value)
+			continue
+		}
+		enc = append(enc, listField)
+		enc = binary.LittleEndian.AppendUint64(enc, f.length)
+		enc = binary.LittleEndian.AppendUint64(enc, uint64(len(f.elements)))
+		for _, e := range f.elements {
+			enc = binary.LittleEndian.AppendUint64(enc, e.index)
+			enc = appendBytes(enc, e.value)
+		}
+	}
+	return enc
+}
+
+// Unmarshal decodes a diff encoded by Marshal.
+func Unmarshal(enc []byte) (*Diff, error) {
+	r := &reader{buf: enc}
+	numFields := r.uint32()
+	d := &Diff{}
+	for i := uint32(0); i < numFields && r.err == nil; i++ {
+		f := &fieldDiff{number: protoreflect.FieldNumber(r.uint32())}
+		switch kind := r.byte(); kind {
+		case valueField:
+			f.value = r.bytes()
+		case listField:
+			f.list = true
+			f.length = r.uint64()
+			numElements := r.uint64()
+			for j := uint64(0); j < numElements && r.err == nil; j++ {
+				f.elements = append(f.elements, &elementDiff{index: r.uint64(), value: r.bytes()})
+			}
+		default:
+			if r.err == nil {
+				r.err = errors.Errorf("unknown field kind %d", kind)
+			}
+		}
+		d.fields = append(d.fields, f)
+	}
+	if r.err != nil {
+		return nil, errors.Wrap(r.err, "could not decode state diff")
+	}
+	if len(r.buf) != 0 {
+		return nil, errors.Errorf("could not decode state diff: %d trailing bytes", len(r.buf))
+	}
+	return d, nil
+}
+
+func appendBytes(enc, b []byte) []byte {
+	enc = binary.LittleEndian.AppendUint32(enc, uint32(len(b)))
+	return append(enc, b...)
+}
+
+// reader decodes the integers and byte slices of an encoded diff, recording the first error encountered.
+type reader struct {
+	buf []byte
+	err error
+}
+
+func (r *reader) next(n uint64) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if uint64(len(r.buf)) < n {
+		r.err = errors.New("unexpected end of data")
+		return nil
+	}
+	b := r.buf[:n]
+	r.buf = r.buf[n:]
+	return b
+}
+
+func (r *reader) byte() byte {
+	b := r.next(1)
+	if b == nil {
+		return 0
+	}
+	return b[0]
+}
+
+func (r *reader) uint32() uint32 {
+	b := r.next(4)
+	if b == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(b)
+}
+
+func (r *reader) uint64() uint64 {
+	b := r.next(8)
+	if b == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint64(b)
+}
+
+func (r *reader) bytes() []byte {
+	n := r.uint32()
+	return r.next(uint64(n))
+}
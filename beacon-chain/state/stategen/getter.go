@@ -27,7 +27,7 @@ func (s *State) HasState(ctx context.Context, blockRoot [32]byte) (bool, error)
 	if has {
 		return true, nil
 	}
-	return s.beaconDB.HasState(ctx, blockRoot), nil
+	return s.hasArchivedState(ctx, blockRoot), nil
 }
 
 // hasStateInCache returns true if the state exists in cache.
@@ -210,6 +210,9 @@ func (s *State) loadStateByRoot(ctx context.Context, blockRoot [32]byte) (state.
 	if s.beaconDB.HasState(ctx, blockRoot) {
 		return s.beaconDB.State(ctx, blockRoot)
 	}
+	if s.beaconDB.HasStateDiff(ctx, blockRoot) {
+		return loadStateDiff(ctx, s.beaconDB, blockRoot)
+	}
 
 	summary, err := s.stateSummary(ctx, blockRoot)
 	if err != nil {
@@ -309,6 +312,10 @@ func (s *State) latestAncestor(ctx context.Context, blockRoot [32]byte) (state.B
 			s, err := s.beaconDB.State(ctx, parentRoot)
 			return s, errors.Wrap(err, "failed to retrieve state from db")
 		}
+		if s.beaconDB.HasStateDiff(ctx, parentRoot) {
+			s, err := loadStateDiff(ctx, s.beaconDB, parentRoot)
+			return s, errors.Wrap(err, "failed to reconstruct state from diff")
+		}
 
 		b, err = s.beaconDB.Block(ctx, parentRoot)
 		if err != nil {
@@ -132,6 +132,16 @@ func (c *CanonicalHistory) getState(ctx context.Context, blockRoot [32]byte) (st
 			return nil, errors.Wrap(err, "error reading from state cache during state replay")
 		}
 	}
+	// States saved as diffs are reconstructed from the full state they are diffed against.
+	if r, ok := c.h.(stateDiffReader); ok {
+		st, err := loadStateDiff(ctx, r, blockRoot)
+		if err != nil {
+			return nil, err
+		}
+		if st != nil {
+			return st, nil
+		}
+	}
 	return c.h.StateOrError(ctx, blockRoot)
 }
 
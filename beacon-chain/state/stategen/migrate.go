@@ -73,7 +73,7 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 				aRoot = roots[0]
 				// There's no need to generate the state if the state already exists in the DB.
 				// We can skip saving the state.
-				if !s.beaconDB.HasState(ctx, aRoot) {
+				if !s.hasArchivedState(ctx, aRoot) {
 					aState, err = s.StateByRoot(ctx, aRoot)
 					if err != nil {
 						return err
@@ -81,7 +81,7 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 				}
 			}
 
-			if s.beaconDB.HasState(ctx, aRoot) {
+			if s.hasArchivedState(ctx, aRoot) {
 				// If you are migrating a state and its already part of the hot state cache saved to the db,
 				// you can just remove it from the hot state cache as it becomes redundant.
 				s.saveHotStateDB.lock.Lock()
@@ -98,7 +98,7 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 				continue
 			}
 
-			if err := s.beaconDB.SaveState(ctx, aState, aRoot); err != nil {
+			if err := s.saveArchivedState(ctx, aState, aRoot); err != nil {
 				return err
 			}
 			log.WithFields(
@@ -13,7 +13,6 @@ import (
 	"github.com/theQRL/qrysm/v4/beacon-chain/db"
 	"github.com/theQRL/qrysm/v4/beacon-chain/forkchoice"
 	"github.com/theQRL/qrysm/v4/beacon-chain/state"
-	"github.com/theQRL/qrysm/v4/beacon-chain/sync/backfill"
 	"github.com/theQRL/qrysm/v4/config/params"
 	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
 	"github.com/theQRL/qrysm/v4/crypto/dilithium"
@@ -47,11 +46,12 @@ type StateManager interface {
 type State struct {
 	beaconDB                db.NoHeadAccessDatabase
 	slotsPerArchivedPoint   primitives.Slot
+	stateDiffSnapshotSlots  primitives.Slot
 	hotStateCache           *hotStateCache
 	finalizedInfo           *finalizedInfo
 	epochBoundaryStateCache *epochBoundaryState
 	saveHotStateDB          *saveHotStateDbConfig
-	backfillStatus          *backfill.Status
+	backfillStatus          BackfillStatus
 	migrationLock           *sync.Mutex
 	fc                      forkchoice.ForkChoicer
 }
@@ -75,10 +75,16 @@ type finalizedInfo struct {
 	lock  sync.RWMutex
 }
 
+// BackfillStatus reports whether the blocks for a slot are available in the database.
+// It is implemented by backfill.Status.
+type BackfillStatus interface {
+	SlotCovered(slot primitives.Slot) bool
+}
+
 // StateGenOption is a functional option for controlling the initialization of a *State value
 type StateGenOption func(*State)
 
-func WithBackfillStatus(bfs *backfill.Status) StateGenOption {
+func WithBackfillStatus(bfs BackfillStatus) StateGenOption {
 	return func(sg *State) {
 		sg.backfillStatus = bfs
 	}
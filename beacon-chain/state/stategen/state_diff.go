@@ -0,0 +1,201 @@
+package stategen
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/theQRL/qrysm/v4/beacon-chain/db"
+	"github.com/theQRL/qrysm/v4/beacon-chain/state"
+	statenative "github.com/theQRL/qrysm/v4/beacon-chain/state/state-native"
+	"github.com/theQRL/qrysm/v4/beacon-chain/state/statediff"
+	"github.com/theQRL/qrysm/v4/config/params"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+// WithStateDiffs stores the archived states as diffs. A full state is kept for the first archived point of every
+// period of snapshotEpochs epochs, and the other archived states of the period are saved as diffs against it.
+// The scheme is single-level: a diff always applies to a full state, so loading a state applies at most one diff.
+func WithStateDiffs(snapshotEpochs primitives.Epoch) StateGenOption {
+	return func(sg *State) {
+		sg.stateDiffSnapshotSlots = primitives.Slot(snapshotEpochs) * params.BeaconConfig().SlotsPerEpoch
+	}
+}
+
+// stateDiffReader is implemented by the databases that store states as diffs.
+type stateDiffReader interface {
+	StateDiff(ctx context.Context, blockRoot [32]byte) ([32]byte, *statediff.Diff, error)
+	State(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error)
+}
+
+// loadStateDiff reconstructs the state of the block root from its diff and the full state the diff applies to.
+// A nil state is returned if the state is not stored as a diff.
+func loadStateDiff(ctx context.Context, r stateDiffReader, blockRoot [32]byte) (state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.loadStateDiff")
+	defer span.End()
+
+	baseRoot, d, err := r.StateDiff(ctx, blockRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get state diff")
+	}
+	if d == nil {
+		return nil, nil
+	}
+	base, err := r.State(ctx, baseRoot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get base state %#x", baseRoot)
+	}
+	if base == nil || base.IsNil() {
+		return nil, errors.Wrapf(errUnknownState, "missing base state %#x of state diff", baseRoot)
+	}
+	pb, ok := base.ToProto().(proto.Message)
+	if !ok {
+		return nil, errors.New("base state is not a protobuf message")
+	}
+	if err := d.Apply(pb); err != nil {
+		return nil, errors.Wrap(err, "could not apply state diff")
+	}
+	return initializeFromProto(pb)
+}
+
+func initializeFromProto(pb proto.Message) (state.BeaconState, error) {
+	switch st := pb.(type) {
+	case *zondpb.BeaconState:
+		return statenative.InitializeFromProtoUnsafePhase0(st)
+	case *zondpb.BeaconStateAltair:
+		return statenative.InitializeFromProtoUnsafeAltair(st)
+	case *zondpb.BeaconStateBellatrix:
+		return statenative.InitializeFromProtoUnsafeBellatrix(st)
+	case *zondpb.BeaconStateCapella:
+		return statenative.InitializeFromProtoUnsafeCapella(st)
+	case *zondpb.BeaconStateDeneb:
+		return statenative.InitializeFromProtoUnsafeDeneb(st)
+	default:
+		return nil, errors.Errorf("unsupported state type %T", pb)
+	}
+}
+
+// hasArchivedState returns true if the state of the block root is saved in the DB, in full or as a diff.
+func (s *State) hasArchivedState(ctx context.Context, blockRoot [32]byte) bool {
+	return s.beaconDB.HasState(ctx, blockRoot) || s.beaconDB.HasStateDiff(ctx, blockRoot)
+}
+
+// stateDiffBase returns the block root of the full state the archived state of the slot should be diffed
+// against: the first archived state of the snapshot period of the slot, if it was saved in full.
+func (s *State) stateDiffBase(ctx context.Context, slot primitives.Slot) ([32]byte, bool) {
+	if s.stateDiffSnapshotSlots == 0 || s.slotsPerArchivedPoint == 0 {
+		return [32]byte{}, false
+	}
+	periodStart := slot - slot%s.stateDiffSnapshotSlots
+	first := periodStart
+	if r := periodStart % s.slotsPerArchivedPoint; r != 0 {
+		first += s.slotsPerArchivedPoint - r
+	}
+	for archived := first; archived < slot; archived += s.slotsPerArchivedPoint {
+		if !s.beaconDB.HasArchivedPoint(ctx, archived) {
+			continue
+		}
+		root := s.beaconDB.ArchivedPointRoot(ctx, archived)
+		if s.beaconDB.HasState(ctx, root) {
+			return root, true
+		}
+	}
+	return [32]byte{}, false
+}
+
+// saveArchivedState saves the state of an archived point, as a diff if state diffs are enabled and the snapshot
+// of its period is available, and in full otherwise.
+func (s *State) saveArchivedState(ctx context.Context, st state.BeaconState, blockRoot [32]byte) error {
+	if baseRoot, ok := s.stateDiffBase(ctx, st.Slot()); ok && baseRoot != blockRoot {
+		err := s.beaconDB.SaveStateDiff(ctx, st, blockRoot, baseRoot)
+		if err == nil {
+			return nil
+		}
+		// The snapshot belongs to an earlier fork, the state starts a new snapshot.
+		if !errors.Is(err, statediff.ErrTypeMismatch) {
+			return err
+		}
+	}
+	return s.beaconDB.SaveState(ctx, st, blockRoot)
+}
+
+// MigrateToStateDiffs converts the finalized archived states saved in full to diffs, keeping the first archived
+// state of every snapshot period in full. The genesis, finalized and origin checkpoint states are not converted,
+// nor are the full states existing diffs are stored against, which happens when the snapshot period changes.
+func (s *State) MigrateToStateDiffs(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "stateGen.MigrateToStateDiffs")
+	defer span.End()
+
+	if s.stateDiffSnapshotSlots == 0 {
+		return nil
+	}
+	s.migrationLock.Lock()
+	defer s.migrationLock.Unlock()
+
+	cp, err := s.beaconDB.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return err
+	}
+	fRoot := bytesutil.ToBytes32(cp.Root)
+	if fRoot == params.BeaconConfig().ZeroHash {
+		return nil
+	}
+	fBlock, err := s.beaconDB.Block(ctx, fRoot)
+	if err != nil {
+		return err
+	}
+	if fBlock == nil || fBlock.IsNil() {
+		return errUnknownBlock
+	}
+	keep := map[[32]byte]bool{fRoot: true}
+	if r, err := s.beaconDB.GenesisBlockRoot(ctx); err == nil {
+		keep[r] = true
+	}
+	if r, err := s.beaconDB.OriginCheckpointBlockRoot(ctx); err == nil {
+		keep[r] = true
+	}
+
+	converted := 0
+	for slot := s.slotsPerArchivedPoint; slot < fBlock.Block().Slot(); slot += s.slotsPerArchivedPoint {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if !s.beaconDB.HasArchivedPoint(ctx, slot) {
+			continue
+		}
+		root := s.beaconDB.ArchivedPointRoot(ctx, slot)
+		if keep[root] || !s.beaconDB.HasState(ctx, root) {
+			continue
+		}
+		baseRoot, ok := s.stateDiffBase(ctx, slot)
+		if !ok || baseRoot == root {
+			continue
+		}
+		st, err := s.beaconDB.State(ctx, root)
+		if err != nil {
+			return err
+		}
+		if err := s.beaconDB.SaveStateDiff(ctx, st, root, baseRoot); err != nil {
+			// The state belongs to an earlier fork than the snapshot, or it is itself the snapshot of diffs saved
+			// with a different snapshot period.
+			if errors.Is(err, statediff.ErrTypeMismatch) || errors.Is(err, db.ErrStateDiffBase) {
+				continue
+			}
+			return errors.Wrapf(err, "could not convert state of slot %d to a diff", slot)
+		}
+		converted++
+		log.WithFields(logrus.Fields{
+			"slot": slot,
+			"root": hex.EncodeToString(bytesutil.Trunc(root[:])),
+		}).Debug("Converted archived state to a diff")
+	}
+	if converted > 0 {
+		log.WithField("count", converted).Info("Converted archived states to diffs")
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	testDB "github.com/theQRL/qrysm/v4/beacon-chain/db/testing"
+	doublylinkedtree "github.com/theQRL/qrysm/v4/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/theQRL/qrysm/v4/beacon-chain/state"
+	"github.com/theQRL/qrysm/v4/config/params"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+	"github.com/theQRL/qrysm/v4/testing/util"
+)
+
+func TestSaveArchivedState_StateDiffs(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB, doublylinkedtree.New())
+	service.slotsPerArchivedPoint = 64
+	service.stateDiffSnapshotSlots = 256
+
+	base, _ := util.DeterministicGenesisState(t, 32)
+	baseRoot := [32]byte{'a'}
+	require.NoError(t, service.saveArchivedState(ctx, base, baseRoot))
+	assert.Equal(t, true, beaconDB.HasState(ctx, baseRoot))
+
+	// An archived state in the same snapshot period is saved as a diff.
+	st := base.Copy()
+	require.NoError(t, st.SetSlot(64))
+	require.NoError(t, st.UpdateBalancesAtIndex(3, 1))
+	root := [32]byte{'b'}
+	require.NoError(t, beaconDB.SaveStateSummary(ctx, &zondpb.StateSummary{Slot: 64, Root: root[:]}))
+	require.NoError(t, service.saveArchivedState(ctx, st, root))
+	assert.Equal(t, false, beaconDB.HasState(ctx, root))
+	assert.Equal(t, true, beaconDB.HasStateDiff(ctx, root))
+	has, err := service.HasState(ctx, root)
+	require.NoError(t, err)
+	assert.Equal(t, true, has)
+
+	got, err := service.StateByRoot(ctx, root)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, st.ToProtoUnsafe(), got.ToProtoUnsafe())
+
+	// The first archived state of the next period is saved in full.
+	next := base.Copy()
+	require.NoError(t, next.SetSlot(256))
+	nextRoot := [32]byte{'c'}
+	require.NoError(t, service.saveArchivedState(ctx, next, nextRoot))
+	assert.Equal(t, true, beaconDB.HasState(ctx, nextRoot))
+	assert.Equal(t, false, beaconDB.HasStateDiff(ctx, nextRoot))
+}
+
+func TestMigrateToStateDiffs_SnapshotPeriodChange(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB, doublylinkedtree.New())
+	service.slotsPerArchivedPoint = 64
+
+	// Archived states saved in full at slots 0, 64, 128 and 192.
+	genesis, _ := util.DeterministicGenesisState(t, 32)
+	states := make(map[[32]byte]state.BeaconState)
+	roots := make([][32]byte, 4)
+	for i := range roots {
+		st := genesis.Copy()
+		slot := primitives.Slot(i) * service.slotsPerArchivedPoint
+		require.NoError(t, st.SetSlot(slot))
+		require.NoError(t, st.UpdateBalancesAtIndex(primitives.ValidatorIndex(i), uint64(i)))
+		roots[i] = [32]byte{byte(i + 1)}
+		states[roots[i]] = st
+		require.NoError(t, beaconDB.SaveStateSummary(ctx, &zondpb.StateSummary{Slot: slot, Root: roots[i][:]}))
+		require.NoError(t, service.saveArchivedState(ctx, st, roots[i]))
+	}
+	b := util.NewBeaconBlock()
+	b.Block.Slot = 256
+	util.SaveBlock(t, ctx, beaconDB, b)
+	fRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveStateSummary(ctx, &zondpb.StateSummary{Slot: 256, Root: fRoot[:]}))
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &zondpb.Checkpoint{Epoch: 2, Root: fRoot[:]}))
+
+	// With a snapshot period of 128 slots, the states at 64 and 192 become diffs against 0 and 128.
+	service.stateDiffSnapshotSlots = 128
+	require.NoError(t, service.MigrateToStateDiffs(ctx))
+	assert.Equal(t, true, beaconDB.HasStateDiff(ctx, roots[1]))
+	assert.Equal(t, true, beaconDB.HasState(ctx, roots[2]))
+	assert.Equal(t, true, beaconDB.HasStateDiff(ctx, roots[3]))
+
+	// Doubling the snapshot period must not convert the state at 128, as the diff at 192 is stored against it.
+	service.stateDiffSnapshotSlots = 256
+	require.NoError(t, service.MigrateToStateDiffs(ctx))
+	assert.Equal(t, true, beaconDB.HasState(ctx, roots[2]))
+	for _, r := range roots {
+		got, err := service.StateByRoot(ctx, r)
+		require.NoError(t, err)
+		assert.DeepSSZEqual(t, states[r].ToProtoUnsafe(), got.ToProtoUnsafe())
+	}
+}
+
+func TestSaveArchivedState_StateDiffsDisabled(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB, doublylinkedtree.New())
+	service.slotsPerArchivedPoint = 64
+
+	base, _ := util.DeterministicGenesisState(t, 32)
+	require.NoError(t, service.saveArchivedState(ctx, base, [32]byte{'a'}))
+	st := base.Copy()
+	require.NoError(t, st.SetSlot(64))
+	require.NoError(t, service.saveArchivedState(ctx, st, [32]byte{'b'}))
+	assert.Equal(t, true, beaconDB.HasState(ctx, [32]byte{'b'}))
+	assert.Equal(t, false, beaconDB.HasStateDiff(ctx, [32]byte{'b'}))
+}
+
+func TestLoadStateDiff_NotADiff(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+
+	st, err := loadStateDiff(ctx, beaconDB, [32]byte{'a'})
+	require.NoError(t, err)
+	assert.Equal(t, true, st == nil)
+}
+
+func TestWithStateDiffs(t *testing.T) {
+	service := New(testDB.SetupDB(t), doublylinkedtree.New(), WithStateDiffs(2))
+	assert.Equal(t, 2*params.BeaconConfig().SlotsPerEpoch, service.stateDiffSnapshotSlots)
+}
@@ -0,0 +1,40 @@
+package backfill
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	backfillRemainingSlots = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "backfill_remaining_slots",
+			Help: "Number of slots between genesis and the lowest backfilled block.",
+		},
+	)
+	backfillLowestSlot = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "backfill_lowest_slot",
+			Help: "Slot of the lowest block connected to the origin checkpoint block.",
+		},
+	)
+	backfillBlocksImported = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "backfill_blocks_imported_total",
+			Help: "Number of blocks imported by backfill.",
+		},
+	)
+	backfillBatchFailures = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "backfill_batch_failures_total",
+			Help: "Number of backfill batches which failed to download, verify or import.",
+		},
+	)
+	backfillBatchTime = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "backfill_batch_time_milliseconds",
+			Help:    "Time taken to download, verify and import a batch of backfill blocks.",
+			Buckets: []float64{100, 250, 500, 1000, 2000, 4000, 8000, 16000},
+		},
+	)
+)
@@ -0,0 +1,376 @@
+package backfill
+
+import (
+	"context"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/theQRL/qrysm/v4/beacon-chain/db"
+	"github.com/theQRL/qrysm/v4/beacon-chain/p2p"
+	"github.com/theQRL/qrysm/v4/beacon-chain/startup"
+	"github.com/theQRL/qrysm/v4/beacon-chain/state"
+	prysmsync "github.com/theQRL/qrysm/v4/beacon-chain/sync"
+	"github.com/theQRL/qrysm/v4/cmd/beacon-chain/flags"
+	"github.com/theQRL/qrysm/v4/config/params"
+	"github.com/theQRL/qrysm/v4/consensus-types/blocks"
+	"github.com/theQRL/qrysm/v4/consensus-types/interfaces"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	leakybucket "github.com/theQRL/qrysm/v4/container/leaky-bucket"
+	"github.com/theQRL/qrysm/v4/crypto/rand"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	"github.com/theQRL/qrysm/v4/time/slots"
+)
+
+const (
+	// DefaultBatchSize is the number of blocks requested from a peer in a single backfill step.
+	DefaultBatchSize = 64
+	// retryDelay is how long backfill waits before retrying after a step fails or no peers are available.
+	retryDelay = 5 * time.Second
+	// blockLimiterPeriod matches the period of the rate limiter used by initial-sync.
+	blockLimiterPeriod = 30 * time.Second
+)
+
+var (
+	errNoPeers           = errors.New("no suitable peers available for backfill")
+	errMissingDependency = errors.New("backfill service is missing a required dependency")
+)
+
+// Service downloads the blocks between genesis and the checkpoint sync origin block, working backwards from the
+// origin block so that every downloaded batch can be verified against the parent root of a block already held by
+// the node.
+type Service struct {
+	ctx                 context.Context
+	cancel              context.CancelFunc
+	enabled             bool
+	batchSize           uint64
+	su                  *Status
+	db                  db.HeadAccessDatabase
+	p2p                 p2p.P2P
+	clockWaiter         startup.ClockWaiter
+	clock               *startup.Clock
+	initialSyncComplete chan struct{}
+	verifierState       state.ReadOnlyBeaconState
+	verifier            *verifier
+	rateLimiter         *leakybucket.Collector
+	genesisRoot         [32]byte
+	lowest              blocks.ROBlock
+}
+
+// ServiceOption represents a functional option for the backfill service constructor.
+type ServiceOption func(*Service) error
+
+// WithEnableBackfill toggles the entire backfill service on or off, intended to be used by a feature flag.
+func WithEnableBackfill(enabled bool) ServiceOption {
+	return func(s *Service) error {
+		s.enabled = enabled
+		return nil
+	}
+}
+
+// WithBatchSize configures the number of blocks requested from a peer in a single request.
+func WithBatchSize(n uint64) ServiceOption {
+	return func(s *Service) error {
+		if n == 0 {
+			return errors.New("backfill batch size must be greater than 0")
+		}
+		s.batchSize = n
+		return nil
+	}
+}
+
+// WithDatabase sets the database used to store backfilled blocks and update the finalized index.
+func WithDatabase(d db.HeadAccessDatabase) ServiceOption {
+	return func(s *Service) error {
+		s.db = d
+		return nil
+	}
+}
+
+// WithP2P sets the p2p service used to select peers and request blocks.
+func WithP2P(p p2p.P2P) ServiceOption {
+	return func(s *Service) error {
+		s.p2p = p
+		return nil
+	}
+}
+
+// WithClockWaiter sets the ClockWaiter used to wait for the genesis clock before backfill begins.
+func WithClockWaiter(cw startup.ClockWaiter) ServiceOption {
+	return func(s *Service) error {
+		s.clockWaiter = cw
+		return nil
+	}
+}
+
+// WithVerifierState sets the state used to look up proposer public keys when verifying block signatures.
+// This should be the finalized state the node started from, which contains every validator that could
+// have proposed a block below the origin checkpoint.
+func WithVerifierState(st state.ReadOnlyBeaconState) ServiceOption {
+	return func(s *Service) error {
+		s.verifierState = st
+		return nil
+	}
+}
+
+// WithInitialSyncComplete sets a channel which is closed when initial-sync completes. Backfill waits for this
+// so that it does not compete with initial-sync for peer bandwidth.
+func WithInitialSyncComplete(c chan struct{}) ServiceOption {
+	return func(s *Service) error {
+		s.initialSyncComplete = c
+		return nil
+	}
+}
+
+// NewService initializes the backfill Service. Like all implementations of the Service interface, the service
+// won't begin its runloop until Start() is called.
+func NewService(ctx context.Context, su *Status, opts ...ServiceOption) (*Service, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		ctx:       ctx,
+		cancel:    cancel,
+		su:        su,
+		batchSize: DefaultBatchSize,
+	}
+	for _, o := range opts {
+		if err := o(s); err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+	if s.su == nil {
+		cancel()
+		return nil, errMissingDependency
+	}
+	if !s.enabled || s.su.Complete() {
+		return s, nil
+	}
+	if s.db == nil || s.p2p == nil || s.clockWaiter == nil || s.verifierState == nil {
+		cancel()
+		return nil, errMissingDependency
+	}
+	blocksPerPeriod := flags.Get().BlockBatchLimit
+	allowedBlocksBurst := flags.Get().BlockBatchLimitBurstFactor * flags.Get().BlockBatchLimit
+	s.rateLimiter = leakybucket.NewCollector(
+		float64(blocksPerPeriod), int64(allowedBlocksBurst-blocksPerPeriod),
+		blockLimiterPeriod, false /* deleteEmptyBuckets */)
+	s.verifier = newVerifier(s.verifierState)
+	return s, nil
+}
+
+// Start begins the backfill runloop. It returns immediately if backfill is disabled or there is nothing to backfill,
+// otherwise it blocks until backfill is complete or the service is stopped.
+func (s *Service) Start() {
+	if !s.enabled {
+		log.Info("Backfill service not enabled")
+		return
+	}
+	if s.su.Complete() {
+		log.Info("Backfill service not needed, node history is complete")
+		return
+	}
+	clock, err := s.clockWaiter.WaitForClock(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Backfill service failed to start while waiting for genesis data")
+		return
+	}
+	s.clock = clock
+	if s.initialSyncComplete != nil {
+		select {
+		case <-s.initialSyncComplete:
+		case <-s.ctx.Done():
+			return
+		}
+	}
+	if err := s.initLowest(s.ctx); err != nil {
+		log.WithError(err).Error("Backfill service could not determine the lowest backfilled block")
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"lowestSlot": s.lowest.Block().Slot(),
+		"lowestRoot": s.lowest.Root(),
+	}).Info("Backfill service starting")
+
+	for {
+		if s.ctx.Err() != nil {
+			return
+		}
+		if s.su.Complete() {
+			backfillRemainingSlots.Set(0)
+			log.Info("Backfill is complete")
+			return
+		}
+		if err := s.step(s.ctx); err != nil {
+			if errors.Is(err, context.Canceled) {
+				return
+			}
+			backfillBatchFailures.Inc()
+			log.WithError(err).Debug("Backfill step failed, retrying")
+			select {
+			case <-time.After(retryDelay):
+			case <-s.ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// Stop cancels the runloop context.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status is part of the runtime.Service interface. Backfill failures are retried internally
+// and do not affect the health of the node.
+func (s *Service) Status() error {
+	return nil
+}
+
+// initLowest loads the block that the next batch must connect to. This is the block referenced by the backfill
+// block root, or the origin checkpoint block when backfill has not started yet.
+func (s *Service) initLowest(ctx context.Context) error {
+	gr, err := s.db.GenesisBlockRoot(ctx)
+	if err != nil {
+		return err
+	}
+	s.genesisRoot = gr
+	root, err := s.db.BackfillBlockRoot(ctx)
+	if err != nil {
+		return err
+	}
+	if root == gr {
+		root, err = s.db.OriginCheckpointBlockRoot(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	blk, err := s.db.Block(ctx, root)
+	if err != nil {
+		return err
+	}
+	lowest, err := blocks.NewROBlockWithRoot(blk, root)
+	if err != nil {
+		return err
+	}
+	s.lowest = lowest
+	return nil
+}
+
+// step requests a single batch of blocks below the current lowest block. If the batch is valid, it is saved to
+// the database, added to the finalized index, and the backfill Status is advanced to the new lowest block.
+func (s *Service) step(ctx context.Context) error {
+	start := time.Now()
+	end := s.lowest.Block().Slot()
+	parent := s.lowest.Block().ParentRoot()
+	// Blocks below the earliest available slot have been pruned and are not backfilled again.
+	floor := primitives.Slot(1)
+	if earliest := s.su.EarliestAvailableSlot(); earliest > floor {
+		floor = earliest
+	}
+	if parent == s.genesisRoot || end <= floor {
+		s.su.markComplete()
+		return nil
+	}
+	// Skipped slots mean that the parent can be arbitrarily far below the lowest block. Search downwards one batch
+	// at a time until the parent is found; the database never stores a partial segment.
+	var low primitives.Slot
+	for {
+		low = floor
+		if uint64(end) > s.batchSize+uint64(floor) {
+			low = end - primitives.Slot(s.batchSize)
+		}
+		pid, err := s.pickPeer()
+		if err != nil {
+			return err
+		}
+		req := &zondpb.BeaconBlocksByRangeRequest{
+			StartSlot: low,
+			Count:     uint64(end - low),
+			Step:      1,
+		}
+		if err := s.waitForBandwidth(ctx, pid, req.Count); err != nil {
+			return err
+		}
+		resp, err := prysmsync.SendBeaconBlocksByRangeRequest(ctx, s.clock, s.p2p, pid, req, nil)
+		if err != nil {
+			return errors.Wrapf(err, "backfill request to peer %s failed", pid)
+		}
+		if len(resp) == 0 {
+			if low == 1 {
+				s.p2p.Peers().Scorers().BadResponsesScorer().Increment(pid)
+				return errors.Wrapf(errInvalidBatch, "peer %s returned no blocks above genesis", pid)
+			}
+			if low == floor {
+				s.su.markComplete()
+				return nil
+			}
+			end = low
+			continue
+		}
+		robs, err := s.verifier.verify(resp, parent)
+		if err != nil {
+			s.p2p.Peers().Scorers().BadResponsesScorer().Increment(pid)
+			return errors.Wrapf(err, "invalid backfill batch from peer %s", pid)
+		}
+		return s.importBatch(ctx, robs, start)
+	}
+}
+
+func (s *Service) importBatch(ctx context.Context, robs []blocks.ROBlock, start time.Time) error {
+	sbs := make([]interfaces.ReadOnlySignedBeaconBlock, len(robs))
+	for i := range robs {
+		sbs[i] = robs[i].ReadOnlySignedBeaconBlock
+	}
+	if err := s.db.SaveBlocks(ctx, sbs); err != nil {
+		return errors.Wrap(err, "failed to save backfill blocks")
+	}
+	if err := s.db.BackfillFinalizedIndex(ctx, robs, s.lowest.Root()); err != nil {
+		return errors.Wrap(err, "failed to update finalized index with backfill blocks")
+	}
+	lowest := robs[0]
+	if err := s.su.Advance(ctx, lowest.Block().Slot(), lowest.Root()); err != nil {
+		return errors.Wrap(err, "failed to advance backfill status")
+	}
+	s.lowest = lowest
+	if lowest.Block().ParentRoot() == s.genesisRoot {
+		s.su.markComplete()
+	}
+
+	backfillBlocksImported.Add(float64(len(robs)))
+	backfillLowestSlot.Set(float64(lowest.Block().Slot()))
+	backfillRemainingSlots.Set(float64(s.su.EndGap() - s.su.StartGap()))
+	backfillBatchTime.Observe(float64(time.Since(start).Milliseconds()))
+	log.WithFields(logrus.Fields{
+		"count":      len(robs),
+		"lowestSlot": lowest.Block().Slot(),
+		"remaining":  s.su.EndGap() - s.su.StartGap(),
+	}).Debug("Backfilled batch of blocks")
+	return nil
+}
+
+// pickPeer randomly selects one of the connected peers that have finalized at least the epoch of the
+// lowest backfilled block.
+func (s *Service) pickPeer() (peer.ID, error) {
+	epoch := slots.ToEpoch(s.lowest.Block().Slot())
+	_, peers := s.p2p.Peers().BestFinalized(params.BeaconConfig().MaxPeersToSync, epoch)
+	if len(peers) == 0 {
+		return "", errNoPeers
+	}
+	return peers[rand.NewGenerator().Intn(len(peers))], nil
+}
+
+func (s *Service) waitForBandwidth(ctx context.Context, pid peer.ID, count uint64) error {
+	if s.rateLimiter.Remaining(pid.String()) < int64(count) {
+		timer := time.NewTimer(s.rateLimiter.TillEmpty(pid.String()))
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	s.rateLimiter.Add(pid.String(), int64(count))
+	return nil
+}
@@ -0,0 +1,21 @@
+package backfill
+
+import (
+	"context"
+	"testing"
+
+	"github.com/theQRL/qrysm/v4/testing/require"
+)
+
+func TestNewService_MissingDependency(t *testing.T) {
+	ctx := context.Background()
+	_, err := NewService(ctx, nil, WithEnableBackfill(true))
+	require.ErrorIs(t, err, errMissingDependency)
+
+	// The other dependencies are only needed when there is history to backfill.
+	su := &Status{end: 10}
+	_, err = NewService(ctx, su, WithEnableBackfill(false))
+	require.NoError(t, err)
+	_, err = NewService(ctx, su, WithEnableBackfill(true))
+	require.ErrorIs(t, err, errMissingDependency)
+}
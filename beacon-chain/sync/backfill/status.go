@@ -2,6 +2,7 @@ package backfill
 
 import (
 	"context"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/theQRL/qrysm/v4/beacon-chain/db"
@@ -19,12 +20,16 @@ func NewStatus(store BackfillDB) *Status {
 
 // Status provides a way to update and query the status of a backfill process that may be necessary to track when
 // a node was initialized via checkpoint sync. With checkpoint sync, there will be a gap in node history from genesis
-// until the checkpoint sync origin block. Status provides the means to update the value keeping track of the lower
+// until the checkpoint sync origin block. Backfill fills this gap backwards, starting from the origin block and
+// following parent roots towards genesis. Status provides the means to update the value keeping track of the upper
 // end of the missing block range via the Advance() method, to check whether a Slot is missing from the database
 // via the SlotCovered() method, and to see the current StartGap() and EndGap().
+// When history is pruned, Status also tracks the EarliestAvailableSlot(), below which blocks are no longer kept.
 type Status struct {
+	sync.RWMutex
 	start       primitives.Slot
 	end         primitives.Slot
+	earliest    primitives.Slot
 	store       BackfillDB
 	genesisSync bool
 }
@@ -32,12 +37,18 @@ type Status struct {
 // SlotCovered uses StartGap() and EndGap() to determine if the given slot is covered by the current chain history.
 // If the slot is <= StartGap(), or >= EndGap(), the result is true.
 // If the slot is between StartGap() and EndGap(), the result is false.
+// Slots above genesis and below EarliestAvailableSlot() have been pruned and are never covered.
 func (s *Status) SlotCovered(sl primitives.Slot) bool {
+	s.RLock()
+	defer s.RUnlock()
+	if sl > 0 && sl < s.earliest {
+		return false
+	}
 	// short circuit if the node was synced from genesis
 	if s.genesisSync {
 		return true
 	}
-	if s.StartGap() < sl && sl < s.EndGap() {
+	if s.start < sl && sl < s.end {
 		return false
 	}
 	return true
@@ -45,29 +56,79 @@ func (s *Status) SlotCovered(sl primitives.Slot) bool {
 
 // StartGap returns the slot at the beginning of the range that needs to be backfilled.
 func (s *Status) StartGap() primitives.Slot {
+	s.RLock()
+	defer s.RUnlock()
 	return s.start
 }
 
 // EndGap returns the slot at the end of the range that needs to be backfilled.
+// This is the slot of the lowest block that is known to be connected to the origin checkpoint block.
 func (s *Status) EndGap() primitives.Slot {
+	s.RLock()
+	defer s.RUnlock()
 	return s.end
 }
 
+// EarliestAvailableSlot returns the lowest slot above genesis from which blocks are kept, which is zero unless
+// history has been pruned.
+func (s *Status) EarliestAvailableSlot() primitives.Slot {
+	s.RLock()
+	defer s.RUnlock()
+	return s.earliest
+}
+
+// Complete returns true when there is no gap left to backfill, either because the node was synced from genesis,
+// because backfill has reached the genesis block or because the rest of the gap has been pruned.
+func (s *Status) Complete() bool {
+	s.RLock()
+	defer s.RUnlock()
+	return s.genesisSync || s.end <= s.start || s.end <= s.earliest
+}
+
+// MarkPruned records that the blocks below the given slot, except genesis, have been pruned from the database.
+// The earliest available slot never moves back towards genesis.
+func (s *Status) MarkPruned(earliest primitives.Slot) {
+	s.Lock()
+	defer s.Unlock()
+	if earliest > s.earliest {
+		s.earliest = earliest
+	}
+}
+
 var ErrAdvancePastOrigin = errors.New("cannot advance backfill Status beyond the origin checkpoint slot")
 
-// Advance advances the backfill position to the given slot & root.
-// It updates the backfill block root entry in the database,
+// Advance advances the backfill position to the given slot & root, moving the end of the gap
+// towards genesis. It updates the backfill block root entry in the database,
 // and also updates the Status value's copy of the backfill position slot.
-func (s *Status) Advance(ctx context.Context, upTo primitives.Slot, root [32]byte) error {
-	if upTo > s.end {
-		return errors.Wrapf(ErrAdvancePastOrigin, "advance slot=%d, origin slot=%d", upTo, s.end)
+func (s *Status) Advance(ctx context.Context, downTo primitives.Slot, root [32]byte) error {
+	s.Lock()
+	defer s.Unlock()
+	if downTo > s.end {
+		return errors.Wrapf(ErrAdvancePastOrigin, "advance slot=%d, backfill slot=%d", downTo, s.end)
 	}
-	s.start = upTo
-	return s.store.SaveBackfillBlockRoot(ctx, root)
+	if err := s.store.SaveBackfillBlockRoot(ctx, root); err != nil {
+		return err
+	}
+	s.end = downTo
+	return nil
+}
+
+// markComplete closes the gap once the lowest backfilled block is a child of the genesis block.
+func (s *Status) markComplete() {
+	s.Lock()
+	defer s.Unlock()
+	s.end = s.start
 }
 
 // Reload queries the database for backfill status, initializing the internal data and validating the database state.
 func (s *Status) Reload(ctx context.Context) error {
+	s.Lock()
+	defer s.Unlock()
+	earliest, err := s.store.EarliestAvailableSlot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error retrieving earliest available slot")
+	}
+	s.earliest = earliest
 	cpRoot, err := s.store.OriginCheckpointBlockRoot(ctx)
 	if err != nil {
 		// mark genesis sync and short circuit further lookups
@@ -84,9 +145,8 @@ func (s *Status) Reload(ctx context.Context) error {
 	if err := blocks.BeaconBlockIsNil(cpBlock); err != nil {
 		return err
 	}
-	s.end = cpBlock.Block().Slot()
 
-	_, err = s.store.GenesisBlockRoot(ctx)
+	genesisRoot, err := s.store.GenesisBlockRoot(ctx)
 	if err != nil {
 		if errors.Is(err, db.ErrNotFoundGenesisBlockRoot) {
 			return errors.Wrap(err, "genesis block root required for checkpoint sync")
@@ -101,6 +161,12 @@ func (s *Status) Reload(ctx context.Context) error {
 		}
 		return err
 	}
+	// Databases initialized before backfill was implemented used the genesis root as the backfill starting point.
+	// In that case nothing has been backfilled yet and the gap extends all the way up to the origin block.
+	if bfRoot == genesisRoot {
+		s.end = cpBlock.Block().Slot()
+		return nil
+	}
 	bfBlock, err := s.store.Block(ctx, bfRoot)
 	if err != nil {
 		return errors.Wrapf(err, "error retrieving block for backfill root=%#x", bfRoot)
@@ -108,7 +174,10 @@ func (s *Status) Reload(ctx context.Context) error {
 	if err := blocks.BeaconBlockIsNil(bfBlock); err != nil {
 		return err
 	}
-	s.start = bfBlock.Block().Slot()
+	s.end = bfBlock.Block().Slot()
+	if bfBlock.Block().ParentRoot() == genesisRoot {
+		s.end = s.start
+	}
 	return nil
 }
 
@@ -118,5 +187,6 @@ type BackfillDB interface {
 	GenesisBlockRoot(ctx context.Context) ([32]byte, error)
 	OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error)
 	BackfillBlockRoot(ctx context.Context) ([32]byte, error)
+	EarliestAvailableSlot(ctx context.Context) (primitives.Slot, error)
 	Block(ctx context.Context, blockRoot [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error)
 }
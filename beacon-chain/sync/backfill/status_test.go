@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/theQRL/qrysm/v4/beacon-chain/db"
 	"github.com/theQRL/qrysm/v4/consensus-types/blocks"
 	blocktest "github.com/theQRL/qrysm/v4/consensus-types/blocks/testing"
 	"github.com/theQRL/qrysm/v4/consensus-types/interfaces"
@@ -22,6 +23,7 @@ type mockBackfillDB struct {
 	genesisBlockRoot          func(ctx context.Context) ([32]byte, error)
 	originCheckpointBlockRoot func(ctx context.Context) ([32]byte, error)
 	backfillBlockRoot         func(ctx context.Context) ([32]byte, error)
+	earliestAvailableSlot     func(ctx context.Context) (primitives.Slot, error)
 	block                     func(ctx context.Context, blockRoot [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error)
 }
 
@@ -55,6 +57,14 @@ func (db *mockBackfillDB) BackfillBlockRoot(ctx context.Context) ([32]byte, erro
 	return [32]byte{}, errEmptyMockDBMethod
 }
 
+// EarliestAvailableSlot defaults to a database that was never pruned.
+func (db *mockBackfillDB) EarliestAvailableSlot(ctx context.Context) (primitives.Slot, error) {
+	if db.earliestAvailableSlot != nil {
+		return db.earliestAvailableSlot(ctx)
+	}
+	return 0, nil
+}
+
 func (db *mockBackfillDB) Block(ctx context.Context, blockRoot [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error) {
 	if db.block != nil {
 		return db.block(ctx, blockRoot)
@@ -105,6 +115,24 @@ func TestSlotCovered(t *testing.T) {
 			slot:   100,
 			result: true,
 		},
+		{
+			name:   "pruned false",
+			status: &Status{genesisSync: true, earliest: 101},
+			slot:   100,
+			result: false,
+		},
+		{
+			name:   "genesis not pruned",
+			status: &Status{genesisSync: true, earliest: 101},
+			slot:   0,
+			result: true,
+		},
+		{
+			name:   "equal earliest true",
+			status: &Status{genesisSync: true, earliest: 101},
+			slot:   101,
+			result: true,
+		},
 	}
 	for _, c := range cases {
 		result := c.status.SlotCovered(c.slot)
@@ -126,8 +154,10 @@ func TestAdvance(t *testing.T) {
 	copy(root[:], []byte{0x23, 0x23})
 	require.NoError(t, s.Advance(ctx, 90, root))
 	require.Equal(t, root, saveBackfillBuf[0])
-	not := s.SlotCovered(95)
-	require.Equal(t, false, not)
+	require.Equal(t, primitives.Slot(90), s.EndGap())
+	require.Equal(t, true, s.SlotCovered(95))
+	require.Equal(t, false, s.SlotCovered(85))
+	require.Equal(t, false, s.Complete())
 
 	// this should still be len 1 after failing to advance
 	require.Equal(t, 1, len(saveBackfillBuf))
@@ -136,6 +166,21 @@ func TestAdvance(t *testing.T) {
 	require.Equal(t, 1, len(saveBackfillBuf))
 }
 
+func TestMarkPruned(t *testing.T) {
+	s := &Status{start: 0, end: 100}
+	require.Equal(t, false, s.Complete())
+	s.MarkPruned(50)
+	require.Equal(t, primitives.Slot(50), s.EarliestAvailableSlot())
+	require.Equal(t, false, s.SlotCovered(49))
+	require.Equal(t, false, s.Complete())
+	// The earliest available slot never moves back.
+	s.MarkPruned(40)
+	require.Equal(t, primitives.Slot(50), s.EarliestAvailableSlot())
+	// Pruning past the end of the gap leaves nothing to backfill.
+	s.MarkPruned(100)
+	require.Equal(t, true, s.Complete())
+}
+
 func goodBlockRoot(root [32]byte) func(ctx context.Context) ([32]byte, error) {
 	return func(ctx context.Context) ([32]byte, error) {
 		return root, nil
@@ -163,17 +208,27 @@ func TestReload(t *testing.T) {
 
 	backfillSlot := primitives.Slot(50)
 	var backfillRoot [32]byte
-	copy(originRoot[:], []byte{0x02})
+	copy(backfillRoot[:], []byte{0x02})
 	backfillBlock, err := setupTestBlock(backfillSlot)
 	require.NoError(t, err)
 
+	var genesisRoot [32]byte
+	copy(genesisRoot[:], []byte{0x03})
+	gcRaw := util.NewBeaconBlock()
+	gcRaw.Block.Slot = 1
+	gcRaw.Block.ParentRoot = genesisRoot[:]
+	genesisChild, err := blocks.NewSignedBeaconBlock(gcRaw)
+	require.NoError(t, err)
+	var genesisChildRoot [32]byte
+	copy(genesisChildRoot[:], []byte{0x04})
+
 	cases := []struct {
 		name     string
 		db       BackfillDB
 		err      error
 		expected *Status
 	}{
-		/*{
+		{
 			name: "origin not found, implying genesis sync ",
 			db: &mockBackfillDB{
 				genesisBlockRoot: goodBlockRoot(params.BeaconConfig().ZeroHash),
@@ -182,6 +237,25 @@ func TestReload(t *testing.T) {
 				}},
 			expected: &Status{genesisSync: true},
 		},
+		{
+			name: "genesis sync with pruned history",
+			db: &mockBackfillDB{
+				earliestAvailableSlot: func(ctx context.Context) (primitives.Slot, error) {
+					return 64, nil
+				},
+				originCheckpointBlockRoot: func(ctx context.Context) ([32]byte, error) {
+					return [32]byte{}, db.ErrNotFoundOriginBlockRoot
+				}},
+			expected: &Status{genesisSync: true, earliest: 64},
+		},
+		{
+			name: "earliest available slot error",
+			err:  derp,
+			db: &mockBackfillDB{
+				earliestAvailableSlot: func(ctx context.Context) (primitives.Slot, error) {
+					return 0, derp
+				}},
+		},
 		{
 			name: "genesis not found error",
 			err:  db.ErrNotFoundGenesisBlockRoot,
@@ -316,11 +390,11 @@ func TestReload(t *testing.T) {
 				backfillBlockRoot: goodBlockRoot(backfillRoot),
 			},
 			err: derp,
-		},*/
+		},
 		{
 			name: "complete happy path",
 			db: &mockBackfillDB{
-				genesisBlockRoot:          goodBlockRoot(params.BeaconConfig().ZeroHash),
+				genesisBlockRoot:          goodBlockRoot(genesisRoot),
 				originCheckpointBlockRoot: goodBlockRoot(originRoot),
 				block: func(ctx context.Context, root [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error) {
 					switch root {
@@ -333,8 +407,41 @@ func TestReload(t *testing.T) {
 				},
 				backfillBlockRoot: goodBlockRoot(backfillRoot),
 			},
-			err:      derp,
-			expected: &Status{genesisSync: false, start: backfillSlot, end: originSlot},
+			expected: &Status{genesisSync: false, start: 0, end: backfillSlot},
+		},
+		{
+			name: "backfill root is genesis root, backfill not started",
+			db: &mockBackfillDB{
+				genesisBlockRoot:          goodBlockRoot(genesisRoot),
+				originCheckpointBlockRoot: goodBlockRoot(originRoot),
+				block: func(ctx context.Context, root [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error) {
+					switch root {
+					case originRoot:
+						return originBlock, nil
+					}
+					return nil, errors.New("not derp")
+				},
+				backfillBlockRoot: goodBlockRoot(genesisRoot),
+			},
+			expected: &Status{genesisSync: false, start: 0, end: originSlot},
+		},
+		{
+			name: "backfill block is child of genesis, backfill complete",
+			db: &mockBackfillDB{
+				genesisBlockRoot:          goodBlockRoot(genesisRoot),
+				originCheckpointBlockRoot: goodBlockRoot(originRoot),
+				block: func(ctx context.Context, root [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error) {
+					switch root {
+					case originRoot:
+						return originBlock, nil
+					case genesisChildRoot:
+						return genesisChild, nil
+					}
+					return nil, errors.New("not derp")
+				},
+				backfillBlockRoot: goodBlockRoot(genesisChildRoot),
+			},
+			expected: &Status{genesisSync: false, start: 0, end: 0},
 		},
 	}
 
@@ -354,5 +461,6 @@ func TestReload(t *testing.T) {
 		require.Equal(t, c.expected.genesisSync, s.genesisSync)
 		require.Equal(t, c.expected.start, s.start)
 		require.Equal(t, c.expected.end, s.end)
+		require.Equal(t, c.expected.earliest, s.earliest)
 	}
 }
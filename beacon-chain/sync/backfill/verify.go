@@ -0,0 +1,119 @@
+package backfill
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/v4/beacon-chain/core/signing"
+	"github.com/theQRL/qrysm/v4/beacon-chain/state"
+	"github.com/theQRL/qrysm/v4/config/params"
+	"github.com/theQRL/qrysm/v4/consensus-types/blocks"
+	"github.com/theQRL/qrysm/v4/consensus-types/interfaces"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/crypto/dilithium"
+	"github.com/theQRL/qrysm/v4/network/forks"
+	"github.com/theQRL/qrysm/v4/time/slots"
+)
+
+var (
+	errInvalidBatch        = errors.New("invalid batch of blocks received from peer")
+	errChainBroken         = errors.Wrap(errInvalidBatch, "blocks do not form a chain ending at the expected parent root")
+	errProposerIndexTooBig = errors.Wrap(errInvalidBatch, "proposer index not found in the verifying state")
+	errInvalidSignature    = errors.Wrap(errInvalidBatch, "invalid proposer signature in batch")
+)
+
+// verifier checks that batches of blocks downloaded from peers are the ancestors of a known block, and that they
+// were signed by their proposers. Since the validator registry only grows, the public key of every historical
+// proposer can be found in a state at or after the checkpoint sync origin.
+type verifier struct {
+	st      state.ReadOnlyBeaconState
+	vr      []byte
+	domains map[[4]byte][]byte
+}
+
+func newVerifier(st state.ReadOnlyBeaconState) *verifier {
+	return &verifier{
+		st:      st,
+		vr:      st.GenesisValidatorsRoot(),
+		domains: make(map[[4]byte][]byte),
+	}
+}
+
+// verify sorts the given blocks by slot and checks that they form a chain of parent roots where the highest block
+// has the root parentRoot. The proposer signatures of all blocks are then checked as a single batch.
+// The sorted blocks are returned in ascending slot order.
+func (v *verifier) verify(blks []interfaces.ReadOnlySignedBeaconBlock, parentRoot [32]byte) ([]blocks.ROBlock, error) {
+	if len(blks) == 0 {
+		return nil, nil
+	}
+	robs := make([]blocks.ROBlock, len(blks))
+	for i := range blks {
+		rb, err := blocks.NewROBlock(blks[i])
+		if err != nil {
+			return nil, errors.Wrap(errInvalidBatch, err.Error())
+		}
+		robs[i] = rb
+	}
+	sort.Sort(blocks.ROBlockSlice(robs))
+
+	expected := parentRoot
+	for i := len(robs) - 1; i >= 0; i-- {
+		if robs[i].Root() != expected {
+			return nil, errors.Wrapf(errChainBroken, "block at slot %d has root %#x, expected %#x",
+				robs[i].Block().Slot(), robs[i].Root(), expected)
+		}
+		expected = robs[i].Block().ParentRoot()
+	}
+
+	set := dilithium.NewSet()
+	for i := range robs {
+		b, err := v.signatureBatch(robs[i])
+		if err != nil {
+			return nil, err
+		}
+		set.Join(b)
+	}
+	valid, err := set.Verify()
+	if err != nil {
+		return nil, errors.Wrap(errInvalidSignature, err.Error())
+	}
+	if !valid {
+		return nil, errInvalidSignature
+	}
+	return robs, nil
+}
+
+func (v *verifier) signatureBatch(b blocks.ROBlock) (*dilithium.SignatureBatch, error) {
+	idx := b.Block().ProposerIndex()
+	if uint64(idx) >= uint64(v.st.NumValidators()) {
+		return nil, errors.Wrapf(errProposerIndexTooBig, "proposer index %d at slot %d", idx, b.Block().Slot())
+	}
+	pub := v.st.PubkeyAtIndex(idx)
+	domain, err := v.domain(slots.ToEpoch(b.Block().Slot()))
+	if err != nil {
+		return nil, err
+	}
+	sig := b.Signature()
+	return signing.BlockSignatureBatch(pub[:], sig[:], domain, func() ([32]byte, error) {
+		return b.Root(), nil
+	})
+}
+
+// domain returns the proposer signing domain for the fork active at the given epoch.
+func (v *verifier) domain(epoch primitives.Epoch) ([]byte, error) {
+	fork, err := forks.Fork(epoch)
+	if err != nil {
+		return nil, err
+	}
+	var version [4]byte
+	copy(version[:], fork.CurrentVersion)
+	if d, ok := v.domains[version]; ok {
+		return d, nil
+	}
+	d, err := signing.Domain(fork, epoch, params.BeaconConfig().DomainBeaconProposer, v.vr)
+	if err != nil {
+		return nil, err
+	}
+	v.domains[version] = d
+	return d, nil
+}
@@ -0,0 +1,110 @@
+package backfill
+
+import (
+	"testing"
+
+	"github.com/theQRL/qrysm/v4/beacon-chain/core/signing"
+	"github.com/theQRL/qrysm/v4/beacon-chain/state"
+	"github.com/theQRL/qrysm/v4/config/params"
+	"github.com/theQRL/qrysm/v4/consensus-types/blocks"
+	"github.com/theQRL/qrysm/v4/consensus-types/interfaces"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/crypto/dilithium"
+	"github.com/theQRL/qrysm/v4/network/forks"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	"github.com/theQRL/qrysm/v4/testing/require"
+	"github.com/theQRL/qrysm/v4/testing/util"
+	"github.com/theQRL/qrysm/v4/time/slots"
+)
+
+func testVerifierState(t *testing.T, n int) (state.BeaconState, []dilithium.DilithiumKey) {
+	keys := make([]dilithium.DilithiumKey, n)
+	vals := make([]*zondpb.Validator, n)
+	for i := 0; i < n; i++ {
+		k, err := dilithium.RandKey()
+		require.NoError(t, err)
+		keys[i] = k
+		vals[i] = &zondpb.Validator{PublicKey: k.PublicKey().Marshal()}
+	}
+	st, err := util.NewBeaconStateCapella()
+	require.NoError(t, err)
+	require.NoError(t, st.SetValidators(vals))
+	return st, keys
+}
+
+// testChain builds a chain of n signed blocks, starting at slot 1, and returns them along with the root
+// that the highest block's child would reference as its parent.
+func testChain(t *testing.T, st state.ReadOnlyBeaconState, keys []dilithium.DilithiumKey, n int) ([]interfaces.ReadOnlySignedBeaconBlock, [32]byte) {
+	var parent [32]byte
+	vr := st.GenesisValidatorsRoot()
+	blks := make([]interfaces.ReadOnlySignedBeaconBlock, n)
+	for i := 0; i < n; i++ {
+		b := util.NewBeaconBlockCapella()
+		b.Block.Slot = primitives.Slot(i + 1)
+		b.Block.ProposerIndex = primitives.ValidatorIndex(i % len(keys))
+		b.Block.ParentRoot = parent[:]
+		epoch := slots.ToEpoch(b.Block.Slot)
+		fork, err := forks.Fork(epoch)
+		require.NoError(t, err)
+		domain, err := signing.Domain(fork, epoch, params.BeaconConfig().DomainBeaconProposer, vr)
+		require.NoError(t, err)
+		sr, err := signing.ComputeSigningRoot(b.Block, domain)
+		require.NoError(t, err)
+		b.Signature = keys[b.Block.ProposerIndex].Sign(sr[:]).Marshal()
+		sb, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		blks[i] = sb
+		parent, err = b.Block.HashTreeRoot()
+		require.NoError(t, err)
+	}
+	return blks, parent
+}
+
+func TestVerify(t *testing.T) {
+	st, keys := testVerifierState(t, 4)
+	blks, top := testChain(t, st, keys, 8)
+
+	t.Run("valid, unsorted", func(t *testing.T) {
+		shuffled := []interfaces.ReadOnlySignedBeaconBlock{blks[3], blks[0], blks[7], blks[5], blks[1], blks[2], blks[6], blks[4]}
+		robs, err := newVerifier(st).verify(shuffled, top)
+		require.NoError(t, err)
+		require.Equal(t, len(blks), len(robs))
+		for i := range robs {
+			require.Equal(t, primitives.Slot(i+1), robs[i].Block().Slot())
+		}
+	})
+	t.Run("empty", func(t *testing.T) {
+		robs, err := newVerifier(st).verify(nil, top)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(robs))
+	})
+	t.Run("wrong parent", func(t *testing.T) {
+		_, err := newVerifier(st).verify(blks, [32]byte{0x01})
+		require.ErrorIs(t, err, errInvalidBatch)
+	})
+	t.Run("gap in chain", func(t *testing.T) {
+		gapped := append([]interfaces.ReadOnlySignedBeaconBlock{}, blks[:3]...)
+		gapped = append(gapped, blks[4:]...)
+		_, err := newVerifier(st).verify(gapped, top)
+		require.ErrorIs(t, err, errInvalidBatch)
+	})
+	t.Run("unknown proposer", func(t *testing.T) {
+		small, err := util.NewBeaconStateCapella()
+		require.NoError(t, err)
+		require.NoError(t, small.SetValidators([]*zondpb.Validator{{PublicKey: keys[0].PublicKey().Marshal()}}))
+		_, err = newVerifier(small).verify(blks, top)
+		require.ErrorIs(t, err, errInvalidBatch)
+	})
+	t.Run("bad signature", func(t *testing.T) {
+		// A state where the proposer keys are rotated by one, so each signature is checked against the wrong key.
+		rotated, err := util.NewBeaconStateCapella()
+		require.NoError(t, err)
+		vals := make([]*zondpb.Validator, len(keys))
+		for i := range keys {
+			vals[i] = &zondpb.Validator{PublicKey: keys[(i+1)%len(keys)].PublicKey().Marshal()}
+		}
+		require.NoError(t, rotated.SetValidators(vals))
+		_, err = newVerifier(rotated).verify(blks, top)
+		require.ErrorIs(t, err, errInvalidBatch)
+	})
+}
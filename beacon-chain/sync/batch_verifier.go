@@ -6,6 +6,7 @@ import (
 
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/v4/cmd/beacon-chain/flags"
 	"github.com/theQRL/qrysm/v4/crypto/dilithium"
 	"github.com/theQRL/qrysm/v4/monitoring/tracing"
 	"go.opencensus.io/trace"
@@ -37,12 +38,12 @@ func (s *Service) verifierRoutine() {
 		case sig := <-s.signatureChan:
 			verifierBatch = append(verifierBatch, sig)
 			if len(verifierBatch) >= verifierLimit {
-				verifyBatch(verifierBatch)
+				verifyBatch(s.ctx, verifierBatch)
 				verifierBatch = []*signatureVerifier{}
 			}
 		case <-ticker.C:
 			if len(verifierBatch) > 0 {
-				verifyBatch(verifierBatch)
+				verifyBatch(s.ctx, verifierBatch)
 				verifierBatch = []*signatureVerifier{}
 			}
 		}
@@ -63,7 +64,7 @@ func (s *Service) validateWithBatchVerifier(ctx context.Context, message string,
 	// of each signature set.
 	if resErr != nil {
 		log.WithError(resErr).Tracef("Could not perform batch verification of %s", message)
-		verified, err := set.Verify()
+		verified, err := set.VerifyParallel(ctx, flags.Get().SignatureVerificationWorkers)
 		if err != nil {
 			verErr := errors.Wrapf(err, "Could not verify %s", message)
 			tracing.AnnotateError(span, verErr)
@@ -78,7 +79,7 @@ func (s *Service) validateWithBatchVerifier(ctx context.Context, message string,
 	return pubsub.ValidationAccept, nil
 }
 
-func verifyBatch(verifierBatch []*signatureVerifier) {
+func verifyBatch(ctx context.Context, verifierBatch []*signatureVerifier) {
 	if len(verifierBatch) == 0 {
 		return
 	}
@@ -91,7 +92,7 @@ func verifyBatch(verifierBatch []*signatureVerifier) {
 
 	aggSet, verificationErr = performBatchAggregation(aggSet)
 	if verificationErr == nil {
-		verified, err := aggSet.Verify()
+		verified, err := aggSet.VerifyParallel(ctx, flags.Get().SignatureVerificationWorkers)
 		switch {
 		case err != nil:
 			verificationErr = err
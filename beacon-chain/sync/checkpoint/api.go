@@ -8,22 +8,47 @@ import (
 	"github.com/theQRL/qrysm/v4/api/client/beacon"
 	"github.com/theQRL/qrysm/v4/beacon-chain/db"
 	"github.com/theQRL/qrysm/v4/config/params"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
 )
 
 // APIInitializer manages initializing the beacon node using checkpoint sync, retrieving the checkpoint state and root
-// from the remote beacon node api.
+// from the remote beacon node api. It can also retrieve the deposit snapshot of the checkpoint, see WithDepositSnapshotURL.
 type APIInitializer struct {
-	c *beacon.Client
+	c               *beacon.Client
+	snapshotClient  *beacon.Client
+	depositSnapshot *zondpb.DepositSnapshot
+}
+
+// APIInitializerOption is a functional option for the APIInitializer.
+type APIInitializerOption func(*APIInitializer) error
+
+// WithDepositSnapshotURL makes the APIInitializer download the deposit snapshot of the checkpoint from the given beacon
+// node together with the origin state, which it then serves as a DepositSnapshotFetcher.
+func WithDepositSnapshotURL(beaconNodeHost string) APIInitializerOption {
+	return func(dl *APIInitializer) error {
+		c, err := beacon.NewClient(beaconNodeHost)
+		if err != nil {
+			return errors.Wrapf(err, "unable to parse beacon node url or hostname - %s", beaconNodeHost)
+		}
+		dl.snapshotClient = c
+		return nil
+	}
 }
 
 // NewAPIInitializer creates an APIInitializer, handling the set up of a beacon node api client
 // using the provided host string.
-func NewAPIInitializer(beaconNodeHost string) (*APIInitializer, error) {
+func NewAPIInitializer(beaconNodeHost string, opts ...APIInitializerOption) (*APIInitializer, error) {
 	c, err := beacon.NewClient(beaconNodeHost)
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to parse beacon node url or hostname - %s", beaconNodeHost)
 	}
-	return &APIInitializer{c: c}, nil
+	dl := &APIInitializer{c: c}
+	for _, o := range opts {
+		if err := o(dl); err != nil {
+			return nil, err
+		}
+	}
+	return dl, nil
 }
 
 // Initialize downloads origin state and block for checkpoint sync and initializes database records to
@@ -38,9 +63,23 @@ func (dl *APIInitializer) Initialize(ctx context.Context, d db.Database) error {
 			return errors.Wrap(err, "error while checking database for origin root")
 		}
 	}
+	if dl.snapshotClient != nil {
+		od, snapshot, err := beacon.DownloadFinalizedDataAndDepositSnapshot(ctx, dl.c, dl.snapshotClient)
+		if err != nil {
+			return errors.Wrap(err, "Error retrieving checkpoint origin state, block and deposit snapshot")
+		}
+		dl.depositSnapshot = snapshot
+		return d.SaveOrigin(ctx, od.StateBytes(), od.BlockBytes())
+	}
 	od, err := beacon.DownloadFinalizedData(ctx, dl.c)
 	if err != nil {
 		return errors.Wrap(err, "Error retrieving checkpoint origin state and block")
 	}
 	return d.SaveOrigin(ctx, od.StateBytes(), od.BlockBytes())
 }
+
+// DepositSnapshot returns the deposit snapshot downloaded with the origin state by Initialize. It is nil if the
+// database was already initialized, as a snapshot of another checkpoint cannot be verified against the origin state.
+func (dl *APIInitializer) DepositSnapshot(_ context.Context) (*zondpb.DepositSnapshot, error) {
+	return dl.depositSnapshot, nil
+}
@@ -0,0 +1,43 @@
+package checkpoint
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/v4/io/file"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+)
+
+// DepositSnapshotFetcher describes a type that is able to obtain an EIP-4881 deposit snapshot in some way,
+// which is used to initialize the deposit tree of a new node instead of processing every deposit log.
+// See FileDepositSnapshotFetcher and APIInitializer.
+type DepositSnapshotFetcher interface {
+	DepositSnapshot(ctx context.Context) (*zondpb.DepositSnapshot, error)
+}
+
+// FileDepositSnapshotFetcher reads an ssz-encoded deposit snapshot stored in a file on the local filesystem.
+type FileDepositSnapshotFetcher struct {
+	path string
+}
+
+// NewFileDepositSnapshotFetcher validates the given path and creates a FileDepositSnapshotFetcher
+// which will read the deposit snapshot from it.
+func NewFileDepositSnapshotFetcher(path string) (*FileDepositSnapshotFetcher, error) {
+	if err := existsAndIsFile(path); err != nil {
+		return nil, err
+	}
+	return &FileDepositSnapshotFetcher{path: path}, nil
+}
+
+// DepositSnapshot reads and decodes the deposit snapshot file.
+func (f *FileDepositSnapshotFetcher) DepositSnapshot(_ context.Context) (*zondpb.DepositSnapshot, error) {
+	b, err := file.ReadFileAsBytes(f.path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading deposit snapshot file %s", f.path)
+	}
+	snapshot := &zondpb.DepositSnapshot{}
+	if err := snapshot.UnmarshalSSZ(b); err != nil {
+		return nil, errors.Wrapf(err, "error decoding deposit snapshot file %s", f.path)
+	}
+	return snapshot, nil
+}
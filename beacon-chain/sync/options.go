@@ -143,3 +143,12 @@ func WithInitialSyncComplete(c chan struct{}) Option {
 		return nil
 	}
 }
+
+// WithBackfillStatus sets the status used to answer range requests for blocks that are missing from the database,
+// either because they have not been backfilled yet or because they have been pruned.
+func WithBackfillStatus(bfs BackfillStatus) Option {
+	return func(s *Service) error {
+		s.cfg.backfillStatus = bfs
+		return nil
+	}
+}
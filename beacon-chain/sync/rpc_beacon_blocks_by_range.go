@@ -38,6 +38,12 @@ func (s *Service) beaconBlocksByRangeRPCHandler(ctx context.Context, msg interfa
 		return err
 	}
 
+	if !s.rangeAvailable(rp) {
+		s.writeErrorResponseToStream(responseCodeResourceUnavailable, p2ptypes.ErrResourceUnavailable.Error(), stream)
+		tracing.AnnotateError(span, p2ptypes.ErrResourceUnavailable)
+		return p2ptypes.ErrResourceUnavailable
+	}
+
 	blockLimiter, err := s.rateLimiter.topicCollector(string(stream.Protocol()))
 	if err != nil {
 		return err
@@ -125,40 +131,32 @@ func validateRangeRequest(r *pb.BeaconBlocksByRangeRequest, current primitives.S
 	return rp, nil
 }
 
+// rangeAvailable returns false if the start of the requested range has not been backfilled yet or has been pruned,
+// in which case the node can not serve the range and responds with ResourceUnavailable.
+func (s *Service) rangeAvailable(rp rangeParams) bool {
+	if s.cfg.backfillStatus == nil {
+		return true
+	}
+	return s.cfg.backfillStatus.SlotCovered(rp.start)
+}
+
 func (s *Service) writeBlockBatchToStream(ctx context.Context, batch blockBatch, stream libp2pcore.Stream) error {
 	ctx, span := trace.StartSpan(ctx, "sync.WriteBlockRangeToStream")
 	defer span.End()
 
-	blinded := make([]interfaces.ReadOnlySignedBeaconBlock, 0)
+	blks := make([]interfaces.ReadOnlySignedBeaconBlock, 0)
 	for _, b := range batch.canonical() {
 		if err := blocks.BeaconBlockIsNil(b); err != nil {
 			continue
 		}
-		if b.IsBlinded() {
-			blinded = append(blinded, b.ReadOnlySignedBeaconBlock)
-			continue
-		}
-		if chunkErr := s.chunkBlockWriter(stream, b); chunkErr != nil {
-			log.WithError(chunkErr).Debug("Could not send a chunked response")
-			return chunkErr
-		}
-	}
-	if len(blinded) == 0 {
-		return nil
+		blks = append(blks, b.ReadOnlySignedBeaconBlock)
 	}
-
-	reconstructed, err := s.cfg.executionPayloadReconstructor.ReconstructFullBellatrixBlockBatch(ctx, blinded)
+	blks, err := s.reconstructBlindedBlocks(ctx, blks)
 	if err != nil {
 		log.WithError(err).Error("Could not reconstruct full bellatrix block batch from blinded bodies")
 		return err
 	}
-	for _, b := range reconstructed {
-		if err := blocks.BeaconBlockIsNil(b); err != nil {
-			continue
-		}
-		if b.IsBlinded() {
-			continue
-		}
+	for _, b := range blks {
 		if chunkErr := s.chunkBlockWriter(stream, b); chunkErr != nil {
 			log.WithError(chunkErr).Debug("Could not send a chunked response")
 			return chunkErr
@@ -167,3 +165,34 @@ func (s *Service) writeBlockBatchToStream(ctx context.Context, batch blockBatch,
 
 	return nil
 }
+
+// reconstructBlindedBlocks replaces the blinded blocks among blks with the full blocks reconstructed in a single
+// batch from the execution client, keeping the order of the blocks.
+func (s *Service) reconstructBlindedBlocks(
+	ctx context.Context, blks []interfaces.ReadOnlySignedBeaconBlock,
+) ([]interfaces.ReadOnlySignedBeaconBlock, error) {
+	var blinded []interfaces.ReadOnlySignedBeaconBlock
+	var blindedIdx []int
+	for i, b := range blks {
+		if b.IsBlinded() {
+			blinded = append(blinded, b)
+			blindedIdx = append(blindedIdx, i)
+		}
+	}
+	if len(blinded) == 0 {
+		return blks, nil
+	}
+	reconstructed, err := s.cfg.executionPayloadReconstructor.ReconstructFullBellatrixBlockBatch(ctx, blinded)
+	if err != nil {
+		return nil, err
+	}
+	if len(reconstructed) != len(blinded) {
+		return nil, errors.Errorf("reconstructed %d blocks out of %d blinded blocks", len(reconstructed), len(blinded))
+	}
+	full := make([]interfaces.ReadOnlySignedBeaconBlock, len(blks))
+	copy(full, blks)
+	for i, idx := range blindedIdx {
+		full[idx] = reconstructed[i]
+	}
+	return full, nil
+}
@@ -81,6 +81,7 @@ func (s *Service) beaconBlocksRootRPCHandler(ctx context.Context, msg interface{
 	}
 	s.rateLimiter.add(stream, int64(len(blockRoots)))
 
+	blks := make([]interfaces.ReadOnlySignedBeaconBlock, 0, len(blockRoots))
 	for _, root := range blockRoots {
 		blk, err := s.cfg.beaconDB.Block(ctx, root)
 		if err != nil {
@@ -91,20 +92,20 @@ func (s *Service) beaconBlocksRootRPCHandler(ctx context.Context, msg interface{
 		if err := blocks.BeaconBlockIsNil(blk); err != nil {
 			continue
 		}
+		blks = append(blks, blk)
+	}
 
-		if blk.Block().IsBlinded() {
-			blk, err = s.cfg.executionPayloadReconstructor.ReconstructFullBlock(ctx, blk)
-			if err != nil {
-				if errors.Is(err, execution.EmptyBlockHash) {
-					log.WithError(err).Warn("Could not reconstruct block from header with syncing execution client. Waiting to complete syncing")
-				} else {
-					log.WithError(err).Error("Could not get reconstruct full block from blinded body")
-				}
-				s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
-				return err
-			}
+	blks, err := s.reconstructBlindedBlocks(ctx, blks)
+	if err != nil {
+		if errors.Is(err, execution.EmptyBlockHash) {
+			log.WithError(err).Warn("Could not reconstruct block from header with syncing execution client. Waiting to complete syncing")
+		} else {
+			log.WithError(err).Error("Could not get reconstruct full block from blinded body")
 		}
-
+		s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+		return err
+	}
+	for _, blk := range blks {
 		if err := s.chunkBlockWriter(stream, blk); err != nil {
 			return err
 		}
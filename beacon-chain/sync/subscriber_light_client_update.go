@@ -0,0 +1,27 @@
+package sync
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	zondpbv2 "github.com/theQRL/qrysm/v4/proto/zond/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+// lightClientFinalityUpdateSubscriber does nothing beyond type checking, as only updates matching
+// the ones computed by this node pass validation and these have already been published locally.
+func (s *Service) lightClientFinalityUpdateSubscriber(_ context.Context, msg proto.Message) error {
+	if _, ok := msg.(*zondpbv2.LightClientFinalityUpdate); !ok {
+		return errors.Errorf("incorrect type of message received, wanted %T but got %T", &zondpbv2.LightClientFinalityUpdate{}, msg)
+	}
+	return nil
+}
+
+// lightClientOptimisticUpdateSubscriber does nothing beyond type checking, as only updates matching
+// the ones computed by this node pass validation and these have already been published locally.
+func (s *Service) lightClientOptimisticUpdateSubscriber(_ context.Context, msg proto.Message) error {
+	if _, ok := msg.(*zondpbv2.LightClientOptimisticUpdate); !ok {
+		return errors.Errorf("incorrect type of message received, wanted %T but got %T", &zondpbv2.LightClientOptimisticUpdate{}, msg)
+	}
+	return nil
+}
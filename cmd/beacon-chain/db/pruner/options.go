@@ -0,0 +1,29 @@
+package pruner
+
+import (
+	"github.com/theQRL/qrysm/v4/beacon-chain/db/pruner"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	// HistoryRetention defines which historical blocks and states the beacon node keeps in its database.
+	HistoryRetention = &cli.StringFlag{
+		Name: "history-retention",
+		Usage: "Which historical blocks and states to keep in the database. " +
+			"'archive' keeps the full history, 'finality' prunes history older than --history-retention-epochs before the finalized checkpoint, " +
+			"'block-requests' keeps only the MIN_EPOCHS_FOR_BLOCK_REQUESTS window peers may request blocks from.",
+		Value: "archive",
+	}
+	// HistoryRetentionEpochs defines the number of epochs before the finalized checkpoint kept by the 'finality' history retention.
+	HistoryRetentionEpochs = &cli.Uint64Flag{
+		Name:  "history-retention-epochs",
+		Usage: "Number of epochs of history to keep before the finalized checkpoint when --history-retention=finality.",
+		Value: 0,
+	}
+	// HistoryPruningBatchSize defines the number of slots pruned from the database in a single write transaction.
+	HistoryPruningBatchSize = &cli.Uint64Flag{
+		Name:  "history-pruning-batch-size",
+		Usage: "Number of slots of history pruned from the database in a single write transaction.",
+		Value: pruner.DefaultBatchSize,
+	}
+)
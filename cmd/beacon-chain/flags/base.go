@@ -3,6 +3,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/theQRL/qrysm/v4/cmd"
 	"github.com/theQRL/qrysm/v4/config/params"
 	"github.com/urfave/cli/v2"
@@ -10,10 +12,22 @@ import (
 
 var (
 	// MevRelayEndpoint provides an HTTP access endpoint to a MEV builder network.
-	MevRelayEndpoint = &cli.StringFlag{
-		Name:  "http-mev-relay",
-		Usage: "A MEV builder relay string http endpoint, this wil be used to interact MEV builder network using API defined in: https://ethereum.github.io/builder-specs/#/Builder",
-		Value: "",
+	// Several relays can be given, by repeating the flag or separating the endpoints with commas.
+	MevRelayEndpoint = &cli.StringSliceFlag{
+		Name: "http-mev-relay",
+		Usage: "A MEV builder relay string http endpoint, this wil be used to interact MEV builder network using API defined in: https://ethereum.github.io/builder-specs/#/Builder. " +
+			"Can be given several times, headers are then requested from every relay and the highest bid is used",
+	}
+	// BuilderRelayTimeout sets the maximum time allowed for each relay to respond to a header request.
+	BuilderRelayTimeout = &cli.DurationFlag{
+		Name:  "builder-relay-timeout",
+		Usage: "The maximum time allowed for each MEV relay to respond to a header request, the bids of slower relays are ignored",
+		Value: 800 * time.Millisecond,
+	}
+	// MinBuilderBid sets the minimum value of the relay bids.
+	MinBuilderBid = &cli.Uint64Flag{
+		Name:  "min-builder-bid",
+		Usage: "The minimum value in Gwei of the MEV relay bids, the local execution payload is used if no relay bids at least this value",
 	}
 	MaxBuilderConsecutiveMissedSlots = &cli.IntFlag{
 		Name:  "max-builder-consecutive-missed-slots",
@@ -151,6 +165,15 @@ var (
 		Usage: "The slot durations of when an archived state gets saved in the beaconDB.",
 		Value: 2048,
 	}
+	// StateDiffSnapshotEpochs specifies the number of epochs between the archived states saved in full when the
+	// archived states are stored as diffs.
+	StateDiffSnapshotEpochs = &cli.Uint64Flag{
+		Name: "state-diff-snapshot-epochs",
+		Usage: "The number of epochs between the archived states saved in full when --enable-state-diffs is set. " +
+			"The archived states in between are saved as diffs against the first full state of their period. Diffs are " +
+			"single-level: every diff applies to a full state, never to another diff.",
+		Value: 1024,
+	}
 	// BlockBatchLimit specifies the requested block batch size.
 	BlockBatchLimit = &cli.IntFlag{
 		Name:  "block-batch-limit",
@@ -175,6 +198,13 @@ var (
 		Usage: "The factor by which blob batch limit may increase on burst.",
 		Value: 2,
 	}
+	// SignatureVerificationWorkers specifies the number of goroutines used to verify a batch of signatures.
+	SignatureVerificationWorkers = &cli.IntFlag{
+		Name: "signature-verification-workers",
+		Usage: "The number of goroutines used to verify a batch of signatures in parallel. " +
+			"A value of 0 uses one goroutine per available CPU.",
+		Value: 0,
+	}
 	// EnableDebugRPCEndpoints as /v1/beacon/state.
 	EnableDebugRPCEndpoints = &cli.BoolFlag{
 		Name:  "enable-debug-rpc-endpoints",
@@ -261,6 +291,27 @@ var (
 		Usage: "Directory for the slasher database",
 		Value: cmd.DefaultDataDir(),
 	}
+	// SlasherChunkSize defines the number of epochs of a validator min or max span stored in a slasher chunk.
+	SlasherChunkSize = &cli.Uint64Flag{
+		Name: "slasher-chunk-size",
+		Usage: "Number of epochs of a validator min or max span stored in a slasher chunk. " +
+			"Changing it requires migrating the slasher database with `qrysmctl db migrate-slasher`.",
+		Value: 16,
+	}
+	// SlasherValidatorChunkSize defines the number of validators whose min or max spans are stored in a slasher chunk.
+	SlasherValidatorChunkSize = &cli.Uint64Flag{
+		Name: "slasher-validator-chunk-size",
+		Usage: "Number of validators whose min or max spans are stored in a slasher chunk. " +
+			"Changing it requires migrating the slasher database with `qrysmctl db migrate-slasher`.",
+		Value: 256,
+	}
+	// SlasherHistoryLength defines the number of epochs of attesting history the slasher keeps for every validator.
+	SlasherHistoryLength = &cli.Uint64Flag{
+		Name: "slasher-history-length",
+		Usage: "Number of epochs of attesting history the slasher keeps for every validator, must be a multiple of --slasher-chunk-size. " +
+			"Changing it requires migrating the slasher database with `qrysmctl db migrate-slasher`.",
+		Value: 4096,
+	}
 	BlobRetentionEpoch = &cli.Uint64Flag{
 		Name:  "extend-blob-retention-epoch",
 		Usage: "Extend blob retention epoch period to beyond default 4096 epochs (~18 days). The node will error at start if input value is less than 4096 epochs.",
@@ -8,13 +8,14 @@ import (
 // GlobalFlags specifies all the global flags for the
 // beacon node.
 type GlobalFlags struct {
-	SubscribeToAllSubnets      bool
-	MinimumSyncPeers           int
-	MinimumPeersPerSubnet      int
-	BlockBatchLimit            int
-	BlockBatchLimitBurstFactor int
-	BlobBatchLimit             int
-	BlobBatchLimitBurstFactor  int
+	SubscribeToAllSubnets        bool
+	MinimumSyncPeers             int
+	MinimumPeersPerSubnet        int
+	BlockBatchLimit              int
+	BlockBatchLimitBurstFactor   int
+	BlobBatchLimit               int
+	BlobBatchLimitBurstFactor    int
+	SignatureVerificationWorkers int
 }
 
 var globalConfig *GlobalFlags
@@ -45,6 +46,7 @@ func ConfigureGlobalFlags(ctx *cli.Context) {
 	cfg.BlobBatchLimitBurstFactor = ctx.Int(BlobBatchLimitBurstFactor.Name)
 	cfg.BlockBatchLimitBurstFactor = ctx.Int(BlockBatchLimitBurstFactor.Name)
 	cfg.MinimumPeersPerSubnet = ctx.Int(MinPeersPerSubnet.Name)
+	cfg.SignatureVerificationWorkers = ctx.Int(SignatureVerificationWorkers.Name)
 	configureMinimumPeers(ctx, cfg)
 
 	Init(cfg)
@@ -16,9 +16,11 @@ import (
 	"github.com/theQRL/qrysm/v4/cmd"
 	blockchaincmd "github.com/theQRL/qrysm/v4/cmd/beacon-chain/blockchain"
 	dbcommands "github.com/theQRL/qrysm/v4/cmd/beacon-chain/db"
+	prunerflags "github.com/theQRL/qrysm/v4/cmd/beacon-chain/db/pruner"
 	"github.com/theQRL/qrysm/v4/cmd/beacon-chain/execution"
 	"github.com/theQRL/qrysm/v4/cmd/beacon-chain/flags"
 	jwtcommands "github.com/theQRL/qrysm/v4/cmd/beacon-chain/jwt"
+	"github.com/theQRL/qrysm/v4/cmd/beacon-chain/sync/backfill"
 	"github.com/theQRL/qrysm/v4/cmd/beacon-chain/sync/checkpoint"
 	"github.com/theQRL/qrysm/v4/cmd/beacon-chain/sync/genesis"
 	"github.com/theQRL/qrysm/v4/config/features"
@@ -55,10 +57,12 @@ var appFlags = []cli.Flag{
 	flags.BlockBatchLimitBurstFactor,
 	flags.BlobBatchLimit,
 	flags.BlobBatchLimitBurstFactor,
+	flags.SignatureVerificationWorkers,
 	flags.InteropMockEth1DataVotesFlag,
 	flags.InteropNumValidatorsFlag,
 	flags.InteropGenesisTimeFlag,
 	flags.SlotsPerArchivedPoint,
+	flags.StateDiffSnapshotEpochs,
 	flags.EnableDebugRPCEndpoints,
 	flags.SubscribeToAllSubnets,
 	flags.HistoricalSlasherNode,
@@ -72,11 +76,16 @@ var appFlags = []cli.Flag{
 	flags.TerminalBlockHashOverride,
 	flags.TerminalBlockHashActivationEpochOverride,
 	flags.MevRelayEndpoint,
+	flags.BuilderRelayTimeout,
+	flags.MinBuilderBid,
 	flags.MaxBuilderEpochMissedSlots,
 	flags.MaxBuilderConsecutiveMissedSlots,
 	flags.EngineEndpointTimeoutSeconds,
 	flags.LocalBlockValueBoost,
 	flags.BlobRetentionEpoch,
+	prunerflags.HistoryRetention,
+	prunerflags.HistoryRetentionEpochs,
+	prunerflags.HistoryPruningBatchSize,
 	cmd.BackupWebhookOutputDir,
 	cmd.MinimalConfigFlag,
 	cmd.E2EConfigFlag,
@@ -106,6 +115,7 @@ var appFlags = []cli.Flag{
 	flags.MonitoringPortFlag,
 	cmd.DisableMonitoringFlag,
 	cmd.ClearDB,
+	cmd.CompactDB,
 	cmd.ForceClearDB,
 	cmd.LogFormat,
 	cmd.MaxGoroutines,
@@ -130,9 +140,16 @@ var appFlags = []cli.Flag{
 	checkpoint.BlockPath,
 	checkpoint.StatePath,
 	checkpoint.RemoteURL,
+	checkpoint.DepositSnapshotPath,
+	checkpoint.DepositSnapshotURL,
 	genesis.StatePath,
 	genesis.BeaconAPIURL,
 	flags.SlasherDirFlag,
+	flags.SlasherChunkSize,
+	flags.SlasherValidatorChunkSize,
+	flags.SlasherHistoryLength,
+	backfill.EnableExperimentalBackfill,
+	backfill.BackfillBatchSize,
 }
 
 func init() {
@@ -278,6 +295,8 @@ func startNode(ctx *cli.Context) error {
 	optFuncs := []func(*cli.Context) (node.Option, error){
 		genesis.BeaconNodeOptions,
 		checkpoint.BeaconNodeOptions,
+		checkpoint.DepositSnapshotOptions,
+		backfill.BeaconNodeOptions,
 	}
 	for _, of := range optFuncs {
 		ofo, err := of(ctx)
@@ -0,0 +1,38 @@
+package backfill
+
+import (
+	"github.com/theQRL/qrysm/v4/beacon-chain/node"
+	"github.com/theQRL/qrysm/v4/beacon-chain/sync/backfill"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	// EnableExperimentalBackfill enables backfill for checkpoint synced nodes.
+	EnableExperimentalBackfill = &cli.BoolFlag{
+		Name: "enable-experimental-backfill",
+		Usage: "Backfill is still experimental at this time. " +
+			"It will only be enabled if this flag is specified and the node was started using checkpoint sync.",
+	}
+	// BackfillBatchSize allows users to tune block backfill request sizes to maximize network utilization
+	// at the cost of higher memory.
+	BackfillBatchSize = &cli.Uint64Flag{
+		Name: "backfill-batch-size",
+		Usage: "Number of blocks per backfill batch. " +
+			"A larger number will request more blocks at once from peers, but also consume more system memory to " +
+			"hold batches in memory during processing.",
+		Value: backfill.DefaultBatchSize,
+	}
+)
+
+// BeaconNodeOptions sets the appropriate functional opts on the *node.BeaconNode value, to decouple options
+// from flag parsing.
+func BeaconNodeOptions(c *cli.Context) (node.Option, error) {
+	opt := func(node *node.BeaconNode) (err error) {
+		node.BackfillOpts = []backfill.ServiceOption{
+			backfill.WithBatchSize(c.Uint64(BackfillBatchSize.Name)),
+			backfill.WithEnableBackfill(c.Bool(EnableExperimentalBackfill.Name)),
+		}
+		return nil
+	}
+	return opt, nil
+}
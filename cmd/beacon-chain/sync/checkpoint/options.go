@@ -28,6 +28,21 @@ var (
 			"As an additional safety measure, it is strongly recommended to only use this option in conjunction with " +
 			"--weak-subjectivity-checkpoint flag",
 	}
+	// DepositSnapshotPath defines a flag to initialize the deposit tree from a deposit snapshot file.
+	DepositSnapshotPath = &cli.PathFlag{
+		Name: "deposit-snapshot",
+		Usage: "Rather than processing every deposit contract log, you can initialize the deposit tree of a new node " +
+			"from a ssz-serialized EIP-4881 deposit snapshot. This flag allows you to specify a local file containing the " +
+			"snapshot, which is verified against the deposit index and eth1 data of the finalized state. Requires --enable-eip-4881.",
+	}
+	// DepositSnapshotURL defines a flag to download the deposit snapshot of the checkpoint sync state from a trusted beacon node.
+	DepositSnapshotURL = &cli.StringFlag{
+		Name: "deposit-snapshot-url",
+		Usage: "URL of a synced beacon node to trust in obtaining the EIP-4881 deposit snapshot used to initialize the " +
+			"deposit tree of a new node, usually the same as --checkpoint-sync-url. The snapshot is downloaded together with " +
+			"the checkpoint state and verified against its deposit index and eth1 data. Requires --checkpoint-sync-url and " +
+			"--enable-eip-4881.",
+	}
 )
 
 // BeaconNodeOptions is responsible for determining if the checkpoint sync options have been used, and if so,
@@ -39,11 +54,19 @@ func BeaconNodeOptions(c *cli.Context) (node.Option, error) {
 	remoteURL := c.String(RemoteURL.Name)
 	if remoteURL != "" {
 		return func(node *node.BeaconNode) error {
-			var err error
-			node.CheckpointInitializer, err = checkpoint.NewAPIInitializer(remoteURL)
+			snapshotURL := c.String(DepositSnapshotURL.Name)
+			var opts []checkpoint.APIInitializerOption
+			if snapshotURL != "" {
+				opts = append(opts, checkpoint.WithDepositSnapshotURL(snapshotURL))
+			}
+			initializer, err := checkpoint.NewAPIInitializer(remoteURL, opts...)
 			if err != nil {
 				return errors.Wrap(err, "error while constructing beacon node api client for checkpoint sync")
 			}
+			node.CheckpointInitializer = initializer
+			if snapshotURL != "" {
+				node.DepositSnapshotFetcher = initializer
+			}
 			return nil
 		}, nil
 	}
@@ -66,3 +89,29 @@ func BeaconNodeOptions(c *cli.Context) (node.Option, error) {
 		return nil
 	}, nil
 }
+
+// DepositSnapshotOptions is responsible for determining if the deposit snapshot options have been used, and if so,
+// preparing a checkpoint.DepositSnapshotFetcher, which retrieves the snapshot used to initialize the deposit tree.
+func DepositSnapshotOptions(c *cli.Context) (node.Option, error) {
+	snapshotPath := c.Path(DepositSnapshotPath.Name)
+	if c.String(DepositSnapshotURL.Name) != "" {
+		// The snapshot is downloaded with the checkpoint state by the checkpoint sync initializer, see BeaconNodeOptions.
+		if c.String(RemoteURL.Name) == "" {
+			return nil, fmt.Errorf("--%s requires --%s", DepositSnapshotURL.Name, RemoteURL.Name)
+		}
+		if snapshotPath != "" {
+			return nil, fmt.Errorf("--%s and --%s are mutually exclusive", DepositSnapshotPath.Name, DepositSnapshotURL.Name)
+		}
+		return nil, nil
+	}
+	if snapshotPath != "" {
+		return func(node *node.BeaconNode) (err error) {
+			node.DepositSnapshotFetcher, err = checkpoint.NewFileDepositSnapshotFetcher(snapshotPath)
+			if err != nil {
+				return errors.Wrap(err, "error preparing to initialize deposit tree from local ssz file")
+			}
+			return nil
+		}, nil
+	}
+	return nil, nil
+}
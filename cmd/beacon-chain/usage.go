@@ -6,7 +6,9 @@ import (
 	"sort"
 
 	"github.com/theQRL/qrysm/v4/cmd"
+	prunerflags "github.com/theQRL/qrysm/v4/cmd/beacon-chain/db/pruner"
 	"github.com/theQRL/qrysm/v4/cmd/beacon-chain/flags"
+	"github.com/theQRL/qrysm/v4/cmd/beacon-chain/sync/backfill"
 	"github.com/theQRL/qrysm/v4/cmd/beacon-chain/sync/checkpoint"
 	"github.com/theQRL/qrysm/v4/cmd/beacon-chain/sync/genesis"
 	"github.com/theQRL/qrysm/v4/config/features"
@@ -67,6 +69,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.MaxGoroutines,
 			cmd.ForceClearDB,
 			cmd.ClearDB,
+			cmd.CompactDB,
 			cmd.ConfigFileFlag,
 			cmd.ChainConfigFileFlag,
 			cmd.GrpcMaxCallRecvMsgSizeFlag,
@@ -110,10 +113,12 @@ var appHelpFlagGroups = []flagGroup{
 			flags.ExecutionJWTSecretFlag,
 			flags.SetGCPercent,
 			flags.SlotsPerArchivedPoint,
+			flags.StateDiffSnapshotEpochs,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
 			flags.BlobBatchLimit,
 			flags.BlobBatchLimitBurstFactor,
+			flags.SignatureVerificationWorkers,
 			flags.EnableDebugRPCEndpoints,
 			flags.SubscribeToAllSubnets,
 			flags.HistoricalSlasherNode,
@@ -123,17 +128,29 @@ var appHelpFlagGroups = []flagGroup{
 			flags.Eth1HeaderReqLimit,
 			flags.MinPeersPerSubnet,
 			flags.MevRelayEndpoint,
+			flags.BuilderRelayTimeout,
+			flags.MinBuilderBid,
 			flags.MaxBuilderEpochMissedSlots,
 			flags.MaxBuilderConsecutiveMissedSlots,
 			flags.EngineEndpointTimeoutSeconds,
 			flags.SlasherDirFlag,
+			flags.SlasherChunkSize,
+			flags.SlasherValidatorChunkSize,
+			flags.SlasherHistoryLength,
 			flags.LocalBlockValueBoost,
 			flags.BlobRetentionEpoch,
+			prunerflags.HistoryRetention,
+			prunerflags.HistoryRetentionEpochs,
+			prunerflags.HistoryPruningBatchSize,
 			checkpoint.BlockPath,
 			checkpoint.StatePath,
 			checkpoint.RemoteURL,
+			checkpoint.DepositSnapshotPath,
+			checkpoint.DepositSnapshotURL,
 			genesis.StatePath,
 			genesis.BeaconAPIURL,
+			backfill.EnableExperimentalBackfill,
+			backfill.BackfillBatchSize,
 		},
 	},
 	{
@@ -187,6 +187,12 @@ var (
 		Name:  "clear-db",
 		Usage: "Prompt for clearing any previously stored data at the data directory",
 	}
+	// CompactDB compacts the database on startup, keeping a backup of the original file.
+	CompactDB = &cli.BoolFlag{
+		Name: "compact-db",
+		Usage: "Copy the database into a new file holding only live data before starting, to give back the disk space " +
+			"freed by deletions. A backup of the original file is written to the backups directory of the data directory",
+	}
 	// LogFormat specifies the log output format.
 	LogFormat = &cli.StringFlag{
 		Name:  "log-format",
@@ -237,8 +243,9 @@ var (
 	// ValidatorMonitorIndicesFlag specifies a list of validator indices to
 	// track for performance updates
 	ValidatorMonitorIndicesFlag = &cli.IntSliceFlag{
-		Name:  "monitor-indices",
-		Usage: "List of validator indices to track performance",
+		Name: "monitor-indices",
+		Usage: "List of validator indices to track performance. Validators can also be added or removed at runtime through the /qrysm/v1/monitor/validators endpoints, " +
+			"and the validators removed at runtime stay untracked after a restart until they are added again through the endpoints",
 	}
 
 	// RestoreSourceFileFlag specifies the filepath to the backed-up database file
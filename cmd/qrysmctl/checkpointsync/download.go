@@ -8,12 +8,14 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/theQRL/qrysm/v4/api/client"
 	"github.com/theQRL/qrysm/v4/api/client/beacon"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
 	"github.com/urfave/cli/v2"
 )
 
 var downloadFlags = struct {
-	BeaconNodeHost string
-	Timeout        time.Duration
+	BeaconNodeHost  string
+	Timeout         time.Duration
+	DepositSnapshot bool
 }{}
 
 var downloadCmd = &cli.Command{
@@ -39,6 +41,12 @@ var downloadCmd = &cli.Command{
 			Destination: &downloadFlags.Timeout,
 			Value:       time.Minute * 4,
 		},
+		&cli.BoolFlag{
+			Name: "deposit-snapshot",
+			Usage: "also download the EIP-4881 deposit snapshot of the finalized state, verified against its deposit index and eth1 data. " +
+				"To be used with the beacon node --deposit-snapshot flag.",
+			Destination: &downloadFlags.DepositSnapshot,
+		},
 	},
 }
 
@@ -57,7 +65,14 @@ func cliActionDownload(_ *cli.Context) error {
 		return err
 	}
 
-	od, err := beacon.DownloadFinalizedData(ctx, client)
+	var od *beacon.OriginData
+	var snapshot *zondpb.DepositSnapshot
+	if f.DepositSnapshot {
+		// The snapshot must be downloaded together with the finalized state it is verified against.
+		od, snapshot, err = beacon.DownloadFinalizedDataAndDepositSnapshot(ctx, client, client)
+	} else {
+		od, err = beacon.DownloadFinalizedData(ctx, client)
+	}
 	if err != nil {
 		return err
 	}
@@ -74,5 +89,13 @@ func cliActionDownload(_ *cli.Context) error {
 	}
 	log.Printf("saved ssz-encoded state to %s", statePath)
 
+	if snapshot != nil {
+		snapshotPath, err := beacon.SaveDepositSnapshot(cwd, snapshot)
+		if err != nil {
+			return err
+		}
+		log.Printf("saved ssz-encoded deposit snapshot to %s", snapshotPath)
+	}
+
 	return nil
 }
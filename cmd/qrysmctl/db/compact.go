@@ -0,0 +1,105 @@
+package db
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/theQRL/qrysm/v4/beacon-chain/db/kv"
+	"github.com/theQRL/qrysm/v4/io/boltcompact"
+	"github.com/theQRL/qrysm/v4/io/file"
+	validatorkv "github.com/theQRL/qrysm/v4/validator/db/kv"
+	"github.com/urfave/cli/v2"
+)
+
+var compactFlags = struct {
+	Path      string
+	OutputDir string
+	BackupDir string
+	NoBackup  bool
+}{}
+
+var compactCmd = &cli.Command{
+	Name:  "compact",
+	Usage: "copy the live data of a stopped beacon or validator db into a new file to give back the disk space freed by deletions",
+	Action: func(cliCtx *cli.Context) error {
+		if err := compactAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not compact db")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to directory containing beaconchain.db or validator.db",
+			Destination: &compactFlags.Path,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "output-dir",
+			Usage:       "directory to write the compacted db to, leaving the original db untouched. The db is compacted in place when unset",
+			Destination: &compactFlags.OutputDir,
+		},
+		&cli.StringFlag{
+			Name:        "backup-dir",
+			Usage:       "directory to back up the original db to before compacting it in place (default: <path>/backups)",
+			Destination: &compactFlags.BackupDir,
+		},
+		&cli.BoolFlag{
+			Name:        "no-backup",
+			Usage:       "do not back up the original db before compacting it in place",
+			Destination: &compactFlags.NoBackup,
+		},
+	},
+}
+
+func compactAction(cliCtx *cli.Context) error {
+	f := compactFlags
+	dir, err := file.ExpandPath(f.Path)
+	if err != nil {
+		return err
+	}
+
+	var (
+		datafile   string
+		backupsDir string
+		compact    func(ctx context.Context, dirPath string, cfg *boltcompact.Config) (*boltcompact.Result, error)
+	)
+	switch {
+	case file.FileExists(kv.KVStoreDatafilePath(dir)):
+		datafile, backupsDir, compact = kv.DatabaseFileName, kv.BackupsDir(dir), kv.CompactDatabase
+	case file.FileExists(filepath.Join(dir, validatorkv.ProtectionDbFileName)):
+		datafile, backupsDir, compact = validatorkv.ProtectionDbFileName, validatorkv.BackupsDir(dir), validatorkv.CompactDatabase
+	default:
+		return errors.Errorf("no %s or %s found in %s", kv.DatabaseFileName, validatorkv.ProtectionDbFileName, dir)
+	}
+
+	cfg := &boltcompact.Config{}
+	if f.OutputDir != "" {
+		outputDir, err := file.ExpandPath(f.OutputDir)
+		if err != nil {
+			return err
+		}
+		cfg.OutputPath = filepath.Join(outputDir, datafile)
+	} else if !f.NoBackup {
+		cfg.BackupDir = backupsDir
+		if f.BackupDir != "" {
+			cfg.BackupDir = f.BackupDir
+		}
+	}
+	res, err := compact(cliCtx.Context, dir, cfg)
+	if err != nil {
+		return err
+	}
+	fields := log.Fields{
+		"path":       res.Path,
+		"sizeBefore": res.SizeBefore,
+		"sizeAfter":  res.SizeAfter,
+	}
+	if res.BackupPath != "" {
+		fields["backup"] = res.BackupPath
+	}
+	log.WithFields(fields).Info("Database compaction complete")
+	return nil
+}
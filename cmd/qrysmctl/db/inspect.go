@@ -0,0 +1,123 @@
+package db
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/theQRL/go-zond/common/hexutil"
+	"github.com/theQRL/qrysm/v4/beacon-chain/db/kv"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/io/file"
+	"github.com/urfave/cli/v2"
+)
+
+var inspectFlags = struct {
+	Path      string
+	Bucket    string
+	StartSlot uint64
+	EndSlot   uint64
+	Root      string
+	Limit     uint64
+}{}
+
+var inspectCmd = &cli.Command{
+	Name:  "inspect",
+	Usage: "decode the records of a bucket of a stopped beacon db into JSON, one record per line",
+	Action: func(cliCtx *cli.Context) error {
+		if err := inspectAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not inspect db")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to directory containing beaconchain.db",
+			Destination: &inspectFlags.Path,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "bucket",
+			Usage:       "bucket to decode, one of: blocks, state, state-summary, check-point, chain-metadata, powchain, blobs, block-slot-indices, state-slot-indices, finalized-block-roots-index",
+			Destination: &inspectFlags.Bucket,
+			Required:    true,
+		},
+		&cli.Uint64Flag{
+			Name:        "start-slot",
+			Usage:       "lowest slot of the records to decode, for records which have a slot",
+			Destination: &inspectFlags.StartSlot,
+		},
+		&cli.Uint64Flag{
+			Name:        "end-slot",
+			Usage:       "highest slot of the records to decode, for records which have a slot (default: no upper bound)",
+			Destination: &inspectFlags.EndSlot,
+		},
+		&cli.StringFlag{
+			Name:        "root",
+			Usage:       "hex encoded block root of the records to decode, for records which refer to a block",
+			Destination: &inspectFlags.Root,
+		},
+		&cli.Uint64Flag{
+			Name:        "limit",
+			Usage:       "maximum number of records to decode (default: no limit)",
+			Destination: &inspectFlags.Limit,
+		},
+	},
+}
+
+// inspectRecord is the JSON representation of a decoded record.
+type inspectRecord struct {
+	Key   string      `json:"key"`
+	Root  string      `json:"root,omitempty"`
+	Slot  string      `json:"slot,omitempty"`
+	Value interface{} `json:"value"`
+}
+
+func inspectAction(cliCtx *cli.Context) error {
+	f := inspectFlags
+	if f.EndSlot != 0 && f.EndSlot < f.StartSlot {
+		return errors.New("end slot must not be lower than start slot")
+	}
+	filter := &kv.InspectFilter{
+		StartSlot: primitives.Slot(f.StartSlot),
+		EndSlot:   primitives.Slot(f.EndSlot),
+		Limit:     f.Limit,
+	}
+	if f.Root != "" {
+		root, err := hexutil.Decode(f.Root)
+		if err != nil {
+			return errors.Wrap(err, "could not decode root")
+		}
+		filter.Root = root
+	}
+	dir, err := file.ExpandPath(f.Path)
+	if err != nil {
+		return err
+	}
+	store, err := kv.NewReadOnlyKVStore(cliCtx.Context, dir)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := store.Close(); err != nil {
+			log.WithError(err).Error("Could not close db")
+		}
+	}()
+
+	enc := json.NewEncoder(os.Stdout)
+	return store.Inspect(cliCtx.Context, f.Bucket, filter, func(r *kv.InspectRecord) error {
+		out := &inspectRecord{
+			Key:   hexutil.Encode(r.Key),
+			Value: toJSON(r.Value),
+		}
+		if r.Root != nil {
+			out.Root = hexutil.Encode(r.Root)
+		}
+		if r.Slot != nil {
+			out.Slot = toJSON(*r.Slot).(string)
+		}
+		return enc.Encode(out)
+	})
+}
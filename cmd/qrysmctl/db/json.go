@@ -0,0 +1,131 @@
+package db
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/theQRL/go-zond/common/hexutil"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// jsonField is a field of a jsonObject.
+type jsonField struct {
+	name  string
+	value interface{}
+}
+
+// jsonObject is a JSON object which keeps the order of its fields, so that decoded records read like their
+// proto definitions.
+type jsonObject []jsonField
+
+// MarshalJSON --
+func (o jsonObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, f := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(f.name)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		value, err := json.Marshal(f.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// toJSON converts a decoded database value into a value that marshals into JSON following the conventions of
+// the beacon API: byte arrays are hex encoded and integers are quoted.
+func toJSON(v interface{}) interface{} {
+	switch t := v.(type) {
+	case nil:
+		return nil
+	case proto.Message:
+		return messageJSON(t.ProtoReflect())
+	case []byte:
+		return hexutil.Encode(t)
+	case [][]byte:
+		out := make([]string, len(t))
+		for i := range t {
+			out[i] = hexutil.Encode(t[i])
+		}
+		return out
+	case primitives.Slot:
+		return strconv.FormatUint(uint64(t), 10)
+	case primitives.Epoch:
+		return strconv.FormatUint(uint64(t), 10)
+	default:
+		return t
+	}
+}
+
+func messageJSON(m protoreflect.Message) jsonObject {
+	fields := m.Descriptor().Fields()
+	obj := make(jsonObject, 0, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.ContainingOneof() != nil && !m.Has(fd) {
+			continue
+		}
+		obj = append(obj, jsonField{name: fd.TextName(), value: fieldJSON(fd, m.Get(fd))})
+	}
+	return obj
+}
+
+func fieldJSON(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch {
+	case fd.IsList():
+		l := v.List()
+		out := make([]interface{}, l.Len())
+		for i := 0; i < l.Len(); i++ {
+			out[i] = singularJSON(fd, l.Get(i))
+		}
+		return out
+	case fd.IsMap():
+		out := make(map[string]interface{})
+		v.Map().Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+			out[k.String()] = singularJSON(fd.MapValue(), mv)
+			return true
+		})
+		return out
+	default:
+		return singularJSON(fd, v)
+	}
+}
+
+func singularJSON(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return messageJSON(v.Message())
+	case protoreflect.BytesKind:
+		return hexutil.Encode(v.Bytes())
+	case protoreflect.BoolKind:
+		return v.Bool()
+	case protoreflect.StringKind:
+		return v.String()
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return strconv.Itoa(int(v.Enum()))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return strconv.FormatUint(v.Uint(), 10)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return strconv.FormatInt(v.Int(), 10)
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
@@ -0,0 +1,87 @@
+package db
+
+import (
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/theQRL/qrysm/v4/beacon-chain/db/slasherkv"
+	"github.com/theQRL/qrysm/v4/beacon-chain/slasher"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/io/file"
+	"github.com/urfave/cli/v2"
+)
+
+var migrateSlasherFlags = struct {
+	Path               string
+	ChunkSize          uint64
+	ValidatorChunkSize uint64
+	HistoryLength      uint64
+}{}
+
+var migrateSlasherCmd = &cli.Command{
+	Name:  "migrate-slasher",
+	Usage: "rewrite the min and max span chunks of a stopped slasher db with new chunk parameters",
+	Action: func(cliCtx *cli.Context) error {
+		if err := migrateSlasherAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not migrate slasher db")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to directory containing slasher.db",
+			Destination: &migrateSlasherFlags.Path,
+			Required:    true,
+		},
+		&cli.Uint64Flag{
+			Name:        "chunk-size",
+			Usage:       "number of epochs of a validator min or max span stored in a chunk, as in --slasher-chunk-size",
+			Destination: &migrateSlasherFlags.ChunkSize,
+			Value:       16,
+		},
+		&cli.Uint64Flag{
+			Name:        "validator-chunk-size",
+			Usage:       "number of validators whose min or max spans are stored in a chunk, as in --slasher-validator-chunk-size",
+			Destination: &migrateSlasherFlags.ValidatorChunkSize,
+			Value:       256,
+		},
+		&cli.Uint64Flag{
+			Name:        "history-length",
+			Usage:       "number of epochs of attesting history kept for every validator, as in --slasher-history-length",
+			Destination: &migrateSlasherFlags.HistoryLength,
+			Value:       4096,
+		},
+	},
+}
+
+func migrateSlasherAction(cliCtx *cli.Context) error {
+	f := migrateSlasherFlags
+	dir, err := file.ExpandPath(f.Path)
+	if err != nil {
+		return err
+	}
+	if !file.FileExists(filepath.Join(dir, slasherkv.DatabaseFileName)) {
+		return errors.Errorf("no %s found in %s", slasherkv.DatabaseFileName, dir)
+	}
+	p, err := slasher.NewParams(f.ChunkSize, f.ValidatorChunkSize, primitives.Epoch(f.HistoryLength))
+	if err != nil {
+		return err
+	}
+	ctx := cliCtx.Context
+	d, err := slasherkv.NewKVStore(ctx, dir)
+	if err != nil {
+		return errors.Wrap(err, "could not open slasher db")
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close slasher db")
+		}
+	}()
+	if err := slasher.MigrateChunks(ctx, d, p); err != nil {
+		return err
+	}
+	log.WithField("params", p).Info("Slasher db migration complete")
+	return nil
+}
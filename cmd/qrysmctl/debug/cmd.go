@@ -0,0 +1,13 @@
+package debug
+
+import "github.com/urfave/cli/v2"
+
+var Commands = []*cli.Command{
+	{
+		Name:  "debug",
+		Usage: "commands for inspecting a running beacon node",
+		Subcommands: []*cli.Command{
+			forkChoiceCmd,
+		},
+	},
+}
@@ -0,0 +1,160 @@
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/theQRL/qrysm/v4/api/client"
+	"github.com/theQRL/qrysm/v4/api/client/beacon"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/prysm/debug"
+	"github.com/theQRL/qrysm/v4/io/file"
+	"github.com/urfave/cli/v2"
+)
+
+var forkChoiceFlags = struct {
+	BeaconNodeHost string
+	Timeout        time.Duration
+	Format         string
+	Output         string
+}{}
+
+var forkChoiceCmd = &cli.Command{
+	Name:    "fork-choice",
+	Aliases: []string{"fc"},
+	Usage:   "Render the live fork choice tree of a beacon node, to diagnose reorgs. Requires the beacon node to run with --enable-debug-rpc-endpoints.",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionForkChoice(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not render fork choice tree")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "beacon-node-host",
+			Usage:       "host:port for beacon node to query",
+			Destination: &forkChoiceFlags.BeaconNodeHost,
+			Value:       "http://localhost:3500",
+		},
+		&cli.DurationFlag{
+			Name:        "http-timeout",
+			Usage:       "timeout for http requests made to beacon-node-url (uses duration format, ex: 2m31s). default: 2m",
+			Destination: &forkChoiceFlags.Timeout,
+			Value:       time.Minute * 2,
+		},
+		&cli.StringFlag{
+			Name:        "format",
+			Usage:       "output format: dot (Graphviz, render with `dot -Tsvg`), json or tree (indented text)",
+			Destination: &forkChoiceFlags.Format,
+			Value:       "dot",
+		},
+		&cli.StringFlag{
+			Name:        "output",
+			Usage:       "file to write the fork choice tree to, instead of stdout",
+			Destination: &forkChoiceFlags.Output,
+		},
+	},
+}
+
+func cliActionForkChoice(_ *cli.Context) error {
+	ctx := context.Background()
+	f := forkChoiceFlags
+
+	opts := []client.ClientOpt{client.WithTimeout(f.Timeout)}
+	client, err := beacon.NewClient(f.BeaconNodeHost, opts...)
+	if err != nil {
+		return err
+	}
+
+	var out []byte
+	switch f.Format {
+	case "dot":
+		out, err = client.GetForkChoiceGraphDOT(ctx)
+		if err != nil {
+			return err
+		}
+	case "json":
+		graph, err := client.GetForkChoiceGraph(ctx)
+		if err != nil {
+			return err
+		}
+		out, err = json.MarshalIndent(graph, "", "  ")
+		if err != nil {
+			return errors.Wrap(err, "could not encode fork choice graph")
+		}
+		out = append(out, '\n')
+	case "tree":
+		graph, err := client.GetForkChoiceGraph(ctx)
+		if err != nil {
+			return err
+		}
+		b := &strings.Builder{}
+		writeTree(b, graph)
+		out = []byte(b.String())
+	default:
+		return fmt.Errorf("unknown format %s, must be one of dot, json or tree", f.Format)
+	}
+
+	if f.Output == "" {
+		_, err := os.Stdout.Write(out)
+		return err
+	}
+	if err := file.WriteFile(f.Output, out); err != nil {
+		return errors.Wrapf(err, "could not write fork choice tree to %s", f.Output)
+	}
+	log.Printf("Fork choice tree written to %s", f.Output)
+	return nil
+}
+
+// writeTree writes the fork choice graph as an indented tree, one block per line under its parent.
+func writeTree(w io.Writer, graph *debug.ForkChoiceGraph) {
+	fmt.Fprintf(w, "justified: %s/%s finalized: %s/%s head: %s\n",
+		graph.JustifiedCheckpoint.Epoch, debug.ShortRoot(graph.JustifiedCheckpoint.Root),
+		graph.FinalizedCheckpoint.Epoch, debug.ShortRoot(graph.FinalizedCheckpoint.Root),
+		debug.ShortRoot(graph.HeadRoot))
+	children := make(map[string][]*debug.ForkChoiceGraphNode)
+	known := make(map[string]bool, len(graph.Nodes))
+	for _, n := range graph.Nodes {
+		known[n.BlockRoot] = true
+	}
+	var roots []*debug.ForkChoiceGraphNode
+	for _, n := range graph.Nodes {
+		if known[n.ParentRoot] {
+			children[n.ParentRoot] = append(children[n.ParentRoot], n)
+		} else {
+			roots = append(roots, n)
+		}
+	}
+	var write func(n *debug.ForkChoiceGraphNode, depth int)
+	write = func(n *debug.ForkChoiceGraphNode, depth int) {
+		var notes []string
+		if n.Head {
+			notes = append(notes, "head")
+		}
+		if n.ProposerBoost {
+			notes = append(notes, "proposer boost")
+		}
+		if n.PreviousProposerBoost {
+			notes = append(notes, "previous proposer boost")
+		}
+		fmt.Fprintf(w, "%sslot %s %s weight=%s justified=%s finalized=%s %s",
+			strings.Repeat("  ", depth), n.Slot, debug.ShortRoot(n.BlockRoot), n.Weight,
+			n.JustifiedEpoch, n.FinalizedEpoch, n.Validity)
+		if len(notes) > 0 {
+			fmt.Fprintf(w, " [%s]", strings.Join(notes, ", "))
+		}
+		fmt.Fprintln(w)
+		for _, c := range children[n.BlockRoot] {
+			write(c, depth+1)
+		}
+	}
+	for _, r := range roots {
+		write(r, 0)
+	}
+}
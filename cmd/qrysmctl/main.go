@@ -6,6 +6,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/theQRL/qrysm/v4/cmd/qrysmctl/checkpointsync"
 	"github.com/theQRL/qrysm/v4/cmd/qrysmctl/db"
+	"github.com/theQRL/qrysm/v4/cmd/qrysmctl/debug"
 	"github.com/theQRL/qrysm/v4/cmd/qrysmctl/deprecated"
 	"github.com/theQRL/qrysm/v4/cmd/qrysmctl/p2p"
 	"github.com/theQRL/qrysm/v4/cmd/qrysmctl/testnet"
@@ -33,6 +34,7 @@ func init() {
 
 	qrysmctlCommands = append(qrysmctlCommands, checkpointsync.Commands...)
 	qrysmctlCommands = append(qrysmctlCommands, db.Commands...)
+	qrysmctlCommands = append(qrysmctlCommands, debug.Commands...)
 	qrysmctlCommands = append(qrysmctlCommands, p2p.Commands...)
 	qrysmctlCommands = append(qrysmctlCommands, testnet.Commands...)
 	qrysmctlCommands = append(qrysmctlCommands, weaksubjectivity.Commands...)
@@ -70,8 +70,53 @@ var (
 		Aliases: []string{"t"},
 		Usage:   "keymanager API bearer token, note: currently required but may be removed in the future, this is the same token as the web ui token.",
 	}
+
+	AuthTokenFileFlag = &cli.StringFlag{
+		Name:  "auth-token-file",
+		Usage: "path to the auth-token file of the validator client to read the keymanager API bearer token from when --token is not set, defaults to the auth-token file of --wallet-dir",
+	}
+
+	PublicKeysFlag = &cli.StringSliceFlag{
+		Name:    "public-keys",
+		Aliases: []string{"pubkeys"},
+		Usage:   "comma-separated list of the hex encoded public keys of the validators to act on",
+	}
+
+	FeeRecipientFlag = &cli.StringFlag{
+		Name:    "fee-recipient",
+		Aliases: []string{"fr"},
+		Usage:   "zond address in hex format to receive the transaction fees of the blocks proposed by the validators",
+	}
+
+	GasLimitFlag = &cli.Uint64Flag{
+		Name:  "gas-limit",
+		Usage: "gas limit of the blocks the validators build with a builder",
+	}
 )
 
+// keymanagerFlags are the flags of the commands calling the keymanager API of a validator client.
+var keymanagerFlags = []cli.Flag{
+	cmd.ConfigFileFlag,
+	ValidatorHostFlag,
+	TokenFlag,
+	AuthTokenFileFlag,
+	flags.WalletDirFlag,
+}
+
+func loadKeymanagerFlags(cliCtx *cli.Context) error {
+	return cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags)
+}
+
+// keymanagerAction wraps an action of a keymanager command, exiting with the message on error.
+func keymanagerAction(action func(*cli.Context) error, msg string) cli.ActionFunc {
+	return func(cliCtx *cli.Context) error {
+		if err := action(cliCtx); err != nil {
+			log.WithError(err).Fatal(msg)
+		}
+		return nil
+	}
+}
+
 var Commands = []*cli.Command{
 	{
 		Name:    "validator",
@@ -144,6 +189,96 @@ var Commands = []*cli.Command{
 					return nil
 				},
 			},
+			{
+				Name:  "keys",
+				Usage: "List, import or delete the keys of a validator client through its keymanager API.",
+				Subcommands: []*cli.Command{
+					{
+						Name:   "list",
+						Usage:  "Lists the local and remote keys of the validator client.",
+						Flags:  keymanagerFlags,
+						Before: loadKeymanagerFlags,
+						Action: keymanagerAction(listKeys, "Could not list keys"),
+					},
+					{
+						Name:  "import",
+						Usage: "Imports the keystores of a directory, or a single keystore, into the validator client.",
+						Flags: append([]cli.Flag{
+							flags.KeysDirFlag,
+							flags.AccountPasswordFileFlag,
+							flags.SlashingProtectionJSONFileFlag,
+						}, keymanagerFlags...),
+						Before: loadKeymanagerFlags,
+						Action: keymanagerAction(func(cliCtx *cli.Context) error {
+							return importKeys(cliCtx, os.Stdin)
+						}, "Could not import keys"),
+					},
+					{
+						Name:  "delete",
+						Usage: "Deletes local keys from the validator client, exporting their slashing protection history.",
+						Flags: append([]cli.Flag{
+							PublicKeysFlag,
+							flags.SlashingProtectionExportDirFlag,
+						}, keymanagerFlags...),
+						Before: loadKeymanagerFlags,
+						Action: keymanagerAction(deleteKeys, "Could not delete keys"),
+					},
+				},
+			},
+			{
+				Name:  "fee-recipient",
+				Usage: "Display, set or reset the fee recipients of validators through the keymanager API of a validator client.",
+				Subcommands: []*cli.Command{
+					{
+						Name:   "get",
+						Usage:  "Displays the fee recipients of the validators, or of all the validators when no public keys are given.",
+						Flags:  append([]cli.Flag{PublicKeysFlag}, keymanagerFlags...),
+						Before: loadKeymanagerFlags,
+						Action: keymanagerAction(getFeeRecipients, "Could not get fee recipients"),
+					},
+					{
+						Name:   "set",
+						Usage:  "Sets the fee recipient of the validators.",
+						Flags:  append([]cli.Flag{PublicKeysFlag, FeeRecipientFlag}, keymanagerFlags...),
+						Before: loadKeymanagerFlags,
+						Action: keymanagerAction(setFeeRecipients, "Could not set fee recipients"),
+					},
+					{
+						Name:   "delete",
+						Usage:  "Resets the fee recipient of the validators to the default of the validator client.",
+						Flags:  append([]cli.Flag{PublicKeysFlag}, keymanagerFlags...),
+						Before: loadKeymanagerFlags,
+						Action: keymanagerAction(deleteFeeRecipients, "Could not delete fee recipients"),
+					},
+				},
+			},
+			{
+				Name:  "gas-limit",
+				Usage: "Display, set or reset the builder gas limits of validators through the keymanager API of a validator client.",
+				Subcommands: []*cli.Command{
+					{
+						Name:   "get",
+						Usage:  "Displays the gas limits of the validators, or of all the validators when no public keys are given.",
+						Flags:  append([]cli.Flag{PublicKeysFlag}, keymanagerFlags...),
+						Before: loadKeymanagerFlags,
+						Action: keymanagerAction(getGasLimits, "Could not get gas limits"),
+					},
+					{
+						Name:   "set",
+						Usage:  "Sets the gas limit of the validators.",
+						Flags:  append([]cli.Flag{PublicKeysFlag, GasLimitFlag}, keymanagerFlags...),
+						Before: loadKeymanagerFlags,
+						Action: keymanagerAction(setGasLimits, "Could not set gas limits"),
+					},
+					{
+						Name:   "delete",
+						Usage:  "Resets the gas limit of the validators to the default of the validator client.",
+						Flags:  append([]cli.Flag{PublicKeysFlag}, keymanagerFlags...),
+						Before: loadKeymanagerFlags,
+						Action: keymanagerAction(deleteGasLimits, "Could not delete gas limits"),
+					},
+				},
+			},
 			{
 				Name:    "exit",
 				Aliases: []string{"e", "voluntary-exit"},
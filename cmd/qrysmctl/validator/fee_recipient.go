@@ -0,0 +1,78 @@
+package validator
+
+import (
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	"go.opencensus.io/trace"
+)
+
+func getFeeRecipients(c *cli.Context) error {
+	ctx, span := trace.StartSpan(c.Context, "qrysmctl.getFeeRecipients")
+	defer span.End()
+	cl, err := newKeymanagerClient(c)
+	if err != nil {
+		return err
+	}
+	pubkeys := c.StringSlice(PublicKeysFlag.Name)
+	if len(pubkeys) == 0 {
+		pubkeys, err = cl.GetValidatorPubKeys(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	feeRecipients, err := cl.GetFeeRecipientAddresses(ctx, pubkeys)
+	if err != nil {
+		return err
+	}
+	for i, pubkey := range pubkeys {
+		log.Infof("Validator: %s. Fee-recipient: %s", pubkey, feeRecipients[i])
+	}
+	return nil
+}
+
+func setFeeRecipients(c *cli.Context) error {
+	ctx, span := trace.StartSpan(c.Context, "qrysmctl.setFeeRecipients")
+	defer span.End()
+	pubkeys, err := publicKeys(c)
+	if err != nil {
+		return err
+	}
+	if !c.IsSet(FeeRecipientFlag.Name) {
+		return errNoFlag(FeeRecipientFlag.Name)
+	}
+	recipient := c.String(FeeRecipientFlag.Name)
+	if err := validateIsExecutionAddress(recipient); err != nil {
+		return err
+	}
+	cl, err := newKeymanagerClient(c)
+	if err != nil {
+		return err
+	}
+	for _, pubkey := range pubkeys {
+		if err := cl.SetFeeRecipientAddress(ctx, pubkey, recipient); err != nil {
+			return err
+		}
+		log.Infof("Validator: %s. Fee-recipient set to %s", pubkey, recipient)
+	}
+	return nil
+}
+
+func deleteFeeRecipients(c *cli.Context) error {
+	ctx, span := trace.StartSpan(c.Context, "qrysmctl.deleteFeeRecipients")
+	defer span.End()
+	pubkeys, err := publicKeys(c)
+	if err != nil {
+		return err
+	}
+	cl, err := newKeymanagerClient(c)
+	if err != nil {
+		return err
+	}
+	for _, pubkey := range pubkeys {
+		if err := cl.DeleteFeeRecipientAddress(ctx, pubkey); err != nil {
+			return err
+		}
+		log.Infof("Validator: %s. Fee-recipient reset to the default", pubkey)
+	}
+	return nil
+}
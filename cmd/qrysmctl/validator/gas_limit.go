@@ -0,0 +1,75 @@
+package validator
+
+import (
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	"go.opencensus.io/trace"
+)
+
+func getGasLimits(c *cli.Context) error {
+	ctx, span := trace.StartSpan(c.Context, "qrysmctl.getGasLimits")
+	defer span.End()
+	cl, err := newKeymanagerClient(c)
+	if err != nil {
+		return err
+	}
+	pubkeys := c.StringSlice(PublicKeysFlag.Name)
+	if len(pubkeys) == 0 {
+		pubkeys, err = cl.GetValidatorPubKeys(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	for _, pubkey := range pubkeys {
+		gasLimit, err := cl.GetGasLimit(ctx, pubkey)
+		if err != nil {
+			return err
+		}
+		log.Infof("Validator: %s. Gas limit: %d", pubkey, gasLimit)
+	}
+	return nil
+}
+
+func setGasLimits(c *cli.Context) error {
+	ctx, span := trace.StartSpan(c.Context, "qrysmctl.setGasLimits")
+	defer span.End()
+	pubkeys, err := publicKeys(c)
+	if err != nil {
+		return err
+	}
+	if !c.IsSet(GasLimitFlag.Name) {
+		return errNoFlag(GasLimitFlag.Name)
+	}
+	gasLimit := c.Uint64(GasLimitFlag.Name)
+	cl, err := newKeymanagerClient(c)
+	if err != nil {
+		return err
+	}
+	for _, pubkey := range pubkeys {
+		if err := cl.SetGasLimit(ctx, pubkey, gasLimit); err != nil {
+			return err
+		}
+		log.Infof("Validator: %s. Gas limit set to %d", pubkey, gasLimit)
+	}
+	return nil
+}
+
+func deleteGasLimits(c *cli.Context) error {
+	ctx, span := trace.StartSpan(c.Context, "qrysmctl.deleteGasLimits")
+	defer span.End()
+	pubkeys, err := publicKeys(c)
+	if err != nil {
+		return err
+	}
+	cl, err := newKeymanagerClient(c)
+	if err != nil {
+		return err
+	}
+	for _, pubkey := range pubkeys {
+		if err := cl.DeleteGasLimit(ctx, pubkey); err != nil {
+			return err
+		}
+		log.Infof("Validator: %s. Gas limit reset to the default", pubkey)
+	}
+	return nil
+}
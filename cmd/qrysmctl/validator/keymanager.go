@@ -0,0 +1,39 @@
+package validator
+
+import (
+	"github.com/theQRL/qrysm/v4/api/client"
+	"github.com/theQRL/qrysm/v4/api/client/validator"
+	"github.com/theQRL/qrysm/v4/cmd/validator/flags"
+	"github.com/urfave/cli/v2"
+)
+
+// newKeymanagerClient creates a keymanager API client for the validator client at the host of the validator host
+// flag. The bearer token is the value of the token flag if it is set, or is read from the auth token file of the
+// auth token file flag or, failing that, of the wallet directory.
+func newKeymanagerClient(c *cli.Context) (*validator.Client, error) {
+	if !c.IsSet(ValidatorHostFlag.Name) {
+		return nil, errNoFlag(ValidatorHostFlag.Name)
+	}
+	token := c.String(TokenFlag.Name)
+	if token == "" {
+		var err error
+		if c.IsSet(AuthTokenFileFlag.Name) {
+			token, err = validator.AuthTokenFromFile(c.String(AuthTokenFileFlag.Name))
+		} else {
+			token, err = validator.AuthTokenFromWalletDir(c.String(flags.WalletDirFlag.Name))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return validator.NewClient(c.String(ValidatorHostFlag.Name), client.WithAuthenticationToken(token))
+}
+
+// publicKeys returns the public keys of the public keys flag, failing when there are none.
+func publicKeys(c *cli.Context) ([]string, error) {
+	pubkeys := c.StringSlice(PublicKeysFlag.Name)
+	if len(pubkeys) == 0 {
+		return nil, errNoFlag(PublicKeysFlag.Name)
+	}
+	return pubkeys, nil
+}
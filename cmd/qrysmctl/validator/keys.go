@@ -0,0 +1,167 @@
+package validator
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/theQRL/qrysm/v4/cmd/validator/flags"
+	"github.com/theQRL/qrysm/v4/io/file"
+	"github.com/theQRL/qrysm/v4/io/prompt"
+	"github.com/urfave/cli/v2"
+	"go.opencensus.io/trace"
+)
+
+const slashingProtectionExportFileName = "slashing_protection.json"
+
+func listKeys(c *cli.Context) error {
+	ctx, span := trace.StartSpan(c.Context, "qrysmctl.listKeys")
+	defer span.End()
+	cl, err := newKeymanagerClient(c)
+	if err != nil {
+		return err
+	}
+	local, err := cl.GetLocalValidatorKeys(ctx)
+	if err != nil {
+		return err
+	}
+	remote, err := cl.GetRemoteValidatorKeys(ctx)
+	if err != nil {
+		return err
+	}
+	log.Infof("===============DISPLAYING %d LOCAL AND %d REMOTE KEYS===============", len(local.Keystores), len(remote.Keystores))
+	for _, k := range local.Keystores {
+		log.Infof("Local key: %s. Derivation path: %s", k.ValidatingPubkey, k.DerivationPath)
+	}
+	for _, k := range remote.Keystores {
+		log.Infof("Remote key: %s. Web3Signer URL: %s", k.Pubkey, k.Url)
+	}
+	return nil
+}
+
+func importKeys(c *cli.Context, r io.Reader) error {
+	ctx, span := trace.StartSpan(c.Context, "qrysmctl.importKeys")
+	defer span.End()
+	if !c.IsSet(flags.KeysDirFlag.Name) {
+		return errNoFlag(flags.KeysDirFlag.Name)
+	}
+	keystores, err := readKeystores(c.String(flags.KeysDirFlag.Name))
+	if err != nil {
+		return err
+	}
+	var password string
+	if c.IsSet(flags.AccountPasswordFileFlag.Name) {
+		b, err := file.ReadFileAsBytes(c.String(flags.AccountPasswordFileFlag.Name))
+		if err != nil {
+			return errors.Wrap(err, "could not read password file")
+		}
+		password = strings.TrimSpace(string(b))
+	} else {
+		password, err = prompt.ValidatePrompt(r, "Enter the password of the keystores to import", prompt.NotEmpty)
+		if err != nil {
+			return err
+		}
+	}
+	passwords := make([]string, len(keystores))
+	for i := range passwords {
+		passwords[i] = password
+	}
+	var slashingProtection string
+	if c.IsSet(flags.SlashingProtectionJSONFileFlag.Name) {
+		b, err := file.ReadFileAsBytes(c.String(flags.SlashingProtectionJSONFileFlag.Name))
+		if err != nil {
+			return errors.Wrap(err, "could not read slashing protection file")
+		}
+		slashingProtection = string(b)
+	}
+
+	cl, err := newKeymanagerClient(c)
+	if err != nil {
+		return err
+	}
+	statuses, err := cl.ImportKeystores(ctx, keystores, passwords, slashingProtection)
+	if err != nil {
+		return err
+	}
+	for i, s := range statuses {
+		log.Infof("Keystore %d: %s %s", i, s.Status, s.Message)
+	}
+	return nil
+}
+
+func deleteKeys(c *cli.Context) error {
+	ctx, span := trace.StartSpan(c.Context, "qrysmctl.deleteKeys")
+	defer span.End()
+	pubkeys, err := publicKeys(c)
+	if err != nil {
+		return err
+	}
+	cl, err := newKeymanagerClient(c)
+	if err != nil {
+		return err
+	}
+	resp, err := cl.DeleteKeystores(ctx, pubkeys)
+	if err != nil {
+		return err
+	}
+	for i, s := range resp.Statuses {
+		log.Infof("Validator: %s. %s %s", pubkeys[i], s.Status, s.Message)
+	}
+	if c.IsSet(flags.SlashingProtectionExportDirFlag.Name) {
+		p := filepath.Join(c.String(flags.SlashingProtectionExportDirFlag.Name), slashingProtectionExportFileName)
+		if err := file.WriteFile(p, []byte(resp.SlashingProtection)); err != nil {
+			return err
+		}
+		log.Infof("Slashing protection history of the deleted keys saved to %s", p)
+	} else {
+		log.Warnf("The slashing protection history of the deleted keys was not saved, it can be with the `--%s` flag", flags.SlashingProtectionExportDirFlag.Name)
+	}
+	return nil
+}
+
+// readKeystores reads the keystore at the path, or the keystores of the directory at the path sorted by file name.
+// Files of the directory that are not JSON are skipped.
+func readKeystores(path string) ([]string, error) {
+	isDir, err := file.HasDir(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not determine if path is a directory")
+	}
+	if !isDir {
+		b, err := file.ReadFileAsBytes(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read keystore")
+		}
+		return []string{string(b)}, nil
+	}
+	files, err := os.ReadDir(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read dir")
+	}
+	names := make([]string, 0, len(files))
+	for _, f := range files {
+		if !f.IsDir() {
+			names = append(names, f.Name())
+		}
+	}
+	sort.Strings(names)
+	keystores := make([]string, 0, len(names))
+	for _, name := range names {
+		b, err := file.ReadFileAsBytes(filepath.Join(path, name))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read keystore at path: %s", name)
+		}
+		if !json.Valid(b) {
+			continue
+		}
+		keystores = append(keystores, string(b))
+	}
+	if len(keystores) == 0 {
+		return nil, errors.Errorf("directory %s has no keystores, cannot import from it", path)
+	}
+	return keystores, nil
+}
@@ -0,0 +1,103 @@
+package validator
+
+import (
+	"encoding/json"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+	"github.com/theQRL/qrysm/v4/validator/rpc/apimiddleware"
+	"github.com/urfave/cli/v2"
+)
+
+func TestReadKeystores(t *testing.T) {
+	dir := t.TempDir()
+	_, err := readKeystores(dir)
+	require.ErrorContains(t, "has no keystores", err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "keystore-1.json"), []byte(`{"id":"1"}`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "keystore-0.json"), []byte(`{"id":"0"}`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "deposit_data.txt"), []byte("not json"), 0600))
+	keystores, err := readKeystores(dir)
+	require.NoError(t, err)
+	require.DeepEqual(t, []string{`{"id":"0"}`, `{"id":"1"}`}, keystores)
+
+	keystores, err = readKeystores(filepath.Join(dir, "keystore-1.json"))
+	require.NoError(t, err)
+	require.DeepEqual(t, []string{`{"id":"1"}`}, keystores)
+}
+
+func TestImportKeys(t *testing.T) {
+	dir := t.TempDir()
+	keysDir := filepath.Join(dir, "keys")
+	require.NoError(t, os.MkdirAll(keysDir, 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(keysDir, "keystore-0.json"), []byte(`{"id":"0"}`), 0600))
+	passwordFile := filepath.Join(dir, "password.txt")
+	require.NoError(t, os.WriteFile(passwordFile, []byte("password\n"), 0600))
+	// The token is read from the auth token file of the wallet directory when the token flag is not set.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "auth-token"), []byte("abcd\nmy.jwt.token\n"), 0600))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/zond/v1/keystores", r.URL.Path)
+		require.Equal(t, "Bearer my.jwt.token", r.Header.Get("Authorization"))
+		req := &apimiddleware.ImportKeystoresRequestJson{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+		require.DeepEqual(t, []string{`{"id":"0"}`}, req.Keystores)
+		require.DeepEqual(t, []string{"password"}, req.Passwords)
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(&apimiddleware.ImportKeystoresResponseJson{
+			Statuses: []*apimiddleware.StatusJson{{Status: "imported"}},
+		}))
+	}))
+	defer srv.Close()
+
+	hook := logtest.NewGlobal()
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String("validator-host", srv.URL, "")
+	set.String("wallet-dir", dir, "")
+	set.String("keys-dir", keysDir, "")
+	set.String("account-password-file", passwordFile, "")
+	assert.NoError(t, set.Set("validator-host", srv.URL))
+	assert.NoError(t, set.Set("keys-dir", keysDir))
+	assert.NoError(t, set.Set("account-password-file", passwordFile))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	require.NoError(t, importKeys(cliCtx, os.Stdin))
+	assert.LogsContain(t, hook, "Keystore 0: imported")
+}
+
+func TestSetGasLimits(t *testing.T) {
+	key := "0x855ae9c6184d6edd46351b375f16f541b2d33b0ed0da9be4571b13938588aee840ba606a946f0e8023ae3a4b2a43b4d4"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/zond/v1/validator/"+key+"/gas_limit", r.URL.Path)
+		require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		req := &apimiddleware.SetGasLimitRequestJson{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+		require.Equal(t, "25000000", req.GasLimit)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	hook := logtest.NewGlobal()
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String("validator-host", srv.URL, "")
+	set.String("token", "token", "")
+	set.Var(cli.NewStringSlice(key), "public-keys", "")
+	set.Uint64("gas-limit", 25000000, "")
+	assert.NoError(t, set.Set("validator-host", srv.URL))
+	assert.NoError(t, set.Set("gas-limit", "25000000"))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	require.NoError(t, setGasLimits(cliCtx))
+	assert.LogsContain(t, hook, "Gas limit set to 25000000")
+}
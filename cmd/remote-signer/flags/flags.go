@@ -0,0 +1,50 @@
+// Package flags contains all configuration runtime flags for
+// the remote signer.
+package flags
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	// HostFlag defines the host on which the remote signer listens.
+	HostFlag = &cli.StringFlag{
+		Name:  "host",
+		Usage: "Host on which the remote signer listens",
+		Value: "127.0.0.1",
+	}
+	// PortFlag defines the port on which the remote signer listens.
+	PortFlag = &cli.IntFlag{
+		Name:  "port",
+		Usage: "Port on which the remote signer listens",
+		Value: 9000,
+	}
+	// MonitoringPortFlag defines the port used to serve the prometheus metrics of the remote signer.
+	MonitoringPortFlag = &cli.IntFlag{
+		Name:  "monitoring-port",
+		Usage: "Port used to listening and respond metrics for prometheus.",
+		Value: 8083,
+	}
+	// TLSCertFlag defines the certificate used to serve the remote signer over TLS.
+	TLSCertFlag = &cli.StringFlag{
+		Name:  "tls-cert",
+		Usage: "Path to the certificate used to serve the remote signer over TLS",
+	}
+	// TLSKeyFlag defines the key of the certificate used to serve the remote signer over TLS.
+	TLSKeyFlag = &cli.StringFlag{
+		Name:  "tls-key",
+		Usage: "Path to the key of the certificate used to serve the remote signer over TLS",
+	}
+	// TLSClientCACertFlag requires clients to present a certificate signed by the given certificate authority.
+	TLSClientCACertFlag = &cli.StringFlag{
+		Name:  "tls-client-ca-cert",
+		Usage: "Path to a certificate authority. When set, clients must present a certificate signed by it (mutual TLS)",
+	}
+	// ClientAllowlistFileFlag defines the file listing the keys each client may sign with.
+	ClientAllowlistFileFlag = &cli.StringFlag{
+		Name: "client-allowlist-file",
+		Usage: "Path to a yaml file listing the public keys each client may sign with, clients being identified " +
+			"by the common name of their certificate. Requires --tls-client-ca-cert. eg: " +
+			"'clients: {validator-1: [0xabc...], validator-2: [0xdef...]}'",
+	}
+)
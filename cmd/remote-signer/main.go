@@ -0,0 +1,211 @@
+// Package main defines a remote signer, which signs the messages of validator clients with the keys of a
+// local keystore wallet, enforcing its own slashing protection.
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	runtimeDebug "runtime/debug"
+	"syscall"
+
+	joonix "github.com/joonix/log"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/theQRL/qrysm/v4/cmd"
+	"github.com/theQRL/qrysm/v4/cmd/remote-signer/flags"
+	validatorflags "github.com/theQRL/qrysm/v4/cmd/validator/flags"
+	"github.com/theQRL/qrysm/v4/io/logs"
+	"github.com/theQRL/qrysm/v4/monitoring/journald"
+	"github.com/theQRL/qrysm/v4/monitoring/prometheus"
+	"github.com/theQRL/qrysm/v4/runtime"
+	prefixed "github.com/theQRL/qrysm/v4/runtime/logging/logrus-prefixed-formatter"
+	"github.com/theQRL/qrysm/v4/runtime/version"
+	"github.com/theQRL/qrysm/v4/validator/accounts/wallet"
+	"github.com/theQRL/qrysm/v4/validator/db/kv"
+	"github.com/theQRL/qrysm/v4/validator/keymanager"
+	"github.com/theQRL/qrysm/v4/validator/keymanager/local"
+	remotesigner "github.com/theQRL/qrysm/v4/validator/remote-signer"
+	"github.com/urfave/cli/v2"
+)
+
+var appFlags = []cli.Flag{
+	cmd.VerbosityFlag,
+	cmd.LogFormat,
+	cmd.LogFileName,
+	cmd.ConfigFileFlag,
+	cmd.DataDirFlag,
+	cmd.MonitoringHostFlag,
+	cmd.DisableMonitoringFlag,
+	validatorflags.WalletDirFlag,
+	validatorflags.WalletPasswordFileFlag,
+	flags.HostFlag,
+	flags.PortFlag,
+	flags.MonitoringPortFlag,
+	flags.TLSCertFlag,
+	flags.TLSKeyFlag,
+	flags.TLSClientCACertFlag,
+	flags.ClientAllowlistFileFlag,
+}
+
+func init() {
+	appFlags = cmd.WrapFlags(appFlags)
+}
+
+func main() {
+	app := cli.App{}
+	app.Name = "remote-signer"
+	app.Usage = "signs the messages of validator clients with the keys of a local wallet, enforcing slashing protection"
+	app.Action = run
+	app.Version = version.Version()
+
+	app.Flags = appFlags
+
+	app.Before = func(ctx *cli.Context) error {
+		// Load flags from config file, if specified.
+		if err := cmd.LoadFlagsFromConfig(ctx, app.Flags); err != nil {
+			return err
+		}
+
+		verbosity := ctx.String(cmd.VerbosityFlag.Name)
+		level, err := logrus.ParseLevel(verbosity)
+		if err != nil {
+			return err
+		}
+		logrus.SetLevel(level)
+
+		format := ctx.String(cmd.LogFormat.Name)
+		switch format {
+		case "text":
+			formatter := new(prefixed.TextFormatter)
+			formatter.TimestampFormat = "2006-01-02 15:04:05"
+			formatter.FullTimestamp = true
+			// If persistent log files are written - we disable the log messages coloring because
+			// the colors are ANSI codes and seen as gibberish in the log files.
+			formatter.DisableColors = ctx.String(cmd.LogFileName.Name) != ""
+			logrus.SetFormatter(formatter)
+		case "fluentd":
+			f := joonix.NewFormatter()
+			if err := joonix.DisableTimestampFormat(f); err != nil {
+				panic(err)
+			}
+			logrus.SetFormatter(f)
+		case "json":
+			logrus.SetFormatter(&logrus.JSONFormatter{})
+		case "journald":
+			if err := journald.Enable(); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unknown log format %s", format)
+		}
+
+		logFileName := ctx.String(cmd.LogFileName.Name)
+		if logFileName != "" {
+			if err := logs.ConfigurePersistentLogging(logFileName); err != nil {
+				log.WithError(err).Error("Failed to configuring logging to disk.")
+			}
+		}
+		return cmd.ValidateNoArgs(ctx)
+	}
+
+	defer func() {
+		if x := recover(); x != nil {
+			log.Errorf("Runtime panic: %v\n%v", x, string(runtimeDebug.Stack()))
+			panic(x)
+		}
+	}()
+
+	if err := app.Run(os.Args); err != nil {
+		log.Error(err.Error())
+	}
+}
+
+func run(cliCtx *cli.Context) error {
+	ctx := cliCtx.Context
+	w, err := wallet.OpenWalletOrElseCli(cliCtx, func(_ *cli.Context) (*wallet.Wallet, error) {
+		return nil, wallet.ErrNoWalletFound
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open wallet")
+	}
+	if w.KeymanagerKind() != keymanager.Local {
+		return fmt.Errorf("wallet is of kind %s, only %s wallets are supported", w.KeymanagerKind(), keymanager.Local)
+	}
+	km, err := local.NewKeymanager(ctx, &local.SetupConfig{
+		Wallet:           w,
+		ListenForChanges: true,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not initialize keymanager")
+	}
+
+	dataDir := w.AccountsDir()
+	if cliCtx.String(cmd.DataDirFlag.Name) != cmd.DefaultDataDir() {
+		dataDir = cliCtx.String(cmd.DataDirFlag.Name)
+	}
+	log.WithField("databasePath", filepath.Join(dataDir, kv.ProtectionDbFileName)).Info("Opening slashing protection database")
+	valDB, err := kv.NewKVStore(ctx, dataDir, &kv.Config{})
+	if err != nil {
+		return errors.Wrap(err, "could not initialize db")
+	}
+	defer func() {
+		if err := valDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close db")
+		}
+	}()
+	if err := valDB.RunUpMigrations(ctx); err != nil {
+		return errors.Wrap(err, "could not run database migration")
+	}
+
+	var allowlist *remotesigner.Allowlist
+	if cliCtx.IsSet(flags.ClientAllowlistFileFlag.Name) {
+		allowlist, err = remotesigner.LoadAllowlist(cliCtx.String(flags.ClientAllowlistFileFlag.Name))
+		if err != nil {
+			return err
+		}
+	}
+	signer, err := remotesigner.New(ctx, &remotesigner.Config{
+		Keymanager:       km,
+		ValDB:            valDB,
+		Host:             cliCtx.String(flags.HostFlag.Name),
+		Port:             cliCtx.Int(flags.PortFlag.Name),
+		CertPath:         cliCtx.String(flags.TLSCertFlag.Name),
+		KeyPath:          cliCtx.String(flags.TLSKeyFlag.Name),
+		ClientCACertPath: cliCtx.String(flags.TLSClientCACertFlag.Name),
+		Allowlist:        allowlist,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not create remote signer")
+	}
+
+	services := runtime.NewServiceRegistry()
+	if !cliCtx.Bool(cmd.DisableMonitoringFlag.Name) {
+		monitoring := prometheus.NewService(
+			fmt.Sprintf("%s:%d", cliCtx.String(cmd.MonitoringHostFlag.Name), cliCtx.Int(flags.MonitoringPortFlag.Name)),
+			services,
+		)
+		logrus.AddHook(prometheus.NewLogrusCollector())
+		if err := services.RegisterService(monitoring); err != nil {
+			return err
+		}
+	}
+	if err := services.RegisterService(signer); err != nil {
+		return err
+	}
+
+	log.WithField("version", version.Version()).Info("Starting remote signer")
+	services.StartAll()
+	defer services.StopAll()
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigc)
+	select {
+	case <-sigc:
+		log.Info("Got interrupt, shutting down...")
+	case <-ctx.Done():
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+// Package flags contains all configuration runtime flags for
+// the standalone slasher.
+package flags
+
+import (
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	// BeaconNodesFlag defines the REST API endpoints of the beacon nodes watched by the slasher.
+	BeaconNodesFlag = &cli.StringSliceFlag{
+		Name: "beacon-nodes",
+		Usage: "Comma-separated REST API endpoints of the beacon nodes whose blocks and attestations are checked " +
+			"for slashable offenses, and to which the detected slashings are submitted",
+		Value: cli.NewStringSlice("http://127.0.0.1:3500"),
+	}
+	// BeaconNodeTimeoutFlag defines the timeout of the requests to the beacon nodes.
+	BeaconNodeTimeoutFlag = &cli.DurationFlag{
+		Name:  "beacon-node-timeout",
+		Usage: "Timeout of the requests to the beacon nodes, the event streams excepted",
+		Value: 10 * time.Second,
+	}
+	// MonitoringPortFlag defines the port used to serve the prometheus metrics of the slasher.
+	MonitoringPortFlag = &cli.IntFlag{
+		Name:  "monitoring-port",
+		Usage: "Port used to listening and respond metrics for prometheus.",
+		Value: 8084,
+	}
+)
@@ -0,0 +1,203 @@
+// Package main defines a standalone slasher, which checks the blocks and attestations streamed by the event
+// APIs of beacon nodes for slashable offenses and submits the slashings it finds back to the beacon nodes.
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	runtimeDebug "runtime/debug"
+	"syscall"
+
+	joonix "github.com/joonix/log"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/theQRL/qrysm/v4/beacon-chain/db/kv"
+	"github.com/theQRL/qrysm/v4/beacon-chain/db/slasherkv"
+	"github.com/theQRL/qrysm/v4/beacon-chain/slasher"
+	"github.com/theQRL/qrysm/v4/beacon-chain/slasher/standalone"
+	"github.com/theQRL/qrysm/v4/cmd"
+	beaconflags "github.com/theQRL/qrysm/v4/cmd/beacon-chain/flags"
+	"github.com/theQRL/qrysm/v4/cmd/slasher/flags"
+	"github.com/theQRL/qrysm/v4/config/params"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/io/logs"
+	"github.com/theQRL/qrysm/v4/monitoring/journald"
+	"github.com/theQRL/qrysm/v4/monitoring/prometheus"
+	"github.com/theQRL/qrysm/v4/runtime"
+	prefixed "github.com/theQRL/qrysm/v4/runtime/logging/logrus-prefixed-formatter"
+	"github.com/theQRL/qrysm/v4/runtime/version"
+	"github.com/urfave/cli/v2"
+)
+
+var appFlags = []cli.Flag{
+	cmd.VerbosityFlag,
+	cmd.LogFormat,
+	cmd.LogFileName,
+	cmd.ConfigFileFlag,
+	cmd.DataDirFlag,
+	cmd.ChainConfigFileFlag,
+	cmd.MonitoringHostFlag,
+	cmd.DisableMonitoringFlag,
+	flags.BeaconNodesFlag,
+	flags.BeaconNodeTimeoutFlag,
+	flags.MonitoringPortFlag,
+	beaconflags.SlasherChunkSize,
+	beaconflags.SlasherValidatorChunkSize,
+	beaconflags.SlasherHistoryLength,
+}
+
+func init() {
+	appFlags = cmd.WrapFlags(appFlags)
+}
+
+func main() {
+	app := cli.App{}
+	app.Name = "slasher"
+	app.Usage = "detects the slashable offenses of the validators watched by beacon nodes and submits them to the beacon nodes"
+	app.Action = run
+	app.Version = version.Version()
+
+	app.Flags = appFlags
+
+	app.Before = func(ctx *cli.Context) error {
+		// Load flags from config file, if specified.
+		if err := cmd.LoadFlagsFromConfig(ctx, app.Flags); err != nil {
+			return err
+		}
+
+		verbosity := ctx.String(cmd.VerbosityFlag.Name)
+		level, err := logrus.ParseLevel(verbosity)
+		if err != nil {
+			return err
+		}
+		logrus.SetLevel(level)
+
+		format := ctx.String(cmd.LogFormat.Name)
+		switch format {
+		case "text":
+			formatter := new(prefixed.TextFormatter)
+			formatter.TimestampFormat = "2006-01-02 15:04:05"
+			formatter.FullTimestamp = true
+			// If persistent log files are written - we disable the log messages coloring because
+			// the colors are ANSI codes and seen as gibberish in the log files.
+			formatter.DisableColors = ctx.String(cmd.LogFileName.Name) != ""
+			logrus.SetFormatter(formatter)
+		case "fluentd":
+			f := joonix.NewFormatter()
+			if err := joonix.DisableTimestampFormat(f); err != nil {
+				panic(err)
+			}
+			logrus.SetFormatter(f)
+		case "json":
+			logrus.SetFormatter(&logrus.JSONFormatter{})
+		case "journald":
+			if err := journald.Enable(); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unknown log format %s", format)
+		}
+
+		logFileName := ctx.String(cmd.LogFileName.Name)
+		if logFileName != "" {
+			if err := logs.ConfigurePersistentLogging(logFileName); err != nil {
+				log.WithError(err).Error("Failed to configuring logging to disk.")
+			}
+		}
+		return cmd.ValidateNoArgs(ctx)
+	}
+
+	defer func() {
+		if x := recover(); x != nil {
+			log.Errorf("Runtime panic: %v\n%v", x, string(runtimeDebug.Stack()))
+			panic(x)
+		}
+	}()
+
+	if err := app.Run(os.Args); err != nil {
+		log.Error(err.Error())
+	}
+}
+
+func run(cliCtx *cli.Context) error {
+	ctx := cliCtx.Context
+	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
+		if err := params.LoadChainConfigFile(cliCtx.String(cmd.ChainConfigFileFlag.Name), nil); err != nil {
+			return errors.Wrap(err, "could not load chain config file")
+		}
+	}
+	slasherParams, err := slasher.NewParams(
+		cliCtx.Uint64(beaconflags.SlasherChunkSize.Name),
+		cliCtx.Uint64(beaconflags.SlasherValidatorChunkSize.Name),
+		primitives.Epoch(cliCtx.Uint64(beaconflags.SlasherHistoryLength.Name)),
+	)
+	if err != nil {
+		return errors.Wrap(err, "invalid slasher parameters")
+	}
+
+	dbPath := filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName)
+	log.WithField("databasePath", dbPath).Info("Opening slasher database")
+	slasherDB, err := slasherkv.NewKVStore(ctx, dbPath)
+	if err != nil {
+		return errors.Wrap(err, "could not initialize db")
+	}
+	defer func() {
+		if err := slasherDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close db")
+		}
+	}()
+
+	beaconNodes, err := standalone.New(ctx, &standalone.Config{
+		Endpoints: cliCtx.StringSlice(flags.BeaconNodesFlag.Name),
+		Timeout:   cliCtx.Duration(flags.BeaconNodeTimeoutFlag.Name),
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not watch beacon nodes")
+	}
+	slasherSrv, err := slasher.New(ctx, &slasher.ServiceConfig{
+		IndexedAttestationsFeed: beaconNodes.IndexedAttestationsFeed(),
+		BeaconBlockHeadersFeed:  beaconNodes.BeaconBlockHeadersFeed(),
+		Database:                slasherDB,
+		SyncChecker:             beaconNodes,
+		ClockWaiter:             beaconNodes.ClockWaiter(),
+		Params:                  slasherParams,
+		RemoteBeaconNode:        beaconNodes,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not create slasher")
+	}
+
+	services := runtime.NewServiceRegistry()
+	if !cliCtx.Bool(cmd.DisableMonitoringFlag.Name) {
+		monitoring := prometheus.NewService(
+			fmt.Sprintf("%s:%d", cliCtx.String(cmd.MonitoringHostFlag.Name), cliCtx.Int(flags.MonitoringPortFlag.Name)),
+			services,
+		)
+		logrus.AddHook(prometheus.NewLogrusCollector())
+		if err := services.RegisterService(monitoring); err != nil {
+			return err
+		}
+	}
+	if err := services.RegisterService(beaconNodes); err != nil {
+		return err
+	}
+	if err := services.RegisterService(slasherSrv); err != nil {
+		return err
+	}
+
+	log.WithField("version", version.Version()).Info("Starting slasher")
+	services.StartAll()
+	defer services.StopAll()
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigc)
+	select {
+	case <-sigc:
+		log.Info("Got interrupt, shutting down...")
+	case <-ctx.Done():
+	}
+	return nil
+}
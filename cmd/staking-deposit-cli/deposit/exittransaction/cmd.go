@@ -0,0 +1,171 @@
+package exittransaction
+
+import (
+	"fmt"
+	"syscall"
+
+	"github.com/sirupsen/logrus"
+	"github.com/theQRL/qrysm/v4/cmd/staking-deposit-cli/stakingdeposit"
+	"github.com/urfave/cli/v2"
+	"golang.org/x/term"
+)
+
+var (
+	exitTransactionSeedFlags = struct {
+		OutputFolder        string
+		Chain               string
+		Seed                string
+		ValidatorStartIndex uint64
+		ValidatorIndices    *cli.Uint64Slice
+		Epoch               uint64
+		DevnetChainSetting  string
+	}{
+		ValidatorIndices: cli.NewUint64Slice(),
+	}
+	exitTransactionKeystoreFlags = struct {
+		OutputFolder       string
+		Chain              string
+		Keystore           string
+		ValidatorIndex     uint64
+		Epoch              uint64
+		DevnetChainSetting string
+	}{}
+	log = logrus.WithField("prefix", "deposit")
+)
+
+var Commands = []*cli.Command{
+	{
+		Name:  "exit-transaction-seed",
+		Usage: "Generates signed voluntary exits for validators whose keys are derived from a seed",
+		Action: func(cliCtx *cli.Context) error {
+			if err := cliActionExitTransactionSeed(cliCtx); err != nil {
+				log.WithError(err).Fatal("Could not generate exit transactions using the seed")
+			}
+			return nil
+		},
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "output-folder",
+				Usage:       "Folder where the signed exit transaction files will be created",
+				Destination: &exitTransactionSeedFlags.OutputFolder,
+				Required:    true,
+			},
+			&cli.StringFlag{
+				Name:        "chain",
+				Usage:       "Name of the chain should be one of these mainnet, betanet",
+				Destination: &exitTransactionSeedFlags.Chain,
+				Required:    true,
+			},
+			&cli.StringFlag{
+				Name:        "seed",
+				Usage:       "Seed from which the validator signing keys are derived",
+				Destination: &exitTransactionSeedFlags.Seed,
+				Required:    true,
+			},
+			&cli.Uint64Flag{
+				Name:        "validator-start-index",
+				Usage:       "Key derivation index of the first validator to exit",
+				Destination: &exitTransactionSeedFlags.ValidatorStartIndex,
+				Required:    true,
+			},
+			&cli.Uint64SliceFlag{
+				Name:        "validator-indices",
+				Usage:       "Beacon chain indices of the validators to exit, one per key starting at validator-start-index",
+				Destination: exitTransactionSeedFlags.ValidatorIndices,
+				Required:    true,
+			},
+			&cli.Uint64Flag{
+				Name:        "epoch",
+				Usage:       "Earliest epoch at which the voluntary exits can be included",
+				Destination: &exitTransactionSeedFlags.Epoch,
+				Value:       0,
+			},
+			&cli.StringFlag{
+				Name:        "devnet-chain-setting",
+				Usage:       "Use for devnet only, to set the custom network_name, genesis_fork_name, genesis_validator_root. Input should be in JSON format.",
+				Destination: &exitTransactionSeedFlags.DevnetChainSetting,
+				Value:       "",
+			},
+		},
+	},
+	{
+		Name:  "exit-transaction-keystore",
+		Usage: "Generates a signed voluntary exit for the validator of a keystore",
+		Action: func(cliCtx *cli.Context) error {
+			if err := cliActionExitTransactionKeystore(cliCtx); err != nil {
+				log.WithError(err).Fatal("Could not generate exit transaction using the keystore")
+			}
+			return nil
+		},
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "output-folder",
+				Usage:       "Folder where the signed exit transaction file will be created",
+				Destination: &exitTransactionKeystoreFlags.OutputFolder,
+				Required:    true,
+			},
+			&cli.StringFlag{
+				Name:        "chain",
+				Usage:       "Name of the chain should be one of these mainnet, betanet",
+				Destination: &exitTransactionKeystoreFlags.Chain,
+				Required:    true,
+			},
+			&cli.StringFlag{
+				Name:        "keystore",
+				Usage:       "Path to the keystore of the validator signing key",
+				Destination: &exitTransactionKeystoreFlags.Keystore,
+				Required:    true,
+			},
+			&cli.Uint64Flag{
+				Name:        "validator-index",
+				Usage:       "Beacon chain index of the validator to exit",
+				Destination: &exitTransactionKeystoreFlags.ValidatorIndex,
+				Required:    true,
+			},
+			&cli.Uint64Flag{
+				Name:        "epoch",
+				Usage:       "Earliest epoch at which the voluntary exit can be included",
+				Destination: &exitTransactionKeystoreFlags.Epoch,
+				Value:       0,
+			},
+			&cli.StringFlag{
+				Name:        "devnet-chain-setting",
+				Usage:       "Use for devnet only, to set the custom network_name, genesis_fork_name, genesis_validator_root. Input should be in JSON format.",
+				Destination: &exitTransactionKeystoreFlags.DevnetChainSetting,
+				Value:       "",
+			},
+		},
+	},
+}
+
+func cliActionExitTransactionSeed(cliCtx *cli.Context) error {
+	stakingdeposit.GenerateExitTransactionsFromSeed(
+		exitTransactionSeedFlags.OutputFolder,
+		exitTransactionSeedFlags.Chain,
+		exitTransactionSeedFlags.Seed,
+		exitTransactionSeedFlags.ValidatorStartIndex,
+		exitTransactionSeedFlags.ValidatorIndices.Value(),
+		exitTransactionSeedFlags.Epoch,
+		exitTransactionSeedFlags.DevnetChainSetting,
+	)
+	return nil
+}
+
+func cliActionExitTransactionKeystore(cliCtx *cli.Context) error {
+	fmt.Println("Enter the password that secures your validator keystore.")
+	keystorePassword, err := term.ReadPassword(int(syscall.Stdin))
+	if err != nil {
+		return err
+	}
+
+	stakingdeposit.GenerateExitTransactionFromKeystore(
+		exitTransactionKeystoreFlags.OutputFolder,
+		exitTransactionKeystoreFlags.Chain,
+		exitTransactionKeystoreFlags.Keystore,
+		string(keystorePassword),
+		exitTransactionKeystoreFlags.ValidatorIndex,
+		exitTransactionKeystoreFlags.Epoch,
+		exitTransactionKeystoreFlags.DevnetChainSetting,
+	)
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/theQRL/qrysm/v4/cmd/staking-deposit-cli/deposit/existingseed"
+	"github.com/theQRL/qrysm/v4/cmd/staking-deposit-cli/deposit/exittransaction"
 	"github.com/theQRL/qrysm/v4/cmd/staking-deposit-cli/deposit/generatedilithiumtoexecutionchange"
 	"github.com/theQRL/qrysm/v4/cmd/staking-deposit-cli/deposit/newseed"
 	"github.com/theQRL/qrysm/v4/cmd/staking-deposit-cli/deposit/submit"
@@ -29,5 +30,6 @@ func init() {
 	depositCommands = append(depositCommands, existingseed.Commands...)
 	depositCommands = append(depositCommands, newseed.Commands...)
 	depositCommands = append(depositCommands, generatedilithiumtoexecutionchange.Commands...)
+	depositCommands = append(depositCommands, exittransaction.Commands...)
 	depositCommands = append(depositCommands, submit.Command)
 }
@@ -0,0 +1,43 @@
+package stakingdeposit
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/theQRL/qrysm/v4/cmd/staking-deposit-cli/config"
+)
+
+// getChainSetting returns the settings of the named chain, overridden by devnetChainSetting
+// when it is set. devnetChainSetting is a JSON object with the network_name, genesis_fork_version
+// and genesis_validator_root of a devnet.
+func getChainSetting(chain, devnetChainSetting string) *config.ChainSetting {
+	chainSettings, ok := config.GetConfig().ChainSettings[chain]
+	if !ok {
+		panic(fmt.Errorf("cannot find chain settings for %s", chain))
+	}
+	if len(devnetChainSetting) != 0 {
+		devnetChainSettingMap := make(map[string]string)
+		err := json.Unmarshal([]byte(devnetChainSetting), &devnetChainSettingMap)
+		if err != nil {
+			panic(fmt.Errorf("failed to unmarshal devnetChainSetting %s | reason %v", devnetChainSetting, err))
+		}
+		networkName, ok := devnetChainSettingMap["network_name"]
+		if !ok {
+			panic("network_name not found in devnetChainSetting passed as argument")
+		}
+		genesisForkVersion, ok := devnetChainSettingMap["genesis_fork_version"]
+		if !ok {
+			panic("genesis_fork_version not found in devnetChainSetting passed as argument")
+		}
+		genesisValidatorRoot, ok := devnetChainSettingMap["genesis_validator_root"]
+		if !ok {
+			panic("genesis_validator_root not found in devnetChainSetting passed as argument")
+		}
+		chainSettings = &config.ChainSetting{
+			Name:                  networkName,
+			GenesisForkVersion:    config.ToHex(genesisForkVersion),
+			GenesisValidatorsRoot: config.ToHex(genesisValidatorRoot),
+		}
+	}
+	return chainSettings
+}
@@ -2,4 +2,5 @@ package stakingdeposit
 
 const (
 	defaultDilithiumToExecutionChangesFolderName = "dilithium_to_execution_changes"
+	defaultExitTransactionsFolderName            = "exit_transactions"
 )
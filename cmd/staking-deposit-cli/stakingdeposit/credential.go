@@ -47,6 +47,15 @@ func (c *Credential) WithdrawalPK() []byte {
 	return withdrawalKey.PublicKey().Marshal()
 }
 
+func (c *Credential) SigningPK() []byte {
+	binSigningSeed := misc.StrSeedToBinSeed(c.signingSeed)
+	signingKey, err := dilithium.SecretKeyFromBytes(binSigningSeed[:])
+	if err != nil {
+		panic(fmt.Errorf("failed to generate dilithium key from signingSeed %s", c.signingSeed))
+	}
+	return signingKey.PublicKey().Marshal()
+}
+
 func (c *Credential) WithdrawalPrefix() uint8 {
 	withdrawalAddress := c.ZondWithdrawalAddress()
 	if reflect.DeepEqual(withdrawalAddress, common.Address{}) {
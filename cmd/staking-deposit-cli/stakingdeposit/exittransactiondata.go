@@ -0,0 +1,30 @@
+package stakingdeposit
+
+import (
+	"fmt"
+	"strconv"
+
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+)
+
+type ExitTransactionMessage struct {
+	Epoch          string `json:"epoch"`
+	ValidatorIndex string `json:"validator_index"`
+}
+
+// ExitTransactionData is a signed voluntary exit in the JSON format accepted by
+// the /zond/v1/beacon/pool/voluntary_exits endpoint of the beacon node.
+type ExitTransactionData struct {
+	Message   *ExitTransactionMessage `json:"message"`
+	Signature string                  `json:"signature"`
+}
+
+func NewExitTransactionData(signedVoluntaryExit *zondpb.SignedVoluntaryExit) *ExitTransactionData {
+	return &ExitTransactionData{
+		Message: &ExitTransactionMessage{
+			Epoch:          strconv.FormatUint(uint64(signedVoluntaryExit.Exit.Epoch), 10),
+			ValidatorIndex: strconv.FormatUint(uint64(signedVoluntaryExit.Exit.ValidatorIndex), 10),
+		},
+		Signature: fmt.Sprintf("0x%x", signedVoluntaryExit.Signature),
+	}
+}
@@ -35,34 +35,7 @@ func GenerateDilithiumToExecutionChange(dilithiumExecutionChangesFolder string,
 			panic(fmt.Errorf("cannot create folder. reason: %v", err))
 		}
 	}
-	chainSettings, ok := config.GetConfig().ChainSettings[chain]
-	if !ok {
-		panic(fmt.Errorf("cannot find chain settings for %s", chain))
-	}
-	if len(devnetChainSetting) != 0 {
-		devnetChainSettingMap := make(map[string]string)
-		err := json.Unmarshal([]byte(devnetChainSetting), &devnetChainSettingMap)
-		if err != nil {
-			panic(fmt.Errorf("failed to unmarshal devnetChainSetting %s | reason %v", devnetChainSetting, err))
-		}
-		networkName, ok := devnetChainSettingMap["network_name"]
-		if !ok {
-			panic("network_name not found in devnetChainSetting passed as argument")
-		}
-		genesisForkVersion, ok := devnetChainSettingMap["genesis_fork_version"]
-		if !ok {
-			panic("genesis_fork_version not found in devnetChainSetting passed as argument")
-		}
-		genesisValidatorRoot, ok := devnetChainSettingMap["genesis_validator_root"]
-		if !ok {
-			panic("genesis_validator_root not found in devnetChainSetting passed as argument")
-		}
-		chainSettings = &config.ChainSetting{
-			Name:                  networkName,
-			GenesisForkVersion:    config.ToHex(genesisForkVersion),
-			GenesisValidatorsRoot: config.ToHex(genesisValidatorRoot),
-		}
-	}
+	chainSettings := getChainSetting(chain, devnetChainSetting)
 
 	numValidators := uint64(len(validatorIndices))
 	if numValidators != uint64(len(dilithiumWithdrawalCredentialsList)) {
@@ -0,0 +1,264 @@
+package stakingdeposit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"time"
+
+	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/qrysm/v4/beacon-chain/core/signing"
+	"github.com/theQRL/qrysm/v4/cmd/staking-deposit-cli/config"
+	"github.com/theQRL/qrysm/v4/cmd/staking-deposit-cli/misc"
+	"github.com/theQRL/qrysm/v4/cmd/staking-deposit-cli/stakingdeposit/keyhandling"
+	"github.com/theQRL/qrysm/v4/config/params"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/crypto/dilithium"
+	"github.com/theQRL/qrysm/v4/network/forks"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+)
+
+// GenerateExitTransactionsFromSeed writes a signed voluntary exit for each of the validators whose signing keys
+// are derived from the seed, starting at validatorStartIndex. validatorIndices are the indices of these validators
+// in the beacon state, in the same order.
+func GenerateExitTransactionsFromSeed(exitTransactionsFolder string,
+	chain,
+	seed string,
+	validatorStartIndex uint64,
+	validatorIndices []uint64,
+	epoch uint64,
+	devnetChainSetting string) {
+	exitTransactionsFolder = createExitTransactionsFolder(exitTransactionsFolder)
+	chainSettings := getChainSetting(chain, devnetChainSetting)
+
+	numValidators := uint64(len(validatorIndices))
+	amounts := make([]uint64, numValidators)
+	for i := uint64(0); i < numValidators; i++ {
+		amounts[i] = params.BeaconConfig().MaxEffectiveBalance
+	}
+	credentials, err := NewCredentialsFromSeed(seed, numValidators, amounts, chainSettings, validatorStartIndex, "")
+	if err != nil {
+		panic(fmt.Errorf("new credentials from seed failed. reason: %v", err))
+	}
+
+	for i, credential := range credentials.credentials {
+		signingSeed := misc.StrSeedToBinSeed(credential.signingSeed)
+		exitTransactionFile, err := exportExitTransactionJSON(exitTransactionsFolder, signingSeed[:], validatorIndices[i], epoch, chainSettings)
+		if err != nil {
+			panic(fmt.Errorf("error in exportExitTransactionJSON %v", err))
+		}
+		if !VerifyExitTransactionJSON(exitTransactionFile, credential.SigningPK(), validatorIndices[i], epoch, chainSettings) {
+			panic("failed to verify the exit transaction json file")
+		}
+	}
+}
+
+// GenerateExitTransactionFromKeystore writes a signed voluntary exit for the validator at validatorIndex in the
+// beacon state, whose signing key is decrypted from the keystore with the keystore password.
+func GenerateExitTransactionFromKeystore(exitTransactionsFolder string,
+	chain,
+	keystoreFile,
+	keystorePassword string,
+	validatorIndex uint64,
+	epoch uint64,
+	devnetChainSetting string) {
+	exitTransactionsFolder = createExitTransactionsFolder(exitTransactionsFolder)
+	chainSettings := getChainSetting(chain, devnetChainSetting)
+
+	keystore := keyhandling.NewKeystoreFromFile(keystoreFile)
+	signingSeed := keystore.Decrypt(keystorePassword)
+	signingKey, err := dilithium.SecretKeyFromBytes(signingSeed[:])
+	if err != nil {
+		panic(fmt.Errorf("failed to generate dilithium key from keystore %s | reason %v", keystoreFile, err))
+	}
+	signingPK := signingKey.PublicKey().Marshal()
+	if len(keystore.PubKey) != 0 && !bytes.Equal(misc.DecodeHex(keystore.PubKey), signingPK) {
+		panic(fmt.Errorf("public key of keystore %s does not match its decrypted signing key", keystoreFile))
+	}
+
+	exitTransactionFile, err := exportExitTransactionJSON(exitTransactionsFolder, signingSeed[:], validatorIndex, epoch, chainSettings)
+	if err != nil {
+		panic(fmt.Errorf("error in exportExitTransactionJSON %v", err))
+	}
+	if !VerifyExitTransactionJSON(exitTransactionFile, signingPK, validatorIndex, epoch, chainSettings) {
+		panic("failed to verify the exit transaction json file")
+	}
+}
+
+// SignVoluntaryExit signs the voluntary exit of the validator at validatorIndex with the signing key generated
+// from signingSeed. The signature domain is the one the beacon chain verifies the exit with at its epoch, see
+// exitFork.
+func SignVoluntaryExit(signingSeed []byte,
+	validatorIndex uint64,
+	epoch uint64,
+	chainSetting *config.ChainSetting) (*zondpb.SignedVoluntaryExit, error) {
+	signingKey, err := dilithium.SecretKeyFromBytes(signingSeed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate secret key from signing seed %v", err)
+	}
+	exit := &zondpb.VoluntaryExit{
+		Epoch:          primitives.Epoch(epoch),
+		ValidatorIndex: primitives.ValidatorIndex(validatorIndex),
+	}
+	signingRoot, err := exitSigningRoot(exit, chainSetting)
+	if err != nil {
+		return nil, err
+	}
+	return &zondpb.SignedVoluntaryExit{
+		Exit:      exit,
+		Signature: signingKey.Sign(signingRoot[:]).Marshal(),
+	}, nil
+}
+
+func VerifyExitTransactionJSON(fileFolder string,
+	signingPK []byte,
+	inputValidatorIndex uint64,
+	inputEpoch uint64,
+	chainSetting *config.ChainSetting) bool {
+	data, err := os.ReadFile(fileFolder)
+	if err != nil {
+		panic(fmt.Errorf("failed to read file %s | reason %v", fileFolder, err))
+	}
+	var exitTransactionData *ExitTransactionData
+	if err := json.Unmarshal(data, &exitTransactionData); err != nil {
+		panic(fmt.Errorf("failed to unmarshal file %s | reason %v", fileFolder, err))
+	}
+	return ValidateExitTransaction(exitTransactionData, signingPK, inputValidatorIndex, inputEpoch, chainSetting)
+}
+
+func ValidateExitTransaction(exitTransactionData *ExitTransactionData,
+	signingPK []byte, inputValidatorIndex uint64, inputEpoch uint64, chainSetting *config.ChainSetting) bool {
+	if exitTransactionData == nil || exitTransactionData.Message == nil {
+		return false
+	}
+	validatorIndex, err := strconv.ParseUint(exitTransactionData.Message.ValidatorIndex, 10, 64)
+	if err != nil {
+		panic(fmt.Errorf("failed to parse validatorIndex %s | reason %v", exitTransactionData.Message.ValidatorIndex, err))
+	}
+	epoch, err := strconv.ParseUint(exitTransactionData.Message.Epoch, 10, 64)
+	if err != nil {
+		panic(fmt.Errorf("failed to parse epoch %s | reason %v", exitTransactionData.Message.Epoch, err))
+	}
+	if validatorIndex != inputValidatorIndex || epoch != inputEpoch {
+		return false
+	}
+	signature, err := dilithium.SignatureFromBytes(misc.DecodeHex(exitTransactionData.Signature))
+	if err != nil {
+		panic(fmt.Errorf("failed to convert %s to dilithium signature | reason %v",
+			exitTransactionData.Signature, err))
+	}
+
+	signingRoot, err := exitSigningRoot(&zondpb.VoluntaryExit{
+		Epoch:          primitives.Epoch(epoch),
+		ValidatorIndex: primitives.ValidatorIndex(validatorIndex),
+	}, chainSetting)
+	if err != nil {
+		panic(err)
+	}
+	sizedPK := misc.ToSizedDilithiumPublicKey(signingPK)
+	return dilithium2.Verify(signingRoot[:], misc.ToSizedDilithiumSignature(signature.Marshal()), &sizedPK)
+}
+
+func exitSigningRoot(exit *zondpb.VoluntaryExit, chainSetting *config.ChainSetting) ([32]byte, error) {
+	domain, err := signing.Domain(
+		exitFork(exit.Epoch, chainSetting),
+		exit.Epoch,
+		params.BeaconConfig().DomainVoluntaryExit,
+		chainSetting.GenesisValidatorsRoot, /*genesisValidatorsRoot*/
+	)
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("failed to compute domain %v", err)
+	}
+	signingRoot, err := signing.ComputeSigningRoot(exit, domain)
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("failed to compute signing root for voluntary exit %v", err)
+	}
+	return signingRoot, nil
+}
+
+// exitFork returns the fork of the beacon state of the chain at the exit epoch, which the beacon chain uses to
+// verify the exit signature. The chain starts with its genesis fork version and then follows the fork schedule
+// of the network config. From Deneb onwards, exits are verified with the Capella fork version (EIP-7044).
+func exitFork(epoch primitives.Epoch, chainSetting *config.ChainSetting) *zondpb.Fork {
+	cfg := params.BeaconConfig()
+	if epoch >= cfg.DenebForkEpoch {
+		return &zondpb.Fork{
+			PreviousVersion: cfg.CapellaForkVersion,
+			CurrentVersion:  cfg.CapellaForkVersion,
+			Epoch:           cfg.CapellaForkEpoch,
+		}
+	}
+	fork := &zondpb.Fork{
+		PreviousVersion: chainSetting.GenesisForkVersion,
+		CurrentVersion:  chainSetting.GenesisForkVersion,
+		Epoch:           cfg.GenesisEpoch,
+	}
+	versions := forks.SortedForkVersions(cfg.ForkVersionSchedule)
+	for i := range versions {
+		forkEpoch := cfg.ForkVersionSchedule[versions[i]]
+		if forkEpoch <= cfg.GenesisEpoch || forkEpoch > epoch {
+			continue
+		}
+		fork = &zondpb.Fork{
+			PreviousVersion: fork.CurrentVersion,
+			CurrentVersion:  versions[i][:],
+			Epoch:           forkEpoch,
+		}
+	}
+	return fork
+}
+
+func createExitTransactionsFolder(exitTransactionsFolder string) string {
+	exitTransactionsFolder = filepath.Join(exitTransactionsFolder, defaultExitTransactionsFolderName)
+	if _, err := os.Stat(exitTransactionsFolder); os.IsNotExist(err) {
+		err := os.MkdirAll(exitTransactionsFolder, 0775)
+		if err != nil {
+			panic(fmt.Errorf("cannot create folder. reason: %v", err))
+		}
+	}
+	return exitTransactionsFolder
+}
+
+func exportExitTransactionJSON(folder string,
+	signingSeed []byte,
+	validatorIndex uint64,
+	epoch uint64,
+	chainSetting *config.ChainSetting) (string, error) {
+	signedVoluntaryExit, err := SignVoluntaryExit(signingSeed, validatorIndex, epoch, chainSetting)
+	if err != nil {
+		return "", err
+	}
+	jsonExitTransactionData, err := json.Marshal(NewExitTransactionData(signedVoluntaryExit))
+	if err != nil {
+		return "", err
+	}
+
+	fileFolder := filepath.Join(folder, fmt.Sprintf("signed_exit_transaction-%d-%d.json", validatorIndex, time.Now().Unix()))
+	f, err := os.Create(fileFolder)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Println(err)
+		}
+	}()
+
+	if _, err := f.Write(jsonExitTransactionData); err != nil {
+		return "", err
+	}
+	if err := f.Sync(); err != nil {
+		return "", err
+	}
+	if runtime.GOOS != "windows" {
+		if err := os.Chmod(fileFolder, 0440); err != nil {
+			return "", err
+		}
+	}
+	return fileFolder, nil
+}
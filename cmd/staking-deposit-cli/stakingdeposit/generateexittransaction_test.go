@@ -0,0 +1,69 @@
+package stakingdeposit
+
+import (
+	"testing"
+
+	qrllibcommon "github.com/theQRL/go-qrllib/common"
+	"github.com/theQRL/qrysm/v4/beacon-chain/core/blocks"
+	state_native "github.com/theQRL/qrysm/v4/beacon-chain/state/state-native"
+	"github.com/theQRL/qrysm/v4/cmd/staking-deposit-cli/config"
+	"github.com/theQRL/qrysm/v4/config/params"
+	"github.com/theQRL/qrysm/v4/crypto/dilithium"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	"github.com/theQRL/qrysm/v4/testing/require"
+	"github.com/theQRL/qrysm/v4/testing/util"
+)
+
+func TestSignVoluntaryExit(t *testing.T) {
+	chainSetting := getChainSetting(config.BETANET, "")
+	exitEpoch := params.BeaconConfig().ShardCommitteePeriod
+
+	signingSeed := make([]byte, qrllibcommon.SeedSize)
+	signingSeed[0] = 1
+	signingKey, err := dilithium.SecretKeyFromBytes(signingSeed)
+	require.NoError(t, err)
+	validator := &zondpb.Validator{
+		PublicKey:         signingKey.PublicKey().Marshal(),
+		ExitEpoch:         params.BeaconConfig().FarFutureEpoch,
+		WithdrawableEpoch: params.BeaconConfig().FarFutureEpoch,
+	}
+	st, err := util.NewBeaconStateCapella(func(st *zondpb.BeaconStateCapella) error {
+		st.Slot = params.BeaconConfig().SlotsPerEpoch.Mul(uint64(exitEpoch))
+		st.GenesisValidatorsRoot = chainSetting.GenesisValidatorsRoot
+		st.Fork = &zondpb.Fork{
+			PreviousVersion: chainSetting.GenesisForkVersion,
+			CurrentVersion:  chainSetting.GenesisForkVersion,
+		}
+		st.Validators = []*zondpb.Validator{validator}
+		st.Balances = []uint64{params.BeaconConfig().MaxEffectiveBalance}
+		return nil
+	})
+	require.NoError(t, err)
+	rv, err := state_native.NewValidator(validator)
+	require.NoError(t, err)
+
+	signed, err := SignVoluntaryExit(signingSeed, 0, uint64(exitEpoch), chainSetting)
+	require.NoError(t, err)
+	require.NoError(t, blocks.VerifyExitAndSignature(rv, st, signed))
+
+	// Exits signed for another chain do not verify.
+	otherSetting := *chainSetting
+	otherSetting.GenesisForkVersion = params.BeaconConfig().CapellaForkVersion
+	signed, err = SignVoluntaryExit(signingSeed, 0, uint64(exitEpoch), &otherSetting)
+	require.NoError(t, err)
+	require.ErrorContains(t, "signature did not verify", blocks.VerifyExitAndSignature(rv, st, signed))
+}
+
+func TestExitFork(t *testing.T) {
+	chainSetting := getChainSetting(config.BETANET, "")
+	cfg := params.BeaconConfig()
+
+	fork := exitFork(cfg.GenesisEpoch, chainSetting)
+	require.DeepEqual(t, chainSetting.GenesisForkVersion, fork.PreviousVersion)
+	require.DeepEqual(t, chainSetting.GenesisForkVersion, fork.CurrentVersion)
+
+	fork = exitFork(cfg.CapellaForkEpoch, chainSetting)
+	require.DeepEqual(t, cfg.BellatrixForkVersion, fork.PreviousVersion)
+	require.DeepEqual(t, cfg.CapellaForkVersion, fork.CurrentVersion)
+	require.Equal(t, cfg.CapellaForkEpoch, fork.Epoch)
+}
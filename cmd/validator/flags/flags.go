@@ -43,9 +43,14 @@ var (
 	// BeaconRESTApiProviderFlag defines a beacon node REST API endpoint.
 	BeaconRESTApiProviderFlag = &cli.StringFlag{
 		Name:  "beacon-rest-api-provider",
-		Usage: "Beacon node REST API provider endpoint",
+		Usage: "Beacon node REST API provider endpoint. Multiple comma separated endpoints fail over to the next healthy beacon node",
 		Value: "http://127.0.0.1:3500",
 	}
+	// BeaconRESTApiBroadcastFlag broadcasts blocks and attestations to all the beacon nodes of the REST API provider flag.
+	BeaconRESTApiBroadcastFlag = &cli.BoolFlag{
+		Name:  "beacon-rest-api-broadcast",
+		Usage: "Broadcasts blocks and attestations to all the healthy beacon nodes of --beacon-rest-api-provider instead of only the current one",
+	}
 	// CertFlag defines a flag for the node's TLS certificate.
 	CertFlag = &cli.StringFlag{
 		Name:  "tls-cert",
@@ -253,6 +258,11 @@ var (
 		Name:  "slashing-protection-json-file",
 		Usage: "Path to an EIP-3076 compliant JSON file containing a user's slashing protection history",
 	}
+	// SlashingProtectionJSONFilesFlag is used to enter the file paths of the slashing protection JSON files to merge.
+	SlashingProtectionJSONFilesFlag = &cli.StringSliceFlag{
+		Name:  "slashing-protection-json-files",
+		Usage: "Comma separated paths to EIP-3076 compliant JSON files containing slashing protection history to merge",
+	}
 	// KeysDirFlag defines the path for a directory where keystores to be imported at stored.
 	KeysDirFlag = &cli.StringFlag{
 		Name:  "keys-dir",
@@ -298,6 +308,28 @@ var (
 		Usage: "comma separated list of public keys OR an external url endpoint for the validator to retrieve public keys from for usage with web3signer",
 	}
 
+	// Web3SignerBatchSigningFlag enables signing messages in batches with the remote signer, which greatly
+	// reduces the number of requests made by validator clients running many keys.
+	Web3SignerBatchSigningFlag = &cli.BoolFlag{
+		Name:  "validators-external-signer-batch-signing",
+		Usage: "Sign messages in batches using the /api/v2/zond/sign/batch endpoint of the remote signer",
+	}
+	// Web3SignerTLSCertFlag defines the client certificate presented to a remote signer requiring mutual TLS.
+	Web3SignerTLSCertFlag = &cli.StringFlag{
+		Name:  "validators-external-signer-tls-cert",
+		Usage: "Path to the client certificate presented to the remote signer when it requires mutual TLS",
+	}
+	// Web3SignerTLSKeyFlag defines the key of the client certificate presented to a remote signer.
+	Web3SignerTLSKeyFlag = &cli.StringFlag{
+		Name:  "validators-external-signer-tls-key",
+		Usage: "Path to the key of the client certificate presented to the remote signer",
+	}
+	// Web3SignerTLSCACertFlag defines the certificate authority used to verify the remote signer.
+	Web3SignerTLSCACertFlag = &cli.StringFlag{
+		Name:  "validators-external-signer-tls-ca-cert",
+		Usage: "Path to the certificate authority used to verify the certificate of the remote signer, defaults to the system certificate authorities",
+	}
+
 	// KeymanagerKindFlag defines the kind of keymanager desired by a user during wallet creation.
 	KeymanagerKindFlag = &cli.StringFlag{
 		Name:  "keymanager-kind",
@@ -0,0 +1,80 @@
+package historycmd
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/v4/cmd"
+	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
+	"github.com/theQRL/qrysm/v4/io/file"
+	"github.com/theQRL/qrysm/v4/validator/accounts/userprompt"
+	"github.com/theQRL/qrysm/v4/validator/db/kv"
+	slashingprotection "github.com/theQRL/qrysm/v4/validator/slashing-protection-history"
+	"github.com/urfave/cli/v2"
+)
+
+// Compares an input slashing protection EIP-3076 standard JSON file with the
+// slashing protection history of the validator DB, logging the public keys
+// whose highest signing history differs.
+//
+// Steps:
+// 1. Parse a path to the validator's datadir from the CLI context.
+// 2. Open the validator database.
+// 3. Read the JSON file from user input.
+// 4. Compare the highest signing history of each public key of the file and of the database.
+func diffSlashingProtectionJSON(cliCtx *cli.Context) error {
+	var err error
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	if !cliCtx.IsSet(cmd.DataDirFlag.Name) {
+		dataDir, err = userprompt.InputDirectory(cliCtx, userprompt.DataDirDirPromptText, cmd.DataDirFlag)
+		if err != nil {
+			return errors.Wrapf(err, "could not read directory value from input")
+		}
+	}
+	// ensure that the validator.db is found under the specified dir or its subdirectories
+	found, _, err := file.RecursiveFileFind(kv.ProtectionDbFileName, dataDir)
+	if err != nil {
+		return errors.Wrapf(err, "error finding validator database at path %s", dataDir)
+	}
+	if !found {
+		return fmt.Errorf("validator.db file (validator database) was not found at path %s", dataDir)
+	}
+	protectionFilePath, err := inputSlashingProtectionJSONFile(cliCtx)
+	if err != nil {
+		return err
+	}
+	interchangeJSON, err := readSlashingProtectionJSON(protectionFilePath)
+	if err != nil {
+		return err
+	}
+
+	validatorDB, err := kv.NewKVStore(cliCtx.Context, dataDir, &kv.Config{})
+	if err != nil {
+		return errors.Wrapf(err, "could not access validator database at path %s", dataDir)
+	}
+	defer func() {
+		if err := validatorDB.Close(); err != nil {
+			log.WithError(err).Errorf("Could not close validator DB")
+		}
+	}()
+	diffs, err := slashingprotection.DiffStandardProtectionJSON(cliCtx.Context, validatorDB, interchangeJSON)
+	if err != nil {
+		return errors.Wrap(err, "could not compare slashing protection file with the validator database")
+	}
+	if len(diffs) == 0 {
+		log.Infof("Slashing protection file %s holds the same signing history as the validator database", protectionFilePath)
+		return nil
+	}
+	for _, diff := range diffs {
+		fileHistory, dbHistory := "not in file", "not in database"
+		if diff.File != nil {
+			fileHistory = diff.File.String()
+		}
+		if diff.DB != nil {
+			dbHistory = diff.DB.String()
+		}
+		fmt.Printf("%#x\n  file:     %s\n  database: %s\n", bytesutil.Trunc(diff.PubKey[:]), fileHistory, dbHistory)
+	}
+	log.Infof("Found %d public keys whose signing history differs between %s and the validator database", len(diffs), protectionFilePath)
+	return nil
+}
@@ -2,11 +2,9 @@ package historycmd
 
 import (
 	"bytes"
-	"fmt"
 
 	"github.com/pkg/errors"
 	"github.com/theQRL/qrysm/v4/cmd"
-	"github.com/theQRL/qrysm/v4/cmd/validator/flags"
 	"github.com/theQRL/qrysm/v4/io/file"
 	"github.com/theQRL/qrysm/v4/validator/accounts/userprompt"
 	"github.com/theQRL/qrysm/v4/validator/db/kv"
@@ -54,16 +52,9 @@ func importSlashingProtectionJSON(cliCtx *cli.Context) error {
 			log.WithError(err).Errorf("Could not close validator DB")
 		}
 	}()
-	protectionFilePath, err := userprompt.InputDirectory(cliCtx, userprompt.SlashingProtectionJSONPromptText, flags.SlashingProtectionJSONFileFlag)
+	protectionFilePath, err := inputSlashingProtectionJSONFile(cliCtx)
 	if err != nil {
-		return errors.Wrap(err, "could not get slashing protection json file")
-	}
-	if protectionFilePath == "" {
-		return fmt.Errorf(
-			"no path to a slashing_protection.json file specified, please retry or "+
-				"you can also specify it with the %s flag",
-			flags.SlashingProtectionJSONFileFlag.Name,
-		)
+		return err
 	}
 	enc, err := file.ReadFileAsBytes(protectionFilePath)
 	if err != nil {
@@ -0,0 +1,80 @@
+package historycmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/v4/cmd/validator/flags"
+	"github.com/theQRL/qrysm/v4/io/file"
+	"github.com/theQRL/qrysm/v4/validator/accounts/userprompt"
+	slashingprotection "github.com/theQRL/qrysm/v4/validator/slashing-protection-history"
+	"github.com/theQRL/qrysm/v4/validator/slashing-protection-history/format"
+	"github.com/urfave/cli/v2"
+)
+
+// Merges several slashing protection EIP-3076 standard JSON files into a single
+// file, keeping the highest signing history of each public key.
+//
+// Steps:
+// 1. Read and validate each of the JSON files.
+// 2. Merge them into a single EIP standard slashing protection format.
+// 3. Format and save the JSON file to a user's specified output directory.
+func mergeSlashingProtectionJSON(cliCtx *cli.Context) error {
+	protectionFilePaths := cliCtx.StringSlice(flags.SlashingProtectionJSONFilesFlag.Name)
+	if len(protectionFilePaths) < 2 {
+		return fmt.Errorf("at least two slashing protection files must be specified with the %s flag", flags.SlashingProtectionJSONFilesFlag.Name)
+	}
+	interchangeJSONs := make([]*format.EIPSlashingProtectionFormat, len(protectionFilePaths))
+	for i, protectionFilePath := range protectionFilePaths {
+		interchangeJSON, err := readSlashingProtectionJSON(protectionFilePath)
+		if err != nil {
+			return err
+		}
+		interchangeJSONs[i] = interchangeJSON
+	}
+	merged, err := slashingprotection.MergeStandardProtectionJSON(cliCtx.Context, interchangeJSONs...)
+	if err != nil {
+		return errors.Wrap(err, "could not merge slashing protection files")
+	}
+
+	outputDir, err := userprompt.InputDirectory(
+		cliCtx,
+		"Enter your desired output directory for your merged slashing protection history file",
+		flags.SlashingProtectionExportDirFlag,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not get output directory")
+	}
+	if outputDir == "" {
+		return errors.New("output directory not specified")
+	}
+	exists, err := file.HasDir(outputDir)
+	if err != nil {
+		return errors.Wrapf(err, "could not check if output directory %s already exists", outputDir)
+	}
+	if !exists {
+		if err := file.MkdirAll(outputDir); err != nil {
+			return errors.Wrapf(err, "could not create output directory %s", outputDir)
+		}
+	}
+	outputFilePath := filepath.Join(outputDir, jsonExportFileName)
+	if file.FileExists(outputFilePath) {
+		return fmt.Errorf("file %s already exists, please choose another output directory", outputFilePath)
+	}
+	encoded, err := json.MarshalIndent(merged, "", "\t")
+	if err != nil {
+		return errors.Wrap(err, "could not JSON marshal merged slashing protection history")
+	}
+	if err := file.WriteFile(outputFilePath, encoded); err != nil {
+		return errors.Wrapf(err, "could not write file to path %s", outputFilePath)
+	}
+	log.Infof(
+		"Successfully merged %d slashing protection files holding the history of %d public keys into %s",
+		len(protectionFilePaths),
+		len(merged.Data),
+		outputFilePath,
+	)
+	return nil
+}
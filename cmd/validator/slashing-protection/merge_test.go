@@ -0,0 +1,58 @@
+package historycmd
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/qrysm/v4/cmd/validator/flags"
+	"github.com/theQRL/qrysm/v4/io/file"
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+	"github.com/theQRL/qrysm/v4/validator/slashing-protection-history/format"
+	"github.com/urfave/cli/v2"
+)
+
+func TestMergeSlashingProtectionCli(t *testing.T) {
+	dir := t.TempDir()
+	outputDir := filepath.Join(dir, "merged")
+	pubKey := fmt.Sprintf("%#x", [dilithium2.CryptoPublicKeyBytes]byte{1})
+
+	protectionFilePaths := make([]string, 2)
+	for i, slot := range []string{"5", "7"} {
+		interchangeJSON := &format.EIPSlashingProtectionFormat{
+			Data: []*format.ProtectionData{{
+				Pubkey:       pubKey,
+				SignedBlocks: []*format.SignedBlock{{Slot: slot}},
+			}},
+		}
+		interchangeJSON.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+		interchangeJSON.Metadata.GenesisValidatorsRoot = fmt.Sprintf("%#x", [32]byte{1})
+		encoded, err := json.Marshal(interchangeJSON)
+		require.NoError(t, err)
+		protectionFilePaths[i] = filepath.Join(dir, fmt.Sprintf("slashing_protection_%d.json", i))
+		require.NoError(t, file.WriteFile(protectionFilePaths[i], encoded))
+	}
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	require.NoError(t, (&cli.StringSliceFlag{Name: flags.SlashingProtectionJSONFilesFlag.Name}).Apply(set))
+	set.String(flags.SlashingProtectionExportDirFlag.Name, outputDir, "")
+	require.NoError(t, set.Set(flags.SlashingProtectionJSONFilesFlag.Name, protectionFilePaths[0]+","+protectionFilePaths[1]))
+	require.NoError(t, set.Set(flags.SlashingProtectionExportDirFlag.Name, outputDir))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	require.NoError(t, mergeSlashingProtectionJSON(cliCtx))
+	enc, err := file.ReadFileAsBytes(filepath.Join(outputDir, jsonExportFileName))
+	require.NoError(t, err)
+	merged := &format.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal(enc, merged))
+	require.Equal(t, 1, len(merged.Data))
+	assert.DeepEqual(t, []*format.SignedBlock{{Slot: "7"}}, merged.Data[0].SignedBlocks)
+
+	// The merged file is not overwritten.
+	assert.ErrorContains(t, "already exists", mergeSlashingProtectionJSON(cliCtx))
+}
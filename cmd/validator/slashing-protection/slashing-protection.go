@@ -72,5 +72,88 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name:        "validate",
+			Description: `validates a selected EIP-3076 compliant slashing protection JSON is well formed for Dilithium keys and free of slashable history`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.SlashingProtectionJSONFileFlag,
+				features.Mainnet,
+				features.PraterTestnet,
+				features.SepoliaTestnet,
+				features.HoleskyTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := features.ConfigureValidator(cliCtx); err != nil {
+					return err
+				}
+				if err := validateSlashingProtectionJSON(cliCtx); err != nil {
+					logrus.Fatalf("Slashing protection file is not valid: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "merge",
+			Description: `merges several EIP-3076 compliant slashing protection JSON files into one, keeping the highest signing history of each key`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.SlashingProtectionJSONFilesFlag,
+				flags.SlashingProtectionExportDirFlag,
+				features.Mainnet,
+				features.PraterTestnet,
+				features.SepoliaTestnet,
+				features.HoleskyTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := features.ConfigureValidator(cliCtx); err != nil {
+					return err
+				}
+				if err := mergeSlashingProtectionJSON(cliCtx); err != nil {
+					logrus.Fatalf("Could not merge slashing protection files: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "diff",
+			Description: `compares a selected EIP-3076 compliant slashing protection JSON with the validator database`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.SlashingProtectionJSONFileFlag,
+				features.Mainnet,
+				features.PraterTestnet,
+				features.SepoliaTestnet,
+				features.HoleskyTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := features.ConfigureValidator(cliCtx); err != nil {
+					return err
+				}
+				if err := diffSlashingProtectionJSON(cliCtx); err != nil {
+					logrus.Fatalf("Could not compare slashing protection file: %v", err)
+				}
+				return nil
+			},
+		},
 	},
 }
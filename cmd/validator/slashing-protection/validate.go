@@ -0,0 +1,63 @@
+package historycmd
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/qrysm/v4/cmd/validator/flags"
+	"github.com/theQRL/qrysm/v4/io/file"
+	"github.com/theQRL/qrysm/v4/validator/accounts/userprompt"
+	slashingprotection "github.com/theQRL/qrysm/v4/validator/slashing-protection-history"
+	"github.com/theQRL/qrysm/v4/validator/slashing-protection-history/format"
+	"github.com/urfave/cli/v2"
+)
+
+// Checks an input slashing protection EIP-3076 standard JSON file is well formed
+// and does not contain slashable signing history, logging every problem found.
+func validateSlashingProtectionJSON(cliCtx *cli.Context) error {
+	protectionFilePath, err := inputSlashingProtectionJSONFile(cliCtx)
+	if err != nil {
+		return err
+	}
+	interchangeJSON, err := readSlashingProtectionJSON(protectionFilePath)
+	if err != nil {
+		return err
+	}
+	problems := slashingprotection.ValidateStandardProtectionJSON(cliCtx.Context, interchangeJSON)
+	for _, problem := range problems {
+		log.Error(problem)
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("found %d problems in slashing protection file %s", len(problems), protectionFilePath)
+	}
+	log.Infof("Slashing protection file %s is valid and holds the history of %d public keys", protectionFilePath, len(interchangeJSON.Data))
+	return nil
+}
+
+func inputSlashingProtectionJSONFile(cliCtx *cli.Context) (string, error) {
+	protectionFilePath, err := userprompt.InputDirectory(cliCtx, userprompt.SlashingProtectionJSONPromptText, flags.SlashingProtectionJSONFileFlag)
+	if err != nil {
+		return "", errors.Wrap(err, "could not get slashing protection json file")
+	}
+	if protectionFilePath == "" {
+		return "", fmt.Errorf(
+			"no path to a slashing_protection.json file specified, please retry or "+
+				"you can also specify it with the %s flag",
+			flags.SlashingProtectionJSONFileFlag.Name,
+		)
+	}
+	return protectionFilePath, nil
+}
+
+func readSlashingProtectionJSON(protectionFilePath string) (*format.EIPSlashingProtectionFormat, error) {
+	enc, err := file.ReadFileAsBytes(protectionFilePath)
+	if err != nil {
+		return nil, err
+	}
+	interchangeJSON, err := slashingprotection.ParseStandardProtectionJSON(bytes.NewBuffer(enc))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not parse slashing protection file %s", protectionFilePath)
+	}
+	return interchangeJSON, nil
+}
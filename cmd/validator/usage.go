@@ -49,6 +49,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.VerbosityFlag,
 			cmd.DataDirFlag,
 			cmd.ClearDB,
+			cmd.CompactDB,
 			cmd.ForceClearDB,
 			cmd.EnableBackupWebhookFlag,
 			cmd.BackupWebhookOutputDir,
@@ -87,6 +88,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.BeaconRPCProviderFlag,
 			flags.BeaconRPCGatewayProviderFlag,
 			flags.BeaconRESTApiProviderFlag,
+			flags.BeaconRESTApiBroadcastFlag,
 			flags.CertFlag,
 			flags.EnableWebFlag,
 			flags.DisablePenaltyRewardLogFlag,
@@ -108,6 +110,10 @@ var appHelpFlagGroups = []flagGroup{
 			flags.GraffitiFileFlag,
 			flags.Web3SignerURLFlag,
 			flags.Web3SignerPublicValidatorKeysFlag,
+			flags.Web3SignerBatchSigningFlag,
+			flags.Web3SignerTLSCertFlag,
+			flags.Web3SignerTLSKeyFlag,
+			flags.Web3SignerTLSCACertFlag,
 			flags.ProposerSettingsFlag,
 			flags.ProposerSettingsURLFlag,
 			flags.SuggestedFeeRecipientFlag,
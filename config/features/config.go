@@ -59,6 +59,7 @@ type Flags struct {
 	EnableSlashingProtectionPruning bool // EnableSlashingProtectionPruning for the validator client.
 
 	SaveFullExecutionPayloads bool // Save full beacon blocks with execution payloads in the database.
+	BlindedBlockStorage       bool // BlindedBlockStorage saves all new beacon blocks as blinded blocks, even in a database holding full blocks.
 	EnableStartOptimistic     bool // EnableStartOptimistic treats every block as optimistic at startup.
 
 	DisableResourceManager     bool // Disables running the node with libp2p's resource manager.
@@ -72,12 +73,20 @@ type Flags struct {
 
 	AggregateParallel bool // AggregateParallel aggregates attestations in parallel.
 
+	EnableVerifiedSignatureCache bool // EnableVerifiedSignatureCache skips verification of signatures which have already been verified.
+	EnableLightClient            bool // EnableLightClient enables the light client server: light client updates are computed, stored, served over REST and gossiped.
+	EnableStateDiffs             bool // EnableStateDiffs stores the archived states between full snapshots as diffs against the snapshot.
+	EnableForkChoicePersistence  bool // EnableForkChoicePersistence saves the fork choice store every epoch and restores it on restart.
+
 	// KeystoreImportDebounceInterval specifies the time duration the validator waits to reload new keys if they have
 	// changed on disk. This feature is for advanced use cases only.
 	KeystoreImportDebounceInterval time.Duration
 
 	// AggregateIntervals specifies the time durations at which we aggregate attestations preparing for forkchoice.
 	AggregateIntervals [3]time.Duration
+
+	// VerifiedSignatureCacheSize bounds the number of signatures remembered when EnableVerifiedSignatureCache is set.
+	VerifiedSignatureCacheSize int
 }
 
 var featureConfig *Flags
@@ -221,6 +230,10 @@ func ConfigureBeaconChain(ctx *cli.Context) error {
 		logEnabled(SaveFullExecutionPayloads)
 		cfg.SaveFullExecutionPayloads = true
 	}
+	if ctx.Bool(BlindedBlockStorage.Name) {
+		logEnabled(BlindedBlockStorage)
+		cfg.BlindedBlockStorage = true
+	}
 	if ctx.Bool(enableStartupOptimistic.Name) {
 		logEnabled(enableStartupOptimistic)
 		cfg.EnableStartOptimistic = true
@@ -233,6 +246,23 @@ func ConfigureBeaconChain(ctx *cli.Context) error {
 		logEnabled(enableVerboseSigVerification)
 		cfg.EnableVerboseSigVerification = true
 	}
+	if ctx.IsSet(enableVerifiedSignatureCache.Name) {
+		logEnabled(enableVerifiedSignatureCache)
+		cfg.EnableVerifiedSignatureCache = true
+	}
+	cfg.VerifiedSignatureCacheSize = ctx.Int(verifiedSignatureCacheSize.Name)
+	if ctx.IsSet(enableLightClient.Name) {
+		logEnabled(enableLightClient)
+		cfg.EnableLightClient = true
+	}
+	if ctx.IsSet(enableStateDiffs.Name) {
+		logEnabled(enableStateDiffs)
+		cfg.EnableStateDiffs = true
+	}
+	if ctx.IsSet(enableForkChoicePersistence.Name) {
+		logEnabled(enableForkChoicePersistence)
+		cfg.EnableForkChoicePersistence = true
+	}
 	cfg.EnableOptionalEngineMethods = true
 	if ctx.IsSet(disableOptionalEngineMethods.Name) {
 		logEnabled(disableOptionalEngineMethods)
@@ -6,6 +6,10 @@ import (
 	"github.com/urfave/cli/v2"
 )
 
+// DefaultVerifiedSignatureCacheSize is the default number of signatures remembered by the verified signature cache.
+// Each entry only holds hashes, so this comfortably covers several epochs of unaggregated attestations.
+const DefaultVerifiedSignatureCacheSize = 1 << 20
+
 var (
 	// PraterTestnet flag for the multiclient Ethereum consensus testnet.
 	PraterTestnet = &cli.BoolFlag{
@@ -128,6 +132,13 @@ var (
 		Name:  "save-full-execution-payloads",
 		Usage: "Saves beacon blocks with full execution payloads instead of execution payload headers in the database",
 	}
+	BlindedBlockStorage = &cli.BoolFlag{
+		Name: "blinded-block-storage",
+		Usage: "Saves all new beacon blocks with execution payload headers instead of full execution payloads in the database, " +
+			"even if it already holds full blocks. Full blocks are reconstructed from the execution client when served to peers and APIs. " +
+			"The switch is one-way: the database keeps saving blinded blocks once the flag is removed, and can no longer be used with " +
+			"--save-full-execution-payloads",
+	}
 	EnableBeaconRESTApi = &cli.BoolFlag{
 		Name:  "enable-beacon-rest-api",
 		Usage: "Experimental enable of the beacon REST API when querying a beacon node",
@@ -136,6 +147,31 @@ var (
 		Name:  "enable-verbose-sig-verification",
 		Usage: "Enables identifying invalid signatures if batch verification fails when processing block",
 	}
+	enableVerifiedSignatureCache = &cli.BoolFlag{
+		Name: "enable-verified-signature-cache",
+		Usage: "Enables a cache of verified signatures, so that a signature seen at gossip time is not verified again " +
+			"when it is included in an aggregate or a block",
+	}
+	verifiedSignatureCacheSize = &cli.IntFlag{
+		Name:  "verified-signature-cache-size",
+		Usage: "The maximum number of signatures remembered by the cache of --enable-verified-signature-cache",
+		Value: DefaultVerifiedSignatureCacheSize,
+	}
+	enableLightClient = &cli.BoolFlag{
+		Name: "enable-lightclient",
+		Usage: "Enables the light client server, which computes, stores and serves light client updates " +
+			"over the beacon API and gossips them to peers",
+	}
+	enableStateDiffs = &cli.BoolFlag{
+		Name: "enable-state-diffs",
+		Usage: "Stores the archived states between full snapshots, taken every --state-diff-snapshot-epochs epochs, " +
+			"as field-level diffs against the snapshot. Archived states saved in full are converted at startup",
+	}
+	enableForkChoicePersistence = &cli.BoolFlag{
+		Name: "enable-forkchoice-persistence",
+		Usage: "Saves the fork choice store in the database every epoch and on shutdown, and restores it on restart " +
+			"instead of processing the non-finalized blocks again",
+	}
 	disableOptionalEngineMethods = &cli.BoolFlag{
 		Name:  "disable-optional-engine-methods",
 		Usage: "Disables the optional engine methods",
@@ -207,9 +243,15 @@ var BeaconChainFlags = append(deprecatedBeaconFlags, append(deprecatedFlags, []c
 	disableStakinContractCheck,
 	disableReorgLateBlocks,
 	SaveFullExecutionPayloads,
+	BlindedBlockStorage,
 	enableStartupOptimistic,
 	enableFullSSZDataLogging,
 	enableVerboseSigVerification,
+	enableVerifiedSignatureCache,
+	verifiedSignatureCacheSize,
+	enableLightClient,
+	enableStateDiffs,
+	enableForkChoicePersistence,
 	disableOptionalEngineMethods,
 	prepareAllPayloads,
 	aggregateFirstInterval,
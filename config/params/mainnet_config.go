@@ -45,6 +45,7 @@ var mainnetNetworkConfig = &NetworkConfig{
 	MinimumPeersInSubnetSearch:       20,
 	ContractDeploymentBlock:          11184524, // Note: contract was deployed in block 11052984 but no transactions were sent until 11184524.
 	MinEpochsForBlobsSidecarsRequest: 4096,
+	MinEpochsForBlockRequests:        33024, // MIN_VALIDATOR_WITHDRAWABILITY_DELAY + CHURN_LIMIT_QUOTIENT / 2
 	MaxRequestBlobSidecars:           768,
 	MaxRequestBlocksDeneb:            128,
 	BootstrapNodes: []string{
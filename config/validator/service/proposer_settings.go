@@ -18,17 +18,23 @@ func ToSettings(ps *validatorpb.ProposerSettingsPayload) (*ProposerSettings, err
 	if ps.ProposerConfig != nil {
 		settings.ProposeConfig = make(map[[dilithium2.CryptoPublicKeyBytes]byte]*ProposerOption)
 		for key, optionPayload := range ps.ProposerConfig {
-			if optionPayload.FeeRecipient == "" {
+			if optionPayload.FeeRecipient == "" && optionPayload.Graffiti == "" {
 				continue
 			}
 			b, err := hexutil.Decode(key)
 			if err != nil {
 				return nil, errors.Wrap(err, fmt.Sprintf("cannot decode public key %s", key))
 			}
-			p := &ProposerOption{
-				FeeRecipientConfig: &FeeRecipientConfig{
+			p := &ProposerOption{}
+			if optionPayload.FeeRecipient != "" {
+				p.FeeRecipientConfig = &FeeRecipientConfig{
 					FeeRecipient: common.HexToAddress(optionPayload.FeeRecipient),
-				},
+				}
+			}
+			if optionPayload.Graffiti != "" {
+				p.GraffitiConfig = &GraffitiConfig{
+					Graffiti: optionPayload.Graffiti,
+				}
 			}
 			if optionPayload.Builder != nil {
 				p.BuilderConfig = ToBuilderConfig(optionPayload.Builder)
@@ -46,6 +52,11 @@ func ToSettings(ps *validatorpb.ProposerSettingsPayload) (*ProposerSettings, err
 		if ps.DefaultConfig.Builder != nil {
 			d.BuilderConfig = ToBuilderConfig(ps.DefaultConfig.Builder)
 		}
+		if ps.DefaultConfig.Graffiti != "" {
+			d.GraffitiConfig = &GraffitiConfig{
+				Graffiti: ps.DefaultConfig.Graffiti,
+			}
+		}
 		settings.DefaultConfig = d
 	}
 	return settings, nil
@@ -109,6 +120,9 @@ func (ps *ProposerSettings) ToPayload() *validatorpb.ProposerSettingsPayload {
 			if option.BuilderConfig != nil {
 				p.Builder = option.BuilderConfig.ToPayload()
 			}
+			if option.GraffitiConfig != nil {
+				p.Graffiti = option.GraffitiConfig.Graffiti
+			}
 			payload.ProposerConfig[hexutil.Encode(key[:])] = p
 		}
 	}
@@ -120,6 +134,9 @@ func (ps *ProposerSettings) ToPayload() *validatorpb.ProposerSettingsPayload {
 		if ps.DefaultConfig.BuilderConfig != nil {
 			p.Builder = ps.DefaultConfig.BuilderConfig.ToPayload()
 		}
+		if ps.DefaultConfig.GraffitiConfig != nil {
+			p.Graffiti = ps.DefaultConfig.GraffitiConfig.Graffiti
+		}
 		payload.DefaultConfig = p
 	}
 	return payload
@@ -130,10 +147,16 @@ type FeeRecipientConfig struct {
 	FeeRecipient common.Address
 }
 
+// GraffitiConfig is a prysm internal representation of the graffiti set for the validator key through the keymanager API.
+type GraffitiConfig struct {
+	Graffiti string
+}
+
 // ProposerOption is a Prysm internal representation of the ProposerOptionPayload on the validator client in bytes format instead of hex.
 type ProposerOption struct {
 	FeeRecipientConfig *FeeRecipientConfig
 	BuilderConfig      *BuilderConfig
+	GraffitiConfig     *GraffitiConfig
 }
 
 // Clone creates a deep copy of the proposer settings
@@ -165,6 +188,14 @@ func (fo *FeeRecipientConfig) Clone() *FeeRecipientConfig {
 	return &FeeRecipientConfig{fo.FeeRecipient}
 }
 
+// Clone creates a deep copy of graffiti config
+func (gc *GraffitiConfig) Clone() *GraffitiConfig {
+	if gc == nil {
+		return nil
+	}
+	return &GraffitiConfig{gc.Graffiti}
+}
+
 // Clone creates a deep copy of builder config
 func (bc *BuilderConfig) Clone() *BuilderConfig {
 	if bc == nil {
@@ -211,5 +242,8 @@ func (po *ProposerOption) Clone() *ProposerOption {
 	if po.BuilderConfig != nil {
 		p.BuilderConfig = po.BuilderConfig.Clone()
 	}
+	if po.GraffitiConfig != nil {
+		p.GraffitiConfig = po.GraffitiConfig.Clone()
+	}
 	return p
 }
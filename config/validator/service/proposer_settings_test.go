@@ -97,6 +97,31 @@ func Test_Proposer_Setting_Cloning(t *testing.T) {
 		require.Equal(t, option.BuilderConfig.Enabled, option.BuilderConfig.Enabled)
 
 	})
+	t.Run("Graffiti To Payload and ToSettings", func(t *testing.T) {
+		graffitiSettings := settings.Clone()
+		option := graffitiSettings.ProposeConfig[bytesutil.ToBytes2592(key1)]
+		option.FeeRecipientConfig = nil
+		option.GraffitiConfig = &GraffitiConfig{Graffiti: "qrysm"}
+		clone := graffitiSettings.Clone()
+		require.DeepEqual(t, graffitiSettings, clone)
+		clone.ProposeConfig[bytesutil.ToBytes2592(key1)].GraffitiConfig.Graffiti = "zond"
+		require.Equal(t, "qrysm", option.GraffitiConfig.Graffiti)
+
+		payload := graffitiSettings.ToPayload()
+		key := bytesutil.ToBytes2592(key1)
+		potion, pok := payload.ProposerConfig[hexutil.Encode(key[:])]
+		require.Equal(t, true, pok)
+		require.Equal(t, "qrysm", potion.Graffiti)
+		require.Equal(t, "", potion.FeeRecipient)
+
+		// a graffiti is kept even if the fee recipient is not set
+		newSettings, err := ToSettings(payload)
+		require.NoError(t, err)
+		noption, ok := newSettings.ProposeConfig[bytesutil.ToBytes2592(key1)]
+		require.Equal(t, true, ok)
+		require.Equal(t, true, noption.FeeRecipientConfig == nil)
+		require.Equal(t, "qrysm", noption.GraffitiConfig.Graffiti)
+	})
 }
 
 func TestProposerSettings_ShouldBeSaved(t *testing.T) {
@@ -1,11 +1,13 @@
 package dilithium
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 
 	"github.com/pkg/errors"
 	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/qrysm/v4/config/features"
 )
 
 // AggregatedSignature represents aggregated signature produced by AggregateBatch()
@@ -41,8 +43,17 @@ func (s *SignatureBatch) Join(set *SignatureBatch) *SignatureBatch {
 }
 
 // Verify the current signature batch using the batch verify algorithm.
+// When the verified signature cache is enabled, signatures which were already
+// verified are skipped and newly verified signatures are added to the cache.
 func (s *SignatureBatch) Verify() (bool, error) {
-	return VerifyMultipleSignatures(s.Signatures, s.Messages, s.PublicKeys)
+	if !features.Get().EnableVerifiedSignatureCache {
+		return VerifyMultipleSignatures(s.Signatures, s.Messages, s.PublicKeys)
+	}
+	valid, err := s.VerifyParallel(context.Background(), SignatureVerificationWorkers())
+	if errors.Is(err, ErrInvalidSignature) {
+		return false, nil
+	}
+	return valid, err
 }
 
 // VerifyVerbosely verifies signatures as a whole at first, if fails, fallback
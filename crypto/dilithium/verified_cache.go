@@ -0,0 +1,70 @@
+package dilithium
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	lruwrpr "github.com/theQRL/qrysm/v4/cache/lru"
+	"github.com/theQRL/qrysm/v4/config/features"
+	"github.com/theQRL/qrysm/v4/crypto/hash"
+)
+
+// verifiedSignatureCache remembers the verified signatures, up to features.DefaultVerifiedSignatureCacheSize
+// unless resized with SetVerifiedSignatureCacheSize.
+var verifiedSignatureCache = lruwrpr.New(features.DefaultVerifiedSignatureCacheSize)
+
+var (
+	verifiedSignatureCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "verified_signature_cache_hit",
+		Help: "The number of signature verifications skipped because the signature was already verified.",
+	})
+	verifiedSignatureCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "verified_signature_cache_miss",
+		Help: "The number of signature verifications which were not found in the verified signature cache.",
+	})
+)
+
+// SetVerifiedSignatureCacheSize bounds the number of verified signatures remembered by the cache. It is set
+// once at startup from the node configuration; a size <= 0 keeps the current bound.
+func SetVerifiedSignatureCacheSize(size int) {
+	if size <= 0 {
+		return
+	}
+	verifiedSignatureCache.Resize(size)
+}
+
+// verifiedSignatureKey identifies a single verified signature. The public key and signature are hashed
+// with a cryptographic hash function so that entries stay small and cannot be forged by a peer.
+type verifiedSignatureKey struct {
+	pubkey    [32]byte
+	root      [32]byte
+	signature [32]byte
+}
+
+func newVerifiedSignatureKey(sig []byte, msg [32]byte, pubKey PublicKey) verifiedSignatureKey {
+	return verifiedSignatureKey{
+		pubkey:    hash.Hash(pubKey.Marshal()),
+		root:      msg,
+		signature: hash.Hash(sig),
+	}
+}
+
+// verifySignatureCached verifies a signature, skipping the cryptographic check if the same signature
+// over the same signing root by the same public key has already been verified. Only valid signatures
+// are cached; invalid signatures are checked every time they are seen.
+func verifySignatureCached(sig []byte, msg [32]byte, pubKey PublicKey) (bool, error) {
+	if !features.Get().EnableVerifiedSignatureCache {
+		return VerifySignature(sig, msg, pubKey)
+	}
+	key := newVerifiedSignatureKey(sig, msg, pubKey)
+	if verifiedSignatureCache.Contains(key) {
+		verifiedSignatureCacheHit.Inc()
+		return true, nil
+	}
+	verifiedSignatureCacheMiss.Inc()
+	valid, err := VerifySignature(sig, msg, pubKey)
+	if err != nil || !valid {
+		return valid, err
+	}
+	verifiedSignatureCache.Add(key, true)
+	return true, nil
+}
@@ -0,0 +1,88 @@
+package dilithium
+
+import (
+	"testing"
+
+	"github.com/theQRL/qrysm/v4/config/features"
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+)
+
+func TestVerifySignatureCached(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableVerifiedSignatureCache: true})
+	defer resetCfg()
+	verifiedSignatureCache.Purge()
+
+	set := testBatch(t, 2)
+	sig, msg, pub := set.Signatures[0], set.Messages[0], set.PublicKeys[0][0]
+	key := newVerifiedSignatureKey(sig, msg, pub)
+	assert.Equal(t, false, verifiedSignatureCache.Contains(key))
+
+	valid, err := verifySignatureCached(sig, msg, pub)
+	require.NoError(t, err)
+	assert.Equal(t, true, valid)
+	assert.Equal(t, true, verifiedSignatureCache.Contains(key))
+
+	// Invalid signatures are not cached.
+	wrong := [32]byte{0xff}
+	valid, err = verifySignatureCached(sig, wrong, pub)
+	require.NoError(t, err)
+	assert.Equal(t, false, valid)
+	assert.Equal(t, false, verifiedSignatureCache.Contains(newVerifiedSignatureKey(sig, wrong, pub)))
+
+	// The same signature by a different key is not a cache hit.
+	other := set.PublicKeys[1][0]
+	valid, err = verifySignatureCached(sig, msg, other)
+	require.NoError(t, err)
+	assert.Equal(t, false, valid)
+}
+
+func TestSignatureBatch_Verify_PopulatesCache(t *testing.T) {
+	set := testBatch(t, 4)
+
+	t.Run("disabled", func(t *testing.T) {
+		resetCfg := features.InitWithReset(&features.Flags{})
+		defer resetCfg()
+		verifiedSignatureCache.Purge()
+		valid, err := set.Verify()
+		require.NoError(t, err)
+		assert.Equal(t, true, valid)
+		assert.Equal(t, 0, verifiedSignatureCache.Len())
+	})
+	t.Run("enabled", func(t *testing.T) {
+		resetCfg := features.InitWithReset(&features.Flags{EnableVerifiedSignatureCache: true})
+		defer resetCfg()
+		verifiedSignatureCache.Purge()
+		valid, err := set.Verify()
+		require.NoError(t, err)
+		assert.Equal(t, true, valid)
+		assert.Equal(t, len(set.Signatures), verifiedSignatureCache.Len())
+
+		bad := set.Copy()
+		bad.Messages[2] = [32]byte{0xff}
+		valid, err = bad.Verify()
+		require.NoError(t, err)
+		assert.Equal(t, false, valid)
+	})
+}
+
+func TestSetVerifiedSignatureCacheSize(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableVerifiedSignatureCache: true})
+	defer resetCfg()
+	verifiedSignatureCache.Purge()
+	SetVerifiedSignatureCacheSize(1)
+	defer SetVerifiedSignatureCacheSize(features.DefaultVerifiedSignatureCacheSize)
+
+	set := testBatch(t, 2)
+	valid, err := set.Verify()
+	require.NoError(t, err)
+	assert.Equal(t, true, valid)
+	assert.Equal(t, 1, verifiedSignatureCache.Len())
+
+	// A size <= 0 keeps the current bound.
+	SetVerifiedSignatureCacheSize(0)
+	valid, err = set.Verify()
+	require.NoError(t, err)
+	assert.Equal(t, true, valid)
+	assert.Equal(t, 1, verifiedSignatureCache.Len())
+}
@@ -0,0 +1,171 @@
+package dilithium
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
+)
+
+// ErrInvalidSignature is returned, wrapped in a *SignatureVerificationError, when a signature in a batch
+// does not verify against its message and public key.
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// SignatureVerificationError reports the first signature in a batch which failed verification.
+// Index is the position of the failing signature set in the batch, and KeyIndex is the position of the
+// failing public key within that set. Because a set may carry one concatenated signature per public key,
+// both are needed to identify the exact signature that failed.
+type SignatureVerificationError struct {
+	Index       int
+	KeyIndex    int
+	Description string
+	Err         error
+}
+
+// Error implements the error interface.
+func (e *SignatureVerificationError) Error() string {
+	return fmt.Sprintf("signature '%s' at index %d, key index %d failed verification: %v",
+		e.Description, e.Index, e.KeyIndex, e.Err)
+}
+
+// Unwrap returns the underlying cause of the verification failure.
+func (e *SignatureVerificationError) Unwrap() error {
+	return e.Err
+}
+
+// signatureVerificationWorkers is the number of workers verifying a signature batch for the callers which do not
+// choose their own, see SetSignatureVerificationWorkers.
+var signatureVerificationWorkers atomic.Int64
+
+// SetSignatureVerificationWorkers sets the number of workers returned by SignatureVerificationWorkers. It is set
+// once at startup from the node configuration; a value <= 0 uses runtime.GOMAXPROCS(0) workers.
+func SetSignatureVerificationWorkers(workers int) {
+	signatureVerificationWorkers.Store(int64(workers))
+}
+
+// SignatureVerificationWorkers returns the configured number of workers verifying a signature batch, to be
+// passed to VerifyParallel.
+func SignatureVerificationWorkers() int {
+	return int(signatureVerificationWorkers.Load())
+}
+
+// verificationUnit identifies a single signature within a batch, ie. the signature
+// of a single public key within a signature set.
+type verificationUnit struct {
+	set int
+	key int
+}
+
+// VerifyParallel verifies every signature in the batch using a pool of worker goroutines. Dilithium signatures
+// cannot be aggregated, so each signature must be verified on its own; spreading them across cores is the only
+// way to reduce the time spent verifying a large batch. A workers value <= 0 uses runtime.GOMAXPROCS(0) workers.
+//
+// Verification stops early once a signature fails, and the returned *SignatureVerificationError always
+// identifies the failing signature with the lowest index in the batch, regardless of how work was scheduled.
+// If ctx is cancelled before all signatures are verified, the context error is returned.
+func (s *SignatureBatch) VerifyParallel(ctx context.Context, workers int) (bool, error) {
+	if len(s.Signatures) == 0 || len(s.PublicKeys) == 0 {
+		return false, nil
+	}
+	if len(s.Signatures) != len(s.PublicKeys) || len(s.Signatures) != len(s.Messages) {
+		return false, errors.Errorf("provided signatures, pubkeys and messages have differing lengths. S: %d, P: %d,M %d",
+			len(s.Signatures), len(s.PublicKeys), len(s.Messages))
+	}
+	units := make([]verificationUnit, 0, len(s.Signatures))
+	for i := range s.Signatures {
+		if len(s.Signatures[i]) != len(s.PublicKeys[i])*dilithium2.CryptoBytes {
+			return false, &SignatureVerificationError{
+				Index:       i,
+				Description: s.description(i),
+				Err: errors.Errorf("signature length %d does not match %d public keys",
+					len(s.Signatures[i]), len(s.PublicKeys[i])),
+			}
+		}
+		for j := range s.PublicKeys[i] {
+			units = append(units, verificationUnit{set: i, key: j})
+		}
+	}
+	if len(units) == 0 {
+		return false, nil
+	}
+
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > len(units) {
+		workers = len(units)
+	}
+
+	// next is the index of the next unit to be claimed by a worker. failed holds the lowest unit index
+	// known to have failed; units above it don't need to be verified, but units below it still do, so that
+	// the reported failure does not depend on scheduling.
+	var next int64 = -1
+	failed := int64(len(units))
+	errs := make([]error, len(units))
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for {
+				if ctx.Err() != nil {
+					return
+				}
+				u := atomic.AddInt64(&next, 1)
+				if u >= atomic.LoadInt64(&failed) {
+					return
+				}
+				if err := s.verifyUnit(units[u]); err != nil {
+					errs[u] = err
+					for {
+						f := atomic.LoadInt64(&failed)
+						if u >= f || atomic.CompareAndSwapInt64(&failed, f, u) {
+							break
+						}
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	// A cancelled context may have stopped workers before lower units were verified,
+	// so any failure found so far is not necessarily the lowest one.
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	if f := atomic.LoadInt64(&failed); f < int64(len(units)) {
+		u := units[f]
+		return false, &SignatureVerificationError{
+			Index:       u.set,
+			KeyIndex:    u.key,
+			Description: s.description(u.set),
+			Err:         errs[f],
+		}
+	}
+	return true, nil
+}
+
+func (s *SignatureBatch) verifyUnit(u verificationUnit) error {
+	offset := u.key * dilithium2.CryptoBytes
+	sig := s.Signatures[u.set][offset : offset+dilithium2.CryptoBytes]
+	valid, err := verifySignatureCached(sig, s.Messages[u.set], s.PublicKeys[u.set][u.key])
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+func (s *SignatureBatch) description(i int) string {
+	if i < len(s.Descriptions) {
+		return s.Descriptions[i]
+	}
+	return ""
+}
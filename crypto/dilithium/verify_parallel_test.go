@@ -0,0 +1,88 @@
+package dilithium
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+)
+
+func testBatch(t *testing.T, n int) *SignatureBatch {
+	set := NewSet()
+	for i := 0; i < n; i++ {
+		key, err := RandKey()
+		require.NoError(t, err)
+		msg := [32]byte{byte(i), 0x01}
+		set.Signatures = append(set.Signatures, key.Sign(msg[:]).Marshal())
+		set.PublicKeys = append(set.PublicKeys, []PublicKey{key.PublicKey()})
+		set.Messages = append(set.Messages, msg)
+		set.Descriptions = append(set.Descriptions, fmt.Sprintf("signature %d", i))
+	}
+	return set
+}
+
+func TestSignatureBatch_VerifyParallel(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("valid", func(t *testing.T) {
+		set := testBatch(t, 16)
+		for _, workers := range []int{0, 1, 3, 64} {
+			valid, err := set.VerifyParallel(ctx, workers)
+			require.NoError(t, err)
+			assert.Equal(t, true, valid)
+		}
+	})
+	t.Run("empty", func(t *testing.T) {
+		valid, err := NewSet().VerifyParallel(ctx, 4)
+		require.NoError(t, err)
+		assert.Equal(t, false, valid)
+	})
+	t.Run("lowest failing index is reported", func(t *testing.T) {
+		set := testBatch(t, 16)
+		set.Messages[5] = [32]byte{0xff}
+		set.Messages[11] = [32]byte{0xff}
+		for _, workers := range []int{1, 4, 16} {
+			valid, err := set.VerifyParallel(ctx, workers)
+			assert.Equal(t, false, valid)
+			require.ErrorIs(t, err, ErrInvalidSignature)
+			verr, ok := err.(*SignatureVerificationError)
+			require.Equal(t, true, ok)
+			assert.Equal(t, 5, verr.Index)
+			assert.Equal(t, 0, verr.KeyIndex)
+			assert.Equal(t, "signature 5", verr.Description)
+		}
+	})
+	t.Run("concatenated signatures", func(t *testing.T) {
+		set := testBatch(t, 3)
+		joined := NewSet()
+		joined.Signatures = [][]byte{append(append([]byte{}, set.Signatures[0]...), set.Signatures[1]...)}
+		joined.PublicKeys = [][]PublicKey{{set.PublicKeys[0][0], set.PublicKeys[2][0]}}
+		joined.Messages = [][32]byte{set.Messages[0]}
+		joined.Descriptions = []string{"joined"}
+		valid, err := joined.VerifyParallel(ctx, 2)
+		assert.Equal(t, false, valid)
+		verr, ok := err.(*SignatureVerificationError)
+		require.Equal(t, true, ok)
+		assert.Equal(t, 0, verr.Index)
+		assert.Equal(t, 1, verr.KeyIndex)
+	})
+	t.Run("signature length mismatch", func(t *testing.T) {
+		set := testBatch(t, 2)
+		set.Signatures[1] = set.Signatures[1][:10]
+		valid, err := set.VerifyParallel(ctx, 2)
+		assert.Equal(t, false, valid)
+		verr, ok := err.(*SignatureVerificationError)
+		require.Equal(t, true, ok)
+		assert.Equal(t, 1, verr.Index)
+	})
+	t.Run("cancelled context", func(t *testing.T) {
+		set := testBatch(t, 4)
+		cctx, cancel := context.WithCancel(ctx)
+		cancel()
+		valid, err := set.VerifyParallel(cctx, 2)
+		assert.Equal(t, false, valid)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -0,0 +1,420 @@
+// Package boltcompact rewrites bolt database files into fresh files holding only their live pages.
+//
+// Bolt reuses the pages freed by deletions but never shrinks its file, so databases that had a lot of
+// data removed keep their peak size on disk until they are copied into a new file.
+package boltcompact
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/theQRL/qrysm/v4/config/params"
+	"github.com/theQRL/qrysm/v4/io/file"
+	bolt "go.etcd.io/bbolt"
+)
+
+var log = logrus.WithField("prefix", "boltcompact")
+
+const (
+	// DefaultTxMaxSize is the default maximum size of the write transactions used to fill the new file.
+	DefaultTxMaxSize = 64 * 1024 * 1024
+	// DefaultProgressInterval is the default interval between two progress reports.
+	DefaultProgressInterval = 10 * time.Second
+	// compactedSuffix is appended to the database file name while its compacted copy is being written.
+	compactedSuffix = ".compact"
+)
+
+// ErrDatabaseLocked is returned when the database is held open by another process.
+var ErrDatabaseLocked = errors.New("cannot obtain database lock, database may be in use by another process")
+
+// Config defines how a database file is compacted.
+type Config struct {
+	// OutputPath is the file the compacted database is written to. When empty, the compacted database
+	// replaces the original file.
+	OutputPath string
+	// BackupDir is the directory the original file is copied to before being replaced. No backup is kept when
+	// empty. It is ignored when OutputPath is set, as the original file is left untouched.
+	BackupDir string
+	// TxMaxSize is the maximum size of the write transactions used to fill the new file.
+	TxMaxSize int
+	// ProgressInterval is the interval between two progress reports.
+	ProgressInterval time.Duration
+	// AllocSize is the amount of space allocated when the new file needs to grow.
+	AllocSize int
+	// Finalize, when set, is applied to the compacted database before it is closed.
+	Finalize func(tx *bolt.Tx) error
+}
+
+// Result summarizes a compaction.
+type Result struct {
+	Path       string
+	BackupPath string
+	SizeBefore int64
+	SizeAfter  int64
+	Keys       uint64
+	Duration   time.Duration
+}
+
+// CompactFile copies the live buckets of the closed bolt database at the given path into a new file, reporting
+// its progress along the way. Unless an output path is configured, the new file then replaces the original
+// one, after the original has been copied to the backup directory if one is configured.
+func CompactFile(ctx context.Context, datafile string, cfg *Config) (*Result, error) {
+	if cfg == nil {
+		cfg = &Config{}
+	}
+	if !file.FileExists(datafile) {
+		return nil, errors.Errorf("no database found at %s", datafile)
+	}
+	txMaxSize := cfg.TxMaxSize
+	if txMaxSize <= 0 {
+		txMaxSize = DefaultTxMaxSize
+	}
+	interval := cfg.ProgressInterval
+	if interval <= 0 {
+		interval = DefaultProgressInterval
+	}
+	outputPath := cfg.OutputPath
+	inPlace := outputPath == ""
+	if inPlace {
+		outputPath = datafile + compactedSuffix
+	} else if file.FileExists(outputPath) {
+		return nil, errors.Errorf("output database %s already exists", outputPath)
+	}
+	if err := os.RemoveAll(datafile + compactedSuffix); err != nil {
+		return nil, errors.Wrap(err, "could not remove previous compacted database")
+	}
+	if !inPlace {
+		if err := ensureDir(filepath.Dir(outputPath)); err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	res := &Result{Path: outputPath}
+	var err error
+	if res.SizeBefore, err = fileSize(datafile); err != nil {
+		return nil, err
+	}
+
+	src, err := bolt.Open(
+		datafile,
+		params.BeaconIoConfig().ReadWritePermissions,
+		&bolt.Options{Timeout: 1 * time.Second, ReadOnly: true},
+	)
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return nil, ErrDatabaseLocked
+		}
+		return nil, err
+	}
+	defer func() {
+		if err := src.Close(); err != nil {
+			log.WithError(err).Error("Failed to close database")
+		}
+	}()
+
+	totals, err := CountKeys(ctx, src)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not walk database buckets")
+	}
+	var total uint64
+	for _, n := range totals {
+		total += n
+	}
+
+	dst, err := bolt.Open(
+		outputPath,
+		params.BeaconIoConfig().ReadWritePermissions,
+		&bolt.Options{Timeout: 1 * time.Second, NoSync: true, FreelistType: bolt.FreelistMapType},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.AllocSize > 0 {
+		dst.AllocSize = cfg.AllocSize
+	}
+	log.WithFields(logrus.Fields{
+		"path": datafile,
+		"keys": total,
+	}).Info("Compacting database, this may take a while")
+	c := &copier{
+		ctx:       ctx,
+		dst:       dst,
+		txMaxSize: txMaxSize,
+		interval:  interval,
+		total:     total,
+		lastLog:   time.Now(),
+	}
+	if err := c.copyFrom(src); err != nil {
+		return nil, abort(dst, outputPath, errors.Wrap(err, "could not copy database"))
+	}
+	if c.copied != total {
+		return nil, abort(dst, outputPath, errors.Errorf("copied %d keys out of %d", c.copied, total))
+	}
+	if cfg.Finalize != nil {
+		if err := dst.Update(cfg.Finalize); err != nil {
+			return nil, abort(dst, outputPath, err)
+		}
+	}
+	if err := dst.Sync(); err != nil {
+		return nil, abort(dst, outputPath, err)
+	}
+	if err := dst.Close(); err != nil {
+		return nil, err
+	}
+	res.Keys = c.copied
+
+	if inPlace {
+		if cfg.BackupDir != "" {
+			backupPath, err := backup(datafile, cfg.BackupDir)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not back up database")
+			}
+			res.BackupPath = backupPath
+		}
+		if err := os.Rename(outputPath, datafile); err != nil {
+			return nil, errors.Wrap(err, "could not replace database with compacted database")
+		}
+		res.Path = datafile
+	}
+	if res.SizeAfter, err = fileSize(res.Path); err != nil {
+		return nil, err
+	}
+	res.Duration = time.Since(start)
+	log.WithFields(logrus.Fields{
+		"path":       res.Path,
+		"sizeBefore": res.SizeBefore,
+		"sizeAfter":  res.SizeAfter,
+		"keys":       res.Keys,
+		"duration":   res.Duration,
+	}).Info("Compacted database")
+	return res, nil
+}
+
+// CountKeys walks the database and returns the number of keys held by each top level bucket, including the keys
+// of their nested buckets.
+func CountKeys(ctx context.Context, db *bolt.DB) (map[string]uint64, error) {
+	counts := make(map[string]uint64)
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			n, err := countBucketKeys(ctx, b)
+			if err != nil {
+				return err
+			}
+			counts[string(name)] = n
+			return nil
+		})
+	})
+	return counts, err
+}
+
+func countBucketKeys(ctx context.Context, b *bolt.Bucket) (uint64, error) {
+	var n uint64
+	err := b.ForEach(func(k, v []byte) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		n++
+		if v != nil {
+			return nil
+		}
+		nested, err := countBucketKeys(ctx, b.Bucket(k))
+		n += nested
+		return err
+	})
+	return n, err
+}
+
+// copier copies the buckets of a database into another one using bounded write transactions.
+type copier struct {
+	ctx       context.Context
+	dst       *bolt.DB
+	tx        *bolt.Tx
+	txSize    int
+	txMaxSize int
+	interval  time.Duration
+	lastLog   time.Time
+	copied    uint64
+	total     uint64
+}
+
+func (c *copier) copyFrom(src *bolt.DB) error {
+	var err error
+	if c.tx, err = c.dst.Begin(true); err != nil {
+		return err
+	}
+	err = src.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			return c.copyBucket([][]byte{name}, b)
+		})
+	})
+	if err != nil {
+		if rbErr := c.tx.Rollback(); rbErr != nil {
+			log.WithError(rbErr).Error("Failed to roll back compaction transaction")
+		}
+		return err
+	}
+	return c.tx.Commit()
+}
+
+func (c *copier) copyBucket(bucketPath [][]byte, b *bolt.Bucket) error {
+	dstBkt, err := c.bucket(bucketPath)
+	if err != nil {
+		return err
+	}
+	if err := dstBkt.SetSequence(b.Sequence()); err != nil {
+		return err
+	}
+	return b.ForEach(func(k, v []byte) error {
+		if c.ctx.Err() != nil {
+			return c.ctx.Err()
+		}
+		if err := c.rotate(len(k) + len(v)); err != nil {
+			return err
+		}
+		c.copied++
+		c.report(bucketPath[0])
+		if v == nil {
+			nestedPath := append(append(make([][]byte, 0, len(bucketPath)+1), bucketPath...), k)
+			return c.copyBucket(nestedPath, b.Bucket(k))
+		}
+		dstBkt, err := c.bucket(bucketPath)
+		if err != nil {
+			return err
+		}
+		// Keys are written in order, so filling pages completely keeps the new file as small as possible.
+		dstBkt.FillPercent = 1.0
+		return dstBkt.Put(k, v)
+	})
+}
+
+// bucket returns the bucket at the given path in the current write transaction, creating it if needed.
+func (c *copier) bucket(bucketPath [][]byte) (*bolt.Bucket, error) {
+	b, err := c.tx.CreateBucketIfNotExists(bucketPath[0])
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range bucketPath[1:] {
+		if b, err = b.CreateBucketIfNotExists(name); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// rotate commits the current write transaction and starts a new one when writing the given number of bytes
+// would exceed the maximum transaction size.
+func (c *copier) rotate(size int) error {
+	if c.txSize+size <= c.txMaxSize || c.txSize == 0 {
+		c.txSize += size
+		return nil
+	}
+	if err := c.tx.Commit(); err != nil {
+		return err
+	}
+	tx, err := c.dst.Begin(true)
+	if err != nil {
+		return err
+	}
+	c.tx = tx
+	c.txSize = size
+	return nil
+}
+
+func (c *copier) report(bucket []byte) {
+	if time.Since(c.lastLog) < c.interval {
+		return
+	}
+	c.lastLog = time.Now()
+	percent := float64(100)
+	if c.total > 0 {
+		percent = float64(c.copied) * 100 / float64(c.total)
+	}
+	log.WithFields(logrus.Fields{
+		"bucket":  string(bucket),
+		"copied":  c.copied,
+		"total":   c.total,
+		"percent": fmt.Sprintf("%.2f", percent),
+	}).Info("Compacting database")
+}
+
+// backup copies the database file into the backup directory and returns the path of the copy.
+func backup(datafile, backupDir string) (string, error) {
+	dir, err := file.ExpandPath(backupDir)
+	if err != nil {
+		return "", err
+	}
+	if err := ensureDir(dir); err != nil {
+		return "", err
+	}
+	backupPath := filepath.Join(dir, fmt.Sprintf("%s.%d.backup", filepath.Base(datafile), time.Now().Unix()))
+	log.WithField("backup", backupPath).Info("Writing database backup")
+	if err := copyFile(datafile, backupPath); err != nil {
+		return "", err
+	}
+	return backupPath, nil
+}
+
+// copyFile copies a file and syncs the copy to disk, so that the original can be safely replaced afterwards.
+func copyFile(src, dst string) error {
+	in, err := os.Open(src) // #nosec G304
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := in.Close(); err != nil {
+			log.WithError(err).Error("Failed to close database file")
+		}
+	}()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		if closeErr := out.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Failed to close backup file")
+		}
+		return err
+	}
+	if err := out.Sync(); err != nil {
+		if closeErr := out.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Failed to close backup file")
+		}
+		return err
+	}
+	return out.Close()
+}
+
+// ensureDir creates the directory if it does not exist yet.
+func ensureDir(dir string) error {
+	exists, err := file.HasDir(dir)
+	if err != nil || exists {
+		return err
+	}
+	return file.MkdirAll(dir)
+}
+
+// abort closes and removes a partially written compacted database.
+func abort(dst *bolt.DB, outputPath string, err error) error {
+	if closeErr := dst.Close(); closeErr != nil {
+		log.WithError(closeErr).Error("Failed to close compacted database")
+	}
+	if rmErr := os.Remove(outputPath); rmErr != nil {
+		log.WithError(rmErr).Error("Failed to remove compacted database")
+	}
+	return err
+}
+
+func fileSize(path string) (int64, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
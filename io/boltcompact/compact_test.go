@@ -0,0 +1,136 @@
+package boltcompact
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/theQRL/qrysm/v4/config/params"
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+// setupDB writes a database with a top level bucket holding values and a nested bucket, then deletes most
+// of the values so that the file holds many free pages.
+func setupDB(t *testing.T) string {
+	datafile := filepath.Join(t.TempDir(), "test.db")
+	db, err := bolt.Open(datafile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: time.Second})
+	require.NoError(t, err)
+	value := make([]byte, 1024)
+	require.NoError(t, db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucket([]byte("values"))
+		if err != nil {
+			return err
+		}
+		for i := 0; i < 2000; i++ {
+			if err := b.Put([]byte(fmt.Sprintf("key-%04d", i)), value); err != nil {
+				return err
+			}
+		}
+		if err := b.SetSequence(42); err != nil {
+			return err
+		}
+		nested, err := b.CreateBucket([]byte("nested"))
+		if err != nil {
+			return err
+		}
+		if err := nested.Put([]byte("inner"), []byte("value")); err != nil {
+			return err
+		}
+		_, err = tx.CreateBucket([]byte("empty"))
+		return err
+	}))
+	require.NoError(t, db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte("values"))
+		for i := 10; i < 2000; i++ {
+			if err := b.Delete([]byte(fmt.Sprintf("key-%04d", i))); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+	require.NoError(t, db.Close())
+	return datafile
+}
+
+func checkContents(t *testing.T, datafile string) {
+	db, err := bolt.Open(datafile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: time.Second, ReadOnly: true})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	counts, err := CountKeys(context.Background(), db)
+	require.NoError(t, err)
+	assert.DeepEqual(t, map[string]uint64{"values": 12, "empty": 0}, counts)
+	require.NoError(t, db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte("values"))
+		assert.Equal(t, uint64(42), b.Sequence())
+		assert.Equal(t, 1024, len(b.Get([]byte("key-0009"))))
+		assert.DeepEqual(t, []byte("value"), b.Bucket([]byte("nested")).Get([]byte("inner")))
+		return nil
+	}))
+}
+
+func TestCompactFile_InPlace(t *testing.T) {
+	datafile := setupDB(t)
+	backupDir := filepath.Join(t.TempDir(), "backups")
+
+	res, err := CompactFile(context.Background(), datafile, &Config{
+		BackupDir: backupDir,
+		TxMaxSize: 4096,
+		Finalize: func(tx *bolt.Tx) error {
+			return tx.DeleteBucket([]byte("empty"))
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, datafile, res.Path)
+	assert.Equal(t, uint64(12), res.Keys)
+	assert.Equal(t, true, res.SizeAfter < res.SizeBefore, "compacted size %d not below original size %d", res.SizeAfter, res.SizeBefore)
+	_, err = os.Stat(datafile + compactedSuffix)
+	assert.Equal(t, true, os.IsNotExist(err))
+
+	// The backup holds the original database.
+	require.Equal(t, backupDir, filepath.Dir(res.BackupPath))
+	checkContents(t, res.BackupPath)
+
+	db, err := bolt.Open(datafile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: time.Second, ReadOnly: true})
+	require.NoError(t, err)
+	require.NoError(t, db.View(func(tx *bolt.Tx) error {
+		assert.Equal(t, true, tx.Bucket([]byte("empty")) == nil)
+		return nil
+	}))
+	require.NoError(t, db.Close())
+}
+
+func TestCompactFile_OutputPath(t *testing.T) {
+	datafile := setupDB(t)
+	outputPath := filepath.Join(t.TempDir(), "out", "test.db")
+
+	res, err := CompactFile(context.Background(), datafile, &Config{OutputPath: outputPath})
+	require.NoError(t, err)
+	assert.Equal(t, outputPath, res.Path)
+	assert.Equal(t, "", res.BackupPath)
+	checkContents(t, outputPath)
+	checkContents(t, datafile)
+
+	_, err = CompactFile(context.Background(), datafile, &Config{OutputPath: outputPath})
+	require.ErrorContains(t, "already exists", err)
+}
+
+func TestCompactFile_Errors(t *testing.T) {
+	_, err := CompactFile(context.Background(), filepath.Join(t.TempDir(), "missing.db"), nil)
+	require.ErrorContains(t, "no database found", err)
+
+	datafile := setupDB(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = CompactFile(ctx, datafile, nil)
+	require.ErrorIs(t, err, context.Canceled)
+	_, err = os.Stat(datafile + compactedSuffix)
+	assert.Equal(t, true, os.IsNotExist(err))
+	checkContents(t, datafile)
+}
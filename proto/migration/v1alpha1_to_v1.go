@@ -103,9 +103,10 @@ func V1Alpha1IndexedAttToV1(v1alpha1Att *zondpbalpha.IndexedAttestation) *zondpb
 		return &zondpbv1.IndexedAttestation{}
 	}
 	return &zondpbv1.IndexedAttestation{
-		AttestingIndices: v1alpha1Att.AttestingIndices,
-		Data:             V1Alpha1AttDataToV1(v1alpha1Att.Data),
-		Signature:        v1alpha1Att.Signature,
+		AttestingIndices:        v1alpha1Att.AttestingIndices,
+		Data:                    V1Alpha1AttDataToV1(v1alpha1Att.Data),
+		Signature:               v1alpha1Att.Signature,
+		SignatureValidatorIndex: v1alpha1Att.SignatureValidatorIndex,
 	}
 }
 
@@ -255,9 +256,10 @@ func V1IndexedAttToV1Alpha1(v1Att *zondpbv1.IndexedAttestation) *zondpbalpha.Ind
 		return &zondpbalpha.IndexedAttestation{}
 	}
 	return &zondpbalpha.IndexedAttestation{
-		AttestingIndices: v1Att.AttestingIndices,
-		Data:             V1AttDataToV1Alpha1(v1Att.Data),
-		Signature:        v1Att.Signature,
+		AttestingIndices:        v1Att.AttestingIndices,
+		Data:                    V1AttDataToV1Alpha1(v1Att.Data),
+		Signature:               v1Att.Signature,
+		SignatureValidatorIndex: v1Att.SignatureValidatorIndex,
 	}
 }
 
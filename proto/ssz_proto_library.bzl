@@ -26,6 +26,9 @@ mainnet = {
     "extra_data.size": "32",
     "max_blobs_per_block.size": "6",
     "max_blob_commitments.size":"4096",
+    "finality_branch.depth": "6",
+    "current_sync_committee_branch.depth": "5",
+    "next_sync_committee_branch.depth": "5",
 }
 
 minimal = {
@@ -47,6 +50,9 @@ minimal = {
     "extra_data.size": "32",
     "max_blobs_per_block.size": "6",
     "max_blob_commitments.size":"16",
+    "finality_branch.depth": "6",
+    "current_sync_committee_branch.depth": "5",
+    "next_sync_committee_branch.depth": "5",
 }
 
 ###### Rules definitions #######
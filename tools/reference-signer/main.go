@@ -0,0 +1,84 @@
+// Package main implements a reference remote signer serving the web3signer apis used by the
+// validator client, including the batch signing api, backed by a local keystore wallet.
+// It does not apply any slashing protection and is only meant for testing.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/theQRL/qrysm/v4/io/file"
+	"github.com/theQRL/qrysm/v4/validator/accounts/wallet"
+	"github.com/theQRL/qrysm/v4/validator/keymanager"
+	"github.com/theQRL/qrysm/v4/validator/keymanager/local"
+)
+
+func main() {
+	walletDir := flag.String("wallet-dir", "", "path to a local keystore wallet")
+	walletPasswordFile := flag.String("wallet-password-file", "", "path to a file containing the wallet password")
+	host := flag.String("host", "127.0.0.1", "host to listen on")
+	port := flag.Int("port", 9000, "port to listen on")
+	tlsCert := flag.String("tls-cert", "", "path to a TLS certificate, serving over HTTPS and HTTP/2 when set")
+	tlsKey := flag.String("tls-key", "", "path to the key of the TLS certificate")
+	flag.Parse()
+	if *walletDir == "" || *walletPasswordFile == "" {
+		log.Fatal("Needs a -wallet-dir and a -wallet-password-file")
+	}
+	if (*tlsCert == "") != (*tlsKey == "") {
+		log.Fatal("Needs both a -tls-cert and a -tls-key to serve over TLS")
+	}
+
+	ctx := context.Background()
+	km, err := openKeymanager(ctx, *walletDir, *walletPasswordFile)
+	if err != nil {
+		log.WithError(err).Fatal("Could not open wallet")
+	}
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		log.WithError(err).Fatal("Could not fetch public keys")
+	}
+
+	s := &server{km: km}
+	srv := &http.Server{
+		Addr:              fmt.Sprintf("%s:%d", *host, *port),
+		Handler:           s.router(),
+		ReadHeaderTimeout: 3 * time.Second,
+	}
+	log.WithFields(log.Fields{
+		"address": srv.Addr,
+		"keys":    len(keys),
+		"tls":     *tlsCert != "",
+	}).Warn("Starting reference remote signer without slashing protection, do not use it with real funds")
+	if *tlsCert != "" {
+		err = srv.ListenAndServeTLS(*tlsCert, *tlsKey)
+	} else {
+		err = srv.ListenAndServe()
+	}
+	log.WithError(err).Fatal("Server stopped")
+}
+
+func openKeymanager(ctx context.Context, walletDir, walletPasswordFile string) (keymanager.IKeymanager, error) {
+	password, err := file.ReadFileAsBytes(walletPasswordFile)
+	if err != nil {
+		return nil, err
+	}
+	w, err := wallet.OpenWallet(ctx, &wallet.Config{
+		WalletDir:      walletDir,
+		WalletPassword: strings.TrimRight(string(password), "\r\n"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if w.KeymanagerKind() != keymanager.Local {
+		return nil, fmt.Errorf("wallet is of kind %s, only %s wallets are supported", w.KeymanagerKind(), keymanager.Local)
+	}
+	return local.NewKeymanager(ctx, &local.SetupConfig{
+		Wallet:           w,
+		ListenForChanges: true,
+	})
+}
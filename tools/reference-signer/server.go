@@ -0,0 +1,152 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/go-zond/common/hexutil"
+	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
+	http2 "github.com/theQRL/qrysm/v4/network/http"
+	validatorpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1/validator-client"
+	"github.com/theQRL/qrysm/v4/validator/keymanager"
+	web3signerv2 "github.com/theQRL/qrysm/v4/validator/keymanager/remote-web3signer/v2"
+)
+
+// server serves the remote signer apis used by the web3signer keymanager of the validator client.
+// It signs the signing root of every request as is and does not apply any slashing protection,
+// so it must only be used for testing.
+type server struct {
+	km keymanager.IKeymanager
+}
+
+func (s *server) router() *mux.Router {
+	r := mux.NewRouter()
+	r.HandleFunc("/upcheck", s.upcheck).Methods(http.MethodGet)
+	r.HandleFunc("/api/v1/eth2/publicKeys", s.publicKeys).Methods(http.MethodGet)
+	r.HandleFunc("/api/v1/eth2/sign/{identifier}", s.sign).Methods(http.MethodPost)
+	r.HandleFunc(web3signerv2.BatchSignPath, s.signBatch).Methods(http.MethodPost)
+	return r
+}
+
+func (*server) upcheck(w http.ResponseWriter, _ *http.Request) {
+	http2.WriteJson(w, "OK")
+}
+
+func (s *server) publicKeys(w http.ResponseWriter, r *http.Request) {
+	keys, err := s.km.FetchValidatingPublicKeys(r.Context())
+	if err != nil {
+		http2.HandleError(w, "Could not fetch public keys: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	resp := make([]string, len(keys))
+	for i, key := range keys {
+		resp[i] = hexutil.Encode(key[:])
+	}
+	http2.WriteJson(w, resp)
+}
+
+func (s *server) sign(w http.ResponseWriter, r *http.Request) {
+	pubKey, err := hexutil.Decode(mux.Vars(r)["identifier"])
+	if err != nil {
+		http2.HandleError(w, "Invalid public key: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http2.HandleError(w, "Could not read request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	keys, err := s.validatingKeys(r)
+	if err != nil {
+		http2.HandleError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	result := s.signMessage(r, keys, pubKey, body)
+	if result.Error != nil {
+		http2.HandleError(w, result.Error.Message, result.Error.Code)
+		return
+	}
+	http2.WriteJson(w, &struct {
+		Signature hexutil.Bytes `json:"signature"`
+	}{Signature: result.Signature})
+}
+
+func (s *server) signBatch(w http.ResponseWriter, r *http.Request) {
+	var req web3signerv2.BatchSignRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http2.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(req.Requests) == 0 {
+		http2.HandleError(w, "No sign requests in batch", http.StatusBadRequest)
+		return
+	}
+	if len(req.Requests) > web3signerv2.MaxBatchSize {
+		http2.HandleError(w, fmt.Sprintf("Batch exceeds the maximum size of %d sign requests", web3signerv2.MaxBatchSize), http.StatusBadRequest)
+		return
+	}
+	keys, err := s.validatingKeys(r)
+	if err != nil {
+		http2.HandleError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	resp := &web3signerv2.BatchSignResponse{Results: make([]*web3signerv2.SignResult, len(req.Requests))}
+	for i, item := range req.Requests {
+		if item == nil {
+			resp.Results[i] = signError(http.StatusBadRequest, "empty sign request")
+			continue
+		}
+		resp.Results[i] = s.signMessage(r, keys, item.PublicKey, item.Request)
+	}
+	http2.WriteJson(w, resp)
+}
+
+// signMessage signs the signing root of a web3signer v1 sign request with the given key.
+func (s *server) signMessage(
+	r *http.Request,
+	keys map[[dilithium2.CryptoPublicKeyBytes]byte]bool,
+	pubKey []byte,
+	request []byte,
+) *web3signerv2.SignResult {
+	if len(pubKey) != dilithium2.CryptoPublicKeyBytes {
+		return signError(http.StatusBadRequest, fmt.Sprintf("public key is not byte length %d", dilithium2.CryptoPublicKeyBytes))
+	}
+	if !keys[bytesutil.ToBytes2592(pubKey)] {
+		return signError(http.StatusNotFound, fmt.Sprintf("public key %#x not found", pubKey))
+	}
+	var header web3signerv2.SignRequestHeader
+	if err := json.Unmarshal(request, &header); err != nil {
+		return signError(http.StatusBadRequest, "could not decode sign request: "+err.Error())
+	}
+	if header.Type == "" || len(header.SigningRoot) == 0 {
+		return signError(http.StatusBadRequest, "sign request is missing its type or signing root")
+	}
+	sig, err := s.km.Sign(r.Context(), &validatorpb.SignRequest{
+		PublicKey:   pubKey,
+		SigningRoot: header.SigningRoot,
+	})
+	if err != nil {
+		return signError(http.StatusInternalServerError, "could not sign message: "+err.Error())
+	}
+	return &web3signerv2.SignResult{Signature: sig.Marshal()}
+}
+
+func (s *server) validatingKeys(r *http.Request) (map[[dilithium2.CryptoPublicKeyBytes]byte]bool, error) {
+	keys, err := s.km.FetchValidatingPublicKeys(r.Context())
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch public keys: %w", err)
+	}
+	m := make(map[[dilithium2.CryptoPublicKeyBytes]byte]bool, len(keys))
+	for _, key := range keys {
+		m[key] = true
+	}
+	return m, nil
+}
+
+func signError(code int, message string) *web3signerv2.SignResult {
+	return &web3signerv2.SignResult{Error: &web3signerv2.SignError{Code: code, Message: message}}
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/theQRL/go-zond/common/hexutil"
+	"github.com/theQRL/qrysm/v4/crypto/dilithium"
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+	"github.com/theQRL/qrysm/v4/validator/keymanager/local"
+	web3signerv2 "github.com/theQRL/qrysm/v4/validator/keymanager/remote-web3signer/v2"
+)
+
+func setupServer(t *testing.T) (*httptest.Server, [][2592]byte) {
+	ctx := context.Background()
+	km, err := local.NewInteropKeymanager(ctx, 0, 2)
+	require.NoError(t, err)
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	s := &server{km: km}
+	srv := httptest.NewServer(s.router())
+	t.Cleanup(srv.Close)
+	return srv, keys
+}
+
+func signRequestJSON(t *testing.T, signingRoot []byte) []byte {
+	req, err := json.Marshal(&web3signerv2.SignRequestHeader{Type: "ATTESTATION", SigningRoot: signingRoot})
+	require.NoError(t, err)
+	return req
+}
+
+func TestServer_Sign(t *testing.T) {
+	srv, keys := setupServer(t)
+	signingRoot := bytes.Repeat([]byte{1}, 32)
+
+	resp, err := http.Post(fmt.Sprintf("%s/api/v1/eth2/sign/%s", srv.URL, hexutil.Encode(keys[0][:])), "application/json", bytes.NewReader(signRequestJSON(t, signingRoot)))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, resp.Body.Close())
+	}()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body := &struct {
+		Signature hexutil.Bytes `json:"signature"`
+	}{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(body))
+	sig, err := dilithium.SignatureFromBytes(body.Signature)
+	require.NoError(t, err)
+	pubKey, err := dilithium.PublicKeyFromBytes(keys[0][:])
+	require.NoError(t, err)
+	assert.Equal(t, true, sig.Verify(pubKey, signingRoot))
+
+	unknownKey := make([]byte, len(keys[0]))
+	resp, err = http.Post(fmt.Sprintf("%s/api/v1/eth2/sign/%s", srv.URL, hexutil.Encode(unknownKey)), "application/json", bytes.NewReader(signRequestJSON(t, signingRoot)))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestServer_SignBatch(t *testing.T) {
+	srv, keys := setupServer(t)
+	signingRoot := bytes.Repeat([]byte{2}, 32)
+	unknownKey := make([]byte, len(keys[0]))
+
+	req, err := json.Marshal(&web3signerv2.BatchSignRequest{Requests: []*web3signerv2.SignRequest{
+		{PublicKey: keys[0][:], Request: signRequestJSON(t, signingRoot)},
+		{PublicKey: unknownKey, Request: signRequestJSON(t, signingRoot)},
+		{PublicKey: keys[1][:], Request: []byte(`{}`)},
+		{PublicKey: keys[1][:], Request: signRequestJSON(t, signingRoot)},
+	}})
+	require.NoError(t, err)
+	resp, err := http.Post(srv.URL+web3signerv2.BatchSignPath, "application/json", bytes.NewReader(req))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, resp.Body.Close())
+	}()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body := &web3signerv2.BatchSignResponse{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(body))
+	require.Equal(t, 4, len(body.Results))
+
+	for _, i := range []int{0, 3} {
+		require.Equal(t, true, body.Results[i].Error == nil)
+		sig, err := dilithium.SignatureFromBytes(body.Results[i].Signature)
+		require.NoError(t, err)
+		pubKey, err := dilithium.PublicKeyFromBytes(keys[i/3][:])
+		require.NoError(t, err)
+		assert.Equal(t, true, sig.Verify(pubKey, signingRoot))
+	}
+	require.NotNil(t, body.Results[1].Error)
+	assert.Equal(t, http.StatusNotFound, body.Results[1].Error.Code)
+	require.NotNil(t, body.Results[2].Error)
+	assert.Equal(t, http.StatusBadRequest, body.Results[2].Error.Code)
+}
+
+func TestServer_SignBatch_InvalidBatch(t *testing.T) {
+	srv, _ := setupServer(t)
+
+	tooLarge := &web3signerv2.BatchSignRequest{Requests: make([]*web3signerv2.SignRequest, web3signerv2.MaxBatchSize+1)}
+	for _, batch := range []*web3signerv2.BatchSignRequest{{}, tooLarge} {
+		req, err := json.Marshal(batch)
+		require.NoError(t, err)
+		resp, err := http.Post(srv.URL+web3signerv2.BatchSignPath, "application/json", bytes.NewReader(req))
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	}
+}
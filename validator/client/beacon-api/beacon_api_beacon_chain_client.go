@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"net/http"
 	"reflect"
 	"strconv"
 	"time"
@@ -363,10 +362,7 @@ func (c beaconApiBeaconChainClient) GetValidatorParticipation(ctx context.Contex
 }
 
 func NewBeaconApiBeaconChainClientWithFallback(host string, timeout time.Duration, fallbackClient iface.BeaconChainClient) iface.BeaconChainClient {
-	jsonRestHandler := beaconApiJsonRestHandler{
-		httpClient: http.Client{Timeout: timeout},
-		host:       host,
-	}
+	jsonRestHandler := newJsonRestHandler(host, timeout, false)
 
 	return &beaconApiBeaconChainClient{
 		jsonRestHandler:         jsonRestHandler,
@@ -2,7 +2,6 @@ package beacon_api
 
 import (
 	"context"
-	"net/http"
 	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
@@ -22,11 +21,10 @@ type beaconApiValidatorClient struct {
 	beaconBlockConverter    beaconBlockConverter
 }
 
-func NewBeaconApiValidatorClient(host string, timeout time.Duration) iface.ValidatorClient {
-	jsonRestHandler := beaconApiJsonRestHandler{
-		httpClient: http.Client{Timeout: timeout},
-		host:       host,
-	}
+// NewBeaconApiValidatorClient returns a validator client for the comma separated list of beacon node hosts. With several hosts,
+// requests fail over to the next healthy beacon node and, if broadcast is set, blocks and attestations are sent to all of them.
+func NewBeaconApiValidatorClient(host string, timeout time.Duration, broadcast bool) iface.ValidatorClient {
+	jsonRestHandler := newJsonRestHandler(host, timeout, broadcast)
 
 	return &beaconApiValidatorClient{
 		genesisProvider:         beaconApiGenesisProvider{jsonRestHandler: jsonRestHandler},
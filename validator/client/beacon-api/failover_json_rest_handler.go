@@ -0,0 +1,284 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/theQRL/qrysm/v4/api/gateway/apimiddleware"
+	rpcmiddleware "github.com/theQRL/qrysm/v4/beacon-chain/rpc/apimiddleware"
+	"github.com/theQRL/qrysm/v4/config/params"
+)
+
+const (
+	healthEndpoint  = "/zond/v1/node/health"
+	syncingEndpoint = "/zond/v1/node/syncing"
+	// maxSyncDistance is the number of slots a beacon node may lag behind the head of the chain and still be
+	// considered healthy.
+	maxSyncDistance = 2
+)
+
+// broadcastEndpoints are the endpoints whose requests are sent to all healthy beacon nodes when broadcasting is enabled,
+// so that blocks and attestations reach the network even if the peers of a single beacon node do not relay them.
+var broadcastEndpoints = map[string]bool{
+	"/zond/v1/beacon/blocks":            true,
+	"/zond/v1/beacon/blinded_blocks":    true,
+	"/zond/v1/beacon/pool/attestations": true,
+}
+
+// newJsonRestHandler returns a handler for the comma separated list of beacon node hosts. A single host is queried directly,
+// while multiple hosts are queried through a failoverJsonRestHandler.
+func newJsonRestHandler(hosts string, timeout time.Duration, broadcast bool) jsonRestHandler {
+	var handlers []beaconApiJsonRestHandler
+	for _, host := range strings.Split(hosts, ",") {
+		host = strings.TrimSpace(host)
+		if host == "" {
+			continue
+		}
+		handlers = append(handlers, beaconApiJsonRestHandler{
+			httpClient: http.Client{Timeout: timeout},
+			host:       host,
+		})
+	}
+	if len(handlers) == 1 {
+		return handlers[0]
+	}
+	return &failoverJsonRestHandler{
+		handlers:            handlers,
+		broadcast:           broadcast,
+		healthCheckInterval: time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second,
+		health:              make([]nodeHealth, len(handlers)),
+	}
+}
+
+// nodeHealth is the result of the last health check of a beacon node.
+type nodeHealth struct {
+	checked time.Time
+	healthy bool
+}
+
+// failoverJsonRestHandler sends requests to the first healthy beacon node of a list of beacon nodes. A beacon node is unhealthy
+// when it is not ready, is syncing, is optimistic or lags behind the head of the chain. When a request to a beacon node fails
+// with a network or server error, the beacon node is considered unhealthy until its next health check and the request is sent to
+// the next healthy beacon node.
+type failoverJsonRestHandler struct {
+	handlers            []beaconApiJsonRestHandler
+	broadcast           bool
+	healthCheckInterval time.Duration
+	lock                sync.Mutex
+	current             int
+	health              []nodeHealth
+}
+
+// GetRestJsonResponse sends a GET request to apiEndpoint of the first healthy beacon node, failing over to the next healthy
+// beacon node on network or server errors.
+func (c *failoverJsonRestHandler) GetRestJsonResponse(ctx context.Context, apiEndpoint string, responseJson interface{}) (*apimiddleware.DefaultErrorJson, error) {
+	return c.failover(ctx, func(h beaconApiJsonRestHandler) (*apimiddleware.DefaultErrorJson, error) {
+		return h.GetRestJsonResponse(ctx, apiEndpoint, responseJson)
+	})
+}
+
+// PostRestJson sends a POST request to apiEndpoint of the first healthy beacon node, failing over to the next healthy
+// beacon node on network or server errors. Blocks and attestations are sent to all healthy beacon nodes when broadcasting
+// is enabled.
+func (c *failoverJsonRestHandler) PostRestJson(ctx context.Context, apiEndpoint string, headers map[string]string, data *bytes.Buffer, responseJson interface{}) (*apimiddleware.DefaultErrorJson, error) {
+	if data == nil {
+		return nil, errors.New("POST data is nil")
+	}
+	// The data is read by every request sent, so each request reads its own buffer.
+	body := data.Bytes()
+	post := func(h beaconApiJsonRestHandler) (*apimiddleware.DefaultErrorJson, error) {
+		return h.PostRestJson(ctx, apiEndpoint, headers, bytes.NewBuffer(body), responseJson)
+	}
+	if c.broadcast && responseJson == nil && broadcastEndpoints[apiEndpoint] {
+		return c.broadcastToAll(ctx, post)
+	}
+	return c.failover(ctx, post)
+}
+
+// failover sends the request to the healthy beacon nodes in turn, starting with the current beacon node, until one of them
+// does not fail with a network or server error. If no beacon node is healthy, all of them are tried.
+func (c *failoverJsonRestHandler) failover(
+	ctx context.Context,
+	request func(h beaconApiJsonRestHandler) (*apimiddleware.DefaultErrorJson, error),
+) (*apimiddleware.DefaultErrorJson, error) {
+	var errJson *apimiddleware.DefaultErrorJson
+	var err error
+	nodes := c.allNodes()
+	for _, onlyHealthy := range []bool{true, false} {
+		tried := false
+		for _, i := range nodes {
+			// Beacon nodes are checked lazily, so that a healthy current beacon node does not wait for the others.
+			if onlyHealthy && !c.isHealthy(ctx, i) {
+				continue
+			}
+			tried = true
+			errJson, err = request(c.handlers[i])
+			if !isNodeError(errJson, err) {
+				c.setCurrent(i)
+				return errJson, err
+			}
+			if ctx.Err() != nil {
+				return errJson, err
+			}
+			log.WithError(err).WithField("host", c.handlers[i].host).Warn("Beacon node request failed, failing over to the next beacon node")
+			c.setUnhealthy(i)
+		}
+		if tried {
+			break
+		}
+	}
+	return errJson, err
+}
+
+// broadcastToAll sends the request to all healthy beacon nodes concurrently. It succeeds if any of the beacon nodes succeeds,
+// otherwise it returns the result of the first beacon node.
+func (c *failoverJsonRestHandler) broadcastToAll(
+	ctx context.Context,
+	request func(h beaconApiJsonRestHandler) (*apimiddleware.DefaultErrorJson, error),
+) (*apimiddleware.DefaultErrorJson, error) {
+	nodes := c.healthyNodes(ctx)
+	if len(nodes) == 0 {
+		nodes = c.allNodes()
+	}
+
+	errJsons := make([]*apimiddleware.DefaultErrorJson, len(nodes))
+	errs := make([]error, len(nodes))
+	var wg sync.WaitGroup
+	for i, node := range nodes {
+		wg.Add(1)
+		go func(i, node int) {
+			defer wg.Done()
+			errJsons[i], errs[i] = request(c.handlers[node])
+			if isNodeError(errJsons[i], errs[i]) && ctx.Err() == nil {
+				log.WithError(errs[i]).WithField("host", c.handlers[node].host).Warn("Could not broadcast to beacon node")
+				c.setUnhealthy(node)
+			}
+		}(i, node)
+	}
+	wg.Wait()
+
+	for i := range nodes {
+		if errs[i] == nil {
+			return nil, nil
+		}
+	}
+	return errJsons[0], errs[0]
+}
+
+// healthyNodes returns the indices of the healthy beacon nodes, starting with the current beacon node.
+func (c *failoverJsonRestHandler) healthyNodes(ctx context.Context) []int {
+	var nodes []int
+	for _, i := range c.allNodes() {
+		if c.isHealthy(ctx, i) {
+			nodes = append(nodes, i)
+		}
+	}
+	return nodes
+}
+
+// allNodes returns the indices of all beacon nodes, starting with the current beacon node.
+func (c *failoverJsonRestHandler) allNodes() []int {
+	c.lock.Lock()
+	current := c.current
+	c.lock.Unlock()
+
+	nodes := make([]int, len(c.handlers))
+	for i := range nodes {
+		nodes[i] = (current + i) % len(c.handlers)
+	}
+	return nodes
+}
+
+// isHealthy returns the health of the beacon node, checking it again if the last check is older than the health check interval.
+func (c *failoverJsonRestHandler) isHealthy(ctx context.Context, i int) bool {
+	c.lock.Lock()
+	health := c.health[i]
+	c.lock.Unlock()
+	if !health.checked.IsZero() && time.Since(health.checked) < c.healthCheckInterval {
+		return health.healthy
+	}
+
+	err := checkHealth(ctx, c.handlers[i])
+	if err != nil {
+		log.WithError(err).WithField("host", c.handlers[i].host).Warn("Beacon node is unhealthy")
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.health[i] = nodeHealth{checked: time.Now(), healthy: err == nil}
+	return err == nil
+}
+
+func (c *failoverJsonRestHandler) setUnhealthy(i int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.health[i] = nodeHealth{checked: time.Now(), healthy: false}
+}
+
+func (c *failoverJsonRestHandler) setCurrent(i int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.current != i {
+		log.WithFields(logrus.Fields{
+			"previousHost": c.handlers[c.current].host,
+			"host":         c.handlers[i].host,
+		}).Info("Switched beacon node")
+		c.current = i
+	}
+}
+
+// checkHealth returns an error if the beacon node is not ready, is syncing, is optimistic or lags behind the head of the chain.
+func checkHealth(ctx context.Context, h beaconApiJsonRestHandler) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, h.host+healthEndpoint, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to create request with context")
+	}
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to query REST API %s", req.URL)
+	}
+	if err := resp.Body.Close(); err != nil {
+		return errors.Wrap(err, "failed to close response body")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("beacon node is not ready, health status code %d", resp.StatusCode)
+	}
+
+	syncingResponseJson := &rpcmiddleware.SyncingResponseJson{}
+	if _, err := h.GetRestJsonResponse(ctx, syncingEndpoint, syncingResponseJson); err != nil {
+		return errors.Wrapf(err, "failed to get json response from `%s` REST endpoint", syncingEndpoint)
+	}
+	syncDetails := syncingResponseJson.Data
+	if syncDetails == nil {
+		return errors.New("syncing data is nil")
+	}
+	if syncDetails.IsSyncing {
+		return errors.New("beacon node is syncing")
+	}
+	if syncDetails.IsOptimistic {
+		return errors.New("beacon node is optimistic")
+	}
+	syncDistance, err := strconv.ParseUint(syncDetails.SyncDistance, 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse sync distance %s", syncDetails.SyncDistance)
+	}
+	if syncDistance > maxSyncDistance {
+		return errors.Errorf("beacon node is %d slots behind the head of the chain", syncDistance)
+	}
+	return nil
+}
+
+// isNodeError returns true if the request failed because of the beacon node rather than because of the request,
+// i.e. the beacon node could not be reached, did not answer a valid response or answered a server error.
+func isNodeError(errJson *apimiddleware.DefaultErrorJson, err error) bool {
+	if err == nil {
+		return false
+	}
+	return errJson == nil || errJson.Code >= http.StatusInternalServerError
+}
@@ -0,0 +1,233 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/apimiddleware"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/beacon"
+	"github.com/theQRL/qrysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+)
+
+const failoverTestEndpoint = "/example/rest/api/endpoint"
+
+type testBeaconNode struct {
+	server   *httptest.Server
+	requests atomic.Int32
+	bodies   chan []byte
+}
+
+// newTestBeaconNode serves the health and syncing endpoints with the given status, and answers requests to the test
+// endpoint with the given status code.
+func newTestBeaconNode(t *testing.T, healthStatus int, syncDetails *shared.SyncDetails, statusCode int) *testBeaconNode {
+	node := &testBeaconNode{bodies: make(chan []byte, 10)}
+	mux := http.NewServeMux()
+	mux.HandleFunc(healthEndpoint, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(healthStatus)
+	})
+	mux.HandleFunc(syncingEndpoint, func(w http.ResponseWriter, _ *http.Request) {
+		marshalledJson, err := json.Marshal(&apimiddleware.SyncingResponseJson{Data: syncDetails})
+		require.NoError(t, err)
+		_, err = w.Write(marshalledJson)
+		require.NoError(t, err)
+	})
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		node.requests.Add(1)
+		if r.Body != nil {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			node.bodies <- body
+		}
+		if statusCode != http.StatusOK {
+			httpErrorJsonHandler(statusCode, "error")(w, r)
+			return
+		}
+		marshalledJson, err := json.Marshal(&beacon.GetGenesisResponse{Data: &beacon.Genesis{GenesisTime: node.server.URL}})
+		require.NoError(t, err)
+		_, err = w.Write(marshalledJson)
+		require.NoError(t, err)
+	}
+	mux.HandleFunc(failoverTestEndpoint, handler)
+	mux.HandleFunc("/zond/v1/beacon/pool/attestations", handler)
+	node.server = httptest.NewServer(mux)
+	t.Cleanup(node.server.Close)
+	return node
+}
+
+func syncedDetails() *shared.SyncDetails {
+	return &shared.SyncDetails{HeadSlot: "100", SyncDistance: "0"}
+}
+
+func newTestFailoverHandler(t *testing.T, broadcast bool, nodes ...*testBeaconNode) *failoverJsonRestHandler {
+	hosts := make([]string, len(nodes))
+	for i, node := range nodes {
+		hosts[i] = node.server.URL
+	}
+	handler, ok := newJsonRestHandler(strings.Join(hosts, ","), time.Second*5, broadcast).(*failoverJsonRestHandler)
+	require.Equal(t, true, ok)
+	return handler
+}
+
+func TestNewJsonRestHandler_SingleHost(t *testing.T) {
+	handler := newJsonRestHandler("http://localhost:3500", time.Second, true)
+	_, ok := handler.(beaconApiJsonRestHandler)
+	assert.Equal(t, true, ok)
+
+	handler = newJsonRestHandler("http://localhost:3500, http://localhost:3501", time.Second, true)
+	failover, ok := handler.(*failoverJsonRestHandler)
+	require.Equal(t, true, ok)
+	require.Equal(t, 2, len(failover.handlers))
+	assert.Equal(t, "http://localhost:3501", failover.handlers[1].host)
+}
+
+func TestFailoverJsonRestHandler_GetRestJsonResponse(t *testing.T) {
+	testCases := []struct {
+		name         string
+		healthStatus int
+		syncDetails  *shared.SyncDetails
+		statusCode   int
+	}{
+		{
+			name:         "not ready",
+			healthStatus: http.StatusServiceUnavailable,
+			syncDetails:  syncedDetails(),
+			statusCode:   http.StatusOK,
+		},
+		{
+			name:         "syncing",
+			healthStatus: http.StatusOK,
+			syncDetails:  &shared.SyncDetails{HeadSlot: "10", SyncDistance: "90", IsSyncing: true},
+			statusCode:   http.StatusOK,
+		},
+		{
+			name:         "optimistic",
+			healthStatus: http.StatusOK,
+			syncDetails:  &shared.SyncDetails{HeadSlot: "100", SyncDistance: "0", IsOptimistic: true},
+			statusCode:   http.StatusOK,
+		},
+		{
+			name:         "behind",
+			healthStatus: http.StatusOK,
+			syncDetails:  &shared.SyncDetails{HeadSlot: "95", SyncDistance: "5"},
+			statusCode:   http.StatusOK,
+		},
+		{
+			name:         "server error",
+			healthStatus: http.StatusOK,
+			syncDetails:  syncedDetails(),
+			statusCode:   http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			unhealthy := newTestBeaconNode(t, testCase.healthStatus, testCase.syncDetails, testCase.statusCode)
+			healthy := newTestBeaconNode(t, http.StatusOK, syncedDetails(), http.StatusOK)
+			handler := newTestFailoverHandler(t, false, unhealthy, healthy)
+
+			responseJson := &beacon.GetGenesisResponse{}
+			_, err := handler.GetRestJsonResponse(context.Background(), failoverTestEndpoint, responseJson)
+			require.NoError(t, err)
+			assert.Equal(t, healthy.server.URL, responseJson.Data.GenesisTime)
+			assert.Equal(t, 1, handler.current)
+
+			// The next request goes to the healthy beacon node directly.
+			_, err = handler.GetRestJsonResponse(context.Background(), failoverTestEndpoint, responseJson)
+			require.NoError(t, err)
+			assert.Equal(t, int32(2), healthy.requests.Load())
+		})
+	}
+}
+
+func TestFailoverJsonRestHandler_ClientErrorDoesNotFailOver(t *testing.T) {
+	first := newTestBeaconNode(t, http.StatusOK, syncedDetails(), http.StatusBadRequest)
+	second := newTestBeaconNode(t, http.StatusOK, syncedDetails(), http.StatusOK)
+	handler := newTestFailoverHandler(t, false, first, second)
+
+	errJson, err := handler.GetRestJsonResponse(context.Background(), failoverTestEndpoint, &beacon.GetGenesisResponse{})
+	assert.ErrorContains(t, "error 400", err)
+	require.NotNil(t, errJson)
+	assert.Equal(t, http.StatusBadRequest, errJson.Code)
+	assert.Equal(t, int32(0), second.requests.Load())
+}
+
+func TestFailoverJsonRestHandler_NoHealthyNode(t *testing.T) {
+	first := newTestBeaconNode(t, http.StatusServiceUnavailable, syncedDetails(), http.StatusOK)
+	second := newTestBeaconNode(t, http.StatusPartialContent, syncedDetails(), http.StatusOK)
+	handler := newTestFailoverHandler(t, false, first, second)
+
+	// The request is still sent when no beacon node is healthy.
+	responseJson := &beacon.GetGenesisResponse{}
+	_, err := handler.GetRestJsonResponse(context.Background(), failoverTestEndpoint, responseJson)
+	require.NoError(t, err)
+	assert.Equal(t, first.server.URL, responseJson.Data.GenesisTime)
+}
+
+func TestFailoverJsonRestHandler_AllNodesFail(t *testing.T) {
+	first := newTestBeaconNode(t, http.StatusOK, syncedDetails(), http.StatusInternalServerError)
+	second := newTestBeaconNode(t, http.StatusOK, syncedDetails(), http.StatusServiceUnavailable)
+	handler := newTestFailoverHandler(t, false, first, second)
+
+	_, err := handler.PostRestJson(context.Background(), failoverTestEndpoint, nil, bytes.NewBuffer([]byte{1, 2, 3}), nil)
+	assert.ErrorContains(t, "error 503", err)
+	assert.Equal(t, int32(1), first.requests.Load())
+	assert.Equal(t, int32(1), second.requests.Load())
+	assert.DeepEqual(t, []byte{1, 2, 3}, <-second.bodies)
+}
+
+func TestFailoverJsonRestHandler_Broadcast(t *testing.T) {
+	data := []byte{1, 2, 3}
+
+	t.Run("broadcast enabled", func(t *testing.T) {
+		first := newTestBeaconNode(t, http.StatusOK, syncedDetails(), http.StatusInternalServerError)
+		second := newTestBeaconNode(t, http.StatusOK, syncedDetails(), http.StatusOK)
+		unhealthy := newTestBeaconNode(t, http.StatusServiceUnavailable, syncedDetails(), http.StatusOK)
+		handler := newTestFailoverHandler(t, true, first, second, unhealthy)
+
+		_, err := handler.PostRestJson(context.Background(), "/zond/v1/beacon/pool/attestations", nil, bytes.NewBuffer(data), nil)
+		require.NoError(t, err)
+		assert.DeepEqual(t, data, <-first.bodies)
+		assert.DeepEqual(t, data, <-second.bodies)
+		assert.Equal(t, int32(0), unhealthy.requests.Load())
+	})
+	t.Run("broadcast disabled", func(t *testing.T) {
+		first := newTestBeaconNode(t, http.StatusOK, syncedDetails(), http.StatusOK)
+		second := newTestBeaconNode(t, http.StatusOK, syncedDetails(), http.StatusOK)
+		handler := newTestFailoverHandler(t, false, first, second)
+
+		_, err := handler.PostRestJson(context.Background(), "/zond/v1/beacon/pool/attestations", nil, bytes.NewBuffer(data), nil)
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), first.requests.Load())
+		assert.Equal(t, int32(0), second.requests.Load())
+	})
+	t.Run("not a broadcast endpoint", func(t *testing.T) {
+		first := newTestBeaconNode(t, http.StatusOK, syncedDetails(), http.StatusOK)
+		second := newTestBeaconNode(t, http.StatusOK, syncedDetails(), http.StatusOK)
+		handler := newTestFailoverHandler(t, true, first, second)
+
+		_, err := handler.PostRestJson(context.Background(), failoverTestEndpoint, nil, bytes.NewBuffer(data), nil)
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), first.requests.Load())
+		assert.Equal(t, int32(0), second.requests.Load())
+	})
+	t.Run("all broadcasts fail", func(t *testing.T) {
+		first := newTestBeaconNode(t, http.StatusOK, syncedDetails(), http.StatusInternalServerError)
+		second := newTestBeaconNode(t, http.StatusOK, syncedDetails(), http.StatusBadRequest)
+		handler := newTestFailoverHandler(t, true, first, second)
+
+		errJson, err := handler.PostRestJson(context.Background(), "/zond/v1/beacon/pool/attestations", nil, bytes.NewBuffer(data), nil)
+		assert.ErrorContains(t, "error 500", err)
+		require.NotNil(t, errJson)
+		assert.Equal(t, http.StatusInternalServerError, errJson.Code)
+	})
+}
@@ -409,16 +409,26 @@ func signVoluntaryExit(
 
 // Gets the graffiti from cli or file for the validator public key.
 func (v *validator) getGraffiti(ctx context.Context, pubKey [dilithium2.CryptoPublicKeyBytes]byte) ([]byte, error) {
-	// When specified, default graffiti from the command line takes the first priority.
+	// When specified, graffiti set for the key in the proposer settings, which the keymanager API updates at
+	// runtime, takes the first priority.
+	if g, ok := v.proposerSettingsGraffiti(pubKey); ok {
+		return []byte(g), nil
+	}
+
+	// When specified, default graffiti from the command line takes the second priority, followed by the
+	// graffiti of the default proposer settings.
 	if len(v.graffiti) != 0 {
 		return v.graffiti, nil
 	}
+	if g, ok := v.defaultProposerSettingsGraffiti(); ok {
+		return []byte(g), nil
+	}
 
 	if v.graffitiStruct == nil {
 		return nil, errors.New("graffitiStruct can't be nil")
 	}
 
-	// When specified, individual validator specified graffiti takes the second priority.
+	// When specified, individual validator specified graffiti takes the third priority.
 	idx, err := v.validatorClient.ValidatorIndex(ctx, &zondpb.ValidatorIndexRequest{PublicKey: pubKey[:]})
 	if err != nil {
 		return []byte{}, err
@@ -428,7 +438,7 @@ func (v *validator) getGraffiti(ctx context.Context, pubKey [dilithium2.CryptoPu
 		return []byte(g), nil
 	}
 
-	// When specified, a graffiti from the ordered list in the file take third priority.
+	// When specified, a graffiti from the ordered list in the file take fourth priority.
 	if v.graffitiOrderedIndex < uint64(len(v.graffitiStruct.Ordered)) {
 		graffiti := v.graffitiStruct.Ordered[v.graffitiOrderedIndex]
 		v.graffitiOrderedIndex = v.graffitiOrderedIndex + 1
@@ -439,7 +449,7 @@ func (v *validator) getGraffiti(ctx context.Context, pubKey [dilithium2.CryptoPu
 		return []byte(graffiti), nil
 	}
 
-	// When specified, a graffiti from the random list in the file take fourth priority.
+	// When specified, a graffiti from the random list in the file take fifth priority.
 	if len(v.graffitiStruct.Random) != 0 {
 		r := rand.NewGenerator()
 		r.Seed(time.Now().Unix())
@@ -454,3 +464,25 @@ func (v *validator) getGraffiti(ctx context.Context, pubKey [dilithium2.CryptoPu
 
 	return []byte{}, nil
 }
+
+// proposerSettingsGraffiti returns the graffiti configured in the proposer settings for the given key.
+func (v *validator) proposerSettingsGraffiti(pubKey [dilithium2.CryptoPublicKeyBytes]byte) (string, bool) {
+	settings := v.ProposerSettings()
+	if settings == nil || settings.ProposeConfig == nil {
+		return "", false
+	}
+	option, ok := settings.ProposeConfig[pubKey]
+	if ok && option != nil && option.GraffitiConfig != nil {
+		return option.GraffitiConfig.Graffiti, true
+	}
+	return "", false
+}
+
+// defaultProposerSettingsGraffiti returns the graffiti configured in the default proposer settings.
+func (v *validator) defaultProposerSettingsGraffiti() (string, bool) {
+	settings := v.ProposerSettings()
+	if settings == nil || settings.DefaultConfig == nil || settings.DefaultConfig.GraffitiConfig == nil {
+		return "", false
+	}
+	return settings.DefaultConfig.GraffitiConfig.Graffiti, true
+}
@@ -15,6 +15,7 @@ import (
 	lruwrpr "github.com/theQRL/qrysm/v4/cache/lru"
 	fieldparams "github.com/theQRL/qrysm/v4/config/fieldparams"
 	"github.com/theQRL/qrysm/v4/config/params"
+	validatorserviceconfig "github.com/theQRL/qrysm/v4/config/validator/service"
 	"github.com/theQRL/qrysm/v4/consensus-types/blocks"
 	blocktest "github.com/theQRL/qrysm/v4/consensus-types/blocks/testing"
 	"github.com/theQRL/qrysm/v4/consensus-types/interfaces"
@@ -967,6 +968,60 @@ func TestGetGraffiti_Ok(t *testing.T) {
 			},
 			want: []byte{'b'},
 		},
+		{name: "use proposer settings graffiti over default cli graffiti",
+			v: &validator{
+				graffiti: []byte{'b'},
+				proposerSettings: &validatorserviceconfig.ProposerSettings{
+					ProposeConfig: map[[dilithium2.CryptoPublicKeyBytes]byte]*validatorserviceconfig.ProposerOption{
+						pubKey: {GraffitiConfig: &validatorserviceconfig.GraffitiConfig{Graffiti: "h"}},
+					},
+				},
+			},
+			want: []byte{'h'},
+		},
+		{name: "use default cli graffiti over default proposer settings graffiti",
+			v: &validator{
+				graffiti: []byte{'b'},
+				proposerSettings: &validatorserviceconfig.ProposerSettings{
+					DefaultConfig: &validatorserviceconfig.ProposerOption{
+						GraffitiConfig: &validatorserviceconfig.GraffitiConfig{Graffiti: "i"},
+					},
+				},
+			},
+			want: []byte{'b'},
+		},
+		{name: "use proposer settings graffiti",
+			v: &validator{
+				graffitiStruct: &graffiti.Graffiti{
+					Default: "c",
+				},
+				proposerSettings: &validatorserviceconfig.ProposerSettings{
+					ProposeConfig: map[[dilithium2.CryptoPublicKeyBytes]byte]*validatorserviceconfig.ProposerOption{
+						pubKey: {GraffitiConfig: &validatorserviceconfig.GraffitiConfig{Graffiti: "h"}},
+					},
+					DefaultConfig: &validatorserviceconfig.ProposerOption{
+						GraffitiConfig: &validatorserviceconfig.GraffitiConfig{Graffiti: "i"},
+					},
+				},
+			},
+			want: []byte{'h'},
+		},
+		{name: "use default proposer settings graffiti",
+			v: &validator{
+				graffitiStruct: &graffiti.Graffiti{
+					Default: "c",
+				},
+				proposerSettings: &validatorserviceconfig.ProposerSettings{
+					ProposeConfig: map[[dilithium2.CryptoPublicKeyBytes]byte]*validatorserviceconfig.ProposerOption{
+						pubKey: {FeeRecipientConfig: &validatorserviceconfig.FeeRecipientConfig{}},
+					},
+					DefaultConfig: &validatorserviceconfig.ProposerOption{
+						GraffitiConfig: &validatorserviceconfig.GraffitiConfig{Graffiti: "i"},
+					},
+				},
+			},
+			want: []byte{'i'},
+		},
 		{name: "use default file graffiti",
 			v: &validator{
 				validatorClient: m.validatorClient,
@@ -1011,7 +1066,7 @@ func TestGetGraffiti_Ok(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if !strings.Contains(tt.name, "use default cli graffiti") {
+			if !strings.Contains(tt.name, "use default cli graffiti") && !strings.Contains(tt.name, "proposer settings graffiti") {
 				m.validatorClient.EXPECT().
 					ValidatorIndex(gomock.Any(), &zondpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
 					Return(&zondpb.ValidatorIndexResponse{Index: 2}, nil)
@@ -99,6 +99,7 @@ type Config struct {
 	ProposerSettings           *validatorserviceconfig.ProposerSettings
 	BeaconApiEndpoint          string
 	BeaconApiTimeout           time.Duration
+	BeaconApiBroadcast         bool
 }
 
 // NewValidatorService creates a new validator service for the service
@@ -152,6 +153,7 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		grpcConn,
 		cfg.BeaconApiEndpoint,
 		cfg.BeaconApiTimeout,
+		validatorHelpers.WithBeaconApiBroadcast(cfg.BeaconApiBroadcast),
 	)
 
 	return s, nil
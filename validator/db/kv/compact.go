@@ -0,0 +1,18 @@
+package kv
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/theQRL/qrysm/v4/io/boltcompact"
+	"go.opencensus.io/trace"
+)
+
+// CompactDatabase copies the live buckets of the closed database in the directory path into a new file, which
+// replaces the original file unless the config sets an output path. Pruning slashing protection history frees
+// pages that bolt reuses but never gives back to the disk.
+func CompactDatabase(ctx context.Context, dirPath string, cfg *boltcompact.Config) (*boltcompact.Result, error) {
+	ctx, span := trace.StartSpan(ctx, "ValidatorDB.CompactDatabase")
+	defer span.End()
+	return boltcompact.CompactFile(ctx, filepath.Join(dirPath, ProtectionDbFileName), cfg)
+}
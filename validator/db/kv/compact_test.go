@@ -0,0 +1,34 @@
+package kv
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/theQRL/qrysm/v4/io/boltcompact"
+	"github.com/theQRL/qrysm/v4/testing/require"
+)
+
+func TestStore_CompactDatabase(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	db, err := NewKVStore(ctx, dir, &Config{})
+	require.NoError(t, err)
+	root := [32]byte{1}
+	require.NoError(t, db.SaveGenesisValidatorsRoot(ctx, root[:]))
+	require.NoError(t, db.Close())
+
+	res, err := CompactDatabase(ctx, dir, &boltcompact.Config{BackupDir: BackupsDir(dir)})
+	require.NoError(t, err)
+	_, err = os.Stat(res.BackupPath)
+	require.NoError(t, err)
+
+	db, err = NewKVStore(ctx, dir, &Config{})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close(), "Failed to close database")
+	})
+	genesisRoot, err := db.GenesisValidatorsRoot(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, root[:], genesisRoot)
+}
@@ -11,13 +11,25 @@ type NodeConnection interface {
 	GetGrpcClientConn() *grpc.ClientConn
 	GetBeaconApiUrl() string
 	GetBeaconApiTimeout() time.Duration
+	GetBeaconApiBroadcast() bool
 	dummy()
 }
 
 type nodeConnection struct {
-	grpcClientConn   *grpc.ClientConn
-	beaconApiUrl     string
-	beaconApiTimeout time.Duration
+	grpcClientConn     *grpc.ClientConn
+	beaconApiUrl       string
+	beaconApiTimeout   time.Duration
+	beaconApiBroadcast bool
+}
+
+// NodeConnectionOption configures a node connection.
+type NodeConnectionOption func(*nodeConnection)
+
+// WithBeaconApiBroadcast broadcasts blocks and attestations to all the beacon nodes of the beacon API url.
+func WithBeaconApiBroadcast(broadcast bool) NodeConnectionOption {
+	return func(c *nodeConnection) {
+		c.beaconApiBroadcast = broadcast
+	}
 }
 
 func (c *nodeConnection) GetGrpcClientConn() *grpc.ClientConn {
@@ -32,12 +44,19 @@ func (c *nodeConnection) GetBeaconApiTimeout() time.Duration {
 	return c.beaconApiTimeout
 }
 
+func (c *nodeConnection) GetBeaconApiBroadcast() bool {
+	return c.beaconApiBroadcast
+}
+
 func (*nodeConnection) dummy() {}
 
-func NewNodeConnection(grpcConn *grpc.ClientConn, beaconApiUrl string, beaconApiTimeout time.Duration) NodeConnection {
+func NewNodeConnection(grpcConn *grpc.ClientConn, beaconApiUrl string, beaconApiTimeout time.Duration, opts ...NodeConnectionOption) NodeConnection {
 	conn := &nodeConnection{}
 	conn.grpcClientConn = grpcConn
 	conn.beaconApiUrl = beaconApiUrl
 	conn.beaconApiTimeout = beaconApiTimeout
+	for _, opt := range opts {
+		opt(conn)
+	}
 	return conn
 }
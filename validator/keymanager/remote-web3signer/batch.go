@@ -0,0 +1,179 @@
+package remote_web3signer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/theQRL/go-zond/common/hexutil"
+	"github.com/theQRL/qrysm/v4/config/params"
+	"github.com/theQRL/qrysm/v4/crypto/dilithium"
+	"github.com/theQRL/qrysm/v4/validator/keymanager/remote-web3signer/internal"
+	web3signerv2 "github.com/theQRL/qrysm/v4/validator/keymanager/remote-web3signer/v2"
+)
+
+// batchSignWindow is how long the first request of a batch waits for more requests to join it
+// before the batch is sent to the remote signer.
+const batchSignWindow = 10 * time.Millisecond
+
+// errBatchSignerStopped is returned for the requests which could not be signed before the keymanager was stopped.
+var errBatchSignerStopped = errors.New("batch signer stopped")
+
+type pendingSignRequest struct {
+	request *web3signerv2.SignRequest
+	result  chan *signResult
+}
+
+type signResult struct {
+	signature dilithium.Signature
+	err       error
+}
+
+// batchSigner collects the sign requests issued concurrently by the validator client, such as the
+// attestations of all its keys at a given slot, and sends them to the remote signer in batches.
+// If the remote signer does not serve the batch signing endpoint, the requests are signed one at a time.
+// The batch signer stops when the context of the keymanager is done.
+type batchSigner struct {
+	ctx         context.Context
+	client      internal.HttpSignerClient
+	queue       chan *pendingSignRequest
+	maxSize     int
+	window      time.Duration
+	start       sync.Once
+	unsupported atomic.Bool
+}
+
+func newBatchSigner(ctx context.Context, client internal.HttpSignerClient) *batchSigner {
+	return &batchSigner{
+		ctx:     ctx,
+		client:  client,
+		queue:   make(chan *pendingSignRequest, web3signerv2.MaxBatchSize),
+		maxSize: web3signerv2.MaxBatchSize,
+		window:  batchSignWindow,
+	}
+}
+
+// sign queues the request to be sent with the next batch and waits for its signature.
+func (b *batchSigner) sign(ctx context.Context, pubKey []byte, request internal.SignRequestJson) (dilithium.Signature, error) {
+	if b.unsupported.Load() {
+		return b.client.Sign(ctx, hexutil.Encode(pubKey), request)
+	}
+	b.start.Do(func() {
+		go b.run()
+	})
+	pending := &pendingSignRequest{
+		request: &web3signerv2.SignRequest{PublicKey: pubKey, Request: []byte(request)},
+		result:  make(chan *signResult, 1),
+	}
+	select {
+	case b.queue <- pending:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-b.ctx.Done():
+		return nil, errBatchSignerStopped
+	}
+	select {
+	case res := <-pending.result:
+		return res.signature, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-b.ctx.Done():
+		return nil, errBatchSignerStopped
+	}
+}
+
+// run groups the queued requests into batches of at most maxSize requests, waiting at most
+// window for a batch to fill up. It returns once the context of the keymanager is done.
+func (b *batchSigner) run() {
+	for {
+		var batch []*pendingSignRequest
+		select {
+		case pending := <-b.queue:
+			batch = append(batch, pending)
+		case <-b.ctx.Done():
+			return
+		}
+		timer := time.NewTimer(b.window)
+	collect:
+		for len(batch) < b.maxSize {
+			select {
+			case pending := <-b.queue:
+				batch = append(batch, pending)
+			case <-timer.C:
+				break collect
+			case <-b.ctx.Done():
+				timer.Stop()
+				return
+			}
+		}
+		timer.Stop()
+		go b.send(batch)
+	}
+}
+
+// send signs the batch with the remote signer and hands the result of each request back to its caller.
+// A batch is abandoned after a slot, as all of its messages are outdated by then.
+func (b *batchSigner) send(batch []*pendingSignRequest) {
+	timeout := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	ctx, cancel := context.WithTimeout(b.ctx, timeout)
+	defer cancel()
+
+	requests := make([]*web3signerv2.SignRequest, len(batch))
+	for i, pending := range batch {
+		requests[i] = pending.request
+	}
+	batchSignRequestsTotal.Inc()
+	batchSignSize.Observe(float64(len(batch)))
+	results, err := b.client.SignBatch(ctx, requests)
+	if errors.Is(err, internal.ErrBatchSigningNotSupported) {
+		if !b.unsupported.Swap(true) {
+			log.Warn("Remote signer does not support batch signing, signing messages one at a time")
+		}
+		b.signEach(ctx, batch)
+		return
+	}
+	if err != nil {
+		erroredResponsesTotal.Inc()
+		for _, pending := range batch {
+			pending.result <- &signResult{err: errors.Wrap(err, "could not sign batch")}
+		}
+		return
+	}
+	for i, pending := range batch {
+		pending.result <- toSignResult(results[i])
+	}
+}
+
+// signEach signs the requests of the batch one at a time, in parallel, with the single message signing api.
+func (b *batchSigner) signEach(ctx context.Context, batch []*pendingSignRequest) {
+	var wg sync.WaitGroup
+	for _, pending := range batch {
+		wg.Add(1)
+		go func(pending *pendingSignRequest) {
+			defer wg.Done()
+			sig, err := b.client.Sign(ctx, hexutil.Encode(pending.request.PublicKey), internal.SignRequestJson(pending.request.Request))
+			pending.result <- &signResult{signature: sig, err: err}
+		}(pending)
+	}
+	wg.Wait()
+}
+
+func toSignResult(result *web3signerv2.SignResult) *signResult {
+	switch {
+	case result == nil:
+		return &signResult{err: errors.New("no result returned by remote signer")}
+	case result.Error != nil:
+		erroredResponsesTotal.Inc()
+		return &signResult{err: fmt.Errorf("remote signer refused to sign, Status: %d, Message: %s", result.Error.Code, result.Error.Message)}
+	default:
+		sig, err := dilithium.SignatureFromBytes(result.Signature)
+		if err != nil {
+			return &signResult{err: errors.Wrap(err, "invalid signature returned by remote signer")}
+		}
+		return &signResult{signature: sig}
+	}
+}
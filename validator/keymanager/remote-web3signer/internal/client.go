@@ -3,6 +3,7 @@ package internal
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -20,13 +21,20 @@ import (
 	"github.com/theQRL/qrysm/v4/crypto/dilithium"
 	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
 	"github.com/theQRL/qrysm/v4/monitoring/tracing"
+	web3signerv2 "github.com/theQRL/qrysm/v4/validator/keymanager/remote-web3signer/v2"
 	"go.opencensus.io/trace"
 )
 
 const (
 	ethApiNamespace = "/api/v1/eth2/sign/"
+	// maxIdleConnsPerHost is the number of idle connections kept open to the remote signer, so that
+	// concurrent sign requests do not open a new connection each.
+	maxIdleConnsPerHost = 64
 )
 
+// ErrBatchSigningNotSupported is returned when the remote signer does not serve the batch signing endpoint.
+var ErrBatchSigningNotSupported = errors.New("remote signer does not support batch signing")
+
 type SignRequestJson []byte
 
 // SignatureResponse is the struct representing the signing request response in json format
@@ -37,6 +45,7 @@ type SignatureResponse struct {
 // HttpSignerClient defines the interface for interacting with a remote web3signer.
 type HttpSignerClient interface {
 	Sign(ctx context.Context, pubKey string, request SignRequestJson) (dilithium.Signature, error)
+	SignBatch(ctx context.Context, requests []*web3signerv2.SignRequest) ([]*web3signerv2.SignResult, error)
 	GetPublicKeys(ctx context.Context, url string) ([][dilithium2.CryptoPublicKeyBytes]byte, error)
 }
 
@@ -47,7 +56,8 @@ type ApiClient struct {
 }
 
 // NewApiClient method instantiates a new ApiClient object.
-func NewApiClient(baseEndpoint string) (*ApiClient, error) {
+// The TLS config is optional and used to connect to remote signers that require client certificates.
+func NewApiClient(baseEndpoint string, tlsConfig *tls.Config) (*ApiClient, error) {
 	u, err := url.ParseRequestURI(baseEndpoint)
 	if err != nil {
 		return nil, errors.Wrap(err, "invalid format, unable to parse url")
@@ -57,10 +67,20 @@ func NewApiClient(baseEndpoint string) (*ApiClient, error) {
 	}
 	return &ApiClient{
 		BaseURL:    u,
-		RestClient: &http.Client{},
+		RestClient: &http.Client{Transport: newTransport(tlsConfig)},
 	}, nil
 }
 
+// newTransport returns a transport that keeps connections to the remote signer open between requests.
+// HTTP/2 is negotiated with TLS endpoints, which multiplexes concurrent requests over a single connection.
+func newTransport(tlsConfig *tls.Config) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	transport.ForceAttemptHTTP2 = true
+	transport.MaxIdleConnsPerHost = maxIdleConnsPerHost
+	return transport
+}
+
 // Sign is a wrapper method around the web3signer sign api.
 func (client *ApiClient) Sign(ctx context.Context, pubKey string, request SignRequestJson) (dilithium.Signature, error) {
 	requestPath := ethApiNamespace + pubKey
@@ -69,9 +89,11 @@ func (client *ApiClient) Sign(ctx context.Context, pubKey string, request SignRe
 		return nil, err
 	}
 	if resp.StatusCode == http.StatusNotFound {
+		closeBody(resp.Body)
 		return nil, fmt.Errorf("public key not found")
 	}
 	if resp.StatusCode == http.StatusPreconditionFailed {
+		closeBody(resp.Body)
 		return nil, fmt.Errorf("signing operation failed due to slashing protection rules,  Signing Request URL: %v, Status: %v", client.BaseURL.String()+requestPath, resp.StatusCode)
 	}
 	contentType := resp.Header.Get("Content-Type")
@@ -86,6 +108,36 @@ func (client *ApiClient) Sign(ctx context.Context, pubKey string, request SignRe
 	}
 }
 
+// SignBatch signs many messages in a single request to the batch signing api of the remote signer.
+// The results are in the same order as the requests, and hold either the signature or the reason
+// the remote signer did not sign the message.
+func (client *ApiClient) SignBatch(ctx context.Context, requests []*web3signerv2.SignRequest) ([]*web3signerv2.SignResult, error) {
+	body, err := json.Marshal(&web3signerv2.BatchSignRequest{Requests: requests})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal batch sign request")
+	}
+	resp, err := client.doRequest(ctx, http.MethodPost, client.BaseURL.String()+web3signerv2.BatchSignPath, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		closeBody(resp.Body)
+		return nil, ErrBatchSigningNotSupported
+	}
+	if resp.StatusCode != http.StatusOK {
+		closeBody(resp.Body)
+		return nil, fmt.Errorf("batch signing failed, Signing Request URL: %v, Status: %v", client.BaseURL.String()+web3signerv2.BatchSignPath, resp.StatusCode)
+	}
+	var batchResp web3signerv2.BatchSignResponse
+	if err := unmarshalResponse(resp.Body, &batchResp); err != nil {
+		return nil, err
+	}
+	if len(batchResp.Results) != len(requests) {
+		return nil, fmt.Errorf("remote signer returned %d results for a batch of %d requests", len(batchResp.Results), len(requests))
+	}
+	return batchResp.Results, nil
+}
+
 // GetPublicKeys is a wrapper method around the web3signer publickeys api (this may be removed in the future or moved to another location due to its usage).
 func (client *ApiClient) GetPublicKeys(ctx context.Context, url string) ([][dilithium2.CryptoPublicKeyBytes]byte, error) {
 	resp, err := client.doRequest(ctx, http.MethodGet, url, nil /* no body needed on get request */)
@@ -163,6 +215,12 @@ func (client *ApiClient) doRequest(ctx context.Context, httpMethod, fullPath str
 		signRequestDurationSeconds.WithLabelValues(req.Method, strconv.Itoa(resp.StatusCode)).Observe(duration.Seconds())
 	}
 	if resp.StatusCode != http.StatusOK {
+		// The request body has been consumed by the transport, so it is recreated to be dumped.
+		if req.GetBody != nil {
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
 		requestDump, err = httputil.DumpRequestOut(req, true)
 		if err != nil {
 			return nil, err
@@ -178,10 +236,12 @@ func (client *ApiClient) doRequest(ctx context.Context, httpMethod, fullPath str
 		}).Error("web3signer request failed")
 	}
 	if resp.StatusCode == http.StatusInternalServerError {
+		closeBody(resp.Body)
 		err = fmt.Errorf("internal Web3Signer server error, Signing Request URL: %v Status: %v", fullPath, resp.StatusCode)
 		tracing.AnnotateError(span, err)
 		return nil, err
 	} else if resp.StatusCode == http.StatusBadRequest {
+		closeBody(resp.Body)
 		err = fmt.Errorf("bad request format, Signing Request URL: %v Status: %v", fullPath, resp.StatusCode)
 		tracing.AnnotateError(span, err)
 		return nil, err
@@ -215,8 +275,12 @@ func unmarshalSignatureResponse(responseBody io.ReadCloser) (dilithium.Signature
 	return dilithium.SignatureFromBytes(sigBytes)
 }
 
-// closeBody a utility method to wrap an error for closing
-func closeBody(body io.Closer) {
+// closeBody a utility method to wrap an error for closing. The remaining body is read before closing it,
+// which allows the connection to be reused for the next request.
+func closeBody(body io.ReadCloser) {
+	if _, err := io.Copy(io.Discard, body); err != nil {
+		log.WithError(err).Debug("could not drain response body")
+	}
 	if err := body.Close(); err != nil {
 		log.WithError(err).Error("could not close response body")
 	}
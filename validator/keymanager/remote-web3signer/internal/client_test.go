@@ -14,6 +14,7 @@ import (
 	"github.com/theQRL/go-zond/common/hexutil"
 	"github.com/theQRL/qrysm/v4/testing/require"
 	"github.com/theQRL/qrysm/v4/validator/keymanager/remote-web3signer/internal"
+	web3signerv2 "github.com/theQRL/qrysm/v4/validator/keymanager/remote-web3signer/v2"
 )
 
 // mockTransport is the mock Transport object
@@ -27,7 +28,7 @@ func (m *mockTransport) RoundTrip(*http.Request) (*http.Response, error) {
 }
 
 func TestNewApiClient(t *testing.T) {
-	apiClient, err := internal.NewApiClient("http://localhost:8545")
+	apiClient, err := internal.NewApiClient("http://localhost:8545", nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, apiClient)
 }
@@ -201,3 +202,71 @@ func TestClient_GetServerStatus_HappyPath(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.Nil(t, err)
 }
+
+// batchMockTransport decodes the batch sign request it receives and answers with the given results.
+type batchMockTransport struct {
+	statusCode int
+	results    []*web3signerv2.SignResult
+	request    *web3signerv2.BatchSignRequest
+	path       string
+}
+
+// RoundTrip is mocking the batch signing endpoint of a remote signer
+func (m *batchMockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	m.path = req.URL.Path
+	m.request = &web3signerv2.BatchSignRequest{}
+	if err := json.NewDecoder(req.Body).Decode(m.request); err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(&web3signerv2.BatchSignResponse{Results: m.results})
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		StatusCode: m.statusCode,
+		Body:       io.NopCloser(bytes.NewReader(body)),
+	}, nil
+}
+
+func TestClient_SignBatch(t *testing.T) {
+	requests := []*web3signerv2.SignRequest{
+		{PublicKey: []byte{1}, Request: json.RawMessage(`{"type":"ATTESTATION","signingRoot":"0x01"}`)},
+		{PublicKey: []byte{2}, Request: json.RawMessage(`{"type":"ATTESTATION","signingRoot":"0x02"}`)},
+	}
+	u, err := url.Parse("http://example.com")
+	require.NoError(t, err)
+
+	t.Run("happy path", func(t *testing.T) {
+		mock := &batchMockTransport{
+			statusCode: http.StatusOK,
+			results: []*web3signerv2.SignResult{
+				{Signature: []byte{0xaa}},
+				{Error: &web3signerv2.SignError{Code: http.StatusPreconditionFailed, Message: "slashable"}},
+			},
+		}
+		cl := internal.ApiClient{BaseURL: u, RestClient: &http.Client{Transport: mock}}
+		results, err := cl.SignBatch(context.Background(), requests)
+		require.NoError(t, err)
+		assert.Equal(t, web3signerv2.BatchSignPath, mock.path)
+		require.Equal(t, 2, len(mock.request.Requests))
+		assert.EqualValues(t, requests[1].Request, mock.request.Requests[1].Request)
+		require.Equal(t, 2, len(results))
+		assert.EqualValues(t, []byte{0xaa}, []byte(results[0].Signature))
+		assert.Equal(t, http.StatusPreconditionFailed, results[1].Error.Code)
+	})
+	t.Run("not supported", func(t *testing.T) {
+		mock := &batchMockTransport{statusCode: http.StatusNotFound}
+		cl := internal.ApiClient{BaseURL: u, RestClient: &http.Client{Transport: mock}}
+		_, err := cl.SignBatch(context.Background(), requests)
+		assert.ErrorIs(t, err, internal.ErrBatchSigningNotSupported)
+	})
+	t.Run("missing results", func(t *testing.T) {
+		mock := &batchMockTransport{
+			statusCode: http.StatusOK,
+			results:    []*web3signerv2.SignResult{{Signature: []byte{0xaa}}},
+		}
+		cl := internal.ApiClient{BaseURL: u, RestClient: &http.Client{Transport: mock}}
+		_, err := cl.SignBatch(context.Background(), requests)
+		require.ErrorContains(t, "1 results for a batch of 2 requests", err)
+	})
+}
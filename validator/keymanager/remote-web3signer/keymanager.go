@@ -16,8 +16,8 @@ import (
 	"github.com/theQRL/qrysm/v4/async/event"
 	"github.com/theQRL/qrysm/v4/crypto/dilithium"
 	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
-	zondpbservice "github.com/theQRL/qrysm/v4/proto/zond/service"
 	validatorpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1/validator-client"
+	zondpbservice "github.com/theQRL/qrysm/v4/proto/zond/service"
 	"github.com/theQRL/qrysm/v4/validator/accounts/petnames"
 	"github.com/theQRL/qrysm/v4/validator/keymanager"
 	"github.com/theQRL/qrysm/v4/validator/keymanager/remote-web3signer/internal"
@@ -40,6 +40,16 @@ type SetupConfig struct {
 	// a static list of public keys to be passed by the user to determine what accounts should sign.
 	// This will provide a layer of safety against slashing if the web3signer is shared across validators.
 	ProvidedPublicKeys [][dilithium2.CryptoPublicKeyBytes]byte
+
+	// BatchSigning sends the sign requests issued concurrently by the validator client to the batch
+	// signing api of the remote signer, rather than making one request per message.
+	BatchSigning bool
+
+	// ClientCertPath and ClientKeyPath are the certificate presented to remote signers requiring mutual TLS.
+	// CACertPath is the certificate authority used to verify the remote signer instead of the system roots.
+	ClientCertPath string
+	ClientKeyPath  string
+	CACertPath     string
 }
 
 // Keymanager defines the web3signer keymanager.
@@ -51,18 +61,23 @@ type Keymanager struct {
 	accountsChangedFeed   *event.Feed
 	validator             *validator.Validate
 	publicKeysUrlCalled   bool
+	batchSigner           *batchSigner
 }
 
 // NewKeymanager instantiates a new web3signer key manager.
-func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
+func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
 	if cfg.BaseEndpoint == "" || !bytesutil.IsValidRoot(cfg.GenesisValidatorsRoot) {
 		return nil, fmt.Errorf("invalid setup config, one or more configs are empty: BaseEndpoint: %v, GenesisValidatorsRoot: %#x", cfg.BaseEndpoint, cfg.GenesisValidatorsRoot)
 	}
-	client, err := internal.NewApiClient(cfg.BaseEndpoint)
+	tlsCfg, err := tlsConfig(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load TLS configuration")
+	}
+	client, err := internal.NewApiClient(cfg.BaseEndpoint, tlsCfg)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create apiClient")
 	}
-	return &Keymanager{
+	km := &Keymanager{
 		client:                internal.HttpSignerClient(client),
 		genesisValidatorsRoot: cfg.GenesisValidatorsRoot,
 		accountsChangedFeed:   new(event.Feed),
@@ -70,7 +85,11 @@ func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
 		providedPublicKeys:    cfg.ProvidedPublicKeys,
 		validator:             validator.New(),
 		publicKeysUrlCalled:   false,
-	}, nil
+	}
+	if cfg.BatchSigning {
+		km.batchSigner = newBatchSigner(ctx, km.client)
+	}
+	return km, nil
 }
 
 // FetchValidatingPublicKeys fetches the validating public keys
@@ -100,6 +119,9 @@ func (km *Keymanager) Sign(ctx context.Context, request *validatorpb.SignRequest
 
 	signRequestsTotal.Inc()
 
+	if km.batchSigner != nil {
+		return km.batchSigner.sign(ctx, request.PublicKey, signRequest)
+	}
 	return km.client.Sign(ctx, hexutil.Encode(request.PublicKey), signRequest)
 }
 
@@ -1,37 +1,73 @@
 package remote_web3signer
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"fmt"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
 	"github.com/theQRL/go-zond/common/hexutil"
 	"github.com/theQRL/qrysm/v4/crypto/dilithium"
 	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
-	zondpbservice "github.com/theQRL/qrysm/v4/proto/zond/service"
 	validatorpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1/validator-client"
+	zondpbservice "github.com/theQRL/qrysm/v4/proto/zond/service"
 	"github.com/theQRL/qrysm/v4/testing/require"
 	"github.com/theQRL/qrysm/v4/validator/keymanager/remote-web3signer/internal"
 	"github.com/theQRL/qrysm/v4/validator/keymanager/remote-web3signer/v1/mock"
+	web3signerv2 "github.com/theQRL/qrysm/v4/validator/keymanager/remote-web3signer/v2"
 )
 
 type MockClient struct {
-	Signature       string
-	PublicKeys      []string
-	isThrowingError bool
+	Signature        string
+	PublicKeys       []string
+	isThrowingError  bool
+	batchUnsupported bool
+	refusedPublicKey []byte
+	batchSizes       []int
+	signCalls        int
+	lock             sync.Mutex
 }
 
 func (mc *MockClient) Sign(_ context.Context, _ string, _ internal.SignRequestJson) (dilithium.Signature, error) {
+	mc.lock.Lock()
+	mc.signCalls++
+	mc.lock.Unlock()
 	decoded, err := hexutil.Decode(mc.Signature)
 	if err != nil {
 		return nil, err
 	}
 	return dilithium.SignatureFromBytes(decoded)
 }
+func (mc *MockClient) SignBatch(_ context.Context, requests []*web3signerv2.SignRequest) ([]*web3signerv2.SignResult, error) {
+	mc.lock.Lock()
+	mc.batchSizes = append(mc.batchSizes, len(requests))
+	mc.lock.Unlock()
+	if mc.batchUnsupported {
+		return nil, internal.ErrBatchSigningNotSupported
+	}
+	if mc.isThrowingError {
+		return nil, fmt.Errorf("mock error")
+	}
+	decoded, err := hexutil.Decode(mc.Signature)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*web3signerv2.SignResult, len(requests))
+	for i, req := range requests {
+		if mc.refusedPublicKey != nil && bytes.Equal(mc.refusedPublicKey, req.PublicKey) {
+			results[i] = &web3signerv2.SignResult{Error: &web3signerv2.SignError{Code: 412, Message: "slashable"}}
+			continue
+		}
+		results[i] = &web3signerv2.SignResult{Signature: decoded}
+	}
+	return results, nil
+}
 func (mc *MockClient) GetPublicKeys(_ context.Context, _ string) ([][dilithium2.CryptoPublicKeyBytes]byte, error) {
 	var keys [][dilithium2.CryptoPublicKeyBytes]byte
 	for _, pk := range mc.PublicKeys {
@@ -185,6 +221,131 @@ func TestKeymanager_Sign(t *testing.T) {
 
 }
 
+func TestKeymanager_Sign_Batch(t *testing.T) {
+	ctx := context.Background()
+	sig := hexutil.Encode(make([]byte, dilithium2.CryptoBytes))
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	config := &SetupConfig{
+		BaseEndpoint:          "http://example.com",
+		GenesisValidatorsRoot: root,
+		BatchSigning:          true,
+	}
+	refusedKey := make([]byte, dilithium2.CryptoPublicKeyBytes)
+	refusedKey[0] = 1
+
+	t.Run("concurrent requests are batched", func(t *testing.T) {
+		km, err := NewKeymanager(ctx, config)
+		require.NoError(t, err)
+		client := &MockClient{Signature: sig, refusedPublicKey: refusedKey}
+		km.batchSigner = newBatchSigner(ctx, client)
+		km.batchSigner.window = 200 * time.Millisecond
+
+		const numRequests = 8
+		errs := make([]error, numRequests)
+		var wg sync.WaitGroup
+		for i := 0; i < numRequests; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				request := mock.GetMockSignRequest("ATTESTATION")
+				if i == 0 {
+					request.PublicKey = refusedKey
+				}
+				_, errs[i] = km.Sign(ctx, request)
+			}(i)
+		}
+		wg.Wait()
+		require.ErrorContains(t, "Status: 412", errs[0])
+		for _, err := range errs[1:] {
+			require.NoError(t, err)
+		}
+		client.lock.Lock()
+		defer client.lock.Unlock()
+		require.Equal(t, numRequests, sumInts(client.batchSizes))
+		require.Equal(t, true, len(client.batchSizes) < numRequests)
+	})
+	t.Run("batch size is bounded", func(t *testing.T) {
+		km, err := NewKeymanager(ctx, config)
+		require.NoError(t, err)
+		client := &MockClient{Signature: sig}
+		km.batchSigner = newBatchSigner(ctx, client)
+		km.batchSigner.maxSize = 2
+		km.batchSigner.window = time.Second
+
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := km.Sign(ctx, mock.GetMockSignRequest("ATTESTATION"))
+				require.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+		client.lock.Lock()
+		defer client.lock.Unlock()
+		require.DeepEqual(t, []int{2, 2}, client.batchSizes)
+	})
+	t.Run("batch error", func(t *testing.T) {
+		km, err := NewKeymanager(ctx, config)
+		require.NoError(t, err)
+		km.batchSigner = newBatchSigner(ctx, &MockClient{Signature: sig, isThrowingError: true})
+
+		_, err = km.Sign(ctx, mock.GetMockSignRequest("ATTESTATION"))
+		require.ErrorContains(t, "could not sign batch: mock error", err)
+	})
+	t.Run("context canceled", func(t *testing.T) {
+		km, err := NewKeymanager(ctx, config)
+		require.NoError(t, err)
+		km.batchSigner = newBatchSigner(ctx, &MockClient{Signature: sig})
+		km.batchSigner.window = time.Minute
+
+		cancelCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		_, err = km.Sign(cancelCtx, mock.GetMockSignRequest("ATTESTATION"))
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+	t.Run("batch signing not supported", func(t *testing.T) {
+		km, err := NewKeymanager(ctx, config)
+		require.NoError(t, err)
+		client := &MockClient{Signature: sig, batchUnsupported: true}
+		km.batchSigner = newBatchSigner(ctx, client)
+
+		_, err = km.Sign(ctx, mock.GetMockSignRequest("ATTESTATION"))
+		require.NoError(t, err)
+		_, err = km.Sign(ctx, mock.GetMockSignRequest("ATTESTATION"))
+		require.NoError(t, err)
+		client.lock.Lock()
+		defer client.lock.Unlock()
+		// The batch signing endpoint is only tried once.
+		require.Equal(t, 1, len(client.batchSizes))
+		require.Equal(t, 2, client.signCalls)
+	})
+	t.Run("keymanager stopped", func(t *testing.T) {
+		kmCtx, cancel := context.WithCancel(ctx)
+		km, err := NewKeymanager(kmCtx, config)
+		require.NoError(t, err)
+		km.batchSigner = newBatchSigner(kmCtx, &MockClient{Signature: sig})
+		km.batchSigner.window = time.Minute
+
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			cancel()
+		}()
+		_, err = km.Sign(ctx, mock.GetMockSignRequest("ATTESTATION"))
+		require.ErrorIs(t, err, errBatchSignerStopped)
+	})
+}
+
+func sumInts(values []int) int {
+	sum := 0
+	for _, v := range values {
+		sum += v
+	}
+	return sum
+}
+
 func TestKeymanager_FetchValidatingPublicKeys_HappyPath_WithKeyList(t *testing.T) {
 	ctx := context.Background()
 	decodedKey, err := hexutil.Decode("0xa2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820")
@@ -14,6 +14,15 @@ var (
 		Name: "remote_web3signer_errored_responses_total",
 		Help: "Total number of errored responses when calling web3signer",
 	})
+	batchSignRequestsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "remote_web3signer_batch_sign_requests_total",
+		Help: "Total number of batch sign requests",
+	})
+	batchSignSize = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "remote_web3signer_batch_sign_size",
+		Help:    "Number of messages signed per batch sign request",
+		Buckets: []float64{1, 2, 4, 8, 16, 32, 64, 128, 256, 512},
+	})
 	blockSignRequestsTotal = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "remote_web3signer_block_sign_requests_total",
 		Help: "Total number of block sign requests",
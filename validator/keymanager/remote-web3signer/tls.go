@@ -0,0 +1,41 @@
+package remote_web3signer
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// tlsConfig builds the TLS configuration used to connect to the remote signer,
+// returning nil when the default configuration is enough.
+func tlsConfig(cfg *SetupConfig) (*tls.Config, error) {
+	if cfg.ClientCertPath == "" && cfg.ClientKeyPath == "" && cfg.CACertPath == "" {
+		return nil, nil
+	}
+	if (cfg.ClientCertPath == "") != (cfg.ClientKeyPath == "") {
+		return nil, errors.New("both a client certificate and a client key are required")
+	}
+	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg.ClientCertPath != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.ClientCertPath, cfg.ClientKeyPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load client certificate")
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+	if cfg.CACertPath != "" {
+		caCert, err := os.ReadFile(filepath.Clean(cfg.CACertPath))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read CA certificate")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, errors.Errorf("no certificate found in %s", cfg.CACertPath)
+		}
+		tlsCfg.RootCAs = pool
+	}
+	return tlsCfg, nil
+}
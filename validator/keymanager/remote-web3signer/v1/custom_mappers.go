@@ -57,9 +57,10 @@ func MapAttestation(attestation *zondpb.Attestation) (*Attestation, error) {
 		return nil, err
 	}
 	return &Attestation{
-		AggregationBits: []byte(attestation.AggregationBits),
-		Data:            data,
-		Signature:       attestation.Signature,
+		AggregationBits:         []byte(attestation.AggregationBits),
+		Data:                    data,
+		Signature:               attestation.Signature,
+		SignatureValidatorIndex: mapIndices(attestation.SignatureValidatorIndex),
 	}, nil
 }
 
@@ -228,12 +229,25 @@ func MapIndexedAttestation(attestation *zondpb.IndexedAttestation) (*IndexedAtte
 		return nil, errors.Wrap(err, "could not map attestation data to IndexedAttestation")
 	}
 	return &IndexedAttestation{
-		AttestingIndices: attestingIndices,
-		Data:             attestationData,
-		Signature:        attestation.Signature,
+		AttestingIndices:        attestingIndices,
+		Data:                    attestationData,
+		Signature:               attestation.Signature,
+		SignatureValidatorIndex: mapIndices(attestation.SignatureValidatorIndex),
 	}, nil
 }
 
+// mapIndices maps the validator indices of the signatures of an attestation, which are part of its hash tree root.
+func mapIndices(indices []uint64) []string {
+	if len(indices) == 0 {
+		return nil
+	}
+	mapped := make([]string, len(indices))
+	for i, index := range indices {
+		mapped[i] = fmt.Sprint(index)
+	}
+	return mapped
+}
+
 // MapDeposit maps the eth2.Deposit proto to the Web3Signer spec.
 func MapDeposit(deposit *zondpb.Deposit) (*Deposit, error) {
 	if deposit == nil {
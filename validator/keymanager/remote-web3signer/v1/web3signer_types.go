@@ -136,9 +136,10 @@ type AggregateAndProof struct {
 
 // Attestation a sub property of AggregateAndProofSignRequest.
 type Attestation struct {
-	AggregationBits hexutil.Bytes    `json:"aggregation_bits"` /*hex bitlist*/
-	Data            *AttestationData `json:"data"`
-	Signature       hexutil.Bytes    `json:"signature"`
+	AggregationBits         hexutil.Bytes    `json:"aggregation_bits"` /*hex bitlist*/
+	Data                    *AttestationData `json:"data"`
+	Signature               hexutil.Bytes    `json:"signature"`
+	SignatureValidatorIndex []string         `json:"signature_validator_index,omitempty"` /* uint64[] */
 }
 
 // AttestationData a sub property of Attestation.
@@ -215,9 +216,10 @@ type AttesterSlashing struct {
 
 // IndexedAttestation a sub property of AttesterSlashing.
 type IndexedAttestation struct {
-	AttestingIndices []string         `json:"attesting_indices"` /* uint64[] */
-	Data             *AttestationData `json:"data"`
-	Signature        hexutil.Bytes    `json:"signature"`
+	AttestingIndices        []string         `json:"attesting_indices"` /* uint64[] */
+	Data                    *AttestationData `json:"data"`
+	Signature               hexutil.Bytes    `json:"signature"`
+	SignatureValidatorIndex []string         `json:"signature_validator_index,omitempty"` /* uint64[] */
 }
 
 // Deposit a sub property of DepositSignRequest.
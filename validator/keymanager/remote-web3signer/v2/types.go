@@ -0,0 +1,59 @@
+// Package v2 defines the batch signing protocol of the remote signer. Dilithium signatures are large
+// enough that signing a single message per HTTP round trip becomes the bottleneck of validator clients
+// running thousands of keys, so the v2 API signs many messages in one request and reports the outcome of
+// every message individually.
+package v2
+
+import (
+	"encoding/json"
+
+	"github.com/theQRL/go-zond/common/hexutil"
+)
+
+const (
+	// BatchSignPath is the path of the batch signing endpoint of the remote signer.
+	BatchSignPath = "/api/v2/zond/sign/batch"
+	// MaxBatchSize is the maximum number of messages a remote signer is required to accept in one batch.
+	MaxBatchSize = 512
+)
+
+// BatchSignRequest is the request body of the batch signing endpoint.
+type BatchSignRequest struct {
+	Requests []*SignRequest `json:"requests" validate:"required,min=1,dive"`
+}
+
+// SignRequest is a single message to be signed as part of a batch. Request holds the web3signer v1 sign
+// request of the message, which carries its type and signing root as well as the data the remote signer
+// needs to apply slashing protection.
+type SignRequest struct {
+	PublicKey hexutil.Bytes   `json:"public_key" validate:"required"`
+	Request   json.RawMessage `json:"request" validate:"required"`
+}
+
+// BatchSignResponse is the response body of the batch signing endpoint. Results are in the same order
+// as the requests of the batch.
+type BatchSignResponse struct {
+	Results []*SignResult `json:"results"`
+}
+
+// SignResult is the outcome of signing a single message of a batch, holding either the signature or
+// the reason the message was not signed.
+type SignResult struct {
+	Signature hexutil.Bytes `json:"signature,omitempty"`
+	Error     *SignError    `json:"error,omitempty"`
+}
+
+// SignError describes why a single message of a batch was not signed. Code follows the status codes of
+// the web3signer v1 sign endpoint, e.g. 404 for an unknown public key and 412 for a request refused by
+// slashing protection.
+type SignError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// SignRequestHeader holds the fields shared by all web3signer v1 sign requests that are needed to sign
+// the message.
+type SignRequestHeader struct {
+	Type        string        `json:"type"`
+	SigningRoot hexutil.Bytes `json:"signingRoot"`
+}
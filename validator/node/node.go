@@ -39,6 +39,7 @@ import (
 	"github.com/theQRL/qrysm/v4/consensus-types/validator"
 	"github.com/theQRL/qrysm/v4/container/slice"
 	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
+	"github.com/theQRL/qrysm/v4/io/boltcompact"
 	"github.com/theQRL/qrysm/v4/io/file"
 	"github.com/theQRL/qrysm/v4/monitoring/backup"
 	"github.com/theQRL/qrysm/v4/monitoring/prometheus"
@@ -245,6 +246,11 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 				"Disregard this warning if this is the first time you are running this set of keys.", dataFile)
 		}
 	}
+	if cliCtx.Bool(cmd.CompactDB.Name) {
+		if err := compactDB(cliCtx.Context, dataDir); err != nil {
+			return err
+		}
+	}
 	log.WithField("databasePath", dataDir).Info("Checking DB")
 
 	valDB, err := kv.NewKVStore(cliCtx.Context, dataDir, &kv.Config{
@@ -267,10 +273,11 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 		return err
 	}
 	if cliCtx.Bool(flags.EnableRPCFlag.Name) {
-		if err := c.registerRPCService(cliCtx); err != nil {
+		router := mux.NewRouter()
+		if err := c.registerRPCService(cliCtx, router); err != nil {
 			return err
 		}
-		if err := c.registerRPCGatewayService(cliCtx); err != nil {
+		if err := c.registerRPCGatewayService(cliCtx, router); err != nil {
 			return err
 		}
 	}
@@ -322,6 +329,11 @@ func (c *ValidatorClient) initializeForWeb(cliCtx *cli.Context) error {
 			return err
 		}
 	}
+	if cliCtx.Bool(cmd.CompactDB.Name) {
+		if err := compactDB(cliCtx.Context, dataDir); err != nil {
+			return err
+		}
+	}
 	log.WithField("databasePath", dataDir).Info("Checking DB")
 	valDB, err := kv.NewKVStore(cliCtx.Context, dataDir, &kv.Config{
 		PubKeys: nil,
@@ -342,10 +354,11 @@ func (c *ValidatorClient) initializeForWeb(cliCtx *cli.Context) error {
 	if err := c.registerValidatorService(cliCtx); err != nil {
 		return err
 	}
-	if err := c.registerRPCService(cliCtx); err != nil {
+	router := mux.NewRouter()
+	if err := c.registerRPCService(cliCtx, router); err != nil {
 		return err
 	}
-	if err := c.registerRPCGatewayService(cliCtx); err != nil {
+	if err := c.registerRPCGatewayService(cliCtx, router); err != nil {
 		return err
 	}
 	gatewayHost := cliCtx.String(flags.GRPCGatewayHost.Name)
@@ -436,6 +449,7 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		ProposerSettings:           bpc,
 		BeaconApiTimeout:           time.Second * 30,
 		BeaconApiEndpoint:          c.cliCtx.String(flags.BeaconRESTApiProviderFlag.Name),
+		BeaconApiBroadcast:         c.cliCtx.Bool(flags.BeaconRESTApiBroadcastFlag.Name),
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not initialize validator service")
@@ -458,6 +472,10 @@ func Web3SignerConfig(cliCtx *cli.Context) (*remoteweb3signer.SetupConfig, error
 		web3signerConfig = &remoteweb3signer.SetupConfig{
 			BaseEndpoint:          u.String(),
 			GenesisValidatorsRoot: nil,
+			BatchSigning:          cliCtx.Bool(flags.Web3SignerBatchSigningFlag.Name),
+			ClientCertPath:        cliCtx.String(flags.Web3SignerTLSCertFlag.Name),
+			ClientKeyPath:         cliCtx.String(flags.Web3SignerTLSKeyFlag.Name),
+			CACertPath:            cliCtx.String(flags.Web3SignerTLSCACertFlag.Name),
 		}
 		if cliCtx.IsSet(flags.WalletPasswordFileFlag.Name) {
 			log.Warnf("%s was provided while using web3signer and will be ignored", flags.WalletPasswordFileFlag.Name)
@@ -721,7 +739,7 @@ func reviewGasLimit(gasLimit validator.Uint64) validator.Uint64 {
 	return gasLimit
 }
 
-func (c *ValidatorClient) registerRPCService(cliCtx *cli.Context) error {
+func (c *ValidatorClient) registerRPCService(cliCtx *cli.Context, router *mux.Router) error {
 	var vs *client.ValidatorService
 	if err := c.services.FetchService(&vs); err != nil {
 		return err
@@ -751,6 +769,7 @@ func (c *ValidatorClient) registerRPCService(cliCtx *cli.Context) error {
 		NodeGatewayEndpoint:      nodeGatewayEndpoint,
 		WalletDir:                walletDir,
 		Wallet:                   c.wallet,
+		Router:                   router,
 		ValidatorGatewayHost:     validatorGatewayHost,
 		ValidatorGatewayPort:     validatorGatewayPort,
 		ValidatorMonitoringHost:  validatorMonitoringHost,
@@ -765,7 +784,7 @@ func (c *ValidatorClient) registerRPCService(cliCtx *cli.Context) error {
 	return c.services.RegisterService(server)
 }
 
-func (c *ValidatorClient) registerRPCGatewayService(cliCtx *cli.Context) error {
+func (c *ValidatorClient) registerRPCGatewayService(cliCtx *cli.Context, router *mux.Router) error {
 	gatewayHost := cliCtx.String(flags.GRPCGatewayHost.Name)
 	if gatewayHost != flags.DefaultGatewayHost {
 		log.WithField("web-host", gatewayHost).Warn(
@@ -840,7 +859,7 @@ func (c *ValidatorClient) registerRPCGatewayService(cliCtx *cli.Context) error {
 		Mux:           gwmux,
 	}
 	opts := []gateway.Option{
-		gateway.WithRouter(mux.NewRouter()),
+		gateway.WithRouter(router),
 		gateway.WithRemoteAddr(rpcAddr),
 		gateway.WithGatewayAddr(gatewayAddress),
 		gateway.WithMaxCallRecvMsgSize(maxCallSize),
@@ -913,6 +932,17 @@ func clearDB(ctx context.Context, dataDir string, force bool) error {
 	return nil
 }
 
+// compactDB rewrites the slashing protection database into a new file, keeping a backup of the original one.
+func compactDB(ctx context.Context, dataDir string) error {
+	if !file.FileExists(filepath.Join(dataDir, kv.ProtectionDbFileName)) {
+		return nil
+	}
+	if _, err := kv.CompactDatabase(ctx, dataDir, &boltcompact.Config{BackupDir: kv.BackupsDir(dataDir)}); err != nil {
+		return errors.Wrapf(err, "could not compact DB in dir %s", dataDir)
+	}
+	return nil
+}
+
 func unmarshalFromURL(ctx context.Context, from string, to interface{}) error {
 	u, err := url.ParseRequestURI(from)
 	if err != nil {
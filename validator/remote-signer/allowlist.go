@@ -0,0 +1,83 @@
+package remotesigner
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/go-zond/common/hexutil"
+	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
+	"gopkg.in/yaml.v2"
+)
+
+// Allowlist restricts the keys each client of the remote signer may sign with. Clients are
+// identified by the common name of the certificate they present when connecting over mutual TLS.
+type Allowlist struct {
+	clients map[string]map[[dilithium2.CryptoPublicKeyBytes]byte]bool
+}
+
+// allowlistFile is the yaml (or json) representation of an allowlist, mapping client names to public keys:
+//
+//	clients:
+//	  validator-1:
+//	    - 0x0f2b...
+//	    - 0x8e31...
+type allowlistFile struct {
+	Clients map[string][]string `yaml:"clients"`
+}
+
+// LoadAllowlist reads the allowlist at the given path.
+func LoadAllowlist(path string) (*Allowlist, error) {
+	b, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read allowlist file")
+	}
+	f := &allowlistFile{}
+	if err := yaml.Unmarshal(b, f); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal allowlist file")
+	}
+	return NewAllowlist(f.Clients)
+}
+
+// NewAllowlist creates an allowlist from hex encoded public keys indexed by client name.
+func NewAllowlist(clients map[string][]string) (*Allowlist, error) {
+	a := &Allowlist{clients: make(map[string]map[[dilithium2.CryptoPublicKeyBytes]byte]bool, len(clients))}
+	for client, keys := range clients {
+		if client == "" {
+			return nil, errors.New("allowlist contains a client without a name")
+		}
+		allowed := make(map[[dilithium2.CryptoPublicKeyBytes]byte]bool, len(keys))
+		for _, key := range keys {
+			pubKey, err := hexutil.Decode(key)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not decode public key %s of client %s", key, client)
+			}
+			if len(pubKey) != dilithium2.CryptoPublicKeyBytes {
+				return nil, fmt.Errorf("public key %s of client %s is not byte length %d", key, client, dilithium2.CryptoPublicKeyBytes)
+			}
+			allowed[bytesutil.ToBytes2592(pubKey)] = true
+		}
+		a.clients[client] = allowed
+	}
+	return a, nil
+}
+
+// Allowed returns whether the client may sign with the public key.
+// A nil allowlist allows every client to sign with every key.
+func (a *Allowlist) Allowed(client string, pubKey [dilithium2.CryptoPublicKeyBytes]byte) bool {
+	if a == nil {
+		return true
+	}
+	return a.clients[client][pubKey]
+}
+
+// clientName identifies the client of the request by the common name of its TLS certificate.
+func clientName(r *http.Request) string {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return ""
+	}
+	return r.TLS.PeerCertificates[0].Subject.CommonName
+}
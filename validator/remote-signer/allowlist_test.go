@@ -0,0 +1,56 @@
+package remotesigner
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/go-zond/common/hexutil"
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+)
+
+func TestLoadAllowlist(t *testing.T) {
+	key1 := [dilithium2.CryptoPublicKeyBytes]byte{1}
+	key2 := [dilithium2.CryptoPublicKeyBytes]byte{2}
+	path := filepath.Join(t.TempDir(), "allowlist.yaml")
+	content := fmt.Sprintf("clients:\n  validator-1:\n    - %s\n    - %s\n  validator-2:\n    - %s\n",
+		hexutil.Encode(key1[:]), hexutil.Encode(key2[:]), hexutil.Encode(key2[:]))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+	a, err := LoadAllowlist(path)
+	require.NoError(t, err)
+	assert.Equal(t, true, a.Allowed("validator-1", key1))
+	assert.Equal(t, true, a.Allowed("validator-1", key2))
+	assert.Equal(t, false, a.Allowed("validator-2", key1))
+	assert.Equal(t, true, a.Allowed("validator-2", key2))
+	assert.Equal(t, false, a.Allowed("validator-3", key2))
+	assert.Equal(t, false, a.Allowed("", key2))
+}
+
+func TestNewAllowlist_InvalidKeys(t *testing.T) {
+	_, err := NewAllowlist(map[string][]string{"validator-1": {"0x12"}})
+	assert.ErrorContains(t, "is not byte length", err)
+	_, err = NewAllowlist(map[string][]string{"validator-1": {"foo"}})
+	assert.ErrorContains(t, "could not decode public key", err)
+	_, err = NewAllowlist(map[string][]string{"": {}})
+	assert.ErrorContains(t, "client without a name", err)
+}
+
+func TestAllowlist_Nil(t *testing.T) {
+	var a *Allowlist
+	assert.Equal(t, true, a.Allowed("", [dilithium2.CryptoPublicKeyBytes]byte{1}))
+}
+
+func TestClientName(t *testing.T) {
+	r := httptest.NewRequest("GET", "http://foo.com", nil)
+	assert.Equal(t, "", clientName(r))
+	r.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "validator-1"}}}}
+	assert.Equal(t, "validator-1", clientName(r))
+}
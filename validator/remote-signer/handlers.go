@@ -0,0 +1,121 @@
+package remotesigner
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/go-zond/common/hexutil"
+	"github.com/theQRL/qrysm/v4/crypto/dilithium"
+	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
+	http2 "github.com/theQRL/qrysm/v4/network/http"
+	web3signerv2 "github.com/theQRL/qrysm/v4/validator/keymanager/remote-web3signer/v2"
+)
+
+// SignResponse is the response of the sign api.
+type SignResponse struct {
+	Signature hexutil.Bytes `json:"signature"`
+}
+
+// Upcheck reports the remote signer is up.
+func (*Server) Upcheck(w http.ResponseWriter, _ *http.Request) {
+	http2.WriteJson(w, "OK")
+}
+
+// PublicKeys returns the public keys the client may sign with.
+func (s *Server) PublicKeys(w http.ResponseWriter, r *http.Request) {
+	keys := s.publicKeys()
+	client := clientName(r)
+	resp := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if s.cfg.Allowlist.Allowed(client, key) {
+			resp = append(resp, hexutil.Encode(key[:]))
+		}
+	}
+	http2.WriteJson(w, resp)
+}
+
+// Sign signs a web3signer v1 sign request with the key of the request path.
+func (s *Server) Sign(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http2.HandleError(w, "Could not read request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	pubKey, err := hexutil.Decode(mux.Vars(r)["identifier"])
+	if err != nil {
+		http2.HandleError(w, "Invalid public key: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	sig, signErr := s.sign(r, pubKey, body)
+	if signErr != nil {
+		http2.HandleError(w, signErr.Error(), signErr.code)
+		return
+	}
+	http2.WriteJson(w, &SignResponse{Signature: sig})
+}
+
+// SignBatch signs many web3signer v1 sign requests in a single request, returning the signature or the
+// reason the message was not signed for each of them.
+func (s *Server) SignBatch(w http.ResponseWriter, r *http.Request) {
+	req := &web3signerv2.BatchSignRequest{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		http2.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(req.Requests) == 0 {
+		http2.HandleError(w, "No sign requests in batch", http.StatusBadRequest)
+		return
+	}
+	if len(req.Requests) > web3signerv2.MaxBatchSize {
+		http2.HandleError(w, fmt.Sprintf("Batch exceeds the maximum size of %d sign requests", web3signerv2.MaxBatchSize), http.StatusBadRequest)
+		return
+	}
+	batchSignRequestsTotal.Inc()
+
+	resp := &web3signerv2.BatchSignResponse{Results: make([]*web3signerv2.SignResult, len(req.Requests))}
+	for i, item := range req.Requests {
+		if item == nil {
+			resp.Results[i] = &web3signerv2.SignResult{Error: &web3signerv2.SignError{Code: http.StatusBadRequest, Message: "empty sign request"}}
+			continue
+		}
+		sig, signErr := s.sign(r, item.PublicKey, item.Request)
+		if signErr != nil {
+			resp.Results[i] = &web3signerv2.SignResult{Error: &web3signerv2.SignError{Code: signErr.code, Message: signErr.Error()}}
+			continue
+		}
+		resp.Results[i] = &web3signerv2.SignResult{Signature: sig}
+	}
+	http2.WriteJson(w, resp)
+}
+
+// sign checks the client may sign with the key before signing the message.
+func (s *Server) sign(r *http.Request, rawPubKey []byte, request []byte) ([]byte, *signError) {
+	signErr := s.checkPublicKey(r, rawPubKey)
+	if signErr == nil {
+		var sig dilithium.Signature
+		sig, signErr = s.signMessage(r.Context(), bytesutil.ToBytes2592(rawPubKey), request)
+		if signErr == nil {
+			return sig.Marshal(), nil
+		}
+	}
+	refusedSignRequestsTotal.WithLabelValues(strconv.Itoa(signErr.code)).Inc()
+	log.WithError(signErr).WithField("publicKey", fmt.Sprintf("%#x", bytesutil.Trunc(rawPubKey))).Debug("Refused sign request")
+	return nil, signErr
+}
+
+func (s *Server) checkPublicKey(r *http.Request, rawPubKey []byte) *signError {
+	if len(rawPubKey) != dilithium2.CryptoPublicKeyBytes {
+		return badRequest(fmt.Errorf("public key is not byte length %d", dilithium2.CryptoPublicKeyBytes))
+	}
+	pubKey := bytesutil.ToBytes2592(rawPubKey)
+	// Keys the client may not sign with are reported as unknown, so that clients cannot discover the keys of the signer.
+	if !s.cfg.Allowlist.Allowed(clientName(r), pubKey) || !s.hasKey(pubKey) {
+		return &signError{code: http.StatusNotFound, err: fmt.Errorf("public key %#x not found", bytesutil.Trunc(rawPubKey))}
+	}
+	return nil
+}
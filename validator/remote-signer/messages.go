@@ -0,0 +1,404 @@
+package remotesigner
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/go-bitfield"
+	"github.com/theQRL/go-zond/common/hexutil"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	web3signerv1 "github.com/theQRL/qrysm/v4/validator/keymanager/remote-web3signer/v1"
+)
+
+// The functions below convert the messages of web3signer v1 sign requests back to their consensus types, so the
+// signing root of a request can be computed from its message.
+
+func parseUint(s, name string) (uint64, error) {
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid %s", name)
+	}
+	return v, nil
+}
+
+func parseIndices(indices []string, name string) ([]uint64, error) {
+	if len(indices) == 0 {
+		return nil, nil
+	}
+	parsed := make([]uint64, len(indices))
+	for i, index := range indices {
+		v, err := parseUint(index, name)
+		if err != nil {
+			return nil, err
+		}
+		parsed[i] = v
+	}
+	return parsed, nil
+}
+
+func attestationData(data *web3signerv1.AttestationData) (*zondpb.AttestationData, error) {
+	if data == nil || data.Source == nil || data.Target == nil {
+		return nil, errors.New("attestation sign request is missing its attestation data")
+	}
+	slot, err := parseUint(data.Slot, "attestation slot")
+	if err != nil {
+		return nil, err
+	}
+	index, err := parseUint(data.Index, "attestation committee index")
+	if err != nil {
+		return nil, err
+	}
+	source, err := checkpoint(data.Source)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid attestation source")
+	}
+	target, err := checkpoint(data.Target)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid attestation target")
+	}
+	return &zondpb.AttestationData{
+		Slot:            primitives.Slot(slot),
+		CommitteeIndex:  primitives.CommitteeIndex(index),
+		BeaconBlockRoot: data.BeaconBlockRoot,
+		Source:          source,
+		Target:          target,
+	}, nil
+}
+
+func checkpoint(c *web3signerv1.Checkpoint) (*zondpb.Checkpoint, error) {
+	epoch, err := parseUint(c.Epoch, "epoch")
+	if err != nil {
+		return nil, err
+	}
+	root, err := hexutil.Decode(c.Root)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid root")
+	}
+	return &zondpb.Checkpoint{Epoch: primitives.Epoch(epoch), Root: root}, nil
+}
+
+func attestation(a *web3signerv1.Attestation) (*zondpb.Attestation, error) {
+	if a == nil {
+		return nil, errors.New("attestation is missing")
+	}
+	data, err := attestationData(a.Data)
+	if err != nil {
+		return nil, err
+	}
+	indices, err := parseIndices(a.SignatureValidatorIndex, "signature validator index")
+	if err != nil {
+		return nil, err
+	}
+	return &zondpb.Attestation{
+		AggregationBits:         bitfield.Bitlist(a.AggregationBits),
+		Data:                    data,
+		Signature:               a.Signature,
+		SignatureValidatorIndex: indices,
+	}, nil
+}
+
+func indexedAttestation(a *web3signerv1.IndexedAttestation) (*zondpb.IndexedAttestation, error) {
+	if a == nil {
+		return nil, errors.New("indexed attestation is missing")
+	}
+	data, err := attestationData(a.Data)
+	if err != nil {
+		return nil, err
+	}
+	attestingIndices, err := parseIndices(a.AttestingIndices, "attesting index")
+	if err != nil {
+		return nil, err
+	}
+	signatureIndices, err := parseIndices(a.SignatureValidatorIndex, "signature validator index")
+	if err != nil {
+		return nil, err
+	}
+	return &zondpb.IndexedAttestation{
+		AttestingIndices:        attestingIndices,
+		Data:                    data,
+		Signature:               a.Signature,
+		SignatureValidatorIndex: signatureIndices,
+	}, nil
+}
+
+func beaconBlockHeader(h *web3signerv1.BeaconBlockHeader) (*zondpb.BeaconBlockHeader, error) {
+	if h == nil {
+		return nil, errors.New("block header is missing")
+	}
+	slot, err := parseUint(h.Slot, "block slot")
+	if err != nil {
+		return nil, err
+	}
+	proposerIndex, err := parseUint(h.ProposerIndex, "block proposer index")
+	if err != nil {
+		return nil, err
+	}
+	return &zondpb.BeaconBlockHeader{
+		Slot:          primitives.Slot(slot),
+		ProposerIndex: primitives.ValidatorIndex(proposerIndex),
+		ParentRoot:    h.ParentRoot,
+		StateRoot:     h.StateRoot,
+		BodyRoot:      h.BodyRoot,
+	}, nil
+}
+
+func signedBeaconBlockHeader(h *web3signerv1.SignedBeaconBlockHeader) (*zondpb.SignedBeaconBlockHeader, error) {
+	if h == nil {
+		return nil, errors.New("signed block header is missing")
+	}
+	header, err := beaconBlockHeader(h.Message)
+	if err != nil {
+		return nil, err
+	}
+	return &zondpb.SignedBeaconBlockHeader{Header: header, Signature: h.Signature}, nil
+}
+
+func voluntaryExit(e *web3signerv1.VoluntaryExit) (*zondpb.VoluntaryExit, error) {
+	if e == nil {
+		return nil, errors.New("voluntary exit is missing")
+	}
+	epoch, err := parseUint(e.Epoch, "exit epoch")
+	if err != nil {
+		return nil, err
+	}
+	index, err := parseUint(e.ValidatorIndex, "exit validator index")
+	if err != nil {
+		return nil, err
+	}
+	return &zondpb.VoluntaryExit{Epoch: primitives.Epoch(epoch), ValidatorIndex: primitives.ValidatorIndex(index)}, nil
+}
+
+// blockBody holds the fields shared by the bodies of phase0 and altair blocks.
+type blockBody struct {
+	eth1Data          *zondpb.Eth1Data
+	proposerSlashings []*zondpb.ProposerSlashing
+	attesterSlashings []*zondpb.AttesterSlashing
+	attestations      []*zondpb.Attestation
+	deposits          []*zondpb.Deposit
+	voluntaryExits    []*zondpb.SignedVoluntaryExit
+}
+
+func convertBlockBody(
+	eth1Data *web3signerv1.Eth1Data,
+	proposerSlashings []*web3signerv1.ProposerSlashing,
+	attesterSlashings []*web3signerv1.AttesterSlashing,
+	attestations []*web3signerv1.Attestation,
+	deposits []*web3signerv1.Deposit,
+	voluntaryExits []*web3signerv1.SignedVoluntaryExit,
+) (*blockBody, error) {
+	if eth1Data == nil {
+		return nil, errors.New("block body is missing its eth1 data")
+	}
+	depositCount, err := parseUint(eth1Data.DepositCount, "eth1 data deposit count")
+	if err != nil {
+		return nil, err
+	}
+	body := &blockBody{
+		eth1Data: &zondpb.Eth1Data{
+			DepositRoot:  eth1Data.DepositRoot,
+			DepositCount: depositCount,
+			BlockHash:    eth1Data.BlockHash,
+		},
+		proposerSlashings: make([]*zondpb.ProposerSlashing, len(proposerSlashings)),
+		attesterSlashings: make([]*zondpb.AttesterSlashing, len(attesterSlashings)),
+		attestations:      make([]*zondpb.Attestation, len(attestations)),
+		deposits:          make([]*zondpb.Deposit, len(deposits)),
+		voluntaryExits:    make([]*zondpb.SignedVoluntaryExit, len(voluntaryExits)),
+	}
+	for i, s := range proposerSlashings {
+		if s == nil {
+			return nil, errors.Errorf("proposer slashing %d is missing", i)
+		}
+		header1, err := signedBeaconBlockHeader(s.Signedheader1)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid proposer slashing %d", i)
+		}
+		header2, err := signedBeaconBlockHeader(s.Signedheader2)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid proposer slashing %d", i)
+		}
+		body.proposerSlashings[i] = &zondpb.ProposerSlashing{Header_1: header1, Header_2: header2}
+	}
+	for i, s := range attesterSlashings {
+		if s == nil {
+			return nil, errors.Errorf("attester slashing %d is missing", i)
+		}
+		attestation1, err := indexedAttestation(s.Attestation1)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid attester slashing %d", i)
+		}
+		attestation2, err := indexedAttestation(s.Attestation2)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid attester slashing %d", i)
+		}
+		body.attesterSlashings[i] = &zondpb.AttesterSlashing{Attestation_1: attestation1, Attestation_2: attestation2}
+	}
+	for i, a := range attestations {
+		att, err := attestation(a)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid attestation %d", i)
+		}
+		body.attestations[i] = att
+	}
+	for i, d := range deposits {
+		if d == nil || d.Data == nil {
+			return nil, errors.Errorf("deposit %d is missing its data", i)
+		}
+		proof := make([][]byte, len(d.Proof))
+		for j, p := range d.Proof {
+			proof[j], err = hexutil.Decode(p)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid proof of deposit %d", i)
+			}
+		}
+		amount, err := parseUint(d.Data.Amount, "deposit amount")
+		if err != nil {
+			return nil, err
+		}
+		body.deposits[i] = &zondpb.Deposit{
+			Proof: proof,
+			Data: &zondpb.Deposit_Data{
+				PublicKey:             d.Data.PublicKey,
+				WithdrawalCredentials: d.Data.WithdrawalCredentials,
+				Amount:                amount,
+				Signature:             d.Data.Signature,
+			},
+		}
+	}
+	for i, e := range voluntaryExits {
+		if e == nil {
+			return nil, errors.Errorf("voluntary exit %d is missing", i)
+		}
+		exit, err := voluntaryExit(e.Message)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid voluntary exit %d", i)
+		}
+		body.voluntaryExits[i] = &zondpb.SignedVoluntaryExit{Exit: exit, Signature: e.Signature}
+	}
+	return body, nil
+}
+
+func beaconBlock(b *web3signerv1.BeaconBlock) (*zondpb.BeaconBlock, error) {
+	if b == nil || b.Body == nil {
+		return nil, errors.New("block sign request is missing its block")
+	}
+	slot, err := parseUint(b.Slot, "block slot")
+	if err != nil {
+		return nil, err
+	}
+	proposerIndex, err := parseUint(b.ProposerIndex, "block proposer index")
+	if err != nil {
+		return nil, err
+	}
+	body, err := convertBlockBody(b.Body.Eth1Data, b.Body.ProposerSlashings, b.Body.AttesterSlashings, b.Body.Attestations, b.Body.Deposits, b.Body.VoluntaryExits)
+	if err != nil {
+		return nil, err
+	}
+	return &zondpb.BeaconBlock{
+		Slot:          primitives.Slot(slot),
+		ProposerIndex: primitives.ValidatorIndex(proposerIndex),
+		ParentRoot:    b.ParentRoot,
+		StateRoot:     b.StateRoot,
+		Body: &zondpb.BeaconBlockBody{
+			RandaoReveal:      b.Body.RandaoReveal,
+			Eth1Data:          body.eth1Data,
+			Graffiti:          b.Body.Graffiti,
+			ProposerSlashings: body.proposerSlashings,
+			AttesterSlashings: body.attesterSlashings,
+			Attestations:      body.attestations,
+			Deposits:          body.deposits,
+			VoluntaryExits:    body.voluntaryExits,
+		},
+	}, nil
+}
+
+func beaconBlockAltair(b *web3signerv1.BeaconBlockAltair) (*zondpb.BeaconBlockAltair, error) {
+	if b == nil || b.Body == nil {
+		return nil, errors.New("block sign request is missing its block")
+	}
+	if b.Body.SyncAggregate == nil {
+		return nil, errors.New("block body is missing its sync aggregate")
+	}
+	slot, err := parseUint(b.Slot, "block slot")
+	if err != nil {
+		return nil, err
+	}
+	proposerIndex, err := parseUint(b.ProposerIndex, "block proposer index")
+	if err != nil {
+		return nil, err
+	}
+	body, err := convertBlockBody(b.Body.Eth1Data, b.Body.ProposerSlashings, b.Body.AttesterSlashings, b.Body.Attestations, b.Body.Deposits, b.Body.VoluntaryExits)
+	if err != nil {
+		return nil, err
+	}
+	return &zondpb.BeaconBlockAltair{
+		Slot:          primitives.Slot(slot),
+		ProposerIndex: primitives.ValidatorIndex(proposerIndex),
+		ParentRoot:    b.ParentRoot,
+		StateRoot:     b.StateRoot,
+		Body: &zondpb.BeaconBlockBodyAltair{
+			RandaoReveal:      b.Body.RandaoReveal,
+			Eth1Data:          body.eth1Data,
+			Graffiti:          b.Body.Graffiti,
+			ProposerSlashings: body.proposerSlashings,
+			AttesterSlashings: body.attesterSlashings,
+			Attestations:      body.attestations,
+			Deposits:          body.deposits,
+			VoluntaryExits:    body.voluntaryExits,
+			SyncAggregate: &zondpb.SyncAggregate{
+				SyncCommitteeBits:      bitfield.Bitvector16(b.Body.SyncAggregate.SyncCommitteeBits),
+				SyncCommitteeSignature: b.Body.SyncAggregate.SyncCommitteeSignature,
+			},
+		},
+	}, nil
+}
+
+func contributionAndProof(c *web3signerv1.ContributionAndProof) (*zondpb.ContributionAndProof, error) {
+	if c == nil || c.Contribution == nil {
+		return nil, errors.New("sign request is missing its contribution and proof")
+	}
+	aggregatorIndex, err := parseUint(c.AggregatorIndex, "aggregator index")
+	if err != nil {
+		return nil, err
+	}
+	slot, err := parseUint(c.Contribution.Slot, "contribution slot")
+	if err != nil {
+		return nil, err
+	}
+	subcommitteeIndex, err := parseUint(c.Contribution.SubcommitteeIndex, "subcommittee index")
+	if err != nil {
+		return nil, err
+	}
+	return &zondpb.ContributionAndProof{
+		AggregatorIndex: primitives.ValidatorIndex(aggregatorIndex),
+		Contribution: &zondpb.SyncCommitteeContribution{
+			Slot:              primitives.Slot(slot),
+			BlockRoot:         c.Contribution.BeaconBlockRoot,
+			SubcommitteeIndex: subcommitteeIndex,
+			AggregationBits:   bitfield.Bitvector16(c.Contribution.AggregationBits),
+			Signature:         c.Contribution.Signature,
+		},
+		SelectionProof: c.SelectionProof,
+	}, nil
+}
+
+func validatorRegistration(r *web3signerv1.ValidatorRegistration) (*zondpb.ValidatorRegistrationV1, error) {
+	if r == nil {
+		return nil, errors.New("sign request is missing its validator registration")
+	}
+	gasLimit, err := parseUint(r.GasLimit, "gas limit")
+	if err != nil {
+		return nil, err
+	}
+	timestamp, err := parseUint(r.Timestamp, "timestamp")
+	if err != nil {
+		return nil, err
+	}
+	return &zondpb.ValidatorRegistrationV1{
+		FeeRecipient: r.FeeRecipient,
+		GasLimit:     gasLimit,
+		Timestamp:    timestamp,
+		Pubkey:       r.Pubkey,
+	}, nil
+}
@@ -0,0 +1,21 @@
+package remotesigner
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	signRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "remote_signer_sign_requests_total",
+		Help: "Total number of sign requests received by type",
+	}, []string{"type"})
+	refusedSignRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "remote_signer_refused_sign_requests_total",
+		Help: "Total number of sign requests refused by status code",
+	}, []string{"code"})
+	batchSignRequestsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "remote_signer_batch_sign_requests_total",
+		Help: "Total number of batch sign requests received",
+	})
+)
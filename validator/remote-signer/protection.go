@@ -0,0 +1,153 @@
+package remotesigner
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/qrysm/v4/async"
+	"github.com/theQRL/qrysm/v4/config/params"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	"github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1/slashings"
+	"github.com/theQRL/qrysm/v4/validator/db/iface"
+	"github.com/theQRL/qrysm/v4/validator/db/kv"
+)
+
+// errSlashable is returned when signing a message could get the validator slashed.
+var errSlashable = errors.New("refused to sign slashable message")
+
+// slashingProtection refuses to sign attestations and blocks conflicting with the signing history of a key,
+// which is kept in the attester and proposer protection buckets of the validator database.
+type slashingProtection struct {
+	db iface.ValidatorDB
+}
+
+// signAttestation checks the attestation is not slashable and records it in the signing history of the key
+// before signing it. This happens under a lock of the key, so that concurrent requests for the same key
+// cannot both pass the checks.
+func (p *slashingProtection) signAttestation(
+	ctx context.Context,
+	pubKey [dilithium2.CryptoPublicKeyBytes]byte,
+	signingRoot [32]byte,
+	data *zondpb.AttestationData,
+	sign func() error,
+) error {
+	lock := async.NewMultilock(string(pubKey[:]))
+	lock.Lock()
+	defer lock.Unlock()
+
+	// Based on EIP3076, the signer should refuse to sign any attestation with source epoch less
+	// than the minimum source epoch present in that signer’s attestations.
+	lowestSourceEpoch, exists, err := p.db.LowestSignedSourceEpoch(ctx, pubKey)
+	if err != nil {
+		return err
+	}
+	if exists && data.Source.Epoch < lowestSourceEpoch {
+		return fmt.Errorf(
+			"%w: attestation source epoch %d is lower than the lowest signed source epoch %d",
+			errSlashable,
+			data.Source.Epoch,
+			lowestSourceEpoch,
+		)
+	}
+	existingSigningRoot, err := p.db.SigningRootAtTargetEpoch(ctx, pubKey, data.Target.Epoch)
+	if err != nil {
+		return err
+	}
+	signingRootsDiffer := slashings.SigningRootsDiffer(existingSigningRoot, signingRoot)
+
+	// Based on EIP3076, the signer should refuse to sign any attestation with target epoch less
+	// than or equal to the minimum target epoch present in that signer’s attestations.
+	lowestTargetEpoch, exists, err := p.db.LowestSignedTargetEpoch(ctx, pubKey)
+	if err != nil {
+		return err
+	}
+	if signingRootsDiffer && exists && data.Target.Epoch <= lowestTargetEpoch {
+		return fmt.Errorf(
+			"%w: attestation target epoch %d is lower than or equal to the lowest signed target epoch %d",
+			errSlashable,
+			data.Target.Epoch,
+			lowestTargetEpoch,
+		)
+	}
+	indexedAtt := &zondpb.IndexedAttestation{Data: data}
+	slashingKind, err := p.db.CheckSlashableAttestation(ctx, pubKey, signingRoot, indexedAtt)
+	if err != nil {
+		if slashingKind != kv.NotSlashable {
+			return fmt.Errorf("%w: %v", errSlashable, err)
+		}
+		return err
+	}
+
+	if err := p.db.SaveAttestationForPubKey(ctx, pubKey, signingRoot, indexedAtt); err != nil {
+		return errors.Wrap(err, "could not save attestation history for validator public key")
+	}
+	return sign()
+}
+
+// signBlock checks the block proposal is not slashable and records it in the signing history of the key before signing it.
+func (p *slashingProtection) signBlock(
+	ctx context.Context,
+	pubKey [dilithium2.CryptoPublicKeyBytes]byte,
+	signingRoot [32]byte,
+	slot primitives.Slot,
+	sign func() error,
+) error {
+	lock := async.NewMultilock(string(pubKey[:]))
+	lock.Lock()
+	defer lock.Unlock()
+
+	prevSigningRoot, proposalAtSlotExists, err := p.db.ProposalHistoryForSlot(ctx, pubKey, slot)
+	if err != nil {
+		return errors.Wrap(err, "could not get proposal history")
+	}
+	lowestSignedProposalSlot, lowestProposalExists, err := p.db.LowestSignedProposal(ctx, pubKey)
+	if err != nil {
+		return err
+	}
+
+	// A proposal signed at the same slot is slashable unless it is the exact same block.
+	// An empty signing root in the history is considered slashable, as the block it belongs to is unknown.
+	signingRootIsDifferent := prevSigningRoot == params.BeaconConfig().ZeroHash || prevSigningRoot != signingRoot
+	if proposalAtSlotExists && signingRootIsDifferent {
+		return fmt.Errorf("%w: a different block was already signed at slot %d", errSlashable, slot)
+	}
+	// Based on EIP3076, the signer should refuse to sign any proposal with slot less
+	// than or equal to the minimum signed proposal present in the DB for that public key.
+	if lowestProposalExists && signingRootIsDifferent && lowestSignedProposalSlot >= slot {
+		return fmt.Errorf(
+			"%w: block slot %d is lower than or equal to the lowest signed slot %d",
+			errSlashable,
+			slot,
+			lowestSignedProposalSlot,
+		)
+	}
+
+	if err := p.db.SaveProposalHistoryForSlot(ctx, pubKey, slot, signingRoot[:]); err != nil {
+		return errors.Wrap(err, "could not save proposal history for validator public key")
+	}
+	return sign()
+}
+
+// checkGenesisValidatorsRoot makes sure the slashing protection history is only used for a single network,
+// binding it to the genesis validators root of the first slashable message signed.
+func (p *slashingProtection) checkGenesisValidatorsRoot(ctx context.Context, genesisValidatorsRoot []byte) error {
+	lock := async.NewMultilock("genesis-validators-root")
+	lock.Lock()
+	defer lock.Unlock()
+
+	root, err := p.db.GenesisValidatorsRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis validators root")
+	}
+	if len(root) == 0 {
+		return p.db.SaveGenesisValidatorsRoot(ctx, genesisValidatorsRoot)
+	}
+	if !bytes.Equal(root, genesisValidatorsRoot) {
+		return fmt.Errorf("%w: genesis validators root %#x does not match the root of the slashing protection history %#x", errSlashable, genesisValidatorsRoot, root)
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+// Package remotesigner implements a remote signer serving the web3signer apis used by the validator client,
+// signing with the keys of a local keymanager and enforcing its own slashing protection.
+package remotesigner
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/qrysm/v4/validator/db/iface"
+	"github.com/theQRL/qrysm/v4/validator/keymanager"
+	web3signerv2 "github.com/theQRL/qrysm/v4/validator/keymanager/remote-web3signer/v2"
+)
+
+const (
+	readHeaderTimeout = 3 * time.Second
+	shutdownTimeout   = 2 * time.Second
+)
+
+// Config for the remote signer server.
+type Config struct {
+	Keymanager keymanager.IKeymanager
+	ValDB      iface.ValidatorDB
+	Host       string
+	Port       int
+	// CertPath and KeyPath serve the remote signer over TLS.
+	CertPath string
+	KeyPath  string
+	// ClientCACertPath requires clients to present a certificate signed by the certificate authority.
+	ClientCACertPath string
+	// Allowlist restricts the keys each client may sign with. It requires client certificates,
+	// as clients are identified by the common name of their certificate.
+	Allowlist *Allowlist
+}
+
+// Server is the remote signer http server.
+type Server struct {
+	ctx        context.Context
+	cancel     context.CancelFunc
+	cfg        *Config
+	protection *slashingProtection
+	server     *http.Server
+	startErr   error
+	keysLock   sync.RWMutex
+	keys       [][dilithium2.CryptoPublicKeyBytes]byte
+	keySet     map[[dilithium2.CryptoPublicKeyBytes]byte]bool
+}
+
+// New creates a remote signer server.
+func New(ctx context.Context, cfg *Config) (*Server, error) {
+	if cfg.Keymanager == nil || cfg.ValDB == nil {
+		return nil, errors.New("a keymanager and a validator database are required")
+	}
+	if (cfg.CertPath == "") != (cfg.KeyPath == "") {
+		return nil, errors.New("both a certificate and a key are required to serve over TLS")
+	}
+	if cfg.ClientCACertPath != "" && cfg.CertPath == "" {
+		return nil, errors.New("client certificates can only be required when serving over TLS")
+	}
+	if cfg.Allowlist != nil && cfg.ClientCACertPath == "" {
+		return nil, errors.New("an allowlist requires client certificates to identify clients")
+	}
+	keys, err := cfg.Keymanager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch public keys")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Server{
+		ctx:        ctx,
+		cancel:     cancel,
+		cfg:        cfg,
+		protection: &slashingProtection{db: cfg.ValDB},
+	}
+	s.setKeys(keys)
+	s.server = &http.Server{
+		Addr:              net.JoinHostPort(cfg.Host, fmt.Sprintf("%d", cfg.Port)),
+		Handler:           s.Router(),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	if cfg.CertPath != "" {
+		tlsCfg, err := s.tlsConfig()
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		s.server.TLSConfig = tlsCfg
+	}
+	return s, nil
+}
+
+// Router returns the routes of the remote signer apis.
+func (s *Server) Router() *mux.Router {
+	r := mux.NewRouter()
+	r.HandleFunc("/upcheck", s.Upcheck).Methods(http.MethodGet)
+	r.HandleFunc("/api/v1/eth2/publicKeys", s.PublicKeys).Methods(http.MethodGet)
+	r.HandleFunc("/api/v1/eth2/sign/{identifier}", s.Sign).Methods(http.MethodPost)
+	r.HandleFunc(web3signerv2.BatchSignPath, s.SignBatch).Methods(http.MethodPost)
+	return r
+}
+
+// Start listens for requests in the background.
+func (s *Server) Start() {
+	listener, err := net.Listen("tcp", s.server.Addr)
+	if err != nil {
+		s.startErr = errors.Wrap(err, "could not listen")
+		log.WithError(err).Error("Could not start remote signer server")
+		return
+	}
+	go s.listenForAccountChanges()
+	fields := logrus.Fields{
+		"address":            listener.Addr().String(),
+		"keys":               len(s.publicKeys()),
+		"tls":                s.cfg.CertPath != "",
+		"clientCertificates": s.cfg.ClientCACertPath != "",
+		"allowlist":          s.cfg.Allowlist != nil,
+	}
+	if s.cfg.CertPath == "" {
+		log.WithFields(fields).Warn("Remote signer server is not using TLS, anyone able to reach it can request signatures")
+	} else {
+		log.WithFields(fields).Info("Remote signer server listening")
+	}
+	go func() {
+		var err error
+		if s.cfg.CertPath != "" {
+			err = s.server.ServeTLS(listener, s.cfg.CertPath, s.cfg.KeyPath)
+		} else {
+			err = s.server.Serve(listener)
+		}
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.WithError(err).Error("Remote signer server stopped")
+		}
+	}()
+}
+
+// Stop shuts the server down.
+func (s *Server) Stop() error {
+	defer s.cancel()
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return s.server.Shutdown(ctx)
+}
+
+// Status returns an error if the server could not start.
+func (s *Server) Status() error {
+	return s.startErr
+}
+
+// listenForAccountChanges keeps the keys of the server in sync with the keys of the keymanager.
+func (s *Server) listenForAccountChanges() {
+	keysChan := make(chan [][dilithium2.CryptoPublicKeyBytes]byte, 1)
+	sub := s.cfg.Keymanager.SubscribeAccountChanges(keysChan)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case keys := <-keysChan:
+			s.setKeys(keys)
+			log.WithField("keys", len(keys)).Info("Updated remote signer keys")
+		case err := <-sub.Err():
+			log.WithError(err).Error("Could not listen for account changes")
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Server) setKeys(keys [][dilithium2.CryptoPublicKeyBytes]byte) {
+	keySet := make(map[[dilithium2.CryptoPublicKeyBytes]byte]bool, len(keys))
+	for _, key := range keys {
+		keySet[key] = true
+	}
+	s.keysLock.Lock()
+	defer s.keysLock.Unlock()
+	s.keys = keys
+	s.keySet = keySet
+}
+
+func (s *Server) publicKeys() [][dilithium2.CryptoPublicKeyBytes]byte {
+	s.keysLock.RLock()
+	defer s.keysLock.RUnlock()
+	return s.keys
+}
+
+func (s *Server) hasKey(pubKey [dilithium2.CryptoPublicKeyBytes]byte) bool {
+	s.keysLock.RLock()
+	defer s.keysLock.RUnlock()
+	return s.keySet[pubKey]
+}
+
+func (s *Server) tlsConfig() (*tls.Config, error) {
+	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if s.cfg.ClientCACertPath == "" {
+		return tlsCfg, nil
+	}
+	caCert, err := os.ReadFile(filepath.Clean(s.cfg.ClientCACertPath))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read client CA certificate")
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caCert) {
+		return nil, errors.Errorf("no certificate found in %s", s.cfg.ClientCACertPath)
+	}
+	tlsCfg.ClientCAs = pool
+	tlsCfg.ClientAuth = tls.RequireAndVerifyClientCert
+	return tlsCfg, nil
+}
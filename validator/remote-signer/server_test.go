@@ -0,0 +1,375 @@
+package remotesigner
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	fssz "github.com/prysmaticlabs/fastssz"
+	"github.com/theQRL/go-bitfield"
+	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/go-zond/common/hexutil"
+	"github.com/theQRL/qrysm/v4/beacon-chain/core/signing"
+	"github.com/theQRL/qrysm/v4/config/params"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/crypto/dilithium"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+	dbtest "github.com/theQRL/qrysm/v4/validator/db/testing"
+	"github.com/theQRL/qrysm/v4/validator/keymanager/local"
+	web3signerv1 "github.com/theQRL/qrysm/v4/validator/keymanager/remote-web3signer/v1"
+	web3signerv2 "github.com/theQRL/qrysm/v4/validator/keymanager/remote-web3signer/v2"
+)
+
+var testGenesisValidatorsRoot = bytes.Repeat([]byte{1}, 32)
+
+func setupServer(t *testing.T) (*Server, [][dilithium2.CryptoPublicKeyBytes]byte) {
+	ctx := context.Background()
+	km, err := local.NewInteropKeymanager(ctx, 0, 2)
+	require.NoError(t, err)
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	s, err := New(ctx, &Config{
+		Keymanager: km,
+		ValDB:      dbtest.SetupDB(t, keys),
+	})
+	require.NoError(t, err)
+	return s, keys
+}
+
+func testForkInfo(genesisValidatorsRoot []byte) *web3signerv1.ForkInfo {
+	return &web3signerv1.ForkInfo{
+		Fork: &web3signerv1.Fork{
+			PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+			CurrentVersion:  params.BeaconConfig().GenesisForkVersion,
+			Epoch:           "0",
+		},
+		GenesisValidatorsRoot: genesisValidatorsRoot,
+	}
+}
+
+func testSigningRoot(t *testing.T, forkInfo *web3signerv1.ForkInfo, obj fssz.HashRoot, epoch primitives.Epoch, domainType [4]byte) []byte {
+	fork := &zondpb.Fork{
+		PreviousVersion: forkInfo.Fork.PreviousVersion,
+		CurrentVersion:  forkInfo.Fork.CurrentVersion,
+	}
+	domain, err := signing.Domain(fork, epoch, domainType, forkInfo.GenesisValidatorsRoot)
+	require.NoError(t, err)
+	root, err := signing.ComputeSigningRoot(obj, domain)
+	require.NoError(t, err)
+	return root[:]
+}
+
+func attestationRequest(t *testing.T, source, target primitives.Epoch, blockRoot byte) []byte {
+	return attestationRequestWithGenesisRoot(t, source, target, blockRoot, testGenesisValidatorsRoot)
+}
+
+func attestationRequestWithGenesisRoot(t *testing.T, source, target primitives.Epoch, blockRoot byte, genesisValidatorsRoot []byte) []byte {
+	root := bytes.Repeat([]byte{blockRoot}, 32)
+	data := &zondpb.AttestationData{
+		Slot:            params.BeaconConfig().SlotsPerEpoch.Mul(uint64(target)),
+		BeaconBlockRoot: root,
+		Source:          &zondpb.Checkpoint{Epoch: source, Root: make([]byte, 32)},
+		Target:          &zondpb.Checkpoint{Epoch: target, Root: root},
+	}
+	forkInfo := testForkInfo(genesisValidatorsRoot)
+	req, err := json.Marshal(&web3signerv1.AttestationSignRequest{
+		Type:        attestationType,
+		ForkInfo:    forkInfo,
+		SigningRoot: testSigningRoot(t, forkInfo, data, target, params.BeaconConfig().DomainBeaconAttester),
+		Attestation: &web3signerv1.AttestationData{
+			Slot:            fmt.Sprint(data.Slot),
+			Index:           "0",
+			BeaconBlockRoot: data.BeaconBlockRoot,
+			Source:          &web3signerv1.Checkpoint{Epoch: fmt.Sprint(source), Root: hexutil.Encode(data.Source.Root)},
+			Target:          &web3signerv1.Checkpoint{Epoch: fmt.Sprint(target), Root: hexutil.Encode(data.Target.Root)},
+		},
+	})
+	require.NoError(t, err)
+	return req
+}
+
+func blockRequest(t *testing.T, slot primitives.Slot, bodyRoot byte) []byte {
+	header := &zondpb.BeaconBlockHeader{
+		Slot:       slot,
+		ParentRoot: make([]byte, 32),
+		StateRoot:  make([]byte, 32),
+		BodyRoot:   bytes.Repeat([]byte{bodyRoot}, 32),
+	}
+	forkInfo := testForkInfo(testGenesisValidatorsRoot)
+	req, err := json.Marshal(&web3signerv1.BlockV2BlindedSignRequest{
+		Type:        blockV2Type,
+		ForkInfo:    forkInfo,
+		SigningRoot: testSigningRoot(t, forkInfo, header, primitives.Epoch(slot/params.BeaconConfig().SlotsPerEpoch), params.BeaconConfig().DomainBeaconProposer),
+		BeaconBlock: &web3signerv1.BeaconBlockV2Blinded{
+			Version: "CAPELLA",
+			BlockHeader: &web3signerv1.BeaconBlockHeader{
+				Slot:          fmt.Sprint(slot),
+				ProposerIndex: "0",
+				ParentRoot:    header.ParentRoot,
+				StateRoot:     header.StateRoot,
+				BodyRoot:      header.BodyRoot,
+			},
+		},
+	})
+	require.NoError(t, err)
+	return req
+}
+
+func sign(t *testing.T, s *Server, pubKey [dilithium2.CryptoPublicKeyBytes]byte, request []byte) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodPost, "http://foo.com/api/v1/eth2/sign/{identifier}", bytes.NewReader(request))
+	r = mux.SetURLVars(r, map[string]string{"identifier": hexutil.Encode(pubKey[:])})
+	w := httptest.NewRecorder()
+	w.Body = &bytes.Buffer{}
+	s.Sign(w, r)
+	return w
+}
+
+func TestServer_Sign_Attestation(t *testing.T) {
+	s, keys := setupServer(t)
+
+	request := attestationRequest(t, 0, 1, 1)
+	w := sign(t, s, keys[0], request)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := &SignResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+	sig, err := dilithium.SignatureFromBytes(resp.Signature)
+	require.NoError(t, err)
+	pubKey, err := dilithium.PublicKeyFromBytes(keys[0][:])
+	require.NoError(t, err)
+	header := &signRequestHeader{}
+	require.NoError(t, json.Unmarshal(request, header))
+	assert.Equal(t, true, sig.Verify(pubKey, header.SigningRoot))
+
+	// Signing the same attestation again is not slashable.
+	w = sign(t, s, keys[0], request)
+	assert.Equal(t, http.StatusOK, w.Code)
+	// A different attestation with the same target is a double vote.
+	w = sign(t, s, keys[0], attestationRequest(t, 0, 1, 2))
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+	// The double vote is only slashable for the key which signed the first attestation.
+	w = sign(t, s, keys[1], attestationRequest(t, 0, 1, 2))
+	assert.Equal(t, http.StatusOK, w.Code)
+	// An attestation surrounding a previous attestation is slashable.
+	w = sign(t, s, keys[0], attestationRequest(t, 1, 2, 1))
+	assert.Equal(t, http.StatusOK, w.Code)
+	w = sign(t, s, keys[0], attestationRequest(t, 0, 3, 1))
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+}
+
+func TestServer_Sign_Block(t *testing.T) {
+	s, keys := setupServer(t)
+
+	w := sign(t, s, keys[0], blockRequest(t, 10, 1))
+	require.Equal(t, http.StatusOK, w.Code)
+	w = sign(t, s, keys[0], blockRequest(t, 10, 1))
+	assert.Equal(t, http.StatusOK, w.Code)
+	w = sign(t, s, keys[0], blockRequest(t, 10, 2))
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+	w = sign(t, s, keys[0], blockRequest(t, 9, 2))
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+	w = sign(t, s, keys[0], blockRequest(t, 11, 2))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestServer_Sign_Unslashable(t *testing.T) {
+	s, keys := setupServer(t)
+	forkInfo := testForkInfo(testGenesisValidatorsRoot)
+	epoch := primitives.SSZUint64(3)
+	randaoRequest := func(signingRoot []byte) []byte {
+		req, err := json.Marshal(&web3signerv1.RandaoRevealSignRequest{
+			Type:         randaoRevealType,
+			ForkInfo:     forkInfo,
+			SigningRoot:  signingRoot,
+			RandaoReveal: &web3signerv1.RandaoReveal{Epoch: "3"},
+		})
+		require.NoError(t, err)
+		return req
+	}
+
+	w := sign(t, s, keys[0], randaoRequest(testSigningRoot(t, forkInfo, &epoch, 3, params.BeaconConfig().DomainRandao)))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	// The signing root of an attestation cannot be signed under the label of an unslashable type.
+	att := &web3signerv1.AttestationSignRequest{}
+	require.NoError(t, json.Unmarshal(attestationRequest(t, 0, 1, 1), att))
+	w = sign(t, s, keys[0], randaoRequest(att.SigningRoot))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.StringContains(t, "does not match the signing root", w.Body.String())
+
+	exit := &zondpb.VoluntaryExit{Epoch: 5, ValidatorIndex: 1}
+	req, err := json.Marshal(&web3signerv1.VoluntaryExitSignRequest{
+		Type:          voluntaryExitType,
+		ForkInfo:      forkInfo,
+		SigningRoot:   testSigningRoot(t, forkInfo, exit, 5, params.BeaconConfig().DomainVoluntaryExit),
+		VoluntaryExit: &web3signerv1.VoluntaryExit{Epoch: "5", ValidatorIndex: "1"},
+	})
+	require.NoError(t, err)
+	w = sign(t, s, keys[0], req)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestServer_Sign_FullBlock(t *testing.T) {
+	s, keys := setupServer(t)
+	forkInfo := testForkInfo(testGenesisValidatorsRoot)
+	block := &zondpb.BeaconBlock{
+		Slot:       10,
+		ParentRoot: make([]byte, 32),
+		StateRoot:  make([]byte, 32),
+		Body: &zondpb.BeaconBlockBody{
+			RandaoReveal: make([]byte, 4595),
+			Eth1Data:     &zondpb.Eth1Data{DepositRoot: make([]byte, 32), BlockHash: make([]byte, 32)},
+			Graffiti:     make([]byte, 32),
+			Attestations: []*zondpb.Attestation{{
+				AggregationBits: bitfield.Bitlist{0x03},
+				Data: &zondpb.AttestationData{
+					BeaconBlockRoot: make([]byte, 32),
+					Source:          &zondpb.Checkpoint{Root: make([]byte, 32)},
+					Target:          &zondpb.Checkpoint{Root: make([]byte, 32)},
+				},
+				Signature:               make([]byte, 4595),
+				SignatureValidatorIndex: []uint64{7},
+			}},
+		},
+	}
+	body, err := web3signerv1.MapBeaconBlockBody(block.Body)
+	require.NoError(t, err)
+	signingRoot := testSigningRoot(t, forkInfo, block, primitives.Epoch(block.Slot/params.BeaconConfig().SlotsPerEpoch), params.BeaconConfig().DomainBeaconProposer)
+	blockRequest := func(signingRoot []byte) []byte {
+		req, err := json.Marshal(&web3signerv1.BlockSignRequest{
+			Type:        blockType,
+			ForkInfo:    forkInfo,
+			SigningRoot: signingRoot,
+			Block: &web3signerv1.BeaconBlock{
+				Slot:          "10",
+				ProposerIndex: "0",
+				ParentRoot:    block.ParentRoot,
+				StateRoot:     block.StateRoot,
+				Body:          body,
+			},
+		})
+		require.NoError(t, err)
+		return req
+	}
+
+	w := sign(t, s, keys[0], blockRequest(signingRoot))
+	assert.Equal(t, http.StatusOK, w.Code)
+	w = sign(t, s, keys[1], blockRequest(bytes.Repeat([]byte{9}, 32)))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_Sign_InvalidRequests(t *testing.T) {
+	s, keys := setupServer(t)
+
+	t.Run("unknown key", func(t *testing.T) {
+		w := sign(t, s, [dilithium2.CryptoPublicKeyBytes]byte{1}, attestationRequest(t, 0, 1, 1))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+	t.Run("signing root mismatch", func(t *testing.T) {
+		req := &web3signerv1.AttestationSignRequest{}
+		require.NoError(t, json.Unmarshal(attestationRequest(t, 0, 1, 1), req))
+		req.SigningRoot = bytes.Repeat([]byte{9}, 32)
+		request, err := json.Marshal(req)
+		require.NoError(t, err)
+		w := sign(t, s, keys[0], request)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.StringContains(t, "does not match the signing root", w.Body.String())
+	})
+	t.Run("unsupported type", func(t *testing.T) {
+		w := sign(t, s, keys[0], []byte(`{"type":"FOO","signingRoot":"0x`+fmt.Sprintf("%064x", 1)+`"}`))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("other network", func(t *testing.T) {
+		w := sign(t, s, keys[0], attestationRequest(t, 10, 11, 1))
+		require.Equal(t, http.StatusOK, w.Code)
+		w = sign(t, s, keys[0], attestationRequestWithGenesisRoot(t, 11, 12, 1, bytes.Repeat([]byte{2}, 32)))
+		assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+	})
+}
+
+func TestServer_Allowlist(t *testing.T) {
+	s, keys := setupServer(t)
+	allowlist, err := NewAllowlist(map[string][]string{"validator-1": {hexutil.Encode(keys[1][:])}})
+	require.NoError(t, err)
+	s.cfg.Allowlist = allowlist
+	withClient := func(r *http.Request) *http.Request {
+		r.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "validator-1"}}}}
+		return r
+	}
+
+	w := httptest.NewRecorder()
+	w.Body = &bytes.Buffer{}
+	s.PublicKeys(w, withClient(httptest.NewRequest(http.MethodGet, "http://foo.com/api/v1/eth2/publicKeys", nil)))
+	require.Equal(t, http.StatusOK, w.Code)
+	var resp []string
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.DeepEqual(t, []string{hexutil.Encode(keys[1][:])}, resp)
+
+	for i, code := range []int{http.StatusNotFound, http.StatusOK} {
+		r := httptest.NewRequest(http.MethodPost, "http://foo.com/api/v1/eth2/sign/{identifier}", bytes.NewReader(attestationRequest(t, 0, 1, 1)))
+		r = mux.SetURLVars(withClient(r), map[string]string{"identifier": hexutil.Encode(keys[i][:])})
+		w := httptest.NewRecorder()
+		w.Body = &bytes.Buffer{}
+		s.Sign(w, r)
+		assert.Equal(t, code, w.Code)
+	}
+
+	// Clients without a certificate may not sign with any key.
+	w = sign(t, s, keys[1], attestationRequest(t, 1, 2, 1))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestServer_SignBatch(t *testing.T) {
+	s, keys := setupServer(t)
+
+	body, err := json.Marshal(&web3signerv2.BatchSignRequest{Requests: []*web3signerv2.SignRequest{
+		{PublicKey: keys[0][:], Request: attestationRequest(t, 0, 1, 1)},
+		{PublicKey: keys[0][:], Request: attestationRequest(t, 0, 1, 2)},
+		{PublicKey: make([]byte, dilithium2.CryptoPublicKeyBytes), Request: attestationRequest(t, 0, 1, 1)},
+		{PublicKey: keys[1][:], Request: blockRequest(t, 1, 1)},
+	}})
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	w.Body = &bytes.Buffer{}
+	s.SignBatch(w, httptest.NewRequest(http.MethodPost, "http://foo.com"+web3signerv2.BatchSignPath, bytes.NewReader(body)))
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := &web3signerv2.BatchSignResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+	require.Equal(t, 4, len(resp.Results))
+
+	assert.Equal(t, true, resp.Results[0].Error == nil)
+	require.NotNil(t, resp.Results[1].Error)
+	assert.Equal(t, http.StatusPreconditionFailed, resp.Results[1].Error.Code)
+	require.NotNil(t, resp.Results[2].Error)
+	assert.Equal(t, http.StatusNotFound, resp.Results[2].Error.Code)
+	assert.Equal(t, true, resp.Results[3].Error == nil)
+	_, err = dilithium.SignatureFromBytes(resp.Results[3].Signature)
+	require.NoError(t, err)
+}
+
+func TestNew_InvalidConfig(t *testing.T) {
+	s, _ := setupServer(t)
+	cfg := *s.cfg
+	cfg.CertPath = "cert.pem"
+	_, err := New(context.Background(), &cfg)
+	assert.ErrorContains(t, "both a certificate and a key", err)
+
+	cfg = *s.cfg
+	cfg.ClientCACertPath = "ca.pem"
+	_, err = New(context.Background(), &cfg)
+	assert.ErrorContains(t, "only be required when serving over TLS", err)
+
+	cfg = *s.cfg
+	cfg.Allowlist = &Allowlist{}
+	_, err = New(context.Background(), &cfg)
+	assert.ErrorContains(t, "requires client certificates", err)
+}
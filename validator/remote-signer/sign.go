@@ -0,0 +1,378 @@
+package remotesigner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+	fssz "github.com/prysmaticlabs/fastssz"
+	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/go-zond/common/hexutil"
+	"github.com/theQRL/qrysm/v4/beacon-chain/core/signing"
+	"github.com/theQRL/qrysm/v4/config/params"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/crypto/dilithium"
+	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
+	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
+	validatorpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1/validator-client"
+	"github.com/theQRL/qrysm/v4/time/slots"
+	web3signerv1 "github.com/theQRL/qrysm/v4/validator/keymanager/remote-web3signer/v1"
+)
+
+// Sign request types of the web3signer v1 api.
+const (
+	attestationType                       = "ATTESTATION"
+	blockType                             = "BLOCK"
+	blockV2Type                           = "BLOCK_V2"
+	aggregationSlotType                   = "AGGREGATION_SLOT"
+	aggregateAndProofType                 = "AGGREGATE_AND_PROOF"
+	randaoRevealType                      = "RANDAO_REVEAL"
+	voluntaryExitType                     = "VOLUNTARY_EXIT"
+	syncCommitteeMessageType              = "SYNC_COMMITTEE_MESSAGE"
+	syncCommitteeSelectionProofType       = "SYNC_COMMITTEE_SELECTION_PROOF"
+	syncCommitteeContributionAndProofType = "SYNC_COMMITTEE_CONTRIBUTION_AND_PROOF"
+	validatorRegistrationType             = "VALIDATOR_REGISTRATION"
+)
+
+// signRequestHeader holds the fields shared by all sign requests.
+type signRequestHeader struct {
+	Type        string                 `json:"type"`
+	ForkInfo    *web3signerv1.ForkInfo `json:"fork_info"`
+	SigningRoot hexutil.Bytes          `json:"signingRoot"`
+}
+
+// blockV2SignRequest is a BLOCK_V2 sign request, which holds either a full altair block or the header of a
+// block of a later fork.
+type blockV2SignRequest struct {
+	BeaconBlock *struct {
+		Version     string                          `json:"version"`
+		Block       *web3signerv1.BeaconBlockAltair `json:"block"`
+		BlockHeader *web3signerv1.BeaconBlockHeader `json:"block_header"`
+	} `json:"beacon_block"`
+}
+
+// signError is the reason a sign request was not signed, along with the matching http status code.
+type signError struct {
+	code int
+	err  error
+}
+
+func (e *signError) Error() string {
+	return e.err.Error()
+}
+
+func badRequest(err error) *signError {
+	return &signError{code: http.StatusBadRequest, err: err}
+}
+
+// signMessage signs a web3signer v1 sign request, enforcing slashing protection for attestations and blocks.
+//
+// The signing root of every request is computed from its message with the signature domain of its type, and must
+// match the signing root of the request. Otherwise a slashable message could be signed under the label of another
+// type, bypassing the slashing protection history.
+func (s *Server) signMessage(ctx context.Context, pubKey [dilithium2.CryptoPublicKeyBytes]byte, request []byte) (dilithium.Signature, *signError) {
+	header := &signRequestHeader{}
+	if err := json.Unmarshal(request, header); err != nil {
+		return nil, badRequest(errors.Wrap(err, "could not decode sign request"))
+	}
+	if len(header.SigningRoot) != 32 {
+		return nil, badRequest(errors.New("sign request is missing a 32 byte signing root"))
+	}
+	signingRoot := bytesutil.ToBytes32(header.SigningRoot)
+	signRequestsTotal.WithLabelValues(header.Type).Inc()
+
+	var sig dilithium.Signature
+	sign := func() error {
+		var err error
+		sig, err = s.cfg.Keymanager.Sign(ctx, &validatorpb.SignRequest{
+			PublicKey:   pubKey[:],
+			SigningRoot: signingRoot[:],
+		})
+		return err
+	}
+
+	var err error
+	switch header.Type {
+	case attestationType:
+		err = s.signAttestation(ctx, pubKey, header, request, sign)
+	case blockType, blockV2Type:
+		err = s.signBlock(ctx, pubKey, header, request, sign)
+	default:
+		if err = verifyUnslashable(header, request); err == nil {
+			err = sign()
+		}
+	}
+	if err != nil {
+		var signErr *signError
+		switch {
+		case errors.As(err, &signErr):
+			return nil, signErr
+		case errors.Is(err, errSlashable):
+			return nil, &signError{code: http.StatusPreconditionFailed, err: err}
+		default:
+			return nil, &signError{code: http.StatusInternalServerError, err: err}
+		}
+	}
+	return sig, nil
+}
+
+func (s *Server) signAttestation(
+	ctx context.Context,
+	pubKey [dilithium2.CryptoPublicKeyBytes]byte,
+	header *signRequestHeader,
+	request []byte,
+	sign func() error,
+) error {
+	req := &web3signerv1.AttestationSignRequest{}
+	if err := json.Unmarshal(request, req); err != nil {
+		return badRequest(errors.Wrap(err, "could not decode attestation sign request"))
+	}
+	data, err := attestationData(req.Attestation)
+	if err != nil {
+		return badRequest(err)
+	}
+	if err := verifySigningRoot(header, data, data.Target.Epoch, params.BeaconConfig().DomainBeaconAttester); err != nil {
+		return err
+	}
+	if err := s.checkGenesisValidatorsRoot(ctx, header); err != nil {
+		return err
+	}
+	return s.protection.signAttestation(ctx, pubKey, bytesutil.ToBytes32(header.SigningRoot), data, sign)
+}
+
+func (s *Server) signBlock(
+	ctx context.Context,
+	pubKey [dilithium2.CryptoPublicKeyBytes]byte,
+	header *signRequestHeader,
+	request []byte,
+	sign func() error,
+) error {
+	var (
+		block fssz.HashRoot
+		slot  primitives.Slot
+	)
+	switch header.Type {
+	case blockType:
+		req := &web3signerv1.BlockSignRequest{}
+		if err := json.Unmarshal(request, req); err != nil {
+			return badRequest(errors.Wrap(err, "could not decode block sign request"))
+		}
+		b, err := beaconBlock(req.Block)
+		if err != nil {
+			return badRequest(err)
+		}
+		block, slot = b, b.Slot
+	default:
+		req := &blockV2SignRequest{}
+		if err := json.Unmarshal(request, req); err != nil {
+			return badRequest(errors.Wrap(err, "could not decode block sign request"))
+		}
+		switch {
+		case req.BeaconBlock == nil:
+			return badRequest(errors.New("block sign request is missing its block"))
+		case req.BeaconBlock.BlockHeader != nil:
+			h, err := beaconBlockHeader(req.BeaconBlock.BlockHeader)
+			if err != nil {
+				return badRequest(err)
+			}
+			block, slot = h, h.Slot
+		default:
+			b, err := beaconBlockAltair(req.BeaconBlock.Block)
+			if err != nil {
+				return badRequest(err)
+			}
+			block, slot = b, b.Slot
+		}
+	}
+	if err := verifySigningRoot(header, block, slots.ToEpoch(slot), params.BeaconConfig().DomainBeaconProposer); err != nil {
+		return err
+	}
+	if err := s.checkGenesisValidatorsRoot(ctx, header); err != nil {
+		return err
+	}
+	return s.protection.signBlock(ctx, pubKey, bytesutil.ToBytes32(header.SigningRoot), slot, sign)
+}
+
+// verifyUnslashable verifies the signing root of a sign request which cannot get a validator slashed.
+func verifyUnslashable(header *signRequestHeader, request []byte) error {
+	cfg := params.BeaconConfig()
+	switch header.Type {
+	case aggregationSlotType:
+		req := &web3signerv1.AggregationSlotSignRequest{}
+		if err := json.Unmarshal(request, req); err != nil || req.AggregationSlot == nil {
+			return badRequest(errors.New("could not decode aggregation slot sign request"))
+		}
+		slot, err := parseUint(req.AggregationSlot.Slot, "aggregation slot")
+		if err != nil {
+			return badRequest(err)
+		}
+		sszSlot := primitives.SSZUint64(slot)
+		return verifySigningRoot(header, &sszSlot, slots.ToEpoch(primitives.Slot(slot)), cfg.DomainSelectionProof)
+	case aggregateAndProofType:
+		req := &web3signerv1.AggregateAndProofSignRequest{}
+		if err := json.Unmarshal(request, req); err != nil || req.AggregateAndProof == nil {
+			return badRequest(errors.New("could not decode aggregate and proof sign request"))
+		}
+		aggregate, err := attestation(req.AggregateAndProof.Aggregate)
+		if err != nil {
+			return badRequest(err)
+		}
+		aggregatorIndex, err := parseUint(req.AggregateAndProof.AggregatorIndex, "aggregator index")
+		if err != nil {
+			return badRequest(err)
+		}
+		agg := &zondpb.AggregateAttestationAndProof{
+			AggregatorIndex: primitives.ValidatorIndex(aggregatorIndex),
+			Aggregate:       aggregate,
+			SelectionProof:  req.AggregateAndProof.SelectionProof,
+		}
+		return verifySigningRoot(header, agg, slots.ToEpoch(aggregate.Data.Slot), cfg.DomainAggregateAndProof)
+	case randaoRevealType:
+		req := &web3signerv1.RandaoRevealSignRequest{}
+		if err := json.Unmarshal(request, req); err != nil || req.RandaoReveal == nil {
+			return badRequest(errors.New("could not decode randao reveal sign request"))
+		}
+		epoch, err := parseUint(req.RandaoReveal.Epoch, "randao reveal epoch")
+		if err != nil {
+			return badRequest(err)
+		}
+		sszEpoch := primitives.SSZUint64(epoch)
+		return verifySigningRoot(header, &sszEpoch, primitives.Epoch(epoch), cfg.DomainRandao)
+	case voluntaryExitType:
+		req := &web3signerv1.VoluntaryExitSignRequest{}
+		if err := json.Unmarshal(request, req); err != nil {
+			return badRequest(errors.New("could not decode voluntary exit sign request"))
+		}
+		exit, err := voluntaryExit(req.VoluntaryExit)
+		if err != nil {
+			return badRequest(err)
+		}
+		err = verifySigningRoot(header, exit, exit.Epoch, cfg.DomainVoluntaryExit)
+		if err == nil {
+			return nil
+		}
+		// EIP-7044: Beginning in Deneb, exits are signed with the Capella fork version.
+		capellaFork := &zondpb.Fork{
+			PreviousVersion: cfg.CapellaForkVersion,
+			CurrentVersion:  cfg.CapellaForkVersion,
+			Epoch:           cfg.CapellaForkEpoch,
+		}
+		if header.ForkInfo != nil && matchSigningRoot(header, capellaFork, exit, exit.Epoch, cfg.DomainVoluntaryExit) == nil {
+			return nil
+		}
+		return err
+	case syncCommitteeMessageType:
+		req := &web3signerv1.SyncCommitteeMessageSignRequest{}
+		if err := json.Unmarshal(request, req); err != nil || req.SyncCommitteeMessage == nil {
+			return badRequest(errors.New("could not decode sync committee message sign request"))
+		}
+		slot, err := parseUint(req.SyncCommitteeMessage.Slot, "sync committee message slot")
+		if err != nil {
+			return badRequest(err)
+		}
+		root := primitives.SSZBytes(req.SyncCommitteeMessage.BeaconBlockRoot)
+		return verifySigningRoot(header, &root, slots.ToEpoch(primitives.Slot(slot)), cfg.DomainSyncCommittee)
+	case syncCommitteeSelectionProofType:
+		req := &web3signerv1.SyncCommitteeSelectionProofSignRequest{}
+		if err := json.Unmarshal(request, req); err != nil || req.SyncAggregatorSelectionData == nil {
+			return badRequest(errors.New("could not decode sync committee selection proof sign request"))
+		}
+		slot, err := parseUint(req.SyncAggregatorSelectionData.Slot, "selection data slot")
+		if err != nil {
+			return badRequest(err)
+		}
+		index, err := parseUint(req.SyncAggregatorSelectionData.SubcommitteeIndex, "subcommittee index")
+		if err != nil {
+			return badRequest(err)
+		}
+		data := &zondpb.SyncAggregatorSelectionData{Slot: primitives.Slot(slot), SubcommitteeIndex: index}
+		return verifySigningRoot(header, data, slots.ToEpoch(data.Slot), cfg.DomainSyncCommitteeSelectionProof)
+	case syncCommitteeContributionAndProofType:
+		req := &web3signerv1.SyncCommitteeContributionAndProofSignRequest{}
+		if err := json.Unmarshal(request, req); err != nil {
+			return badRequest(errors.New("could not decode contribution and proof sign request"))
+		}
+		c, err := contributionAndProof(req.ContributionAndProof)
+		if err != nil {
+			return badRequest(err)
+		}
+		return verifySigningRoot(header, c, slots.ToEpoch(c.Contribution.Slot), cfg.DomainContributionAndProof)
+	case validatorRegistrationType:
+		req := &web3signerv1.ValidatorRegistrationSignRequest{}
+		if err := json.Unmarshal(request, req); err != nil {
+			return badRequest(errors.New("could not decode validator registration sign request"))
+		}
+		reg, err := validatorRegistration(req.ValidatorRegistration)
+		if err != nil {
+			return badRequest(err)
+		}
+		// Registrations are signed with the genesis fork version and a zero genesis validators root.
+		domain, err := signing.ComputeDomain(cfg.DomainApplicationBuilder, nil, nil)
+		if err != nil {
+			return badRequest(errors.Wrap(err, "could not compute signing domain"))
+		}
+		return compareSigningRoot(header, reg, domain)
+	default:
+		return badRequest(fmt.Errorf("unsupported sign request type %q", header.Type))
+	}
+}
+
+// verifySigningRoot computes the signing root of the object with the fork info of the request and compares it with
+// the signing root of the request.
+func verifySigningRoot(
+	header *signRequestHeader,
+	obj fssz.HashRoot,
+	epoch primitives.Epoch,
+	domainType [4]byte,
+) error {
+	if header.ForkInfo == nil || header.ForkInfo.Fork == nil {
+		return badRequest(errors.New("sign request is missing its fork info"))
+	}
+	forkEpoch, err := parseUint(header.ForkInfo.Fork.Epoch, "fork epoch")
+	if err != nil {
+		return badRequest(err)
+	}
+	fork := &zondpb.Fork{
+		PreviousVersion: header.ForkInfo.Fork.PreviousVersion,
+		CurrentVersion:  header.ForkInfo.Fork.CurrentVersion,
+		Epoch:           primitives.Epoch(forkEpoch),
+	}
+	return matchSigningRoot(header, fork, obj, epoch, domainType)
+}
+
+// matchSigningRoot computes the signing root of the object with the given fork and compares it with the signing root
+// of the request.
+func matchSigningRoot(
+	header *signRequestHeader,
+	fork *zondpb.Fork,
+	obj fssz.HashRoot,
+	epoch primitives.Epoch,
+	domainType [4]byte,
+) error {
+	domain, err := signing.Domain(fork, epoch, domainType, header.ForkInfo.GenesisValidatorsRoot)
+	if err != nil {
+		return badRequest(errors.Wrap(err, "could not compute signing domain"))
+	}
+	return compareSigningRoot(header, obj, domain)
+}
+
+func compareSigningRoot(header *signRequestHeader, obj fssz.HashRoot, domain []byte) error {
+	root, err := signing.ComputeSigningRoot(obj, domain)
+	if err != nil {
+		return badRequest(errors.Wrap(err, "could not compute signing root"))
+	}
+	if root != bytesutil.ToBytes32(header.SigningRoot) {
+		return badRequest(fmt.Errorf("signing root %#x does not match the signing root of the message %#x", header.SigningRoot, root))
+	}
+	return nil
+}
+
+// checkGenesisValidatorsRoot checks the request is for the network of the slashing protection history.
+func (s *Server) checkGenesisValidatorsRoot(ctx context.Context, header *signRequestHeader) error {
+	if header.ForkInfo == nil || len(header.ForkInfo.GenesisValidatorsRoot) != 32 {
+		return badRequest(errors.New("sign request is missing its genesis validators root"))
+	}
+	return s.protection.checkGenesisValidatorsRoot(ctx, header.ForkInfo.GenesisValidatorsRoot)
+}
@@ -0,0 +1,153 @@
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/go-zond/common/hexutil"
+	validatorServiceConfig "github.com/theQRL/qrysm/v4/config/validator/service"
+	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
+	http2 "github.com/theQRL/qrysm/v4/network/http"
+)
+
+// graffitiMaxLength is the maximum length in bytes of the graffiti of a beacon block.
+const graffitiMaxLength = 32
+
+// GetGraffiti returns the graffiti set for the public key in the proposer settings,
+// falling back to the graffiti of the default proposer settings.
+func (s *Server) GetGraffiti(w http.ResponseWriter, r *http.Request) {
+	if s.validatorService == nil {
+		http2.HandleError(w, "Validator service not ready", http.StatusServiceUnavailable)
+		return
+	}
+	rawPubkey, pubkey, ok := pubkeyFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	settings := s.validatorService.ProposerSettings()
+	if settings != nil && settings.ProposeConfig != nil {
+		option, found := settings.ProposeConfig[pubkey]
+		if found && option != nil && option.GraffitiConfig != nil {
+			http2.WriteJson(w, &GetGraffitiResponse{Data: &Graffiti{Pubkey: rawPubkey, Graffiti: option.GraffitiConfig.Graffiti}})
+			return
+		}
+	}
+	if settings != nil && settings.DefaultConfig != nil && settings.DefaultConfig.GraffitiConfig != nil {
+		http2.WriteJson(w, &GetGraffitiResponse{Data: &Graffiti{Pubkey: rawPubkey, Graffiti: settings.DefaultConfig.GraffitiConfig.Graffiti}})
+		return
+	}
+	http2.HandleError(w, fmt.Sprintf("No graffiti set for pubkey %s", rawPubkey), http.StatusNotFound)
+}
+
+// SetGraffiti sets the graffiti of the public key in the proposer settings. The graffiti takes precedence
+// over the graffiti given through the command line and the graffiti file for blocks proposed by the key.
+func (s *Server) SetGraffiti(w http.ResponseWriter, r *http.Request) {
+	if s.validatorService == nil {
+		http2.HandleError(w, "Validator service not ready", http.StatusServiceUnavailable)
+		return
+	}
+	_, pubkey, ok := pubkeyFromPath(w, r)
+	if !ok {
+		return
+	}
+	var req SetGraffitiRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case err == io.EOF:
+		http2.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		http2.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(req.Graffiti) > graffitiMaxLength {
+		http2.HandleError(w, fmt.Sprintf("Graffiti exceeds the maximum length of %d bytes", graffitiMaxLength), http.StatusBadRequest)
+		return
+	}
+
+	graffitiConfig := &validatorServiceConfig.GraffitiConfig{Graffiti: req.Graffiti}
+	settings := s.validatorService.ProposerSettings()
+	switch {
+	case settings == nil:
+		settings = &validatorServiceConfig.ProposerSettings{
+			ProposeConfig: map[[dilithium2.CryptoPublicKeyBytes]byte]*validatorServiceConfig.ProposerOption{
+				pubkey: {GraffitiConfig: graffitiConfig},
+			},
+		}
+	case settings.ProposeConfig == nil:
+		settings.ProposeConfig = map[[dilithium2.CryptoPublicKeyBytes]byte]*validatorServiceConfig.ProposerOption{
+			pubkey: newGraffitiProposerOption(settings.DefaultConfig, graffitiConfig),
+		}
+	default:
+		option, found := settings.ProposeConfig[pubkey]
+		if found && option != nil {
+			option.GraffitiConfig = graffitiConfig
+		} else {
+			settings.ProposeConfig[pubkey] = newGraffitiProposerOption(settings.DefaultConfig, graffitiConfig)
+		}
+	}
+	if err := s.validatorService.SetProposerSettings(r.Context(), settings); err != nil {
+		http2.HandleError(w, "Could not set proposer settings: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// DeleteGraffiti removes the graffiti of the public key from the proposer settings.
+func (s *Server) DeleteGraffiti(w http.ResponseWriter, r *http.Request) {
+	if s.validatorService == nil {
+		http2.HandleError(w, "Validator service not ready", http.StatusServiceUnavailable)
+		return
+	}
+	_, pubkey, ok := pubkeyFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	settings := s.validatorService.ProposerSettings()
+	if settings != nil && settings.ProposeConfig != nil {
+		option, found := settings.ProposeConfig[pubkey]
+		if found && option != nil && option.GraffitiConfig != nil {
+			option.GraffitiConfig = nil
+			if err := s.validatorService.SetProposerSettings(r.Context(), settings); err != nil {
+				http2.HandleError(w, "Could not set proposer settings: "+err.Error(), http.StatusInternalServerError)
+				return
+			}
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// newGraffitiProposerOption creates the proposer option of a key that has no proposer settings of its own,
+// keeping the builder settings of the default proposer settings.
+func newGraffitiProposerOption(defaultConfig *validatorServiceConfig.ProposerOption, graffitiConfig *validatorServiceConfig.GraffitiConfig) *validatorServiceConfig.ProposerOption {
+	option := &validatorServiceConfig.ProposerOption{GraffitiConfig: graffitiConfig}
+	if defaultConfig != nil && defaultConfig.BuilderConfig != nil {
+		option.BuilderConfig = defaultConfig.BuilderConfig.Clone()
+	}
+	return option
+}
+
+// pubkeyFromPath decodes the validator public key of the request path, writing an error response if it is invalid.
+func pubkeyFromPath(w http.ResponseWriter, r *http.Request) (string, [dilithium2.CryptoPublicKeyBytes]byte, bool) {
+	rawPubkey := mux.Vars(r)["pubkey"]
+	if rawPubkey == "" {
+		http2.HandleError(w, "pubkey is required in URL params", http.StatusBadRequest)
+		return "", [dilithium2.CryptoPublicKeyBytes]byte{}, false
+	}
+	pubkey, err := hexutil.Decode(rawPubkey)
+	if err != nil {
+		http2.HandleError(w, "pubkey is invalid: "+err.Error(), http.StatusBadRequest)
+		return "", [dilithium2.CryptoPublicKeyBytes]byte{}, false
+	}
+	if len(pubkey) != dilithium2.CryptoPublicKeyBytes {
+		http2.HandleError(w, fmt.Sprintf("pubkey is not byte length %d", dilithium2.CryptoPublicKeyBytes), http.StatusBadRequest)
+		return "", [dilithium2.CryptoPublicKeyBytes]byte{}, false
+	}
+	return rawPubkey, bytesutil.ToBytes2592(pubkey), true
+}
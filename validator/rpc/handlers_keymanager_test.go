@@ -0,0 +1,212 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/go-zond/common"
+	"github.com/theQRL/go-zond/common/hexutil"
+	validatorserviceconfig "github.com/theQRL/qrysm/v4/config/validator/service"
+	http2 "github.com/theQRL/qrysm/v4/network/http"
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+	mock "github.com/theQRL/qrysm/v4/validator/accounts/testing"
+	"github.com/theQRL/qrysm/v4/validator/client"
+	dbtest "github.com/theQRL/qrysm/v4/validator/db/testing"
+)
+
+func graffitiTestServer(t *testing.T, settings *validatorserviceconfig.ProposerSettings) *Server {
+	ctx := context.Background()
+	m := &mock.MockValidator{}
+	require.NoError(t, m.SetProposerSettings(ctx, settings))
+	validatorDB := dbtest.SetupDB(t, [][dilithium2.CryptoPublicKeyBytes]byte{})
+	vs, err := client.NewValidatorService(ctx, &client.Config{
+		Validator: m,
+		ValDB:     validatorDB,
+	})
+	require.NoError(t, err)
+	return &Server{
+		validatorService: vs,
+		valDB:            validatorDB,
+	}
+}
+
+func graffitiRequest(method string, pubkey string, body string) *http.Request {
+	var request *http.Request
+	if body == "" {
+		request = httptest.NewRequest(method, "http://foo.com/zond/v1/validator/{pubkey}/graffiti", nil)
+	} else {
+		request = httptest.NewRequest(method, "http://foo.com/zond/v1/validator/{pubkey}/graffiti", strings.NewReader(body))
+	}
+	return mux.SetURLVars(request, map[string]string{"pubkey": pubkey})
+}
+
+func TestServer_GetGraffiti(t *testing.T) {
+	pubkey := [dilithium2.CryptoPublicKeyBytes]byte{'a'}
+	otherPubkey := [dilithium2.CryptoPublicKeyBytes]byte{'b'}
+	settings := &validatorserviceconfig.ProposerSettings{
+		ProposeConfig: map[[dilithium2.CryptoPublicKeyBytes]byte]*validatorserviceconfig.ProposerOption{
+			pubkey: {
+				GraffitiConfig: &validatorserviceconfig.GraffitiConfig{Graffiti: "qrysm"},
+			},
+			otherPubkey: {
+				FeeRecipientConfig: &validatorserviceconfig.FeeRecipientConfig{
+					FeeRecipient: common.HexToAddress("0x055Fb65722E7b2455012BFEBf6177F1D2e9738D5"),
+				},
+			},
+		},
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		s := graffitiTestServer(t, settings)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetGraffiti(writer, graffitiRequest(http.MethodGet, hexutil.Encode(pubkey[:]), ""))
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &GetGraffitiResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, hexutil.Encode(pubkey[:]), resp.Data.Pubkey)
+		assert.Equal(t, "qrysm", resp.Data.Graffiti)
+	})
+	t.Run("default config", func(t *testing.T) {
+		withDefault := settings.Clone()
+		withDefault.DefaultConfig = &validatorserviceconfig.ProposerOption{
+			GraffitiConfig: &validatorserviceconfig.GraffitiConfig{Graffiti: "zond"},
+		}
+		s := graffitiTestServer(t, withDefault)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetGraffiti(writer, graffitiRequest(http.MethodGet, hexutil.Encode(otherPubkey[:]), ""))
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &GetGraffitiResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "zond", resp.Data.Graffiti)
+	})
+	t.Run("not found", func(t *testing.T) {
+		s := graffitiTestServer(t, settings)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetGraffiti(writer, graffitiRequest(http.MethodGet, hexutil.Encode(otherPubkey[:]), ""))
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+	t.Run("invalid pubkey", func(t *testing.T) {
+		s := graffitiTestServer(t, settings)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetGraffiti(writer, graffitiRequest(http.MethodGet, "0x1234", ""))
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "pubkey is not byte length", e.Message)
+	})
+	t.Run("validator service not ready", func(t *testing.T) {
+		s := &Server{}
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetGraffiti(writer, graffitiRequest(http.MethodGet, hexutil.Encode(pubkey[:]), ""))
+		assert.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+}
+
+func TestServer_SetGraffiti(t *testing.T) {
+	pubkey := [dilithium2.CryptoPublicKeyBytes]byte{'a'}
+	feeRecipient := common.HexToAddress("0x055Fb65722E7b2455012BFEBf6177F1D2e9738D5")
+
+	t.Run("no proposer settings", func(t *testing.T) {
+		s := graffitiTestServer(t, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SetGraffiti(writer, graffitiRequest(http.MethodPost, hexutil.Encode(pubkey[:]), `{"graffiti":"qrysm"}`))
+		require.Equal(t, http.StatusAccepted, writer.Code)
+		option := s.validatorService.ProposerSettings().ProposeConfig[pubkey]
+		require.NotNil(t, option)
+		assert.Equal(t, "qrysm", option.GraffitiConfig.Graffiti)
+	})
+	t.Run("existing proposer option", func(t *testing.T) {
+		s := graffitiTestServer(t, &validatorserviceconfig.ProposerSettings{
+			ProposeConfig: map[[dilithium2.CryptoPublicKeyBytes]byte]*validatorserviceconfig.ProposerOption{
+				pubkey: {
+					FeeRecipientConfig: &validatorserviceconfig.FeeRecipientConfig{FeeRecipient: feeRecipient},
+				},
+			},
+		})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SetGraffiti(writer, graffitiRequest(http.MethodPost, hexutil.Encode(pubkey[:]), `{"graffiti":"qrysm"}`))
+		require.Equal(t, http.StatusAccepted, writer.Code)
+		option := s.validatorService.ProposerSettings().ProposeConfig[pubkey]
+		assert.Equal(t, "qrysm", option.GraffitiConfig.Graffiti)
+		assert.Equal(t, feeRecipient, option.FeeRecipientConfig.FeeRecipient)
+	})
+	t.Run("keeps default builder config", func(t *testing.T) {
+		s := graffitiTestServer(t, &validatorserviceconfig.ProposerSettings{
+			DefaultConfig: &validatorserviceconfig.ProposerOption{
+				FeeRecipientConfig: &validatorserviceconfig.FeeRecipientConfig{FeeRecipient: feeRecipient},
+				BuilderConfig:      &validatorserviceconfig.BuilderConfig{Enabled: true, GasLimit: 30000000},
+			},
+		})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SetGraffiti(writer, graffitiRequest(http.MethodPost, hexutil.Encode(pubkey[:]), `{"graffiti":"qrysm"}`))
+		require.Equal(t, http.StatusAccepted, writer.Code)
+		option := s.validatorService.ProposerSettings().ProposeConfig[pubkey]
+		assert.Equal(t, "qrysm", option.GraffitiConfig.Graffiti)
+		assert.Equal(t, true, option.FeeRecipientConfig == nil)
+		assert.Equal(t, true, option.BuilderConfig.Enabled)
+	})
+	t.Run("graffiti too long", func(t *testing.T) {
+		s := graffitiTestServer(t, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SetGraffiti(writer, graffitiRequest(http.MethodPost, hexutil.Encode(pubkey[:]), `{"graffiti":"`+strings.Repeat("a", 33)+`"}`))
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Graffiti exceeds the maximum length", e.Message)
+	})
+	t.Run("no body", func(t *testing.T) {
+		s := graffitiTestServer(t, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SetGraffiti(writer, graffitiRequest(http.MethodPost, hexutil.Encode(pubkey[:]), ""))
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+func TestServer_DeleteGraffiti(t *testing.T) {
+	pubkey := [dilithium2.CryptoPublicKeyBytes]byte{'a'}
+	feeRecipient := common.HexToAddress("0x055Fb65722E7b2455012BFEBf6177F1D2e9738D5")
+	s := graffitiTestServer(t, &validatorserviceconfig.ProposerSettings{
+		ProposeConfig: map[[dilithium2.CryptoPublicKeyBytes]byte]*validatorserviceconfig.ProposerOption{
+			pubkey: {
+				FeeRecipientConfig: &validatorserviceconfig.FeeRecipientConfig{FeeRecipient: feeRecipient},
+				GraffitiConfig:     &validatorserviceconfig.GraffitiConfig{Graffiti: "qrysm"},
+			},
+		},
+	})
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.DeleteGraffiti(writer, graffitiRequest(http.MethodDelete, hexutil.Encode(pubkey[:]), ""))
+	require.Equal(t, http.StatusNoContent, writer.Code)
+	option := s.validatorService.ProposerSettings().ProposeConfig[pubkey]
+	assert.Equal(t, true, option.GraffitiConfig == nil)
+	assert.Equal(t, feeRecipient, option.FeeRecipientConfig.FeeRecipient)
+}
@@ -3,10 +3,12 @@ package rpc
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/sirupsen/logrus"
+	http2 "github.com/theQRL/qrysm/v4/network/http"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -55,6 +57,27 @@ func (s *Server) authorize(ctx context.Context) error {
 	return nil
 }
 
+// JWTHandler is an http middleware to authorize incoming requests to the http handlers of the server.
+func (s *Server) JWTHandler(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		authHeader := r.Header.Get("Authorization")
+		if authHeader == "" {
+			http2.HandleError(w, "Authorization token could not be found", http.StatusUnauthorized)
+			return
+		}
+		if !strings.Contains(authHeader, "Bearer ") {
+			http2.HandleError(w, "Invalid auth header, needs Bearer {token}", http.StatusUnauthorized)
+			return
+		}
+		token := strings.Split(authHeader, "Bearer ")[1]
+		if _, err := jwt.Parse(token, s.validateJWT); err != nil {
+			http2.HandleError(w, "Could not parse JWT token: "+err.Error(), http.StatusUnauthorized)
+			return
+		}
+		next(w, r)
+	}
+}
+
 func (s *Server) validateJWT(token *jwt.Token) (interface{}, error) {
 	if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 		return nil, fmt.Errorf("unexpected JWT signing method: %v", token.Header["alg"])
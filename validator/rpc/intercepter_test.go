@@ -2,6 +2,8 @@ package rpc
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/golang-jwt/jwt/v4"
@@ -67,3 +69,45 @@ func TestServer_JWTInterceptor_InvalidSigningType(t *testing.T) {
 	_, err := ss.validateJWT(token)
 	require.ErrorContains(t, "unexpected JWT signing method", err)
 }
+
+func TestServer_JWTHandler(t *testing.T) {
+	s := Server{
+		jwtSecret: []byte("testKey"),
+	}
+	called := false
+	handler := s.JWTHandler(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusOK)
+	})
+
+	t.Run("valid token", func(t *testing.T) {
+		called = false
+		token, err := createTokenString(s.jwtSecret)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodGet, "http://foo.com/zond/v1/validator/{pubkey}/graffiti", nil)
+		request.Header.Set("Authorization", "Bearer "+token)
+		writer := httptest.NewRecorder()
+		handler(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		require.Equal(t, true, called)
+	})
+	t.Run("bad token", func(t *testing.T) {
+		called = false
+		token, err := createTokenString([]byte("badTestKey"))
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodGet, "http://foo.com/zond/v1/validator/{pubkey}/graffiti", nil)
+		request.Header.Set("Authorization", "Bearer "+token)
+		writer := httptest.NewRecorder()
+		handler(writer, request)
+		require.Equal(t, http.StatusUnauthorized, writer.Code)
+		require.Equal(t, false, called)
+	})
+	t.Run("no token", func(t *testing.T) {
+		called = false
+		request := httptest.NewRequest(http.MethodGet, "http://foo.com/zond/v1/validator/{pubkey}/graffiti", nil)
+		writer := httptest.NewRecorder()
+		handler(writer, request)
+		require.Equal(t, http.StatusUnauthorized, writer.Code)
+		require.Equal(t, false, called)
+	})
+}
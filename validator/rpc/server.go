@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/http"
 	"path/filepath"
 	"time"
 
+	"github.com/gorilla/mux"
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpcopentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
@@ -15,9 +17,9 @@ import (
 	"github.com/theQRL/qrysm/v4/async/event"
 	"github.com/theQRL/qrysm/v4/io/logs"
 	"github.com/theQRL/qrysm/v4/monitoring/tracing"
-	zondpbservice "github.com/theQRL/qrysm/v4/proto/zond/service"
 	zondpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1"
 	validatorpb "github.com/theQRL/qrysm/v4/proto/prysm/v1alpha1/validator-client"
+	zondpbservice "github.com/theQRL/qrysm/v4/proto/zond/service"
 	"github.com/theQRL/qrysm/v4/validator/accounts/wallet"
 	"github.com/theQRL/qrysm/v4/validator/client"
 	iface "github.com/theQRL/qrysm/v4/validator/client/iface"
@@ -52,6 +54,7 @@ type Config struct {
 	WalletInitializedFeed    *event.Feed
 	NodeGatewayEndpoint      string
 	Wallet                   *wallet.Wallet
+	Router                   *mux.Router
 }
 
 // Server defining a gRPC server for the remote signer API.
@@ -93,12 +96,13 @@ type Server struct {
 	validatorGatewayPort      int
 	beaconApiEndpoint         string
 	beaconApiTimeout          time.Duration
+	router                    *mux.Router
 }
 
 // NewServer instantiates a new gRPC server.
 func NewServer(ctx context.Context, cfg *Config) *Server {
 	ctx, cancel := context.WithCancel(ctx)
-	return &Server{
+	server := &Server{
 		ctx:                      ctx,
 		cancel:                   cancel,
 		logsStreamer:             logs.NewStreamServer(),
@@ -126,7 +130,16 @@ func NewServer(ctx context.Context, cfg *Config) *Server {
 		validatorMonitoringPort:  cfg.ValidatorMonitoringPort,
 		validatorGatewayHost:     cfg.ValidatorGatewayHost,
 		validatorGatewayPort:     cfg.ValidatorGatewayPort,
+		router:                   cfg.Router,
+	}
+	// Register http handlers of the keymanager API served directly by the gateway. They must be registered
+	// before the gateway adds its catch-all route to the shared router, so they are not started with the server.
+	if server.router != nil {
+		server.router.HandleFunc("/zond/v1/validator/{pubkey}/graffiti", server.JWTHandler(server.GetGraffiti)).Methods(http.MethodGet)
+		server.router.HandleFunc("/zond/v1/validator/{pubkey}/graffiti", server.JWTHandler(server.SetGraffiti)).Methods(http.MethodPost)
+		server.router.HandleFunc("/zond/v1/validator/{pubkey}/graffiti", server.JWTHandler(server.DeleteGraffiti)).Methods(http.MethodDelete)
 	}
+	return server
 }
 
 // Start the gRPC server.
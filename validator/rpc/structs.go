@@ -0,0 +1,14 @@
+package rpc
+
+type GetGraffitiResponse struct {
+	Data *Graffiti `json:"data"`
+}
+
+type Graffiti struct {
+	Pubkey   string `json:"pubkey"`
+	Graffiti string `json:"graffiti"`
+}
+
+type SetGraffitiRequest struct {
+	Graffiti string `json:"graffiti"`
+}
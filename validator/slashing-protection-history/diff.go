@@ -0,0 +1,86 @@
+package history
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/qrysm/v4/validator/db"
+	"github.com/theQRL/qrysm/v4/validator/slashing-protection-history/format"
+)
+
+// ProtectionDiff is the highest signing history of a public key in an EIP-3076 compliant JSON file and in the
+// validator database. File, respectively DB, is nil if the public key is not in the file, respectively the database.
+type ProtectionDiff struct {
+	PubKey [dilithium2.CryptoPublicKeyBytes]byte
+	File   *ProtectionSummary
+	DB     *ProtectionSummary
+}
+
+// DiffStandardProtectionJSON compares the highest signing history of the public keys of an EIP-3076 compliant JSON file
+// with the one of the validator database, returning the public keys whose signing history differs, sorted by public key.
+// No difference means importing the file would not change the slashing protection of the validator database.
+func DiffStandardProtectionJSON(
+	ctx context.Context,
+	validatorDB db.Database,
+	interchangeJSON *format.EIPSlashingProtectionFormat,
+) ([]*ProtectionDiff, error) {
+	gvr, err := RootFromHex(interchangeJSON.Metadata.GenesisValidatorsRoot)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not a valid genesis validators root: %w", interchangeJSON.Metadata.GenesisValidatorsRoot, err)
+	}
+	dbGvr, err := validatorDB.GenesisValidatorsRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get genesis validators root from DB")
+	}
+	if dbGvr != nil && !bytes.Equal(dbGvr, gvr[:]) {
+		return nil, fmt.Errorf(
+			"genesis validators root %#x of the slashing protection JSON file does not match %#x of the validator database",
+			gvr,
+			dbGvr,
+		)
+	}
+
+	fileSummaries, err := summarizeProtectionData(interchangeJSON.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not summarize slashing protection JSON file")
+	}
+	dbData, err := exportProtectionData(ctx, validatorDB)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not export slashing protection history from DB")
+	}
+	dbSummaries, err := summarizeProtectionData(dbData)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not summarize slashing protection history from DB")
+	}
+
+	// A public key missing from the file or the database has the same slashing protection as a public key without signing history.
+	pubKeys := make(map[[dilithium2.CryptoPublicKeyBytes]byte]bool, len(fileSummaries))
+	for pubKey := range fileSummaries {
+		pubKeys[pubKey] = true
+	}
+	for pubKey := range dbSummaries {
+		pubKeys[pubKey] = true
+	}
+	diffs := make([]*ProtectionDiff, 0)
+	for pubKey := range pubKeys {
+		fileSummary, dbSummary := fileSummaries[pubKey], dbSummaries[pubKey]
+		if summaryOrEmpty(fileSummary) != summaryOrEmpty(dbSummary) {
+			diffs = append(diffs, &ProtectionDiff{PubKey: pubKey, File: fileSummary, DB: dbSummary})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		return bytes.Compare(diffs[i].PubKey[:], diffs[j].PubKey[:]) < 0
+	})
+	return diffs, nil
+}
+
+func summaryOrEmpty(summary *ProtectionSummary) ProtectionSummary {
+	if summary == nil {
+		return ProtectionSummary{}
+	}
+	return *summary
+}
@@ -0,0 +1,72 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+	dbtest "github.com/theQRL/qrysm/v4/validator/db/testing"
+	"github.com/theQRL/qrysm/v4/validator/slashing-protection-history/format"
+)
+
+func TestDiffStandardProtectionJSON(t *testing.T) {
+	ctx := context.Background()
+	pubKeys := [][dilithium2.CryptoPublicKeyBytes]byte{{1}, {2}, {3}}
+	validatorDB := dbtest.SetupDB(t, pubKeys)
+	gvr := [32]byte{1}
+	require.NoError(t, validatorDB.SaveGenesisValidatorsRoot(ctx, gvr[:]))
+	signingRoot := [32]byte{2}
+	for _, pubKey := range pubKeys[:2] {
+		require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, 10, signingRoot[:]))
+		require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKey, signingRoot, createAttestation(2, 3)))
+	}
+
+	interchangeJSON := interchangeWithData(
+		// Same history as in the database.
+		&format.ProtectionData{
+			Pubkey:             pubKeyHex(1),
+			SignedBlocks:       []*format.SignedBlock{{Slot: "10", SigningRoot: fmt.Sprintf("%#x", signingRoot)}},
+			SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "2", TargetEpoch: "3", SigningRoot: fmt.Sprintf("%#x", signingRoot)}},
+		},
+		// Higher history than in the database.
+		&format.ProtectionData{
+			Pubkey:             pubKeyHex(2),
+			SignedBlocks:       []*format.SignedBlock{{Slot: "12"}},
+			SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "2", TargetEpoch: "3", SigningRoot: fmt.Sprintf("%#x", signingRoot)}},
+		},
+		// Not in the database.
+		&format.ProtectionData{
+			Pubkey:       pubKeyHex(4),
+			SignedBlocks: []*format.SignedBlock{{Slot: "1"}},
+		},
+	)
+
+	diffs, err := DiffStandardProtectionJSON(ctx, validatorDB, interchangeJSON)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(diffs))
+
+	assert.Equal(t, pubKeys[1], diffs[0].PubKey)
+	require.NotNil(t, diffs[0].File)
+	require.NotNil(t, diffs[0].DB)
+	assert.Equal(t, true, diffs[0].File.SignedBlocks)
+	assert.Equal(t, uint64(12), uint64(diffs[0].File.HighestSlot))
+	assert.Equal(t, uint64(10), uint64(diffs[0].DB.HighestSlot))
+	assert.Equal(t, diffs[0].File.HighestTarget, diffs[0].DB.HighestTarget)
+
+	assert.Equal(t, [dilithium2.CryptoPublicKeyBytes]byte{4}, diffs[1].PubKey)
+	require.NotNil(t, diffs[1].File)
+	assert.Equal(t, true, diffs[1].DB == nil)
+}
+
+func TestDiffStandardProtectionJSON_DifferentChain(t *testing.T) {
+	ctx := context.Background()
+	validatorDB := dbtest.SetupDB(t, nil)
+	gvr := [32]byte{2}
+	require.NoError(t, validatorDB.SaveGenesisValidatorsRoot(ctx, gvr[:]))
+
+	_, err := DiffStandardProtectionJSON(ctx, validatorDB, interchangeWithData())
+	assert.ErrorContains(t, "does not match", err)
+}
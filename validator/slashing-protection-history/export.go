@@ -39,7 +39,19 @@ func ExportStandardProtectionJSON(
 	}
 	interchangeJSON.Metadata.GenesisValidatorsRoot = genesisRootHex
 	interchangeJSON.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+	interchangeJSON.Data, err = exportProtectionData(ctx, validatorDB, filteredKeys...)
+	if err != nil {
+		return nil, err
+	}
+	return interchangeJSON, nil
+}
 
+// exportProtectionData extracts the signing history of the public keys of the validator database, sorted by public key.
+func exportProtectionData(
+	ctx context.Context,
+	validatorDB db.Database,
+	filteredKeys ...[]byte,
+) ([]*format.ProtectionData, error) {
 	// Allow for filtering data for the keys we wish to export.
 	filteredKeysMap := make(map[string]bool, len(filteredKeys))
 	for _, k := range filteredKeys {
@@ -127,8 +139,7 @@ func ExportStandardProtectionJSON(
 	sort.Slice(dataList, func(i, j int) bool {
 		return strings.Compare(dataList[i].Pubkey, dataList[j].Pubkey) < 0
 	})
-	interchangeJSON.Data = dataList
-	return interchangeJSON, nil
+	return dataList, nil
 }
 
 func signedAttestationsByPubKey(ctx context.Context, validatorDB db.Database, pubKey [dilithium2.CryptoPublicKeyBytes]byte) ([]*format.SignedAttestation, error) {
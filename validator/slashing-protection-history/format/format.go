@@ -1,9 +1,20 @@
+// Package format defines the slashing protection interchange format of https://eips.ethereum.org/EIPS/eip-3076,
+// extended for Dilithium validator keys.
+//
+// The format is the one of the EIP, except for the public keys: the pubkey field of the protection data holds the
+// 0x-prefixed hex encoding of a 2592-byte Dilithium public key instead of a 48-byte BLS public key. Files holding
+// BLS public keys are rejected, as they cannot belong to a Zond validator.
 package format
 
+import dilithium2 "github.com/theQRL/go-qrllib/dilithium"
+
 // InterchangeFormatVersion specified by https://eips.ethereum.org/EIPS/eip-3076.
 // The version Prysm supports is version 5.
 const InterchangeFormatVersion = "5"
 
+// PubKeyLength is the byte length of the public keys of the interchange format, the length of a Dilithium public key.
+const PubKeyLength = dilithium2.CryptoPublicKeyBytes
+
 // EIPSlashingProtectionFormat string representation of a standard
 // format for representing validator slashing protection db data.
 type EIPSlashingProtectionFormat struct {
@@ -14,7 +25,8 @@ type EIPSlashingProtectionFormat struct {
 	Data []*ProtectionData `json:"data"`
 }
 
-// ProtectionData field for the standard slashing protection format.
+// ProtectionData field for the standard slashing protection format. Pubkey is a hex encoded
+// Dilithium public key of PubKeyLength bytes.
 type ProtectionData struct {
 	Pubkey             string               `json:"pubkey"`
 	SignedBlocks       []*SignedBlock       `json:"signed_blocks"`
@@ -1,9 +1,11 @@
 package history
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math"
 	"reflect"
+	"strings"
 	"testing"
 
 	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
@@ -85,6 +87,11 @@ func Test_uint64FromString(t *testing.T) {
 }
 
 func Test_pubKeyFromHex(t *testing.T) {
+	var pubKey [dilithium2.CryptoPublicKeyBytes]byte
+	for i := range pubKey {
+		pubKey[i] = byte(i)
+	}
+	pubKeyHex := hex.EncodeToString(pubKey[:])
 	tests := []struct {
 		name    string
 		str     string
@@ -108,19 +115,24 @@ func Test_pubKeyFromHex(t *testing.T) {
 		},
 		{
 			name: "Works with 0x prefix and good public key",
-			str:  "0xb845089a1457f811bfc000588fbb4e713669be8ce060ea6be3c6ece09afc3794106c91ca73acda5e5457122d58723bed",
-			want: [dilithium2.CryptoPublicKeyBytes]byte{184, 69, 8, 154, 20, 87, 248, 17, 191, 192, 0, 88, 143, 187, 78, 113, 54, 105, 190, 140, 224, 96, 234, 107, 227, 198, 236, 224, 154, 252, 55, 148, 16, 108, 145, 202, 115, 172, 218, 94, 84, 87, 18, 45, 88, 114, 59, 237},
+			str:  "0x" + pubKeyHex,
+			want: pubKey,
 		},
 		{
 			name: "Works without 0x prefix and good public key",
-			str:  "b845089a1457f811bfc000588fbb4e713669be8ce060ea6be3c6ece09afc3794106c91ca73acda5e5457122d58723bed",
-			want: [dilithium2.CryptoPublicKeyBytes]byte{184, 69, 8, 154, 20, 87, 248, 17, 191, 192, 0, 88, 143, 187, 78, 113, 54, 105, 190, 140, 224, 96, 234, 107, 227, 198, 236, 224, 154, 252, 55, 148, 16, 108, 145, 202, 115, 172, 218, 94, 84, 87, 18, 45, 88, 114, 59, 237},
+			str:  pubKeyHex,
+			want: pubKey,
 		},
 		{
 			name:    "0x prefix and wrong length public key fails",
 			str:     "0xb845089a1457f811bfc000588fbb4e713669be8",
 			wantErr: true,
 		},
+		{
+			name:    "BLS sized public key fails",
+			str:     "0xb845089a1457f811bfc000588fbb4e713669be8ce060ea6be3c6ece09afc3794106c91ca73acda5e5457122d58723bed",
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -264,7 +276,7 @@ func Test_pubKeyToHexString(t *testing.T) {
 		{
 			name:    "non-empty pubkey with correct size returns expected value",
 			pubKey:  mockPubKey[:],
-			want:    "0x010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+			want:    "0x01" + strings.Repeat("00", dilithium2.CryptoPublicKeyBytes-1),
 			wantErr: false,
 		},
 	}
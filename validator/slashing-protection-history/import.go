@@ -23,13 +23,9 @@ import (
 // protection in the validator client's database. For more information, see the EIP document here:
 // https://eips.ethereum.org/EIPS/eip-3076.
 func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database, r io.Reader) error {
-	encodedJSON, err := io.ReadAll(r)
+	interchangeJSON, err := ParseStandardProtectionJSON(r)
 	if err != nil {
-		return errors.Wrap(err, "could not read slashing protection JSON file")
-	}
-	interchangeJSON := &format.EIPSlashingProtectionFormat{}
-	if err := json.Unmarshal(encodedJSON, interchangeJSON); err != nil {
-		return errors.Wrap(err, "could not unmarshal slashing protection JSON file")
+		return err
 	}
 	if interchangeJSON.Data == nil {
 		log.Warn("No slashing protection data to import")
@@ -137,6 +133,19 @@ func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database,
 	return nil
 }
 
+// ParseStandardProtectionJSON reads and unmarshals an EIP-3076 compliant JSON file.
+func ParseStandardProtectionJSON(r io.Reader) (*format.EIPSlashingProtectionFormat, error) {
+	encodedJSON, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read slashing protection JSON file")
+	}
+	interchangeJSON := &format.EIPSlashingProtectionFormat{}
+	if err := json.Unmarshal(encodedJSON, interchangeJSON); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal slashing protection JSON file")
+	}
+	return interchangeJSON, nil
+}
+
 func validateMetadata(ctx context.Context, validatorDB db.Database, interchangeJSON *format.EIPSlashingProtectionFormat) error {
 	// We need to ensure the version in the metadata field matches the one we support.
 	version := interchangeJSON.Metadata.InterchangeFormatVersion
@@ -190,7 +199,7 @@ func validateMetadata(ctx context.Context, validatorDB db.Database, interchangeJ
 func parseBlocksForUniquePublicKeys(data []*format.ProtectionData) (map[[dilithium2.CryptoPublicKeyBytes]byte][]*format.SignedBlock, error) {
 	signedBlocksByPubKey := make(map[[dilithium2.CryptoPublicKeyBytes]byte][]*format.SignedBlock)
 	for _, validatorData := range data {
-		pubKey, err := DilithiumPubKeyFromHex(validatorData.Pubkey)
+		pubKey, err := PubKeyFromHex(validatorData.Pubkey)
 		if err != nil {
 			return nil, fmt.Errorf("%s is not a valid public key: %w", validatorData.Pubkey, err)
 		}
@@ -223,7 +232,7 @@ func parseBlocksForUniquePublicKeys(data []*format.ProtectionData) (map[[dilithi
 func parseAttestationsForUniquePublicKeys(data []*format.ProtectionData) (map[[dilithium2.CryptoPublicKeyBytes]byte][]*format.SignedAttestation, error) {
 	signedAttestationsByPubKey := make(map[[dilithium2.CryptoPublicKeyBytes]byte][]*format.SignedAttestation)
 	for _, validatorData := range data {
-		pubKey, err := DilithiumPubKeyFromHex(validatorData.Pubkey)
+		pubKey, err := PubKeyFromHex(validatorData.Pubkey)
 		if err != nil {
 			return nil, fmt.Errorf("%s is not a valid public key: %w", validatorData.Pubkey, err)
 		}
@@ -265,9 +274,33 @@ func filterSlashablePubKeysFromAttestations(
 	validatorDB db.Database,
 	signedAttsByPubKey map[[dilithium2.CryptoPublicKeyBytes]byte][]*kv.AttestationRecord,
 ) ([][dilithium2.CryptoPublicKeyBytes]byte, error) {
-	slashablePubKeys := make([][dilithium2.CryptoPublicKeyBytes]byte, 0)
 	// First we need to find attestations that are slashable with respect to other
 	// attestations within the same JSON import.
+	slashablePubKeys := filterSlashablePubKeysWithinAttestations(signedAttsByPubKey)
+	// Then, we need to find attestations that are slashable with respect to our database.
+	for pubKey, signedAtts := range signedAttsByPubKey {
+		for _, att := range signedAtts {
+			indexedAtt := createAttestation(att.Source, att.Target)
+			slashable, err := validatorDB.CheckSlashableAttestation(ctx, pubKey, att.SigningRoot, indexedAtt)
+			if err != nil {
+				return nil, err
+			}
+			// Malformed data should not prevent us from completing this function.
+			if slashable != kv.NotSlashable {
+				slashablePubKeys = append(slashablePubKeys, pubKey)
+				break
+			}
+		}
+	}
+	return slashablePubKeys, nil
+}
+
+// filterSlashablePubKeysWithinAttestations returns the public keys with double votes or surround votes
+// between their own signed attestations.
+func filterSlashablePubKeysWithinAttestations(
+	signedAttsByPubKey map[[dilithium2.CryptoPublicKeyBytes]byte][]*kv.AttestationRecord,
+) [][dilithium2.CryptoPublicKeyBytes]byte {
+	slashablePubKeys := make([][dilithium2.CryptoPublicKeyBytes]byte, 0)
 	for pubKey, signedAtts := range signedAttsByPubKey {
 		signingRootsByTarget := make(map[primitives.Epoch][32]byte)
 		targetEpochsBySource := make(map[primitives.Epoch][]primitives.Epoch)
@@ -295,22 +328,7 @@ func filterSlashablePubKeysFromAttestations(
 			targetEpochsBySource[att.Source] = append(targetEpochsBySource[att.Source], att.Target)
 		}
 	}
-	// Then, we need to find attestations that are slashable with respect to our database.
-	for pubKey, signedAtts := range signedAttsByPubKey {
-		for _, att := range signedAtts {
-			indexedAtt := createAttestation(att.Source, att.Target)
-			slashable, err := validatorDB.CheckSlashableAttestation(ctx, pubKey, att.SigningRoot, indexedAtt)
-			if err != nil {
-				return nil, err
-			}
-			// Malformed data should not prevent us from completing this function.
-			if slashable != kv.NotSlashable {
-				slashablePubKeys = append(slashablePubKeys, pubKey)
-				break
-			}
-		}
-	}
-	return slashablePubKeys, nil
+	return slashablePubKeys
 }
 
 func transformSignedBlocks(_ context.Context, signedBlocks []*format.SignedBlock) (*kv.ProposalHistoryForPubkey, error) {
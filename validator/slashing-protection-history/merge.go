@@ -0,0 +1,196 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/qrysm/v4/config/params"
+	"github.com/theQRL/qrysm/v4/consensus-types/primitives"
+	"github.com/theQRL/qrysm/v4/validator/slashing-protection-history/format"
+)
+
+// ProtectionSummary is the highest signing history of a public key, which is all that is needed to protect it
+// from being slashed according to the minimal strategy of EIP-3076.
+type ProtectionSummary struct {
+	// SignedBlocks is false if the public key never signed a block.
+	SignedBlocks    bool
+	HighestSlot     primitives.Slot
+	SlotSigningRoot string
+	// SignedAttestations is false if the public key never signed an attestation.
+	SignedAttestations bool
+	HighestSource      primitives.Epoch
+	HighestTarget      primitives.Epoch
+	TargetSigningRoot  string
+}
+
+// String describes the highest signing history.
+func (s *ProtectionSummary) String() string {
+	blocks := "no signed blocks"
+	if s.SignedBlocks {
+		blocks = fmt.Sprintf("highest signed slot %d", s.HighestSlot)
+	}
+	attestations := "no signed attestations"
+	if s.SignedAttestations {
+		attestations = fmt.Sprintf("highest signed source epoch %d and target epoch %d", s.HighestSource, s.HighestTarget)
+	}
+	return blocks + ", " + attestations
+}
+
+// MergeStandardProtectionJSON merges EIP-3076 compliant JSON files of the same chain into a single file. For each
+// public key, the merged file only holds the highest slot of the signed blocks and the highest source and target epochs
+// of the signed attestations across all files, as in the minimal strategy of EIP-3076. Importing the merged file therefore
+// refuses to sign anything that importing any of the files would refuse to sign.
+func MergeStandardProtectionJSON(
+	ctx context.Context,
+	interchangeJSONs ...*format.EIPSlashingProtectionFormat,
+) (*format.EIPSlashingProtectionFormat, error) {
+	if len(interchangeJSONs) == 0 {
+		return nil, errors.New("no slashing protection JSON files to merge")
+	}
+	merged := &format.EIPSlashingProtectionFormat{}
+	merged.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+	merged.Metadata.GenesisValidatorsRoot = interchangeJSONs[0].Metadata.GenesisValidatorsRoot
+
+	var data []*format.ProtectionData
+	for i, interchangeJSON := range interchangeJSONs {
+		if problems := ValidateStandardProtectionJSON(ctx, interchangeJSON); len(problems) > 0 {
+			return nil, errors.Wrapf(problems[0], "slashing protection JSON file %d is not valid", i)
+		}
+		if !strings.EqualFold(interchangeJSON.Metadata.GenesisValidatorsRoot, merged.Metadata.GenesisValidatorsRoot) {
+			return nil, fmt.Errorf(
+				"slashing protection JSON file %d has genesis validators root %s, which does not match %s of the first file",
+				i,
+				interchangeJSON.Metadata.GenesisValidatorsRoot,
+				merged.Metadata.GenesisValidatorsRoot,
+			)
+		}
+		data = append(data, interchangeJSON.Data...)
+	}
+
+	summaries, err := summarizeProtectionData(data)
+	if err != nil {
+		return nil, err
+	}
+	merged.Data = make([]*format.ProtectionData, 0, len(summaries))
+	for pubKey, summary := range summaries {
+		pubKeyHex, err := pubKeyToHexString(pubKey[:])
+		if err != nil {
+			return nil, errors.Wrap(err, "could not convert public key to hex string")
+		}
+		item := &format.ProtectionData{
+			Pubkey:             pubKeyHex,
+			SignedBlocks:       make([]*format.SignedBlock, 0),
+			SignedAttestations: make([]*format.SignedAttestation, 0),
+		}
+		if summary.SignedBlocks {
+			item.SignedBlocks = append(item.SignedBlocks, &format.SignedBlock{
+				Slot:        fmt.Sprintf("%d", summary.HighestSlot),
+				SigningRoot: summary.SlotSigningRoot,
+			})
+		}
+		if summary.SignedAttestations {
+			item.SignedAttestations = append(item.SignedAttestations, &format.SignedAttestation{
+				SourceEpoch: fmt.Sprintf("%d", summary.HighestSource),
+				TargetEpoch: fmt.Sprintf("%d", summary.HighestTarget),
+				SigningRoot: summary.TargetSigningRoot,
+			})
+		}
+		merged.Data = append(merged.Data, item)
+	}
+	sort.Slice(merged.Data, func(i, j int) bool {
+		return strings.Compare(merged.Data[i].Pubkey, merged.Data[j].Pubkey) < 0
+	})
+	return merged, nil
+}
+
+// summarizeProtectionData returns the highest signing history of each public key of the protection data.
+// A signing root is only kept if every block, respectively attestation, signed at the highest slot, respectively
+// at the highest source and target epochs, has the same signing root. Otherwise, the signing root is left empty,
+// which refuses to sign again at that slot or target epoch.
+func summarizeProtectionData(data []*format.ProtectionData) (map[[dilithium2.CryptoPublicKeyBytes]byte]*ProtectionSummary, error) {
+	signedBlocksByPubKey, err := parseBlocksForUniquePublicKeys(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse unique entries for blocks by public key")
+	}
+	signedAttsByPubKey, err := parseAttestationsForUniquePublicKeys(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse unique entries for attestations by public key")
+	}
+
+	summaries := make(map[[dilithium2.CryptoPublicKeyBytes]byte]*ProtectionSummary)
+	summary := func(pubKey [dilithium2.CryptoPublicKeyBytes]byte) *ProtectionSummary {
+		if _, ok := summaries[pubKey]; !ok {
+			summaries[pubKey] = &ProtectionSummary{}
+		}
+		return summaries[pubKey]
+	}
+	for _, validatorData := range data {
+		// Public keys without any signing history are kept, as an empty history is a history too.
+		pubKey, err := PubKeyFromHex(validatorData.Pubkey)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid public key: %w", validatorData.Pubkey, err)
+		}
+		summary(pubKey)
+	}
+	for pubKey, signedBlocks := range signedBlocksByPubKey {
+		s := summary(pubKey)
+		for _, sBlock := range signedBlocks {
+			slot, err := SlotFromString(sBlock.Slot)
+			if err != nil {
+				return nil, fmt.Errorf("%s is not a valid slot: %w", sBlock.Slot, err)
+			}
+			root := normalizedRoot(sBlock.SigningRoot)
+			switch {
+			case !s.SignedBlocks || slot > s.HighestSlot:
+				s.SignedBlocks, s.HighestSlot, s.SlotSigningRoot = true, slot, root
+			case slot == s.HighestSlot && root != s.SlotSigningRoot:
+				s.SlotSigningRoot = ""
+			}
+		}
+	}
+	for pubKey, signedAtts := range signedAttsByPubKey {
+		s := summary(pubKey)
+		// The source epoch of the attestations at the highest target epoch, as the signing root only belongs
+		// to the highest source and target epochs if it is the highest source epoch too.
+		var targetSource primitives.Epoch
+		for _, sAtt := range signedAtts {
+			source, err := EpochFromString(sAtt.SourceEpoch)
+			if err != nil {
+				return nil, fmt.Errorf("%s is not a valid epoch: %w", sAtt.SourceEpoch, err)
+			}
+			target, err := EpochFromString(sAtt.TargetEpoch)
+			if err != nil {
+				return nil, fmt.Errorf("%s is not a valid epoch: %w", sAtt.TargetEpoch, err)
+			}
+			root := normalizedRoot(sAtt.SigningRoot)
+			if !s.SignedAttestations || source > s.HighestSource {
+				s.HighestSource = source
+			}
+			switch {
+			case !s.SignedAttestations || target > s.HighestTarget:
+				s.HighestTarget, s.TargetSigningRoot, targetSource = target, root, source
+			case target == s.HighestTarget && (root != s.TargetSigningRoot || source != targetSource):
+				s.TargetSigningRoot = ""
+			}
+			s.SignedAttestations = true
+		}
+		if targetSource != s.HighestSource {
+			s.TargetSigningRoot = ""
+		}
+	}
+	return summaries, nil
+}
+
+// normalizedRoot returns the lower case hex signing root, or an empty string for a missing or zero signing root,
+// so that signing roots from JSON files and from the validator database compare equal.
+func normalizedRoot(root string) string {
+	root = strings.ToLower(root)
+	if root == fmt.Sprintf("%#x", params.BeaconConfig().ZeroHash) {
+		return ""
+	}
+	return root
+}
@@ -0,0 +1,89 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+	"github.com/theQRL/qrysm/v4/validator/slashing-protection-history/format"
+)
+
+func TestMergeStandardProtectionJSON(t *testing.T) {
+	ctx := context.Background()
+	root1 := fmt.Sprintf("%#x", [32]byte{1})
+	root2 := fmt.Sprintf("%#x", [32]byte{2})
+	first := interchangeWithData(
+		&format.ProtectionData{
+			Pubkey:       pubKeyHex(1),
+			SignedBlocks: []*format.SignedBlock{{Slot: "10", SigningRoot: root1}, {Slot: "12", SigningRoot: root1}},
+			SignedAttestations: []*format.SignedAttestation{
+				{SourceEpoch: "1", TargetEpoch: "2"},
+				{SourceEpoch: "2", TargetEpoch: "3", SigningRoot: root1},
+			},
+		},
+		&format.ProtectionData{
+			Pubkey:             pubKeyHex(2),
+			SignedBlocks:       []*format.SignedBlock{{Slot: "7", SigningRoot: root1}},
+			SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "5", TargetEpoch: "6", SigningRoot: root1}},
+		},
+	)
+	second := interchangeWithData(
+		&format.ProtectionData{
+			Pubkey:             pubKeyHex(1),
+			SignedBlocks:       []*format.SignedBlock{{Slot: "11", SigningRoot: root2}},
+			SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "2", TargetEpoch: "3", SigningRoot: root1}},
+		},
+		&format.ProtectionData{
+			Pubkey:             pubKeyHex(2),
+			SignedBlocks:       []*format.SignedBlock{{Slot: "7", SigningRoot: root2}},
+			SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "4", TargetEpoch: "8", SigningRoot: root2}},
+		},
+		&format.ProtectionData{
+			Pubkey: pubKeyHex(3),
+		},
+	)
+
+	merged, err := MergeStandardProtectionJSON(ctx, first, second)
+	require.NoError(t, err)
+	assert.DeepEqual(t, first.Metadata, merged.Metadata)
+	want := []*format.ProtectionData{
+		{
+			Pubkey: pubKeyHex(1),
+			// The highest slot and the highest attestation are kept with their signing roots.
+			SignedBlocks:       []*format.SignedBlock{{Slot: "12", SigningRoot: root1}},
+			SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "2", TargetEpoch: "3", SigningRoot: root1}},
+		},
+		{
+			Pubkey: pubKeyHex(2),
+			// Different blocks at the highest slot drop the signing root, as does an attestation at the highest target
+			// epoch without the highest source epoch.
+			SignedBlocks:       []*format.SignedBlock{{Slot: "7"}},
+			SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "5", TargetEpoch: "8"}},
+		},
+		{
+			Pubkey:             pubKeyHex(3),
+			SignedBlocks:       []*format.SignedBlock{},
+			SignedAttestations: []*format.SignedAttestation{},
+		},
+	}
+	assert.DeepEqual(t, want, merged.Data)
+	assert.Equal(t, 0, len(ValidateStandardProtectionJSON(ctx, merged)))
+}
+
+func TestMergeStandardProtectionJSON_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := MergeStandardProtectionJSON(ctx)
+	assert.ErrorContains(t, "no slashing protection JSON files to merge", err)
+
+	otherChain := interchangeWithData()
+	otherChain.Metadata.GenesisValidatorsRoot = fmt.Sprintf("%#x", [32]byte{2})
+	_, err = MergeStandardProtectionJSON(ctx, interchangeWithData(), otherChain)
+	assert.ErrorContains(t, "slashing protection JSON file 1 has genesis validators root", err)
+
+	invalid := interchangeWithData(&format.ProtectionData{Pubkey: "0x01"})
+	_, err = MergeStandardProtectionJSON(ctx, interchangeWithData(), invalid)
+	assert.ErrorContains(t, "slashing protection JSON file 1 is not valid", err)
+}
@@ -0,0 +1,93 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/qrysm/v4/encoding/bytesutil"
+	"github.com/theQRL/qrysm/v4/validator/db/kv"
+	"github.com/theQRL/qrysm/v4/validator/slashing-protection-history/format"
+)
+
+// ValidateStandardProtectionJSON checks an EIP-3076 compliant JSON file is well formed for Dilithium public keys
+// and does not hold slashable signing history, such as two different blocks signed at the same slot or attestations
+// surrounding each other. Unlike an import, which skips the slashable public keys, all the problems found are returned
+// so that they can be fixed at once. An empty list means the file is valid.
+func ValidateStandardProtectionJSON(ctx context.Context, interchangeJSON *format.EIPSlashingProtectionFormat) []error {
+	var problems []error
+	version := interchangeJSON.Metadata.InterchangeFormatVersion
+	if version != format.InterchangeFormatVersion {
+		problems = append(problems, fmt.Errorf(
+			"slashing protection JSON version '%s' is not supported, wanted '%s'",
+			version,
+			format.InterchangeFormatVersion,
+		))
+	}
+	if _, err := RootFromHex(interchangeJSON.Metadata.GenesisValidatorsRoot); err != nil {
+		problems = append(problems, fmt.Errorf("%s is not a valid genesis validators root: %w", interchangeJSON.Metadata.GenesisValidatorsRoot, err))
+	}
+
+	proposalHistoryByPubKey := make(map[[dilithium2.CryptoPublicKeyBytes]byte]kv.ProposalHistoryForPubkey)
+	attestingHistoryByPubKey := make(map[[dilithium2.CryptoPublicKeyBytes]byte][]*kv.AttestationRecord)
+	for i, validatorData := range interchangeJSON.Data {
+		if validatorData == nil {
+			problems = append(problems, fmt.Errorf("data entry %d is empty", i))
+			continue
+		}
+		pubKey, err := PubKeyFromHex(validatorData.Pubkey)
+		if err != nil {
+			problems = append(problems, fmt.Errorf("data entry %d: %w", i, err))
+			continue
+		}
+		signedBlocks := make([]*format.SignedBlock, 0, len(validatorData.SignedBlocks))
+		for _, sBlock := range validatorData.SignedBlocks {
+			if sBlock != nil {
+				signedBlocks = append(signedBlocks, sBlock)
+			}
+		}
+		proposalHistory, err := transformSignedBlocks(ctx, signedBlocks)
+		if err != nil {
+			problems = append(problems, fmt.Errorf("data entry %d: invalid signed block for key %#x: %w", i, bytesutil.Trunc(pubKey[:]), err))
+		} else {
+			history := proposalHistoryByPubKey[pubKey]
+			history.Proposals = append(history.Proposals, proposalHistory.Proposals...)
+			proposalHistoryByPubKey[pubKey] = history
+		}
+
+		signedAtts := make([]*format.SignedAttestation, 0, len(validatorData.SignedAttestations))
+		for _, sAtt := range validatorData.SignedAttestations {
+			if sAtt != nil {
+				signedAtts = append(signedAtts, sAtt)
+			}
+		}
+		attestingHistory, err := transformSignedAttestations(pubKey, signedAtts)
+		if err != nil {
+			problems = append(problems, fmt.Errorf("data entry %d: invalid signed attestation for key %#x: %w", i, bytesutil.Trunc(pubKey[:]), err))
+			continue
+		}
+		for _, att := range attestingHistory {
+			if att.Source > att.Target {
+				problems = append(problems, fmt.Errorf(
+					"data entry %d: signed attestation for key %#x has source epoch %d greater than its target epoch %d",
+					i, bytesutil.Trunc(pubKey[:]), att.Source, att.Target,
+				))
+			}
+		}
+		attestingHistoryByPubKey[pubKey] = append(attestingHistoryByPubKey[pubKey], attestingHistory...)
+	}
+
+	var slashableProblems []error
+	for _, pubKey := range filterSlashablePubKeysFromBlocks(ctx, proposalHistoryByPubKey) {
+		slashableProblems = append(slashableProblems, fmt.Errorf("key %#x signed different blocks at the same slot", bytesutil.Trunc(pubKey[:])))
+	}
+	for _, pubKey := range filterSlashablePubKeysWithinAttestations(attestingHistoryByPubKey) {
+		slashableProblems = append(slashableProblems, fmt.Errorf("key %#x signed double or surrounding attestations", bytesutil.Trunc(pubKey[:])))
+	}
+	// The public keys come from maps, they are sorted for the report to be stable.
+	sort.Slice(slashableProblems, func(i, j int) bool {
+		return slashableProblems[i].Error() < slashableProblems[j].Error()
+	})
+	return append(problems, slashableProblems...)
+}
@@ -0,0 +1,111 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	dilithium2 "github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/qrysm/v4/testing/assert"
+	"github.com/theQRL/qrysm/v4/testing/require"
+	"github.com/theQRL/qrysm/v4/validator/slashing-protection-history/format"
+)
+
+func interchangeWithData(data ...*format.ProtectionData) *format.EIPSlashingProtectionFormat {
+	interchangeJSON := &format.EIPSlashingProtectionFormat{Data: data}
+	interchangeJSON.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+	interchangeJSON.Metadata.GenesisValidatorsRoot = fmt.Sprintf("%#x", [32]byte{1})
+	return interchangeJSON
+}
+
+func pubKeyHex(b byte) string {
+	return fmt.Sprintf("%#x", [dilithium2.CryptoPublicKeyBytes]byte{b})
+}
+
+func TestValidateStandardProtectionJSON(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name            string
+		interchangeJSON *format.EIPSlashingProtectionFormat
+		wantProblems    []string
+	}{
+		{
+			name: "valid",
+			interchangeJSON: interchangeWithData(&format.ProtectionData{
+				Pubkey:       pubKeyHex(1),
+				SignedBlocks: []*format.SignedBlock{{Slot: "1"}, {Slot: "2", SigningRoot: fmt.Sprintf("%#x", [32]byte{2})}},
+				SignedAttestations: []*format.SignedAttestation{
+					{SourceEpoch: "0", TargetEpoch: "1"},
+					{SourceEpoch: "1", TargetEpoch: "2"},
+				},
+			}),
+		},
+		{
+			name: "bad metadata",
+			interchangeJSON: func() *format.EIPSlashingProtectionFormat {
+				interchangeJSON := interchangeWithData()
+				interchangeJSON.Metadata.InterchangeFormatVersion = "4"
+				interchangeJSON.Metadata.GenesisValidatorsRoot = "0x01"
+				return interchangeJSON
+			}(),
+			wantProblems: []string{"version '4' is not supported", "0x01 is not a valid genesis validators root"},
+		},
+		{
+			name: "BLS public key",
+			interchangeJSON: interchangeWithData(&format.ProtectionData{
+				Pubkey: "0xb845089a1457f811bfc000588fbb4e713669be8ce060ea6be3c6ece09afc3794106c91ca73acda5e5457122d58723bed",
+			}),
+			wantProblems: []string{"data entry 0: public key is not correct"},
+		},
+		{
+			name: "bad slot, epoch and source after target",
+			interchangeJSON: interchangeWithData(
+				&format.ProtectionData{
+					Pubkey:       pubKeyHex(1),
+					SignedBlocks: []*format.SignedBlock{{Slot: "abc"}},
+				},
+				&format.ProtectionData{
+					Pubkey:             pubKeyHex(2),
+					SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "x", TargetEpoch: "1"}},
+				},
+				&format.ProtectionData{
+					Pubkey:             pubKeyHex(3),
+					SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "2", TargetEpoch: "1"}},
+				},
+			),
+			wantProblems: []string{
+				"data entry 0: invalid signed block",
+				"data entry 1: invalid signed attestation",
+				"data entry 2: signed attestation for key 0x030000000000 has source epoch 2 greater than its target epoch 1",
+			},
+		},
+		{
+			name: "slashable history across entries of the same key",
+			interchangeJSON: interchangeWithData(
+				&format.ProtectionData{
+					Pubkey:             pubKeyHex(1),
+					SignedBlocks:       []*format.SignedBlock{{Slot: "5", SigningRoot: fmt.Sprintf("%#x", [32]byte{1})}},
+					SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "1", TargetEpoch: "4"}},
+				},
+				&format.ProtectionData{
+					Pubkey:             pubKeyHex(1),
+					SignedBlocks:       []*format.SignedBlock{{Slot: "5", SigningRoot: fmt.Sprintf("%#x", [32]byte{2})}},
+					SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "2", TargetEpoch: "3"}},
+				},
+			),
+			wantProblems: []string{
+				"key 0x010000000000 signed different blocks at the same slot",
+				"key 0x010000000000 signed double or surrounding attestations",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problems := ValidateStandardProtectionJSON(ctx, tt.interchangeJSON)
+			require.Equal(t, len(tt.wantProblems), len(problems), fmt.Sprintf("%v", problems))
+			for i, want := range tt.wantProblems {
+				assert.ErrorContains(t, want, problems[i])
+			}
+		})
+	}
+}